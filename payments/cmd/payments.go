@@ -6,7 +6,9 @@ payment-service
 package main
 
 import (
+	"errors"
 	"log/slog"
+	"net/http"
 	"os"
 
 	"github.com/spf13/viper"
@@ -31,6 +33,41 @@ func main() {
 		}
 	}()
 
+	// Relay integration events from the outbox
+	if service.OutboxRelay != nil {
+		go func() {
+			_ = service.OutboxRelay.Run(service.Context)
+		}()
+	}
+
+	// Expire abandoned payments, SCA timeouts and released authorizations
+	if service.DeadlineScheduler != nil {
+		go func() {
+			_ = service.DeadlineScheduler.Run(service.Context)
+		}()
+	}
+
+	// Catch stale payments up with the provider when a webhook was missed
+	if service.Reconciler != nil {
+		go func() {
+			_ = service.Reconciler.Run(service.Context)
+		}()
+	}
+
+	// Receive provider webhooks
+	if service.WebhookServer != nil {
+		go func() {
+			if err := service.WebhookServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				service.Log.Error("webhook server stopped", slog.Any("error", err))
+			}
+		}()
+	}
+
+	// Serve payments.v1.PaymentService
+	if service.RPCServer != nil {
+		go service.RPCServer.Run()
+	}
+
 	// Handle SIGINT, SIGQUIT and SIGTERM.
 	signal := graceful_shutdown.GracefulShutdown()
 
@@ -0,0 +1,356 @@
+// Package fakeadp is an in-memory payment provider for local development and end-to-end tests.
+//
+// It implements the whole provider port without network access. Its behaviour is deterministic
+// and chosen per call by the fake_scenario metadata key or, like Stripe test cards, by the
+// minor units of the amount (see Scenario). Transitions a real provider completes later — a
+// 3DS challenge, a processing payment, a pending refund — are completed after a delay and
+// reported through webhook events.
+package fakeadp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shortlink-org/go-sdk/logger"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/webhook"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+)
+
+var (
+	ErrUnknownScenario = errors.New("fake: unknown scenario")
+	ErrUnavailable     = errors.New("fake: provider unavailable")
+	ErrNotFound        = errors.New("fake: payment not found")
+	ErrInvalidRequest  = errors.New("fake: invalid request")
+)
+
+// Webhooks receives the events of delayed transitions, normally the webhook use case.
+type Webhooks interface {
+	Handle(ctx context.Context, evt webhook.Event) (*webhook.Result, error)
+}
+
+// Option configures a Provider.
+type Option func(*Provider)
+
+// WithWebhooks sends the events of delayed transitions to h. Without it they only show in GetPayment.
+func WithWebhooks(h Webhooks) Option {
+	return func(p *Provider) { p.webhooks = h }
+}
+
+// WithDelay sets how long delayed transitions take, and how long a rejected webhook waits
+// before it is redelivered. It defaults to 2s.
+func WithDelay(d time.Duration) Option {
+	return func(p *Provider) { p.delay = d }
+}
+
+// redeliveries bounds how often a webhook event is sent, e.g. while the payment is not saved yet.
+const redeliveries = 5
+
+// Provider is a ports.PaymentProvider keeping payments in memory.
+type Provider struct {
+	log      logger.Logger
+	webhooks Webhooks
+	delay    time.Duration
+
+	mu       sync.Mutex
+	intents  map[string]*intent
+	replies  map[string]any // by idempotency key
+	sequence int
+}
+
+// intent is a payment as the fake provider sees it.
+type intent struct {
+	id        string
+	paymentID uuid.UUID
+	manual    bool
+	status    ports.ProviderStatus
+
+	amount     *money.Money
+	authorized *money.Money
+	captured   *money.Money
+	refunded   *money.Money // settled refunds
+	pending    *money.Money // refunds awaiting settlement
+}
+
+var (
+	_ ports.PaymentProvider          = (*Provider)(nil)
+	_ ports.AuthorizationIncrementer = (*Provider)(nil)
+)
+
+func New(log logger.Logger, opts ...Option) *Provider {
+	p := &Provider{
+		log:     log,
+		delay:   2 * time.Second,
+		intents: make(map[string]*intent),
+		replies: make(map[string]any),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Capabilities implements ports.PaymentProvider: the fake supports everything.
+func (p *Provider) Capabilities() ports.Capabilities {
+	return ports.Capabilities{
+		CaptureModes:             []eventv1.CaptureMode{eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE, eventv1.CaptureMode_CAPTURE_MODE_MANUAL},
+		PartialCapture:           true,
+		Refund:                   true,
+		PartialRefund:            true,
+		IncrementalAuthorization: true,
+	}
+}
+
+// Retryable reports whether err is the simulated outage, for the resilience decorator.
+func (p *Provider) Retryable(err error) bool {
+	return errors.Is(err, ErrUnavailable)
+}
+
+func (p *Provider) CreatePayment(ctx context.Context, in ports.CreatePaymentIn) (ports.CreatePaymentOut, error) {
+	sc, err := p.scenario(ctx, in.Metadata, in.Amount)
+	if err != nil {
+		return ports.CreatePaymentOut{}, err
+	}
+	if in.RequireSCA && sc == ScenarioOK {
+		sc = ScenarioRequiresAction
+	}
+
+	return idempotent(p, in.IdempotencyKey, func() (ports.CreatePaymentOut, error) {
+		currency := in.Amount.GetCurrencyCode()
+		it := &intent{
+			id:         p.nextID("pi"),
+			paymentID:  in.PaymentID,
+			manual:     in.CaptureManual,
+			amount:     ledger.Clone(in.Amount),
+			authorized: ledger.Zero(currency),
+			captured:   ledger.Zero(currency),
+			refunded:   ledger.Zero(currency),
+			pending:    ledger.Zero(currency),
+		}
+		p.intents[it.id] = it
+
+		switch sc {
+		case ScenarioDecline:
+			it.status = ports.ProviderStatusFailed
+		case ScenarioRequiresAction, ScenarioPending:
+			it.status = lo.Ternary(sc == ScenarioPending, ports.ProviderStatusPending, ports.ProviderStatusRequiresAction)
+			p.later(func() (webhook.Event, bool) { return p.complete(it) })
+		default:
+			it.settle()
+		}
+
+		return ports.CreatePaymentOut{
+			Provider:     ports.ProviderFake,
+			ProviderID:   it.id,
+			ClientSecret: it.id + "_secret",
+			Status:       it.status,
+			Authorized:   it.held(),
+			Captured:     it.paid(),
+		}, nil
+	})
+}
+
+func (p *Provider) GetPayment(_ context.Context, in ports.GetPaymentIn) (ports.GetPaymentOut, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	it, err := p.intent(in.ProviderID)
+	if err != nil {
+		return ports.GetPaymentOut{}, err
+	}
+	return ports.GetPaymentOut{
+		Provider:   ports.ProviderFake,
+		Status:     it.status,
+		Authorized: it.held(),
+		Captured:   it.paid(),
+	}, nil
+}
+
+func (p *Provider) CapturePayment(ctx context.Context, in ports.CapturePaymentIn) (ports.CapturePaymentOut, error) {
+	if _, err := p.scenario(ctx, in.Metadata, in.Amount); err != nil {
+		return ports.CapturePaymentOut{}, err
+	}
+
+	return idempotent(p, in.IdempotencyKey, func() (ports.CapturePaymentOut, error) {
+		it, err := p.intent(in.ProviderID)
+		if err != nil {
+			return ports.CapturePaymentOut{}, err
+		}
+		if it.status != ports.ProviderStatusRequiresCapture {
+			return ports.CapturePaymentOut{}, fmt.Errorf("%w: capture in status %d", ErrInvalidRequest, it.status)
+		}
+
+		captured, err := ledger.Add(it.captured, in.Amount)
+		if err != nil {
+			return ports.CapturePaymentOut{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+		if ledger.Compare(captured, it.authorized) > 0 {
+			return ports.CapturePaymentOut{}, fmt.Errorf("%w: capture exceeds the hold", ErrInvalidRequest)
+		}
+		it.captured = captured
+		if in.Final || ledger.Compare(captured, it.authorized) == 0 {
+			it.status = ports.ProviderStatusSucceeded
+		}
+
+		return ports.CapturePaymentOut{Provider: ports.ProviderFake, Status: it.status, Captured: ledger.Clone(in.Amount)}, nil
+	})
+}
+
+func (p *Provider) RefundPayment(ctx context.Context, in ports.RefundPaymentIn) (ports.RefundPaymentOut, error) {
+	sc, err := p.scenario(ctx, in.Metadata, in.Amount)
+	if err != nil {
+		return ports.RefundPaymentOut{}, err
+	}
+
+	return idempotent(p, in.IdempotencyKey, func() (ports.RefundPaymentOut, error) {
+		it, err := p.intent(in.ProviderID)
+		if err != nil {
+			return ports.RefundPaymentOut{}, err
+		}
+		if it.status != ports.ProviderStatusSucceeded {
+			return ports.RefundPaymentOut{}, fmt.Errorf("%w: refund in status %d", ErrInvalidRequest, it.status)
+		}
+
+		refunded, err := ledger.Add(it.refunded, in.Amount)
+		if err != nil {
+			return ports.RefundPaymentOut{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+		if requested, _ := ledger.Add(refunded, it.pending); ledger.Compare(requested, it.captured) > 0 {
+			return ports.RefundPaymentOut{}, fmt.Errorf("%w: refund exceeds the captured amount", ErrInvalidRequest)
+		}
+
+		out := ports.RefundPaymentOut{Provider: ports.ProviderFake, RefundID: p.nextID("re"), Amount: ledger.Clone(in.Amount)}
+		switch sc {
+		case ScenarioDecline:
+			out.Status = ports.ProviderStatusFailed
+		case ScenarioRefundPending:
+			out.Status = ports.ProviderStatusPending
+			it.pending, _ = ledger.Add(it.pending, in.Amount)
+			refundID, _ := uuid.Parse(in.Metadata["refund_id"])
+			p.later(func() (webhook.Event, bool) { return p.settleRefund(it, out.RefundID, refundID, in.Amount) })
+		default:
+			out.Status = ports.ProviderStatusSucceeded
+			it.refunded = refunded
+		}
+		return out, nil
+	})
+}
+
+func (p *Provider) CancelPayment(_ context.Context, in ports.CancelPaymentIn) (ports.CancelPaymentOut, error) {
+	return idempotent(p, in.IdempotencyKey, func() (ports.CancelPaymentOut, error) {
+		it, err := p.intent(in.ProviderID)
+		if err != nil {
+			return ports.CancelPaymentOut{}, err
+		}
+		switch it.status {
+		case ports.ProviderStatusRequiresAction, ports.ProviderStatusRequiresCapture, ports.ProviderStatusPending:
+			it.status = ports.ProviderStatusCanceled
+		case ports.ProviderStatusCanceled:
+		default:
+			return ports.CancelPaymentOut{}, fmt.Errorf("%w: cancel in status %d", ErrInvalidRequest, it.status)
+		}
+		return ports.CancelPaymentOut{Provider: ports.ProviderFake, Status: it.status}, nil
+	})
+}
+
+// IncrementAuthorization implements ports.AuthorizationIncrementer.
+func (p *Provider) IncrementAuthorization(ctx context.Context, in ports.IncrementAuthorizationIn) (ports.IncrementAuthorizationOut, error) {
+	sc, err := p.scenario(ctx, in.Metadata, in.Amount)
+	if err != nil {
+		return ports.IncrementAuthorizationOut{}, err
+	}
+
+	return idempotent(p, in.IdempotencyKey, func() (ports.IncrementAuthorizationOut, error) {
+		it, err := p.intent(in.ProviderID)
+		if err != nil {
+			return ports.IncrementAuthorizationOut{}, err
+		}
+		if it.status != ports.ProviderStatusRequiresCapture || sc == ScenarioDecline {
+			return ports.IncrementAuthorizationOut{}, fmt.Errorf("%w: increment declined in status %d", ErrInvalidRequest, it.status)
+		}
+
+		authorized, err := ledger.Add(it.authorized, in.Amount)
+		if err != nil {
+			return ports.IncrementAuthorizationOut{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+		it.authorized = authorized
+		return ports.IncrementAuthorizationOut{Provider: ports.ProviderFake, Status: it.status, Authorized: ledger.Clone(authorized)}, nil
+	})
+}
+
+// scenario picks the scenario of a call and simulates the faults, which need no state.
+func (p *Provider) scenario(ctx context.Context, metadata map[string]string, amount *money.Money) (Scenario, error) {
+	sc, err := scenarioOf(metadata, amount)
+	if err != nil {
+		return "", err
+	}
+
+	switch sc {
+	case ScenarioTimeout:
+		<-ctx.Done()
+		return "", ctx.Err()
+	case ScenarioUnavailable:
+		return "", fmt.Errorf("%w: simulated outage", ErrUnavailable)
+	default:
+		return sc, nil
+	}
+}
+
+// intent must be called with mu held.
+func (p *Provider) intent(id string) (*intent, error) {
+	it, ok := p.intents[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	return it, nil
+}
+
+// nextID must be called with mu held.
+func (p *Provider) nextID(prefix string) string {
+	p.sequence++
+	return fmt.Sprintf("%s_fake_%d", prefix, p.sequence)
+}
+
+// idempotent runs fn with mu held once per key; a repeated key gets the first reply, as with Stripe.
+func idempotent[T any](p *Provider, key string, fn func() (T, error)) (T, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if reply, ok := p.replies[key]; ok && key != "" {
+		return reply.(T), nil
+	}
+	out, err := fn()
+	if err == nil && key != "" {
+		p.replies[key] = out
+	}
+	return out, err
+}
+
+// settle holds or captures the whole amount, depending on the capture mode.
+func (it *intent) settle() {
+	if it.manual {
+		it.status = ports.ProviderStatusRequiresCapture
+		it.authorized = ledger.Clone(it.amount)
+		return
+	}
+	it.status = ports.ProviderStatusSucceeded
+	it.captured = ledger.Clone(it.amount)
+}
+
+// held is the hold of a payment awaiting capture.
+func (it *intent) held() *money.Money {
+	return lo.Ternary(it.status == ports.ProviderStatusRequiresCapture, ledger.Clone(it.authorized), nil)
+}
+
+// paid is the captured amount of a payment that succeeded.
+func (it *intent) paid() *money.Money {
+	return lo.Ternary(it.status == ports.ProviderStatusSucceeded, ledger.Clone(it.captured), nil)
+}
@@ -0,0 +1,265 @@
+package fakeadp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shortlink-org/go-sdk/logger"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/create"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund"
+	refunddto "github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund/dto"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/webhook"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+func eur(units int64, cents int32) *money.Money {
+	return &money.Money{CurrencyCode: "EUR", Units: units, Nanos: cents * 10_000_000}
+}
+
+// recorder is a webhook endpoint rejecting the first rejects deliveries.
+type recorder struct {
+	events  chan webhook.Event
+	rejects int
+}
+
+func (r *recorder) Handle(_ context.Context, evt webhook.Event) (*webhook.Result, error) {
+	if r.rejects > 0 {
+		r.rejects--
+		return nil, errors.New("not yet")
+	}
+	r.events <- evt
+	return &webhook.Result{}, nil
+}
+
+// handled forwards deliveries to the webhook use case and reports the ones it accepted.
+type handled struct {
+	*webhook.Handler
+	done chan webhook.Event
+}
+
+func (h handled) Handle(ctx context.Context, evt webhook.Event) (*webhook.Result, error) {
+	res, err := h.Handler.Handle(ctx, evt)
+	if err == nil {
+		h.done <- evt
+	}
+	return res, err
+}
+
+func newProvider(t *testing.T, opts ...Option) *Provider {
+	t.Helper()
+
+	log, err := logger.New(logger.Configuration{Writer: io.Discard})
+	require.NoError(t, err)
+	return New(log, append([]Option{WithDelay(10 * time.Millisecond)}, opts...)...)
+}
+
+func receive(t *testing.T, events <-chan webhook.Event) webhook.Event {
+	t.Helper()
+
+	select {
+	case evt := <-events:
+		return evt
+	case <-time.After(5 * time.Second):
+		t.Fatal("no webhook delivered")
+		return webhook.Event{}
+	}
+}
+
+func TestProvider_CreatePayment(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		in     ports.CreatePaymentIn
+		want   ports.ProviderStatus
+		hasErr error
+	}{
+		{name: "held", in: ports.CreatePaymentIn{Amount: eur(10, 0), CaptureManual: true}, want: ports.ProviderStatusRequiresCapture},
+		{name: "captured", in: ports.CreatePaymentIn{Amount: eur(10, 10)}, want: ports.ProviderStatusSucceeded},
+		{name: "requires action", in: ports.CreatePaymentIn{Amount: eur(10, 1)}, want: ports.ProviderStatusRequiresAction},
+		{name: "SCA required by policy", in: ports.CreatePaymentIn{Amount: eur(10, 0), RequireSCA: true}, want: ports.ProviderStatusRequiresAction},
+		{name: "declined", in: ports.CreatePaymentIn{Amount: eur(10, 2)}, want: ports.ProviderStatusFailed},
+		{name: "pending", in: ports.CreatePaymentIn{Amount: eur(10, 3)}, want: ports.ProviderStatusPending},
+		{
+			name: "scenario from metadata",
+			in:   ports.CreatePaymentIn{Amount: eur(10, 1), Metadata: map[string]string{ScenarioKey: string(ScenarioDecline)}},
+			want: ports.ProviderStatusFailed,
+		},
+		{name: "unavailable", in: ports.CreatePaymentIn{Amount: eur(10, 5)}, hasErr: ErrUnavailable},
+		{
+			name:   "unknown scenario",
+			in:     ports.CreatePaymentIn{Amount: eur(10, 0), Metadata: map[string]string{ScenarioKey: "stolen_card"}},
+			hasErr: ErrUnknownScenario,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newProvider(t)
+			out, err := p.CreatePayment(ctx, tt.in)
+			if tt.hasErr != nil {
+				require.ErrorIs(t, err, tt.hasErr)
+				require.Equal(t, errors.Is(tt.hasErr, ErrUnavailable), p.Retryable(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, ports.ProviderFake, out.Provider)
+			require.Equal(t, tt.want, out.Status)
+		})
+	}
+
+	t.Run("timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		_, err := newProvider(t).CreatePayment(ctx, ports.CreatePaymentIn{Amount: eur(10, 4)})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("idempotency key", func(t *testing.T) {
+		p := newProvider(t)
+		in := ports.CreatePaymentIn{Amount: eur(10, 0), IdempotencyKey: "k"}
+		first, err := p.CreatePayment(ctx, in)
+		require.NoError(t, err)
+		second, err := p.CreatePayment(ctx, in)
+		require.NoError(t, err)
+		require.Equal(t, first, second)
+	})
+}
+
+func TestProvider_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	p := newProvider(t)
+
+	created, err := p.CreatePayment(ctx, ports.CreatePaymentIn{Amount: eur(30, 0), CaptureManual: true})
+	require.NoError(t, err)
+	id := created.ProviderID
+
+	inc, err := p.IncrementAuthorization(ctx, ports.IncrementAuthorizationIn{ProviderID: id, Amount: eur(10, 0)})
+	require.NoError(t, err)
+	require.Equal(t, eur(40, 0), inc.Authorized)
+
+	_, err = p.CapturePayment(ctx, ports.CapturePaymentIn{ProviderID: id, Amount: eur(50, 0)})
+	require.ErrorIs(t, err, ErrInvalidRequest)
+	captured, err := p.CapturePayment(ctx, ports.CapturePaymentIn{ProviderID: id, Amount: eur(25, 0), Final: true})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStatusSucceeded, captured.Status)
+
+	declined, err := p.RefundPayment(ctx, ports.RefundPaymentIn{ProviderID: id, Amount: eur(5, 2)})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStatusFailed, declined.Status)
+	refunded, err := p.RefundPayment(ctx, ports.RefundPaymentIn{ProviderID: id, Amount: eur(25, 0)})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStatusSucceeded, refunded.Status)
+	_, err = p.RefundPayment(ctx, ports.RefundPaymentIn{ProviderID: id, Amount: eur(0, 10)})
+	require.ErrorIs(t, err, ErrInvalidRequest, "nothing is left to refund")
+
+	_, err = p.CancelPayment(ctx, ports.CancelPaymentIn{ProviderID: id})
+	require.ErrorIs(t, err, ErrInvalidRequest)
+	_, err = p.GetPayment(ctx, ports.GetPaymentIn{ProviderID: "pi_unknown"})
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestProvider_DelayedWebhooks(t *testing.T) {
+	ctx := context.Background()
+	hook := &recorder{events: make(chan webhook.Event, 1), rejects: 1}
+	p := newProvider(t, WithWebhooks(hook))
+	paymentID := uuid.New()
+
+	created, err := p.CreatePayment(ctx, ports.CreatePaymentIn{PaymentID: paymentID, Amount: eur(20, 1), CaptureManual: true})
+	require.NoError(t, err)
+
+	evt := receive(t, hook.events)
+	require.Equal(t, webhook.KindAuthorized, evt.Kind, "the challenge completes, redelivered after a rejection")
+	require.Equal(t, ports.ProviderFake, evt.Provider)
+	require.Equal(t, paymentID, evt.PaymentID)
+	require.Equal(t, eur(20, 1), evt.Authorized)
+
+	got, err := p.GetPayment(ctx, ports.GetPaymentIn{ProviderID: created.ProviderID})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStatusRequiresCapture, got.Status)
+
+	// A payment canceled before the challenge completes stays canceled.
+	pending, err := p.CreatePayment(ctx, ports.CreatePaymentIn{Amount: eur(20, 3)})
+	require.NoError(t, err)
+	_, err = p.CancelPayment(ctx, ports.CancelPaymentIn{ProviderID: pending.ProviderID})
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, hook.events)
+}
+
+// TestEndToEnd runs the use cases against the fake provider and an in-memory repository.
+func TestEndToEnd(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	done := make(chan webhook.Event, 1)
+	p := newProvider(t, WithWebhooks(handled{Handler: &webhook.Handler{Repo: repo, Inbox: repo}, done: done}))
+
+	creator := &create.Handler{Repo: repo, Provider: p}
+	refunder := &refund.Handler{Repo: repo, Provider: p}
+
+	t.Run("3DS challenge", func(t *testing.T) {
+		res, err := creator.Handle(ctx, create.Command{
+			InvoiceID: uuid.New(),
+			Amount:    eur(20, 1),
+			Kind:      eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+			Mode:      eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE,
+		})
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION, res.State)
+		require.Equal(t, ports.ProviderFake, res.Provider)
+
+		require.Equal(t, webhook.KindSucceeded, receive(t, done).Kind)
+		agg, err := repo.Load(ctx, res.ID)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, agg.State())
+	})
+
+	t.Run("pending refund", func(t *testing.T) {
+		res, err := creator.Handle(ctx, create.Command{
+			InvoiceID: uuid.New(),
+			Amount:    eur(20, 0),
+			Kind:      eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+			Mode:      eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE,
+		})
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, res.State)
+
+		refunded, err := refunder.Handle(ctx, refunddto.Command{
+			PaymentID: res.ID,
+			Amount:    eur(5, 6),
+			Reason:    eventv1.RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER,
+		})
+		require.NoError(t, err)
+		require.True(t, refunded.Pending)
+
+		require.Equal(t, webhook.KindRefundSucceeded, receive(t, done).Kind)
+		agg, err := repo.Load(ctx, res.ID)
+		require.NoError(t, err)
+		r, ok := agg.FindRefund(uuid.MustParse(refunded.RefundID))
+		require.True(t, ok)
+		require.Equal(t, payment.RefundStatusSucceeded, r.Status)
+	})
+
+	t.Run("declined", func(t *testing.T) {
+		res, err := creator.Handle(ctx, create.Command{
+			InvoiceID: uuid.New(),
+			Amount:    eur(20, 2),
+			Kind:      eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+			Mode:      eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+		})
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_FAILED, res.State)
+	})
+}
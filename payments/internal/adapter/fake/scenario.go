@@ -0,0 +1,52 @@
+package fakeadp
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// ScenarioKey is the metadata key selecting a scenario explicitly.
+const ScenarioKey = "fake_scenario"
+
+// Scenario is the simulated provider behaviour for one call.
+type Scenario string
+
+const (
+	ScenarioOK             Scenario = "ok"              // held (manual capture) or captured at once
+	ScenarioRequiresAction Scenario = "requires_action" // 3DS challenge, completed by a delayed webhook
+	ScenarioDecline        Scenario = "decline"         // payment or refund declined
+	ScenarioPending        Scenario = "pending"         // processing, completed by a delayed webhook
+	ScenarioTimeout        Scenario = "timeout"         // no answer until the caller gives up
+	ScenarioUnavailable    Scenario = "unavailable"     // retryable error, as a 503 would be
+	ScenarioRefundPending  Scenario = "refund_pending"  // refund settled by a delayed webhook
+)
+
+// magicCents selects a scenario by the minor units of the amount, like Stripe test cards:
+// 10.02 is declined, 10.00 and 10.10 are not.
+var magicCents = map[int32]Scenario{
+	1: ScenarioRequiresAction,
+	2: ScenarioDecline,
+	3: ScenarioPending,
+	4: ScenarioTimeout,
+	5: ScenarioUnavailable,
+	6: ScenarioRefundPending,
+}
+
+// scenarioOf picks the scenario of a call: metadata first, then the amount.
+func scenarioOf(metadata map[string]string, amount *money.Money) (Scenario, error) {
+	if s, ok := metadata[ScenarioKey]; ok {
+		switch sc := Scenario(s); sc {
+		case ScenarioOK, ScenarioRequiresAction, ScenarioDecline, ScenarioPending,
+			ScenarioTimeout, ScenarioUnavailable, ScenarioRefundPending:
+			return sc, nil
+		default:
+			return "", fmt.Errorf("%w: %q", ErrUnknownScenario, s)
+		}
+	}
+
+	if sc, ok := magicCents[amount.GetNanos()/10_000_000]; ok {
+		return sc, nil
+	}
+	return ScenarioOK, nil
+}
@@ -0,0 +1,75 @@
+package fakeadp
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/webhook"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+)
+
+// later runs transition with mu held once the delay has passed and delivers its event, if any.
+func (p *Provider) later(transition func() (webhook.Event, bool)) {
+	time.AfterFunc(p.delay, func() {
+		p.mu.Lock()
+		evt, ok := transition()
+		if ok {
+			evt.ID = p.nextID("evt")
+			evt.Provider = ports.ProviderFake
+		}
+		p.mu.Unlock()
+
+		if ok && p.webhooks != nil {
+			p.deliver(evt)
+		}
+	})
+}
+
+// deliver hands evt to the webhooks, redelivering rejected events like a real provider.
+func (p *Provider) deliver(evt webhook.Event) {
+	ctx := context.Background()
+
+	var err error
+	for attempt := 1; attempt <= redeliveries; attempt++ {
+		if _, err = p.webhooks.Handle(ctx, evt); err == nil {
+			return
+		}
+		time.Sleep(p.delay)
+	}
+	p.log.ErrorWithContext(ctx, "fake provider: webhook not delivered", "event_id", evt.ID, "type", evt.Type, "error", err)
+}
+
+// complete finishes the challenge or processing of a payment that was not canceled meanwhile.
+func (p *Provider) complete(it *intent) (webhook.Event, bool) {
+	if it.status != ports.ProviderStatusRequiresAction && it.status != ports.ProviderStatusPending {
+		return webhook.Event{}, false
+	}
+	it.settle()
+
+	evt := webhook.Event{PaymentID: it.paymentID, ProviderID: it.id}
+	if it.manual {
+		evt.Type, evt.Kind, evt.Authorized = "payment.authorized", webhook.KindAuthorized, it.held()
+	} else {
+		evt.Type, evt.Kind, evt.Captured = "payment.succeeded", webhook.KindSucceeded, it.paid()
+	}
+	return evt, true
+}
+
+// settleRefund settles a pending refund.
+func (p *Provider) settleRefund(it *intent, providerRefundID string, refundID uuid.UUID, amount *money.Money) (webhook.Event, bool) {
+	it.pending, _ = ledger.Sub(it.pending, amount)
+	it.refunded, _ = ledger.Add(it.refunded, amount)
+
+	return webhook.Event{
+		Type:             "refund.succeeded",
+		Kind:             webhook.KindRefundSucceeded,
+		PaymentID:        it.paymentID,
+		ProviderID:       it.id,
+		RefundID:         refundID,
+		ProviderRefundID: providerRefundID,
+	}, true
+}
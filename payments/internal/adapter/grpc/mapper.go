@@ -0,0 +1,137 @@
+package grpcadp
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/settlement"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/cancel"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/capture"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/confirm"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/increment"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/settle"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	paymentsv1 "github.com/shortlink-org/billing/payments/internal/payments/v1"
+)
+
+func toPayment(p *payment.Payment) *paymentsv1.Payment {
+	return &paymentsv1.Payment{
+		Id:         p.ID().String(),
+		InvoiceId:  p.InvoiceID().String(),
+		State:      p.State(),
+		Version:    p.Version(),
+		Provider:   p.Provider(),
+		ProviderId: p.ProviderID(),
+		Amount:     p.Ledger.Amount,
+		Authorized: p.Ledger.Authorized,
+		Captured:   p.Ledger.Captured,
+		Refunded:   p.Ledger.TotalRefunded,
+		Disputed:   p.Ledger.Disputed,
+		Reversed:   p.Ledger.Reversed,
+
+		PendingRefunded: p.Ledger.PendingRefunded,
+		Released:        p.Ledger.Released,
+
+		Fees:      p.Ledger.Fees,
+		Net:       p.Ledger.Net,
+		SettledAt: timestamp(p.Ledger.SettledAt),
+	}
+}
+
+func toSettlement(e settlement.Entry) *paymentsv1.Settlement {
+	return &paymentsv1.Settlement{
+		Id:           e.ID,
+		PaymentId:    e.PaymentID.String(),
+		Provider:     e.Provider,
+		Type:         e.Type,
+		Amount:       e.Amount,
+		Gross:        e.Gross,
+		Fee:          e.Fee,
+		FxAdjustment: e.FXAdjustment,
+		Net:          e.Net,
+		SettledAt:    timestamp(e.SettledAt),
+	}
+}
+
+// timestamp leaves a zero time unset.
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// toStatus maps use case and domain errors to gRPC status codes.
+// Anything unknown (storage, provider transport) is Internal.
+func toStatus(err error) error {
+	code := codes.Internal
+
+	switch {
+	case errors.Is(err, payment.ErrVersionConflict),
+		errors.Is(err, idempotency.ErrInProgress):
+		code = codes.Aborted
+	case errors.Is(err, repository.ErrNotFound),
+		errors.Is(err, refund.ErrPaymentNotFound),
+		errors.Is(err, capture.ErrPaymentNotFound),
+		errors.Is(err, confirm.ErrPaymentNotFound),
+		errors.Is(err, cancel.ErrPaymentNotFound),
+		errors.Is(err, increment.ErrPaymentNotFound),
+		errors.Is(err, settlement.ErrBatchNotFound):
+		code = codes.NotFound
+	case errors.Is(err, idempotency.ErrKeyReused):
+		code = codes.AlreadyExists
+	case errors.Is(err, payment.ErrInvalidTransition),
+		errors.Is(err, payment.ErrTerminalState),
+		errors.Is(err, payment.ErrPolicyCaptureMode),
+		errors.Is(err, payment.ErrProviderNotAttached),
+		errors.Is(err, payment.ErrDisputeOpen),
+		errors.Is(err, payment.ErrAuthorizationClosed),
+		errors.Is(err, refund.ErrPaymentNotRefundable),
+		errors.Is(err, refund.ErrRefundRejected),
+		errors.Is(err, capture.ErrPaymentNotCapturable),
+		errors.Is(err, capture.ErrCaptureRejected),
+		errors.Is(err, confirm.ErrNotAwaitingConfirmation),
+		errors.Is(err, confirm.ErrUnexpectedProviderStatus),
+		errors.Is(err, cancel.ErrPaymentNotCancelable),
+		errors.Is(err, cancel.ErrCancelRejected),
+		errors.Is(err, increment.ErrPaymentNotIncreasable),
+		errors.Is(err, increment.ErrIncrementRejected),
+		limitReached(err):
+		code = codes.FailedPrecondition
+	case errors.Is(err, errInvalidID),
+		errors.Is(err, payment.ErrInvalidArgs),
+		errors.Is(err, payment.ErrUnsupportedCurrency),
+		errors.Is(err, payment.ErrPolicyViolation),
+		errors.Is(err, refund.ErrInvalidRefundAmount),
+		errors.Is(err, refund.ErrInvalidRefundReason),
+		errors.Is(err, capture.ErrInvalidCaptureAmount),
+		errors.Is(err, increment.ErrInvalidIncrementAmount),
+		errors.Is(err, settle.ErrInvalidPayout):
+		code = codes.InvalidArgument
+	case errors.Is(err, ports.ErrUnsupportedOperation):
+		code = codes.Unimplemented
+	case errors.Is(err, ports.ErrProviderUnavailable):
+		code = codes.Unavailable
+	}
+
+	return status.Error(code, err.Error())
+}
+
+// limitReached tells a capture or refund count limit, which depends on the payment, from
+// amount and currency rules, which depend on the request.
+func limitReached(err error) bool {
+	for _, v := range payment.Violations(err) {
+		if v.Rule == payment.RuleMaxCaptures || v.Rule == payment.RuleMaxRefunds {
+			return true
+		}
+	}
+	return false
+}
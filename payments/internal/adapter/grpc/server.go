@@ -0,0 +1,314 @@
+package grpcadp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/settlement"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/cancel"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/capture"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/confirm"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/create"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/increment"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund/dto"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/settle"
+	paymentsv1 "github.com/shortlink-org/billing/payments/internal/payments/v1"
+)
+
+// CallerMetadataKey carries the authenticated client that scopes idempotency keys.
+// It is set by the mesh/gateway in front of the service, never trusted from end users.
+const CallerMetadataKey = "x-client-id"
+
+// errInvalidID is returned for a malformed payment or invoice ID.
+var errInvalidID = errors.New("grpc: invalid ID")
+
+// Server implements payments.v1.PaymentService on top of the payment use cases.
+type Server struct {
+	paymentsv1.UnimplementedPaymentServiceServer
+
+	Repo           repository.PaymentRepository
+	CreatePayment  *create.Handler
+	RefundPayment  *refund.Handler
+	CapturePayment *capture.Handler
+	ConfirmPayment *confirm.Handler
+	CancelPayment  *cancel.Handler
+	IncreaseHold   *increment.Handler
+	SettlePayout   *settle.Handler
+	Settlements    settlement.Store
+}
+
+var _ paymentsv1.PaymentServiceServer = (*Server)(nil)
+
+// Register adds the service to a gRPC server.
+func (s *Server) Register(srv grpc.ServiceRegistrar) {
+	paymentsv1.RegisterPaymentServiceServer(srv, s)
+}
+
+func (s *Server) Create(ctx context.Context, in *paymentsv1.CreateRequest) (*paymentsv1.CreateResponse, error) {
+	paymentID := uuid.Nil
+	if in.GetPaymentId() != "" {
+		id, err := parseID(in.GetPaymentId())
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		paymentID = id
+	}
+	invoiceID, err := parseID(in.GetInvoiceId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res, err := s.CreatePayment.Handle(ctx, create.Command{
+		PaymentID:   paymentID,
+		InvoiceID:   invoiceID,
+		Amount:      in.GetAmount(),
+		Kind:        in.GetKind(),
+		Mode:        in.GetMode(),
+		Description: in.GetDescription(),
+		Metadata:    in.GetMetadata(),
+		ReturnURL:   in.GetReturnUrl(),
+		Idempotency: idempotencyKey(ctx, in.GetIdempotencyKey()),
+
+		CustomerRegion:    in.GetCustomerRegion(),
+		MerchantInitiated: in.GetMerchantInitiated(),
+		MerchantID:        in.GetMerchantId(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	agg, err := s.Repo.Load(ctx, res.ID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &paymentsv1.CreateResponse{
+		Payment:      toPayment(agg),
+		ClientSecret: res.ClientSecret,
+		ScaRequested: res.SCA.Required,
+	}, nil
+}
+
+func (s *Server) Get(ctx context.Context, in *paymentsv1.GetRequest) (*paymentsv1.GetResponse, error) {
+	id, err := parseID(in.GetPaymentId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	agg, err := s.Repo.Load(ctx, id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &paymentsv1.GetResponse{Payment: toPayment(agg)}, nil
+}
+
+func (s *Server) Refund(ctx context.Context, in *paymentsv1.RefundRequest) (*paymentsv1.RefundResponse, error) {
+	id, err := parseID(in.GetPaymentId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res, err := s.RefundPayment.Handle(ctx, dto.Command{
+		PaymentID:   id,
+		Amount:      in.GetAmount(),
+		Reason:      in.GetReason(),
+		Metadata:    in.GetMetadata(),
+		Idempotency: idempotencyKey(ctx, in.GetIdempotencyKey()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &paymentsv1.RefundResponse{
+		PaymentId:     res.PaymentID.String(),
+		RefundId:      res.RefundID,
+		RefundAmount:  res.RefundAmount,
+		TotalRefunded: res.TotalRefunded,
+		FullRefund:    res.IsFullRefund,
+		Pending:       res.Pending,
+		State:         res.State,
+		Version:       res.Version,
+	}, nil
+}
+
+func (s *Server) Capture(ctx context.Context, in *paymentsv1.CaptureRequest) (*paymentsv1.CaptureResponse, error) {
+	id, err := parseID(in.GetPaymentId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res, err := s.CapturePayment.Handle(ctx, capture.Command{
+		PaymentID: id,
+		Amount:    in.GetAmount(),
+		Final:     in.GetFinal(),
+		Metadata:  in.GetMetadata(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &paymentsv1.CaptureResponse{
+		PaymentId:          res.PaymentID.String(),
+		CapturedAmount:     res.CapturedAmount,
+		TotalCaptured:      res.TotalCaptured,
+		RemainingToCapture: res.RemainingToCapture,
+		ReleasedAmount:     res.ReleasedAmount,
+		State:              res.State,
+		Version:            res.Version,
+	}, nil
+}
+
+func (s *Server) IncreaseAuthorization(ctx context.Context, in *paymentsv1.IncreaseAuthorizationRequest) (*paymentsv1.IncreaseAuthorizationResponse, error) {
+	id, err := parseID(in.GetPaymentId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res, err := s.IncreaseHold.Handle(ctx, increment.Command{
+		PaymentID: id,
+		Amount:    in.GetAmount(),
+		Metadata:  in.GetMetadata(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &paymentsv1.IncreaseAuthorizationResponse{
+		PaymentId:       res.PaymentID.String(),
+		IncrementAmount: res.IncrementAmount,
+		TotalAuthorized: res.TotalAuthorized,
+		Amount:          res.Amount,
+		State:           res.State,
+		Version:         res.Version,
+	}, nil
+}
+
+func (s *Server) Confirm(ctx context.Context, in *paymentsv1.ConfirmRequest) (*paymentsv1.ConfirmResponse, error) {
+	id, err := parseID(in.GetPaymentId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res, err := s.ConfirmPayment.Handle(ctx, confirm.Command{PaymentID: id})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &paymentsv1.ConfirmResponse{
+		PaymentId: res.PaymentID.String(),
+		State:     res.State,
+		Version:   res.Version,
+	}, nil
+}
+
+func (s *Server) Cancel(ctx context.Context, in *paymentsv1.CancelRequest) (*paymentsv1.CancelResponse, error) {
+	id, err := parseID(in.GetPaymentId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res, err := s.CancelPayment.Handle(ctx, cancel.Command{PaymentID: id, Reason: in.GetReason()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &paymentsv1.CancelResponse{
+		PaymentId: res.PaymentID.String(),
+		Reason:    res.Reason,
+		State:     res.State,
+		Version:   res.Version,
+	}, nil
+}
+
+func (s *Server) ListByInvoice(ctx context.Context, in *paymentsv1.ListByInvoiceRequest) (*paymentsv1.ListByInvoiceResponse, error) {
+	invoiceID, err := parseID(in.GetInvoiceId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	list, err := s.Repo.ListByInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	out := make([]*paymentsv1.Payment, 0, len(list))
+	for _, agg := range list {
+		out = append(out, toPayment(agg))
+	}
+	return &paymentsv1.ListByInvoiceResponse{Payments: out}, nil
+}
+
+func (s *Server) ImportSettlement(ctx context.Context, in *paymentsv1.ImportSettlementRequest) (*paymentsv1.ImportSettlementResponse, error) {
+	res, err := s.SettlePayout.Handle(ctx, settle.Command{
+		Provider: ports.Provider(in.GetProvider()),
+		PayoutID: in.GetPayoutId(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &paymentsv1.ImportSettlementResponse{
+		PayoutId:   res.PayoutID,
+		Imported:   int32(res.Imported),
+		Duplicates: int32(res.Duplicates),
+		Unmatched:  int32(res.Unmatched),
+		Skipped:    int32(res.Skipped),
+		Rejected:   int32(res.Rejected),
+	}, nil
+}
+
+func (s *Server) GetSettlement(ctx context.Context, in *paymentsv1.GetSettlementRequest) (*paymentsv1.GetSettlementResponse, error) {
+	if in.GetPayoutId() == "" {
+		return nil, toStatus(settle.ErrInvalidPayout)
+	}
+
+	batch, err := s.Settlements.Batch(ctx, in.GetPayoutId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	gross, fees, net, err := batch.Totals()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	out := make([]*paymentsv1.Settlement, 0, len(batch.Entries))
+	for _, e := range batch.Entries {
+		out = append(out, toSettlement(e))
+	}
+	return &paymentsv1.GetSettlementResponse{
+		PayoutId:    batch.PayoutID,
+		Settlements: out,
+		Gross:       gross,
+		Fees:        fees,
+		Net:         net,
+	}, nil
+}
+
+func parseID(s string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil || id == uuid.Nil {
+		return uuid.Nil, fmt.Errorf("%w: %q", errInvalidID, s)
+	}
+	return id, nil
+}
+
+// idempotencyKey scopes a client key to the caller from CallerMetadataKey.
+func idempotencyKey(ctx context.Context, key string) idempotency.Key {
+	var caller string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(CallerMetadataKey); len(v) > 0 {
+			caller = v[0]
+		}
+	}
+	return idempotency.Key{Caller: caller, Key: key}
+}
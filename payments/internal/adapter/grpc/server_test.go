@@ -0,0 +1,265 @@
+package grpcadp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/settlement"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/cancel"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/capture"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/confirm"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/create"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/settle"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+	paymentsv1 "github.com/shortlink-org/billing/payments/internal/payments/v1"
+)
+
+// dial serves s over an in-memory listener and returns a client for it.
+func dial(t *testing.T, s *Server) paymentsv1.PaymentServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	s.Register(srv)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return paymentsv1.NewPaymentServiceClient(conn)
+}
+
+func newServer(t *testing.T) (*Server, *mocks.MockPaymentProvider) {
+	t.Helper()
+
+	repo := memory.New()
+	provider := mocks.NewMockPaymentProvider(t)
+	provider.EXPECT().Capabilities().Return(ports.Capabilities{
+		CaptureModes:   []eventv1.CaptureMode{eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE, eventv1.CaptureMode_CAPTURE_MODE_MANUAL},
+		PartialCapture: true,
+		Refund:         true,
+		PartialRefund:  true,
+	}).Maybe()
+	return &Server{
+		Repo:           repo,
+		CreatePayment:  &create.Handler{Repo: repo, Provider: provider, Idempotency: repo},
+		RefundPayment:  &refund.Handler{Repo: repo, Provider: provider, Idempotency: repo},
+		CapturePayment: &capture.Handler{Repo: repo, Provider: provider},
+		ConfirmPayment: &confirm.Handler{Repo: repo, Provider: provider},
+		CancelPayment:  &cancel.Handler{Repo: repo, Provider: provider},
+	}, provider
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	s, provider := newServer(t)
+	client := dial(t, s)
+
+	provider.EXPECT().CreatePayment(mock.Anything, mock.Anything).Return(ports.CreatePaymentOut{
+		Provider:   ports.ProviderStripe,
+		ProviderID: "pi_1",
+		Status:     ports.ProviderStatusRequiresCapture,
+	}, nil).Once()
+
+	invoiceID := uuid.NewString()
+	created, err := client.Create(ctx, &paymentsv1.CreateRequest{
+		InvoiceId:      invoiceID,
+		Amount:         &money.Money{CurrencyCode: "USD", Units: 100},
+		Kind:           eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+		Mode:           eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+		IdempotencyKey: "order-1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED, created.GetPayment().GetState())
+	require.Equal(t, "pi_1", created.GetPayment().GetProviderId())
+
+	got, err := client.Get(ctx, &paymentsv1.GetRequest{PaymentId: created.GetPayment().GetId()})
+	require.NoError(t, err)
+	require.Equal(t, created.GetPayment().GetVersion(), got.GetPayment().GetVersion())
+
+	list, err := client.ListByInvoice(ctx, &paymentsv1.ListByInvoiceRequest{InvoiceId: invoiceID})
+	require.NoError(t, err)
+	require.Len(t, list.GetPayments(), 1)
+
+	// Not waiting for SCA.
+	_, err = client.Confirm(ctx, &paymentsv1.ConfirmRequest{PaymentId: created.GetPayment().GetId()})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = client.Get(ctx, &paymentsv1.GetRequest{PaymentId: uuid.NewString()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.Refund(ctx, &paymentsv1.RefundRequest{PaymentId: "not-a-uuid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_PendingRefund(t *testing.T) {
+	ctx := context.Background()
+	s, provider := newServer(t)
+	client := dial(t, s)
+
+	provider.EXPECT().CreatePayment(mock.Anything, mock.Anything).Return(ports.CreatePaymentOut{
+		Provider:   ports.ProviderStripe,
+		ProviderID: "pi_1",
+		Status:     ports.ProviderStatusSucceeded,
+		Captured:   &money.Money{CurrencyCode: "USD", Units: 100},
+	}, nil).Once()
+
+	created, err := client.Create(ctx, &paymentsv1.CreateRequest{
+		InvoiceId: uuid.NewString(),
+		Amount:    &money.Money{CurrencyCode: "USD", Units: 100},
+		Kind:      eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+		Mode:      eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, created.GetPayment().GetState())
+
+	provider.EXPECT().RefundPayment(mock.Anything, mock.MatchedBy(func(in ports.RefundPaymentIn) bool {
+		return in.Reason == eventv1.RefundReason_REFUND_REASON_DUPLICATE && in.Metadata["refund_id"] != ""
+	})).Return(ports.RefundPaymentOut{
+		Provider: ports.ProviderStripe,
+		RefundID: "re_1",
+		Status:   ports.ProviderStatusPending,
+		Amount:   &money.Money{CurrencyCode: "USD", Units: 30},
+	}, nil).Once()
+
+	refunded, err := client.Refund(ctx, &paymentsv1.RefundRequest{
+		PaymentId: created.GetPayment().GetId(),
+		Amount:    &money.Money{CurrencyCode: "USD", Units: 30},
+		Reason:    eventv1.RefundReason_REFUND_REASON_DUPLICATE,
+	})
+	require.NoError(t, err)
+	require.True(t, refunded.GetPending())
+	require.Nil(t, refunded.GetTotalRefunded())
+
+	got, err := client.Get(ctx, &paymentsv1.GetRequest{PaymentId: created.GetPayment().GetId()})
+	require.NoError(t, err)
+	require.Equal(t, int64(30), got.GetPayment().GetPendingRefunded().GetUnits())
+	require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, got.GetPayment().GetState())
+
+	provider.EXPECT().RefundPayment(mock.Anything, mock.Anything).Return(ports.RefundPaymentOut{
+		Provider: ports.ProviderStripe,
+		RefundID: "re_2",
+		Status:   ports.ProviderStatusFailed,
+	}, nil).Once()
+
+	_, err = client.Refund(ctx, &paymentsv1.RefundRequest{PaymentId: created.GetPayment().GetId()})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	got, err = client.Get(ctx, &paymentsv1.GetRequest{PaymentId: created.GetPayment().GetId()})
+	require.NoError(t, err)
+	require.Equal(t, int64(30), got.GetPayment().GetPendingRefunded().GetUnits(), "the declined refund is released")
+}
+
+func TestServer_Settlement(t *testing.T) {
+	ctx := context.Background()
+	s, provider := newServer(t)
+	lister := mocks.NewMockSettlementLister(t)
+	repo := s.Repo.(*memory.InMemory)
+	s.SettlePayout = &settle.Handler{Repo: repo, Provider: struct {
+		*mocks.MockPaymentProvider
+		*mocks.MockSettlementLister
+	}{provider, lister}}
+	s.Settlements = repo
+	client := dial(t, s)
+
+	amount := &money.Money{CurrencyCode: "EUR", Units: 100}
+	p, err := payment.New(uuid.New(), uuid.New(), amount,
+		eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE)
+	require.NoError(t, err)
+	require.NoError(t, p.AttachProvider(ctx, "stripe", "pi_1"))
+	require.NoError(t, p.Authorize(ctx, amount))
+	require.NoError(t, p.Capture(ctx, amount))
+	require.NoError(t, repo.Save(ctx, p, 0))
+
+	settledAt := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	lister.EXPECT().ListSettlement(mock.Anything, ports.ListSettlementIn{Provider: ports.ProviderStripe, PayoutID: "po_1"}).
+		Return(ports.ListSettlementOut{
+			Provider: ports.ProviderStripe,
+			PayoutID: "po_1",
+			Entries: []ports.SettlementEntry{{
+				TransactionID: "txn_1",
+				PaymentID:     p.ID(),
+				ProviderID:    "pi_1",
+				Type:          eventv1.SettlementType_SETTLEMENT_TYPE_CHARGE,
+				Amount:        amount,
+				Gross:         &money.Money{CurrencyCode: "USD", Units: 108, Nanos: 500_000_000},
+				Fee:           &money.Money{CurrencyCode: "USD", Units: 3, Nanos: 450_000_000},
+				Net:           &money.Money{CurrencyCode: "USD", Units: 105, Nanos: 50_000_000},
+				SettledAt:     settledAt,
+			}},
+			Skipped: 1,
+		}, nil).Once()
+
+	imported, err := client.ImportSettlement(ctx, &paymentsv1.ImportSettlementRequest{Provider: "stripe", PayoutId: "po_1"})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), imported.GetImported())
+	require.Equal(t, int32(1), imported.GetSkipped())
+
+	batch, err := client.GetSettlement(ctx, &paymentsv1.GetSettlementRequest{PayoutId: "po_1"})
+	require.NoError(t, err)
+	require.Len(t, batch.GetSettlements(), 1)
+	require.Equal(t, p.ID().String(), batch.GetSettlements()[0].GetPaymentId())
+	require.Equal(t, int64(105), batch.GetNet().GetUnits())
+	require.Equal(t, "USD", batch.GetFees().GetCurrencyCode())
+
+	got, err := client.Get(ctx, &paymentsv1.GetRequest{PaymentId: p.ID().String()})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), got.GetPayment().GetFees().GetUnits())
+	require.Equal(t, settledAt, got.GetPayment().GetSettledAt().AsTime())
+
+	_, err = client.GetSettlement(ctx, &paymentsv1.GetSettlementRequest{PayoutId: "po_unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.ImportSettlement(ctx, &paymentsv1.ImportSettlementRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{fmt.Errorf("save: %w", payment.ErrVersionConflict), codes.Aborted},
+		{fmt.Errorf("capture: %w", payment.ErrInvalidTransition), codes.FailedPrecondition},
+		{fmt.Errorf("%w: %s", capture.ErrPaymentNotFound, uuid.Nil), codes.NotFound},
+		{fmt.Errorf("%w: %s", refund.ErrPaymentNotFound, uuid.Nil), codes.NotFound},
+		{fmt.Errorf("%w: amount must be positive", refund.ErrInvalidRefundAmount), codes.InvalidArgument},
+		{fmt.Errorf("%w: refund re_1", refund.ErrRefundRejected), codes.FailedPrecondition},
+		{fmt.Errorf("create aggregate: %w", &payment.Violation{Rule: payment.RuleMaxAmount}), codes.InvalidArgument},
+		{errors.Join(&payment.Violation{Rule: payment.RuleMaxRefunds}), codes.FailedPrecondition},
+		{fmt.Errorf("%w: po_1", settlement.ErrBatchNotFound), codes.NotFound},
+		{fmt.Errorf("%w: partial refund", ports.ErrUnsupportedOperation), codes.Unimplemented},
+		{fmt.Errorf("provider capture: %w", ports.ErrProviderUnavailable), codes.Unavailable},
+		{fmt.Errorf("provider create: %w", context.DeadlineExceeded), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			require.Equal(t, tt.want, status.Code(toStatus(tt.err)))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package kafkaadp
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/spf13/viper"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+)
+
+var (
+	// ErrMissingBrokers is returned when MQ_KAFKA_URI is not set.
+	ErrMissingBrokers = errors.New("kafka: missing MQ_KAFKA_URI")
+)
+
+// Publisher implements ports.EventPublisher on top of a sarama SyncProducer.
+type Publisher struct {
+	producer sarama.SyncProducer
+}
+
+var _ ports.EventPublisher = (*Publisher)(nil)
+
+// New creates an idempotent producer for the brokers in MQ_KAFKA_URI (comma-separated).
+// Example: export MQ_KAFKA_URI=kafka-0:9092,kafka-1:9092
+func New() (*Publisher, error) {
+	viper.AutomaticEnv()
+
+	uri := viper.GetString("MQ_KAFKA_URI")
+	if uri == "" {
+		return nil, ErrMissingBrokers
+	}
+
+	cfg := sarama.NewConfig()
+	cfg.ClientID = viper.GetString("SERVICE_NAME")
+	cfg.Version = sarama.V3_6_0_0
+	cfg.Producer.Return.Successes = true
+	// Idempotence keeps per-partition order across producer retries.
+	cfg.Producer.Idempotent = true
+	cfg.Producer.RequiredAcks = sarama.WaitForAll
+	cfg.Net.MaxOpenRequests = 1
+	cfg.Producer.Partitioner = sarama.NewHashPartitioner
+
+	producer, err := sarama.NewSyncProducer(strings.Split(uri, ","), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithProducer(producer), nil
+}
+
+// NewWithProducer wraps an existing producer (e.g. sarama/mocks in tests).
+func NewWithProducer(producer sarama.SyncProducer) *Publisher {
+	return &Publisher{producer: producer}
+}
+
+// Publish sends msg and waits for the broker acknowledgement.
+func (p *Publisher) Publish(ctx context.Context, msg ports.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers))
+	for k, v := range msg.Headers {
+		headers = append(headers, sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
+	}
+
+	_, _, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   msg.Topic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: headers,
+	})
+	return err
+}
+
+// Close flushes and closes the producer.
+func (p *Publisher) Close() error {
+	return p.producer.Close()
+}
@@ -0,0 +1,44 @@
+package kafkaadp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	pub := NewWithProducer(producer)
+	t.Cleanup(func() { require.NoError(t, pub.Close()) })
+
+	msg := ports.Message{
+		Topic:   "payments.payment.events.v1",
+		Key:     []byte("payment-1"),
+		Value:   []byte("payload"),
+		Headers: map[string]string{"event_id": "0190-…"},
+	}
+
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(got *sarama.ProducerMessage) error {
+		key, err := got.Key.Encode()
+		require.NoError(t, err)
+		value, err := got.Value.Encode()
+		require.NoError(t, err)
+
+		require.Equal(t, msg.Topic, got.Topic)
+		require.Equal(t, msg.Key, key)
+		require.Equal(t, msg.Value, value)
+		require.Equal(t, []sarama.RecordHeader{{Key: []byte("event_id"), Value: []byte("0190-…")}}, got.Headers)
+		return nil
+	})
+	require.NoError(t, pub.Publish(context.Background(), msg))
+
+	brokerErr := errors.New("not enough replicas")
+	producer.ExpectSendMessageAndFail(brokerErr)
+	require.ErrorIs(t, pub.Publish(context.Background(), msg), brokerErr)
+}
@@ -0,0 +1,72 @@
+package resilienceadp
+
+import (
+	"sync"
+	"time"
+)
+
+// outcome of a call as seen by the breaker.
+type outcome int
+
+const (
+	succeeded outcome = iota // the provider answered, an error like a decline included
+	failed                   // the provider did not answer: network, timeout, 5xx, rate limited
+	ignored                  // the caller gave up; says nothing about the provider
+)
+
+// breaker opens after threshold consecutive failures and refuses calls for cooldown.
+// Then one call probes the provider: success closes the breaker, failure opens it again.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time // zero while closed
+	probing  bool
+}
+
+// allow reports whether a call may be made; a true result must be followed by record.
+func (b *breaker) allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case b.openedAt.IsZero():
+		return true
+	case b.probing || b.now().Sub(b.openedAt) < b.cooldown:
+		return false
+	default:
+		b.probing = true
+		return true
+	}
+}
+
+func (b *breaker) record(o outcome) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	probe := b.probing
+	b.probing = false
+
+	switch o {
+	case succeeded:
+		b.failures = 0
+		b.openedAt = time.Time{}
+	case failed:
+		b.failures++
+		if probe || b.failures >= b.threshold {
+			b.openedAt = b.now()
+		}
+	case ignored:
+	}
+}
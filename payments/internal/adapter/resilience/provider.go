@@ -0,0 +1,229 @@
+// Package resilienceadp decorates a payment provider with per-call timeouts, retries and a
+// circuit breaker.
+//
+// Errors are classified as retryable (network errors, timeouts, 5xx, rate limiting) or
+// terminal (declines, invalid requests). Retryable errors of idempotent calls — reads, and
+// writes carrying an idempotency key — are retried with jittered exponential backoff.
+// Retryable errors also count towards the breaker; once it is open, calls fail with
+// ports.ErrProviderUnavailable without reaching the provider.
+package resilienceadp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"time"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+)
+
+// Classifier is implemented by providers telling their transient errors apart.
+type Classifier interface {
+	// Retryable reports whether err, returned by one of the provider's calls, may succeed
+	// when repeated: 5xx and rate limiting, but not declines or invalid requests.
+	Retryable(err error) bool
+}
+
+// Provider is a ports.PaymentProvider calling another one resiliently.
+type Provider struct {
+	name    ports.Provider
+	inner   ports.PaymentProvider
+	timeout time.Duration
+	retries int
+	backoff func(attempt int) time.Duration
+	breaker *breaker
+}
+
+// Option configures a Provider.
+type Option func(*Provider)
+
+// WithTimeout bounds every attempt; 0 leaves attempts to the caller's context. It defaults to 10s.
+func WithTimeout(d time.Duration) Option {
+	return func(p *Provider) { p.timeout = d }
+}
+
+// WithRetries sets how often an idempotent call is repeated after a retryable error. It defaults to 2.
+func WithRetries(n int) Option {
+	return func(p *Provider) { p.retries = n }
+}
+
+// WithBackoff sets the delay before retry number attempt (1-based).
+// It defaults to full jitter over 100ms doubling up to 2s.
+func WithBackoff(f func(attempt int) time.Duration) Option {
+	return func(p *Provider) { p.backoff = f }
+}
+
+// WithBreaker opens the breaker after threshold consecutive retryable errors for cooldown;
+// a threshold of 0 disables it. It defaults to 5 errors and 30s.
+func WithBreaker(threshold int, cooldown time.Duration) Option {
+	return func(p *Provider) { p.breaker.threshold, p.breaker.cooldown = threshold, cooldown }
+}
+
+var (
+	_ ports.PaymentProvider          = (*Provider)(nil)
+	_ ports.AuthorizationIncrementer = (*Provider)(nil)
+	_ ports.RefundLister             = (*Provider)(nil)
+	_ ports.PaymentResolver          = (*Provider)(nil)
+	_ ports.SettlementLister         = (*Provider)(nil)
+)
+
+// New decorates inner, named e.g. ports.ProviderStripe in errors.
+func New(name ports.Provider, inner ports.PaymentProvider, opts ...Option) *Provider {
+	p := &Provider{
+		name:    name,
+		inner:   inner,
+		timeout: 10 * time.Second,
+		retries: 2,
+		backoff: jitteredBackoff,
+		breaker: &breaker{threshold: 5, cooldown: 30 * time.Second, now: time.Now},
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func (p *Provider) Capabilities() ports.Capabilities { return p.inner.Capabilities() }
+
+func (p *Provider) CreatePayment(ctx context.Context, in ports.CreatePaymentIn) (ports.CreatePaymentOut, error) {
+	return call(ctx, p, in.IdempotencyKey != "", func(ctx context.Context) (ports.CreatePaymentOut, error) {
+		return p.inner.CreatePayment(ctx, in)
+	})
+}
+
+func (p *Provider) GetPayment(ctx context.Context, in ports.GetPaymentIn) (ports.GetPaymentOut, error) {
+	return call(ctx, p, true, func(ctx context.Context) (ports.GetPaymentOut, error) {
+		return p.inner.GetPayment(ctx, in)
+	})
+}
+
+func (p *Provider) CapturePayment(ctx context.Context, in ports.CapturePaymentIn) (ports.CapturePaymentOut, error) {
+	return call(ctx, p, in.IdempotencyKey != "", func(ctx context.Context) (ports.CapturePaymentOut, error) {
+		return p.inner.CapturePayment(ctx, in)
+	})
+}
+
+func (p *Provider) RefundPayment(ctx context.Context, in ports.RefundPaymentIn) (ports.RefundPaymentOut, error) {
+	return call(ctx, p, in.IdempotencyKey != "", func(ctx context.Context) (ports.RefundPaymentOut, error) {
+		return p.inner.RefundPayment(ctx, in)
+	})
+}
+
+func (p *Provider) CancelPayment(ctx context.Context, in ports.CancelPaymentIn) (ports.CancelPaymentOut, error) {
+	return call(ctx, p, in.IdempotencyKey != "", func(ctx context.Context) (ports.CancelPaymentOut, error) {
+		return p.inner.CancelPayment(ctx, in)
+	})
+}
+
+// IncrementAuthorization implements ports.AuthorizationIncrementer if the decorated provider does.
+func (p *Provider) IncrementAuthorization(ctx context.Context, in ports.IncrementAuthorizationIn) (ports.IncrementAuthorizationOut, error) {
+	incrementer, ok := p.inner.(ports.AuthorizationIncrementer)
+	if !ok {
+		return ports.IncrementAuthorizationOut{}, fmt.Errorf("%w: %s cannot raise a hold", ports.ErrUnsupportedOperation, p.name)
+	}
+	return call(ctx, p, in.IdempotencyKey != "", func(ctx context.Context) (ports.IncrementAuthorizationOut, error) {
+		return incrementer.IncrementAuthorization(ctx, in)
+	})
+}
+
+// ListRefunds implements ports.RefundLister if the decorated provider does.
+func (p *Provider) ListRefunds(ctx context.Context, in ports.ListRefundsIn) (ports.ListRefundsOut, error) {
+	lister, ok := p.inner.(ports.RefundLister)
+	if !ok {
+		return ports.ListRefundsOut{}, fmt.Errorf("%w: %s cannot list refunds", ports.ErrUnsupportedOperation, p.name)
+	}
+	return call(ctx, p, true, func(ctx context.Context) (ports.ListRefundsOut, error) {
+		return lister.ListRefunds(ctx, in)
+	})
+}
+
+// ResolvePayment implements ports.PaymentResolver if the decorated provider does.
+func (p *Provider) ResolvePayment(ctx context.Context, in ports.ResolvePaymentIn) (ports.ResolvePaymentOut, error) {
+	resolver, ok := p.inner.(ports.PaymentResolver)
+	if !ok {
+		return ports.ResolvePaymentOut{}, fmt.Errorf("%w: %s cannot resolve payments", ports.ErrUnsupportedOperation, p.name)
+	}
+	return call(ctx, p, true, func(ctx context.Context) (ports.ResolvePaymentOut, error) {
+		return resolver.ResolvePayment(ctx, in)
+	})
+}
+
+// ListSettlement implements ports.SettlementLister if the decorated provider does.
+func (p *Provider) ListSettlement(ctx context.Context, in ports.ListSettlementIn) (ports.ListSettlementOut, error) {
+	lister, ok := p.inner.(ports.SettlementLister)
+	if !ok {
+		return ports.ListSettlementOut{}, fmt.Errorf("%w: %s cannot list settlements", ports.ErrUnsupportedOperation, p.name)
+	}
+	return call(ctx, p, true, func(ctx context.Context) (ports.ListSettlementOut, error) {
+		return lister.ListSettlement(ctx, in)
+	})
+}
+
+// call runs fn until it succeeds, fails terminally or runs out of retries.
+func call[T any](ctx context.Context, p *Provider, idempotent bool, fn func(context.Context) (T, error)) (T, error) {
+	for attempt := 1; ; attempt++ {
+		if !p.breaker.allow() {
+			var zero T
+			return zero, fmt.Errorf("%w: %s: circuit breaker open", ports.ErrProviderUnavailable, p.name)
+		}
+
+		out, o, err := try(ctx, p, fn)
+		p.breaker.record(o)
+		if o != failed || !idempotent || attempt > p.retries {
+			return out, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return out, err
+		case <-time.After(p.backoff(attempt)):
+		}
+	}
+}
+
+// try makes one call bounded by the attempt timeout.
+func try[T any](ctx context.Context, p *Provider, fn func(context.Context) (T, error)) (T, outcome, error) {
+	actx := ctx
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		actx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	out, err := fn(actx)
+	switch {
+	case err == nil:
+		return out, succeeded, nil
+	case ctx.Err() != nil:
+		return out, ignored, err
+	case actx.Err() != nil:
+		return out, failed, fmt.Errorf("%s: attempt timed out after %s: %w", p.name, p.timeout, err)
+	case p.retryable(err):
+		return out, failed, err
+	default:
+		return out, succeeded, err
+	}
+}
+
+func (p *Provider) retryable(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	c, ok := p.inner.(Classifier)
+	return ok && c.Retryable(err)
+}
+
+// jitteredBackoff draws from [0, min(2s, 100ms·2^(attempt-1))), so callers retrying together spread out.
+func jitteredBackoff(attempt int) time.Duration {
+	const (
+		base     = 100 * time.Millisecond
+		maxDelay = 2 * time.Second
+	)
+
+	d := min(base<<min(attempt-1, 20), maxDelay)
+	return rand.N(d)
+}
@@ -0,0 +1,147 @@
+package resilienceadp
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+)
+
+var (
+	errUnreachable = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	errDeclined    = errors.New("card_declined")
+	errOverloaded  = errors.New("503 service unavailable")
+)
+
+// classifying is a provider knowing that errOverloaded is transient.
+type classifying struct {
+	*mocks.MockPaymentProvider
+}
+
+func (classifying) Retryable(err error) bool { return errors.Is(err, errOverloaded) }
+
+func newProvider(t *testing.T, opts ...Option) (*Provider, *mocks.MockPaymentProvider) {
+	t.Helper()
+
+	inner := mocks.NewMockPaymentProvider(t)
+	opts = append([]Option{WithBackoff(func(int) time.Duration { return 0 })}, opts...)
+	return New(ports.ProviderStripe, classifying{inner}, opts...), inner
+}
+
+func TestProvider_Retries(t *testing.T) {
+	ctx := context.Background()
+	captured := ports.CapturePaymentOut{Status: ports.ProviderStatusSucceeded}
+
+	t.Run("retryable errors of idempotent calls", func(t *testing.T) {
+		p, inner := newProvider(t)
+		inner.EXPECT().CapturePayment(mock.Anything, mock.Anything).Return(ports.CapturePaymentOut{}, errUnreachable).Once()
+		inner.EXPECT().CapturePayment(mock.Anything, mock.Anything).Return(ports.CapturePaymentOut{}, errOverloaded).Once()
+		inner.EXPECT().CapturePayment(mock.Anything, mock.Anything).Return(captured, nil).Once()
+
+		out, err := p.CapturePayment(ctx, ports.CapturePaymentIn{IdempotencyKey: "k"})
+		require.NoError(t, err)
+		require.Equal(t, captured, out)
+	})
+
+	t.Run("gives up after the last retry", func(t *testing.T) {
+		p, inner := newProvider(t, WithRetries(1))
+		inner.EXPECT().GetPayment(mock.Anything, mock.Anything).Return(ports.GetPaymentOut{}, errOverloaded).Twice()
+
+		_, err := p.GetPayment(ctx, ports.GetPaymentIn{})
+		require.ErrorIs(t, err, errOverloaded)
+	})
+
+	t.Run("writes without an idempotency key", func(t *testing.T) {
+		p, inner := newProvider(t)
+		inner.EXPECT().RefundPayment(mock.Anything, mock.Anything).Return(ports.RefundPaymentOut{}, errUnreachable).Once()
+
+		_, err := p.RefundPayment(ctx, ports.RefundPaymentIn{})
+		require.ErrorIs(t, err, errUnreachable)
+	})
+
+	t.Run("terminal errors", func(t *testing.T) {
+		p, inner := newProvider(t)
+		inner.EXPECT().CreatePayment(mock.Anything, mock.Anything).Return(ports.CreatePaymentOut{}, errDeclined).Once()
+
+		_, err := p.CreatePayment(ctx, ports.CreatePaymentIn{IdempotencyKey: "k"})
+		require.ErrorIs(t, err, errDeclined)
+	})
+
+	t.Run("attempt timeout", func(t *testing.T) {
+		p, inner := newProvider(t, WithTimeout(time.Millisecond))
+		hang := func(ctx context.Context, _ ports.CancelPaymentIn) (ports.CancelPaymentOut, error) {
+			<-ctx.Done()
+			return ports.CancelPaymentOut{}, ctx.Err()
+		}
+		inner.EXPECT().CancelPayment(mock.Anything, mock.Anything).RunAndReturn(hang).Times(3)
+
+		_, err := p.CancelPayment(ctx, ports.CancelPaymentIn{IdempotencyKey: "k"})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("caller gave up", func(t *testing.T) {
+		p, inner := newProvider(t, WithBreaker(1, time.Minute))
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		inner.EXPECT().GetPayment(mock.Anything, mock.Anything).Return(ports.GetPaymentOut{}, context.Canceled).Once()
+
+		_, err := p.GetPayment(ctx, ports.GetPaymentIn{})
+		require.ErrorIs(t, err, context.Canceled)
+		require.True(t, p.breaker.allow(), "a cancelled call does not trip the breaker")
+	})
+}
+
+func TestProvider_Breaker(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	p, inner := newProvider(t, WithRetries(0), WithBreaker(2, time.Minute))
+	p.breaker.now = func() time.Time { return now }
+
+	// A decline shows the provider is up.
+	inner.EXPECT().CreatePayment(mock.Anything, mock.Anything).Return(ports.CreatePaymentOut{}, errOverloaded).Once()
+	inner.EXPECT().CreatePayment(mock.Anything, mock.Anything).Return(ports.CreatePaymentOut{}, errDeclined).Once()
+	inner.EXPECT().CreatePayment(mock.Anything, mock.Anything).Return(ports.CreatePaymentOut{}, errOverloaded).Twice()
+	for _, want := range []error{errOverloaded, errDeclined, errOverloaded, errOverloaded} {
+		_, err := p.CreatePayment(ctx, ports.CreatePaymentIn{})
+		require.ErrorIs(t, err, want)
+	}
+
+	_, err := p.CreatePayment(ctx, ports.CreatePaymentIn{})
+	require.ErrorIs(t, err, ports.ErrProviderUnavailable, "open: the provider is not called")
+
+	// After the cooldown one call probes; its failure opens the breaker again.
+	now = now.Add(time.Minute)
+	inner.EXPECT().CreatePayment(mock.Anything, mock.Anything).Return(ports.CreatePaymentOut{}, errUnreachable).Once()
+	_, err = p.CreatePayment(ctx, ports.CreatePaymentIn{})
+	require.ErrorIs(t, err, errUnreachable)
+	_, err = p.CreatePayment(ctx, ports.CreatePaymentIn{})
+	require.ErrorIs(t, err, ports.ErrProviderUnavailable)
+
+	// A successful probe closes it.
+	now = now.Add(time.Minute)
+	inner.EXPECT().CreatePayment(mock.Anything, mock.Anything).Return(ports.CreatePaymentOut{ProviderID: "pi_1"}, nil).Twice()
+	for range 2 {
+		_, err = p.CreatePayment(ctx, ports.CreatePaymentIn{})
+		require.NoError(t, err)
+	}
+}
+
+func TestProvider_OptionalOperations(t *testing.T) {
+	p, _ := newProvider(t)
+	_, err := p.IncrementAuthorization(context.Background(), ports.IncrementAuthorizationIn{})
+	require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+	_, err = p.ListRefunds(context.Background(), ports.ListRefundsIn{})
+	require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+	_, err = p.ListSettlement(context.Background(), ports.ListSettlementIn{})
+	require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+	_, err = p.ResolvePayment(context.Background(), ports.ResolvePaymentIn{})
+	require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+}
@@ -0,0 +1,183 @@
+package routingadp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+)
+
+// ErrInvalidConfig is returned when routing rules cannot be used.
+var ErrInvalidConfig = errors.New("routing: invalid config")
+
+// DefaultRoute names the target taken when no route matches.
+const DefaultRoute = "default"
+
+// Config is the YAML form of the routing rules.
+type Config struct {
+	// Default takes payments no route matches; its provider is required.
+	Default Target  `yaml:"default"`
+	Routes  []Route `yaml:"routes"`
+}
+
+// Target names the provider a payment is created with.
+type Target struct {
+	Provider string   `yaml:"provider"` // e.g. "stripe"
+	Fallback []string `yaml:"fallback"` // tried in order when the provider fails transiently
+}
+
+// Route sends payments matching all of When to its Target. Routes are evaluated top to bottom.
+type Route struct {
+	Name   string     `yaml:"name"`
+	When   Conditions `yaml:"when"`
+	Target `yaml:",inline"`
+}
+
+// Conditions narrow a route. Empty conditions match every payment.
+type Conditions struct {
+	Currencies []string `yaml:"currencies"` // ISO 4217
+	// MinAmount and MaxAmount are inclusive bounds per currency, e.g. {EUR: "1000.00"}.
+	// A payment in a currency without a bound does not match.
+	MinAmount map[string]string `yaml:"min_amount"`
+	MaxAmount map[string]string `yaml:"max_amount"`
+	Regions   []string          `yaml:"regions"`   // customer countries, ISO 3166-1 alpha-2
+	Merchants []string          `yaml:"merchants"` // merchant IDs
+}
+
+// LoadConfig reads YAML routing rules from path.
+func LoadConfig(path string) (Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("routing: open config: %w", err)
+	}
+	defer f.Close()
+
+	return ReadConfig(f)
+}
+
+// ReadConfig reads YAML routing rules. Unknown keys are rejected, so a typo does not silently drop a condition.
+func ReadConfig(r io.Reader) (Config, error) {
+	var cfg Config
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return Config{}, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+	return cfg, nil
+}
+
+// Providers lists every provider the rules name, each once.
+func (c Config) Providers() []ports.Provider {
+	var out []ports.Provider
+	add := func(t Target) {
+		for _, name := range append([]string{t.Provider}, t.Fallback...) {
+			if name != "" && !slices.Contains(out, ports.Provider(name)) {
+				out = append(out, ports.Provider(name))
+			}
+		}
+	}
+	add(c.Default)
+	for _, r := range c.Routes {
+		add(r.Target)
+	}
+	return out
+}
+
+// route is a Route with parsed values.
+type route struct {
+	name      string
+	providers []ports.Provider // primary first, then the fallbacks
+
+	currencies []string
+	minAmount  map[string]decimal.Decimal
+	maxAmount  map[string]decimal.Decimal
+	regions    []string
+	merchants  []string
+}
+
+func compile(r Route, known map[ports.Provider]ports.PaymentProvider) (route, error) {
+	if r.Provider == "" {
+		return route{}, fmt.Errorf("%w: route %q has no provider", ErrInvalidConfig, r.Name)
+	}
+
+	out := route{
+		name:       r.Name,
+		currencies: upper(r.When.Currencies),
+		regions:    upper(r.When.Regions),
+		merchants:  r.When.Merchants,
+	}
+	for _, name := range append([]string{r.Provider}, r.Fallback...) {
+		if _, ok := known[ports.Provider(name)]; !ok {
+			return route{}, fmt.Errorf("%w: route %q: unknown provider %q", ErrInvalidConfig, r.Name, name)
+		}
+		if slices.Contains(out.providers, ports.Provider(name)) {
+			return route{}, fmt.Errorf("%w: route %q: provider %q is repeated", ErrInvalidConfig, r.Name, name)
+		}
+		out.providers = append(out.providers, ports.Provider(name))
+	}
+
+	var err error
+	if out.minAmount, err = amounts(r.When.MinAmount); err != nil {
+		return route{}, fmt.Errorf("%w: route %q: min_amount: %w", ErrInvalidConfig, r.Name, err)
+	}
+	if out.maxAmount, err = amounts(r.When.MaxAmount); err != nil {
+		return route{}, fmt.Errorf("%w: route %q: max_amount: %w", ErrInvalidConfig, r.Name, err)
+	}
+
+	return out, nil
+}
+
+func (r route) matches(in ports.CreatePaymentIn) bool {
+	currency := strings.ToUpper(in.Amount.GetCurrencyCode())
+
+	if len(r.currencies) > 0 && !slices.Contains(r.currencies, currency) {
+		return false
+	}
+	if len(r.minAmount) > 0 || len(r.maxAmount) > 0 {
+		amt := decimal.New(in.Amount.GetUnits(), 0).Add(decimal.New(int64(in.Amount.GetNanos()), -9))
+		if bound, ok := r.minAmount[currency]; len(r.minAmount) > 0 && (!ok || amt.LessThan(bound)) {
+			return false
+		}
+		if bound, ok := r.maxAmount[currency]; len(r.maxAmount) > 0 && (!ok || amt.GreaterThan(bound)) {
+			return false
+		}
+	}
+	// An unknown region or merchant matches no list.
+	if len(r.regions) > 0 && !slices.Contains(r.regions, strings.ToUpper(in.CustomerRegion)) {
+		return false
+	}
+	if len(r.merchants) > 0 && !slices.Contains(r.merchants, in.MerchantID) {
+		return false
+	}
+	return true
+}
+
+func amounts(in map[string]string) (map[string]decimal.Decimal, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]decimal.Decimal, len(in))
+	for currency, s := range in {
+		d, err := decimal.NewFromString(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", currency, err)
+		}
+		out[strings.ToUpper(currency)] = d
+	}
+	return out, nil
+}
+
+func upper(in []string) []string {
+	out := make([]string, len(in))
+	for i, s := range in {
+		out[i] = strings.ToUpper(s)
+	}
+	return out
+}
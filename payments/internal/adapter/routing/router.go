@@ -0,0 +1,279 @@
+// Package routingadp routes payments across several providers, e.g. RUB to Tinkoff and
+// everything else to Stripe.
+//
+// A new payment is sent to the provider of the first route matching its currency, amount,
+// customer region and merchant. When that provider fails transiently the route's fallbacks
+// are tried in order. The provider that created the payment is attached to the aggregate,
+// and every later call for the payment carries it, so captures, refunds and cancels reach
+// the provider holding the money whatever the rules say by then.
+package routingadp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+)
+
+// ErrUnknownProvider is returned for a payment held by a provider the router does not route to.
+var ErrUnknownProvider = errors.New("routing: unknown provider")
+
+// Router is a ports.PaymentProvider dispatching to other providers.
+type Router struct {
+	providers map[ports.Provider]ports.PaymentProvider
+	routed    []ports.Provider // providers some route names
+	routes    []route
+	fallback  route
+	failover  func(error) bool
+}
+
+// Option configures a Router.
+type Option func(*Router)
+
+// WithFailover sets which create errors let the router try the next provider of a route.
+// It defaults to errors where the request never reached the provider (dial and DNS errors,
+// ports.ErrProviderUnavailable): after e.g. a timeout the payment may exist at the first
+// provider, and creating it at another one could charge the customer twice.
+func WithFailover(failover func(error) bool) Option {
+	return func(r *Router) { r.failover = failover }
+}
+
+var (
+	_ ports.PaymentProvider          = (*Router)(nil)
+	_ ports.AuthorizationIncrementer = (*Router)(nil)
+	_ ports.RefundLister             = (*Router)(nil)
+	_ ports.PaymentResolver          = (*Router)(nil)
+	_ ports.SettlementLister         = (*Router)(nil)
+	_ ports.CapabilitiesRouter       = (*Router)(nil)
+)
+
+// New compiles cfg into a Router over providers; cfg may only name providers given here.
+func New(cfg Config, providers map[ports.Provider]ports.PaymentProvider, opts ...Option) (*Router, error) {
+	r := &Router{
+		providers: providers,
+		failover:  unreachable,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	var err error
+	if r.fallback, err = compile(Route{Name: DefaultRoute, Target: cfg.Default}, providers); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(cfg.Routes))
+	for _, rt := range cfg.Routes {
+		if rt.Name == "" || rt.Name == DefaultRoute || seen[rt.Name] {
+			return nil, fmt.Errorf("%w: route name %q is empty, reserved or repeated", ErrInvalidConfig, rt.Name)
+		}
+		seen[rt.Name] = true
+
+		compiled, err := compile(rt, providers)
+		if err != nil {
+			return nil, err
+		}
+		r.routes = append(r.routes, compiled)
+	}
+	r.routed = cfg.Providers()
+
+	return r, nil
+}
+
+func (r *Router) match(in ports.CreatePaymentIn) route {
+	for _, rt := range r.routes {
+		if rt.matches(in) {
+			return rt
+		}
+	}
+	return r.fallback
+}
+
+// Capabilities implements ports.PaymentProvider: what at least one routed provider supports.
+// CreatePayment checks the capabilities of the provider it picks, later calls those of the
+// provider holding the payment (see CapabilitiesOf).
+func (r *Router) Capabilities() ports.Capabilities {
+	var out ports.Capabilities
+	anyCurrency, unlimitedRefunds := false, false
+	for _, name := range r.routed {
+		caps := r.providers[name].Capabilities()
+
+		anyCurrency = anyCurrency || len(caps.Currencies) == 0
+		for _, c := range upper(caps.Currencies) {
+			if !slices.Contains(out.Currencies, c) {
+				out.Currencies = append(out.Currencies, c)
+			}
+		}
+		for _, m := range caps.CaptureModes {
+			if !out.SupportsCaptureMode(m) {
+				out.CaptureModes = append(out.CaptureModes, m)
+			}
+		}
+
+		out.PartialCapture = out.PartialCapture || caps.PartialCapture
+		out.Refund = out.Refund || caps.Refund
+		out.PartialRefund = out.PartialRefund || caps.PartialRefund
+		out.IncrementalAuthorization = out.IncrementalAuthorization || caps.IncrementalAuthorization
+		unlimitedRefunds = unlimitedRefunds || caps.MaxRefunds == 0
+		out.MaxRefunds = max(out.MaxRefunds, caps.MaxRefunds)
+	}
+	if anyCurrency {
+		out.Currencies = nil
+	}
+	if unlimitedRefunds {
+		out.MaxRefunds = 0
+	}
+	return out
+}
+
+// CapabilitiesOf implements ports.CapabilitiesRouter.
+func (r *Router) CapabilitiesOf(provider ports.Provider) (ports.Capabilities, bool) {
+	p, err := r.provider(provider)
+	if err != nil {
+		return ports.Capabilities{}, false
+	}
+	return p.Capabilities(), true
+}
+
+// CreatePayment sends the payment to the first provider of its route that can take it.
+// Providers lacking the currency or capture mode are skipped; after a failure the next
+// one is only tried if the error allows failover.
+func (r *Router) CreatePayment(ctx context.Context, in ports.CreatePaymentIn) (ports.CreatePaymentOut, error) {
+	rt := r.match(in)
+	mode := eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE
+	if in.CaptureManual {
+		mode = eventv1.CaptureMode_CAPTURE_MODE_MANUAL
+	}
+
+	var errs []error
+	for _, name := range rt.providers {
+		p := r.providers[name]
+		if caps := p.Capabilities(); !caps.SupportsCurrency(in.Amount.GetCurrencyCode()) || !caps.SupportsCaptureMode(mode) {
+			errs = append(errs, fmt.Errorf("%w: %s cannot take %s %v payments",
+				ports.ErrUnsupportedOperation, name, in.Amount.GetCurrencyCode(), mode))
+			continue
+		}
+
+		out, err := p.CreatePayment(ctx, in)
+		if err == nil {
+			if out.Provider == "" {
+				out.Provider = name
+			}
+			return out, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		if !r.failover(err) {
+			break
+		}
+	}
+
+	return ports.CreatePaymentOut{}, fmt.Errorf("routing: route %q: %w", rt.name, errors.Join(errs...))
+}
+
+func (r *Router) GetPayment(ctx context.Context, in ports.GetPaymentIn) (ports.GetPaymentOut, error) {
+	p, err := r.provider(in.Provider)
+	if err != nil {
+		return ports.GetPaymentOut{}, err
+	}
+	return p.GetPayment(ctx, in)
+}
+
+func (r *Router) CapturePayment(ctx context.Context, in ports.CapturePaymentIn) (ports.CapturePaymentOut, error) {
+	p, err := r.provider(in.Provider)
+	if err != nil {
+		return ports.CapturePaymentOut{}, err
+	}
+	return p.CapturePayment(ctx, in)
+}
+
+func (r *Router) RefundPayment(ctx context.Context, in ports.RefundPaymentIn) (ports.RefundPaymentOut, error) {
+	p, err := r.provider(in.Provider)
+	if err != nil {
+		return ports.RefundPaymentOut{}, err
+	}
+	return p.RefundPayment(ctx, in)
+}
+
+func (r *Router) CancelPayment(ctx context.Context, in ports.CancelPaymentIn) (ports.CancelPaymentOut, error) {
+	p, err := r.provider(in.Provider)
+	if err != nil {
+		return ports.CancelPaymentOut{}, err
+	}
+	return p.CancelPayment(ctx, in)
+}
+
+// IncrementAuthorization implements ports.AuthorizationIncrementer for payments held by a provider implementing it.
+func (r *Router) IncrementAuthorization(ctx context.Context, in ports.IncrementAuthorizationIn) (ports.IncrementAuthorizationOut, error) {
+	p, err := r.provider(in.Provider)
+	if err != nil {
+		return ports.IncrementAuthorizationOut{}, err
+	}
+	incrementer, ok := p.(ports.AuthorizationIncrementer)
+	if !ok {
+		return ports.IncrementAuthorizationOut{}, fmt.Errorf("%w: %s cannot raise a hold", ports.ErrUnsupportedOperation, in.Provider)
+	}
+	return incrementer.IncrementAuthorization(ctx, in)
+}
+
+// ListRefunds implements ports.RefundLister for payments held by a provider implementing it.
+func (r *Router) ListRefunds(ctx context.Context, in ports.ListRefundsIn) (ports.ListRefundsOut, error) {
+	p, err := r.provider(in.Provider)
+	if err != nil {
+		return ports.ListRefundsOut{}, err
+	}
+	lister, ok := p.(ports.RefundLister)
+	if !ok {
+		return ports.ListRefundsOut{}, fmt.Errorf("%w: %s cannot list refunds", ports.ErrUnsupportedOperation, in.Provider)
+	}
+	return lister.ListRefunds(ctx, in)
+}
+
+// ResolvePayment implements ports.PaymentResolver for notifications of a provider implementing it.
+func (r *Router) ResolvePayment(ctx context.Context, in ports.ResolvePaymentIn) (ports.ResolvePaymentOut, error) {
+	p, err := r.provider(in.Provider)
+	if err != nil {
+		return ports.ResolvePaymentOut{}, err
+	}
+	resolver, ok := p.(ports.PaymentResolver)
+	if !ok {
+		return ports.ResolvePaymentOut{}, fmt.Errorf("%w: %s cannot resolve payments", ports.ErrUnsupportedOperation, in.Provider)
+	}
+	return resolver.ResolvePayment(ctx, in)
+}
+
+// ListSettlement implements ports.SettlementLister for payouts of a provider implementing it.
+func (r *Router) ListSettlement(ctx context.Context, in ports.ListSettlementIn) (ports.ListSettlementOut, error) {
+	p, err := r.provider(in.Provider)
+	if err != nil {
+		return ports.ListSettlementOut{}, err
+	}
+	lister, ok := p.(ports.SettlementLister)
+	if !ok {
+		return ports.ListSettlementOut{}, fmt.Errorf("%w: %s cannot list settlements", ports.ErrUnsupportedOperation, in.Provider)
+	}
+	return lister.ListSettlement(ctx, in)
+}
+
+// provider returns the provider holding a payment. A payment without one was created
+// before routing and is held by the default provider.
+func (r *Router) provider(name ports.Provider) (ports.PaymentProvider, error) {
+	if name == "" {
+		name = r.fallback.providers[0]
+	}
+	if !slices.Contains(r.routed, name) {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProvider, name)
+	}
+	return r.providers[name], nil
+}
+
+// unreachable reports whether a request failed before reaching the provider.
+func unreachable(err error) bool {
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+	return errors.Is(err, ports.ErrProviderUnavailable) || errors.As(err, &dnsErr) ||
+		errors.As(err, &opErr) && opErr.Op == "dial"
+}
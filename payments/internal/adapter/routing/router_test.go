@@ -0,0 +1,211 @@
+package routingadp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+)
+
+const rules = `
+default: {provider: stripe}
+routes:
+  - name: rub
+    when: {currencies: [RUB]}
+    provider: tinkoff
+    fallback: [stripe]
+  - name: large-eur
+    when: {min_amount: {EUR: "1000"}, regions: [de, AT]}
+    provider: tinkoff
+  - name: marketplace
+    when: {merchants: [seller-1]}
+    provider: tinkoff
+`
+
+var allModes = []eventv1.CaptureMode{eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE, eventv1.CaptureMode_CAPTURE_MODE_MANUAL}
+
+// newRouter routes between a Stripe and a Tinkoff mock.
+func newRouter(t *testing.T, opts ...Option) (*Router, *mocks.MockPaymentProvider, *mocks.MockPaymentProvider) {
+	t.Helper()
+
+	stripe := mocks.NewMockPaymentProvider(t)
+	stripe.EXPECT().Capabilities().Return(ports.Capabilities{CaptureModes: allModes, Refund: true}).Maybe()
+	tinkoff := mocks.NewMockPaymentProvider(t)
+	tinkoff.EXPECT().Capabilities().Return(ports.Capabilities{
+		Currencies:   []string{"RUB", "EUR"},
+		CaptureModes: []eventv1.CaptureMode{eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE},
+		Refund:       true,
+		MaxRefunds:   1,
+	}).Maybe()
+
+	cfg, err := ReadConfig(strings.NewReader(rules))
+	require.NoError(t, err)
+	r, err := New(cfg, map[ports.Provider]ports.PaymentProvider{
+		ports.ProviderStripe:  stripe,
+		ports.ProviderTinkoff: tinkoff,
+	}, opts...)
+	require.NoError(t, err)
+	return r, stripe, tinkoff
+}
+
+func created(_ context.Context, in ports.CreatePaymentIn) (ports.CreatePaymentOut, error) {
+	return ports.CreatePaymentOut{ProviderID: "p_" + in.IdempotencyKey, Status: ports.ProviderStatusSucceeded}, nil
+}
+
+func TestRouter_CreatePayment(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		in   ports.CreatePaymentIn
+		want ports.Provider
+	}{
+		{name: "currency", in: ports.CreatePaymentIn{Amount: &money.Money{CurrencyCode: "RUB", Units: 100}}, want: ports.ProviderTinkoff},
+		{name: "amount and region", in: ports.CreatePaymentIn{Amount: &money.Money{CurrencyCode: "EUR", Units: 1000}, CustomerRegion: "DE"}, want: ports.ProviderTinkoff},
+		{name: "below the amount", in: ports.CreatePaymentIn{Amount: &money.Money{CurrencyCode: "EUR", Units: 999}, CustomerRegion: "DE"}, want: ports.ProviderStripe},
+		{name: "unknown region", in: ports.CreatePaymentIn{Amount: &money.Money{CurrencyCode: "EUR", Units: 1000}}, want: ports.ProviderStripe},
+		{name: "currency without a bound", in: ports.CreatePaymentIn{Amount: &money.Money{CurrencyCode: "USD", Units: 5000}, CustomerRegion: "AT"}, want: ports.ProviderStripe},
+		{name: "merchant", in: ports.CreatePaymentIn{Amount: &money.Money{CurrencyCode: "EUR", Units: 10}, MerchantID: "seller-1"}, want: ports.ProviderTinkoff},
+		{name: "default", in: ports.CreatePaymentIn{Amount: &money.Money{CurrencyCode: "USD", Units: 10}}, want: ports.ProviderStripe},
+		{
+			name: "capture mode the route's provider lacks",
+			in:   ports.CreatePaymentIn{Amount: &money.Money{CurrencyCode: "RUB", Units: 100}, CaptureManual: true},
+			want: ports.ProviderStripe,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, stripe, tinkoff := newRouter(t)
+			target := map[ports.Provider]*mocks.MockPaymentProvider{ports.ProviderStripe: stripe, ports.ProviderTinkoff: tinkoff}[tt.want]
+			target.EXPECT().CreatePayment(mock.Anything, tt.in).RunAndReturn(created).Once()
+
+			out, err := r.CreatePayment(ctx, tt.in)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, out.Provider, "the provider holding the payment is reported for AttachProvider")
+		})
+	}
+}
+
+func TestRouter_Failover(t *testing.T) {
+	ctx := context.Background()
+	rub := ports.CreatePaymentIn{Amount: &money.Money{CurrencyCode: "RUB", Units: 100}}
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+	t.Run("unreachable provider", func(t *testing.T) {
+		r, stripe, tinkoff := newRouter(t)
+		tinkoff.EXPECT().CreatePayment(mock.Anything, rub).Return(ports.CreatePaymentOut{}, refused).Once()
+		stripe.EXPECT().CreatePayment(mock.Anything, rub).RunAndReturn(created).Once()
+
+		out, err := r.CreatePayment(ctx, rub)
+		require.NoError(t, err)
+		require.Equal(t, ports.ProviderStripe, out.Provider)
+	})
+
+	t.Run("open circuit breaker", func(t *testing.T) {
+		r, stripe, tinkoff := newRouter(t)
+		open := fmt.Errorf("%w: tinkoff: circuit breaker open", ports.ErrProviderUnavailable)
+		tinkoff.EXPECT().CreatePayment(mock.Anything, rub).Return(ports.CreatePaymentOut{}, open).Once()
+		stripe.EXPECT().CreatePayment(mock.Anything, rub).RunAndReturn(created).Once()
+
+		out, err := r.CreatePayment(ctx, rub)
+		require.NoError(t, err)
+		require.Equal(t, ports.ProviderStripe, out.Provider)
+	})
+
+	t.Run("declined payment is not sent elsewhere", func(t *testing.T) {
+		r, _, tinkoff := newRouter(t)
+		declined := errors.New("tinkoff: card declined")
+		tinkoff.EXPECT().CreatePayment(mock.Anything, rub).Return(ports.CreatePaymentOut{}, declined).Once()
+
+		_, err := r.CreatePayment(ctx, rub)
+		require.ErrorIs(t, err, declined)
+	})
+
+	t.Run("custom failover", func(t *testing.T) {
+		timeout := errors.New("tinkoff: timeout")
+		r, stripe, tinkoff := newRouter(t, WithFailover(func(err error) bool { return errors.Is(err, timeout) }))
+		tinkoff.EXPECT().CreatePayment(mock.Anything, rub).Return(ports.CreatePaymentOut{}, timeout).Once()
+		stripe.EXPECT().CreatePayment(mock.Anything, rub).Return(ports.CreatePaymentOut{}, refused).Once()
+
+		_, err := r.CreatePayment(ctx, rub)
+		require.ErrorIs(t, err, timeout)
+		require.ErrorIs(t, err, refused)
+	})
+
+	t.Run("no provider can take the payment", func(t *testing.T) {
+		r, _, _ := newRouter(t)
+		_, err := r.CreatePayment(ctx, ports.CreatePaymentIn{Amount: &money.Money{CurrencyCode: "EUR", Units: 10}, MerchantID: "seller-1", CaptureManual: true})
+		require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+	})
+}
+
+func TestRouter_FollowsThePayment(t *testing.T) {
+	ctx := context.Background()
+	r, stripe, tinkoff := newRouter(t)
+
+	tinkoff.EXPECT().RefundPayment(mock.Anything, mock.Anything).
+		Return(ports.RefundPaymentOut{Provider: ports.ProviderTinkoff, Status: ports.ProviderStatusSucceeded}, nil).Once()
+	out, err := r.RefundPayment(ctx, ports.RefundPaymentIn{Provider: ports.ProviderTinkoff, ProviderID: "t_1"})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderTinkoff, out.Provider)
+
+	// Payments that recorded no provider are held by the default one.
+	stripe.EXPECT().CancelPayment(mock.Anything, mock.Anything).
+		Return(ports.CancelPaymentOut{Provider: ports.ProviderStripe, Status: ports.ProviderStatusCanceled}, nil).Once()
+	_, err = r.CancelPayment(ctx, ports.CancelPaymentIn{ProviderID: "pi_1"})
+	require.NoError(t, err)
+
+	_, err = r.CapturePayment(ctx, ports.CapturePaymentIn{Provider: "paypal", ProviderID: "x"})
+	require.ErrorIs(t, err, ErrUnknownProvider)
+
+	_, err = r.IncrementAuthorization(ctx, ports.IncrementAuthorizationIn{Provider: ports.ProviderTinkoff})
+	require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+	_, err = r.ListRefunds(ctx, ports.ListRefundsIn{Provider: ports.ProviderStripe})
+	require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+	_, err = r.ListSettlement(ctx, ports.ListSettlementIn{Provider: ports.ProviderTinkoff, PayoutID: "1"})
+	require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+	_, err = r.ResolvePayment(ctx, ports.ResolvePaymentIn{Provider: ports.ProviderStripe, ProviderID: "pi_1"})
+	require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+
+	caps := ports.CapabilitiesFor(r, ports.ProviderTinkoff)
+	require.Equal(t, 1, caps.MaxRefunds)
+	require.False(t, caps.SupportsCurrency("USD"))
+
+	union := r.Capabilities()
+	require.True(t, union.SupportsCurrency("USD"))
+	require.Zero(t, union.MaxRefunds)
+	require.ElementsMatch(t, allModes, union.CaptureModes)
+}
+
+func TestNew_Invalid(t *testing.T) {
+	providers := map[ports.Provider]ports.PaymentProvider{ports.ProviderStripe: mocks.NewMockPaymentProvider(t)}
+
+	for name, rules := range map[string]string{
+		"no default":        "routes: [{name: a, provider: stripe}]",
+		"unknown provider":  "default: {provider: stripe, fallback: [paypal]}",
+		"repeated provider": "default: {provider: stripe, fallback: [stripe]}",
+		"route without one": "default: {provider: stripe}\nroutes: [{name: a, when: {currencies: [RUB]}}]",
+		"reserved name":     "default: {provider: stripe}\nroutes: [{name: default, provider: stripe}]",
+		"bad amount":        "default: {provider: stripe}\nroutes: [{name: a, when: {min_amount: {EUR: lots}}, provider: stripe}]",
+		"unknown key":       "default: {provider: stripe}\nroutes: [{name: a, when: {country: [DE]}, provider: stripe}]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := ReadConfig(strings.NewReader(rules))
+			if err == nil {
+				_, err = New(cfg, providers)
+			}
+			require.ErrorIs(t, err, ErrInvalidConfig)
+		})
+	}
+}
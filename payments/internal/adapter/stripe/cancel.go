@@ -0,0 +1,46 @@
+package stripeadp
+
+import (
+	"context"
+	"errors"
+
+	"github.com/stripe/stripe-go/v82"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/dto"
+)
+
+// CancelPayment cancels a payment intent through Stripe, releasing any authorization hold.
+func (p *Provider) CancelPayment(ctx context.Context, in ports.CancelPaymentIn) (ports.CancelPaymentOut, error) {
+	params := &stripe.PaymentIntentCancelParams{}
+	if in.IdempotencyKey != "" {
+		params.SetIdempotencyKey(in.IdempotencyKey)
+	}
+
+	// Map domain reasons to Stripe-specific reasons
+	switch in.Reason {
+	case "duplicate":
+		params.CancellationReason = stripe.String(string(stripe.PaymentIntentCancellationReasonDuplicate))
+	case "user":
+		params.CancellationReason = stripe.String(string(stripe.PaymentIntentCancellationReasonRequestedByCustomer))
+	default:
+		params.CancellationReason = stripe.String(string(stripe.PaymentIntentCancellationReasonAbandoned))
+	}
+
+	pi, err := p.client.V1PaymentIntents.Cancel(ctx, in.ProviderID, params)
+	if err != nil {
+		// A retry after the intent was already canceled (e.g. our save lost a race)
+		// is a success: the hold is gone either way.
+		var stripeErr *stripe.Error
+		if errors.As(err, &stripeErr) && stripeErr.PaymentIntent != nil &&
+			stripeErr.PaymentIntent.Status == stripe.PaymentIntentStatusCanceled {
+			return ports.CancelPaymentOut{Provider: ports.ProviderStripe, Status: ports.ProviderStatusCanceled}, nil
+		}
+		return ports.CancelPaymentOut{}, err
+	}
+
+	return ports.CancelPaymentOut{
+		Provider: ports.ProviderStripe,
+		Status:   dto.MapPIStatus(pi),
+	}, nil
+}
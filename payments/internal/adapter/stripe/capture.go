@@ -0,0 +1,52 @@
+package stripeadp
+
+import (
+	"context"
+
+	"github.com/stripe/stripe-go/v82"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+	"github.com/shortlink-org/billing/payments/internal/dto"
+)
+
+// CapturePayment captures funds held by a manual-capture payment intent through Stripe.
+func (p *Provider) CapturePayment(ctx context.Context, in ports.CapturePaymentIn) (ports.CapturePaymentOut, error) {
+	minor, err := ledger.AmountToMinorUnits(in.Amount)
+	if err != nil {
+		return ports.CapturePaymentOut{}, err
+	}
+
+	params := &stripe.PaymentIntentCaptureParams{
+		AmountToCapture: stripe.Int64(minor),
+		// Partial captures keep the rest of the authorization open (multicapture);
+		// without multicapture Stripe releases whatever is not captured.
+		FinalCapture: stripe.Bool(in.Final || !p.multicapture),
+	}
+	if in.IdempotencyKey != "" {
+		params.SetIdempotencyKey(in.IdempotencyKey)
+	}
+
+	// Metadata (use AddMetadata on embedded stripe.Params).
+	for k, v := range in.Metadata {
+		params.AddMetadata(k, v)
+	}
+
+	pi, err := p.client.V1PaymentIntents.Capture(ctx, in.ProviderID, params)
+	if err != nil {
+		return ports.CapturePaymentOut{}, err
+	}
+
+	out := ports.CapturePaymentOut{
+		Provider: ports.ProviderStripe,
+		Status:   dto.MapPIStatus(pi),
+	}
+
+	switch out.Status {
+	case ports.ProviderStatusSucceeded, ports.ProviderStatusRequiresCapture:
+		// Stripe captures exactly amount_to_capture; the intent reports only cumulative totals.
+		out.Captured = dto.FromMinor(pi.Currency, minor)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,417 @@
+package stripeadp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+)
+
+const contractKey = "sk_test_contract"
+
+// testContract checks the provider port against a Stripe API: every call is accepted and
+// answered with a response the adapter can map. It asserts nothing a fixture-based backend
+// such as stripe-mock cannot honour, e.g. the status after a capture.
+func testContract(t *testing.T, p *Provider) {
+	t.Helper()
+	ctx := context.Background()
+	usd := &money.Money{CurrencyCode: "USD", Units: 20}
+	paymentID := uuid.New()
+
+	created, err := p.CreatePayment(ctx, ports.CreatePaymentIn{
+		PaymentID:      paymentID,
+		Amount:         usd,
+		Currency:       "USD",
+		CaptureManual:  true,
+		Description:    "contract",
+		Metadata:       map[string]string{"payment_id": paymentID.String()},
+		ReturnURL:      "https://shop.example/return",
+		RequireSCA:     true,
+		IdempotencyKey: paymentID.String() + ":create",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStripe, created.Provider)
+	require.NotEmpty(t, created.ProviderID)
+	require.NotEmpty(t, created.ClientSecret)
+	id := created.ProviderID
+
+	got, err := p.GetPayment(ctx, ports.GetPaymentIn{ProviderID: id})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStripe, got.Provider)
+
+	_, err = p.ResolvePayment(ctx, ports.ResolvePaymentIn{Provider: ports.ProviderStripe, ProviderID: id})
+	require.NoError(t, err)
+
+	_, err = p.IncrementAuthorization(ctx, ports.IncrementAuthorizationIn{
+		ProviderID: id, Amount: &money.Money{CurrencyCode: "USD", Units: 5}, Total: &money.Money{CurrencyCode: "USD", Units: 25},
+		IdempotencyKey: paymentID.String() + ":increment",
+	})
+	require.NoError(t, err)
+
+	// A partial capture keeps the hold open, the final one takes the rest.
+	require.True(t, p.Capabilities().PartialCapture)
+	partial, err := p.CapturePayment(ctx, ports.CapturePaymentIn{
+		ProviderID: id, Amount: &money.Money{CurrencyCode: "USD", Units: 5}, IdempotencyKey: paymentID.String() + ":capture:1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStripe, partial.Provider)
+
+	captured, err := p.CapturePayment(ctx, ports.CapturePaymentIn{
+		ProviderID: id, Amount: usd, Final: true, IdempotencyKey: paymentID.String() + ":capture:2",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStripe, captured.Provider)
+
+	refundID := uuid.New()
+	refunded, err := p.RefundPayment(ctx, ports.RefundPaymentIn{
+		ProviderID: id, Amount: &money.Money{CurrencyCode: "USD", Units: 5}, Reason: eventv1.RefundReason_REFUND_REASON_DUPLICATE,
+		Metadata:       map[string]string{"refund_id": refundID.String()},
+		IdempotencyKey: paymentID.String() + ":refund",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, refunded.RefundID)
+	require.NotEqual(t, ports.ProviderStatusUnknown, refunded.Status)
+
+	listed, err := p.ListRefunds(ctx, ports.ListRefundsIn{ProviderID: id})
+	require.NoError(t, err)
+	require.NotEmpty(t, listed.Refunds)
+
+	_, err = p.ListSettlement(ctx, ports.ListSettlementIn{PayoutID: "po_contract"})
+	require.NoError(t, err)
+
+	_, err = p.CancelPayment(ctx, ports.CancelPaymentIn{ProviderID: id, Reason: "user", IdempotencyKey: paymentID.String() + ":cancel"})
+	require.NoError(t, err)
+}
+
+// TestContract_StripeMock runs the contract against stripe-mock, which validates every request
+// against the Stripe OpenAPI spec: docker run -p 12111:12111 stripe/stripe-mock and
+// STRIPE_MOCK_URL=http://localhost:12111.
+func TestContract_StripeMock(t *testing.T) {
+	url := os.Getenv("STRIPE_MOCK_URL")
+	if url == "" {
+		t.Skip("STRIPE_MOCK_URL is not set")
+	}
+
+	testContract(t, NewClient(contractKey, WithBackendURL(url), WithMulticapture()))
+}
+
+func TestContract_StandIn(t *testing.T) {
+	api := newStandIn(t)
+	testContract(t, NewClient(contractKey, WithBackendURL(api.url), WithMulticapture()))
+
+	// Requests reach the injected backend with the client's key and the idempotency keys.
+	create := api.request("POST /v1/payment_intents")
+	require.Equal(t, "Bearer "+contractKey, create.Header.Get("Authorization"))
+	require.True(t, strings.HasSuffix(create.Header.Get("Idempotency-Key"), ":create"))
+	require.Equal(t, "2000", create.PostForm.Get("amount"))
+	require.Equal(t, "manual", create.PostForm.Get("capture_method"))
+	require.Equal(t, "any", create.PostForm.Get("payment_method_options[card][request_three_d_secure]"))
+	require.Equal(t, "if_available", create.PostForm.Get("payment_method_options[card][request_multicapture]"))
+	require.Equal(t, "true", api.request("POST /v1/payment_intents/id/capture").PostForm.Get("final_capture"))
+
+	refund := api.request("POST /v1/refunds")
+	require.Equal(t, "duplicate", refund.PostForm.Get("reason"))
+	require.True(t, strings.HasSuffix(refund.Header.Get("Idempotency-Key"), ":refund"))
+}
+
+func TestProvider_StandIn(t *testing.T) {
+	ctx := context.Background()
+	api := newStandIn(t)
+	p := NewClient(contractKey, WithBackendURL(api.url))
+	usd := &money.Money{CurrencyCode: "USD", Units: 20}
+
+	created, err := p.CreatePayment(ctx, ports.CreatePaymentIn{Amount: usd, Currency: "USD", CaptureManual: true})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStatusRequiresCapture, created.Status)
+	require.Equal(t, usd, created.Authorized)
+
+	// Without multicapture Stripe refuses final_capture=false: every capture is final.
+	require.False(t, p.Capabilities().PartialCapture)
+	captured, err := p.CapturePayment(ctx, ports.CapturePaymentIn{ProviderID: created.ProviderID, Amount: usd})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStatusSucceeded, captured.Status)
+	require.Equal(t, usd, captured.Captured)
+	require.Empty(t, api.request("POST /v1/payment_intents").PostForm.Get("payment_method_options[card][request_multicapture]"))
+	require.Equal(t, "true", api.request("POST /v1/payment_intents/id/capture").PostForm.Get("final_capture"))
+
+	refundID := uuid.New()
+	_, err = p.RefundPayment(ctx, ports.RefundPaymentIn{
+		ProviderID: created.ProviderID, Amount: &money.Money{CurrencyCode: "USD", Units: 5},
+		Metadata: map[string]string{"refund_id": refundID.String()},
+	})
+	require.NoError(t, err)
+	listed, err := p.ListRefunds(ctx, ports.ListRefundsIn{ProviderID: created.ProviderID})
+	require.NoError(t, err)
+	require.Equal(t, []ports.ProviderRefund{{
+		ID: refundID, RefundID: "re_1", Status: ports.ProviderStatusSucceeded, Amount: &money.Money{CurrencyCode: "USD", Units: 5},
+	}}, listed.Refunds)
+
+	_, err = p.GetPayment(ctx, ports.GetPaymentIn{ProviderID: "pi_missing"})
+	require.Error(t, err)
+	require.False(t, p.Retryable(err), "a 404 is final")
+
+	// An intent of another account or service is not one of ours.
+	resolved, err := p.ResolvePayment(ctx, ports.ResolvePaymentIn{Provider: ports.ProviderStripe, ProviderID: "pi_missing"})
+	require.NoError(t, err)
+	require.Equal(t, uuid.Nil, resolved.PaymentID)
+}
+
+func TestProvider_ListSettlement(t *testing.T) {
+	ctx := context.Background()
+	api := newStandIn(t)
+	p := NewClient(contractKey, WithBackendURL(api.url))
+	paymentID := uuid.New()
+
+	created, err := p.CreatePayment(ctx, ports.CreatePaymentIn{
+		Amount: &money.Money{CurrencyCode: "EUR", Units: 100}, Currency: "EUR",
+		Metadata: map[string]string{"payment_id": paymentID.String()},
+	})
+	require.NoError(t, err)
+	pi := created.ProviderID
+
+	// 100.00 EUR charged and 10.00 EUR refunded, paid out in USD; the payout itself and an
+	// intent of another service carry no payment of ours.
+	api.payout("po_1",
+		map[string]any{
+			"id": "txn_1", "object": "balance_transaction", "type": "charge", "currency": "usd",
+			"amount": 10850, "fee": 345, "net": 10505, "available_on": 1790000000,
+			"source": map[string]any{"id": "ch_1", "object": "charge", "currency": "eur", "amount": 10000, "amount_captured": 10000, "payment_intent": pi},
+		},
+		map[string]any{
+			"id": "txn_2", "object": "balance_transaction", "type": "refund", "currency": "usd",
+			"amount": -1090, "fee": 0, "net": -1090, "available_on": 1790086400,
+			"source": map[string]any{"id": "re_1", "object": "refund", "currency": "eur", "amount": 1000, "payment_intent": pi},
+		},
+		map[string]any{
+			"id": "txn_3", "object": "balance_transaction", "type": "payout", "currency": "usd",
+			"amount": -9415, "fee": 0, "net": -9415, "available_on": 1790086400,
+			"source": map[string]any{"id": "po_1", "object": "payout"},
+		},
+	)
+
+	out, err := p.ListSettlement(ctx, ports.ListSettlementIn{PayoutID: "po_1"})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStripe, out.Provider)
+	require.Equal(t, "po_1", out.PayoutID)
+	require.Equal(t, 1, out.Skipped)
+	require.Equal(t, []ports.SettlementEntry{
+		{
+			TransactionID: "txn_1", PaymentID: paymentID, ProviderID: pi, Type: eventv1.SettlementType_SETTLEMENT_TYPE_CHARGE,
+			Amount:    &money.Money{CurrencyCode: "EUR", Units: 100},
+			Gross:     &money.Money{CurrencyCode: "USD", Units: 108, Nanos: 500_000_000},
+			Fee:       &money.Money{CurrencyCode: "USD", Units: 3, Nanos: 450_000_000},
+			Net:       &money.Money{CurrencyCode: "USD", Units: 105, Nanos: 50_000_000},
+			SettledAt: time.Unix(1790000000, 0).UTC(),
+		},
+		{
+			TransactionID: "txn_2", PaymentID: paymentID, ProviderID: pi, Type: eventv1.SettlementType_SETTLEMENT_TYPE_REFUND,
+			Amount:    &money.Money{CurrencyCode: "EUR", Units: -10},
+			Gross:     &money.Money{CurrencyCode: "USD", Units: -10, Nanos: -900_000_000},
+			Fee:       &money.Money{CurrencyCode: "USD"},
+			Net:       &money.Money{CurrencyCode: "USD", Units: -10, Nanos: -900_000_000},
+			SettledAt: time.Unix(1790086400, 0).UTC(),
+		},
+	}, out.Entries)
+
+	list := api.request("GET /v1/balance_transactions")
+	require.Equal(t, "po_1", list.Form.Get("payout"))
+	require.Equal(t, "data.source", list.Form.Get("expand[0]"))
+}
+
+// standIn is an in-process Stripe API keeping payment intents, refunds and payouts in memory.
+type standIn struct {
+	url string
+
+	mu       sync.Mutex
+	intents  map[string]map[string]any
+	refunds  []map[string]any
+	payouts  map[string][]map[string]any // balance transactions per payout
+	requests map[string]*http.Request    // last request per route
+}
+
+func newStandIn(t *testing.T) *standIn {
+	t.Helper()
+
+	api := &standIn{
+		intents:  make(map[string]map[string]any),
+		payouts:  make(map[string][]map[string]any),
+		requests: make(map[string]*http.Request),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/payment_intents", api.createIntent)
+	mux.HandleFunc("GET /v1/payment_intents/{id}", api.intent(nil))
+	mux.HandleFunc("POST /v1/payment_intents/{id}/capture", api.intent(capture))
+	mux.HandleFunc("POST /v1/payment_intents/{id}/cancel", api.intent(func(pi map[string]any, _ map[string][]string) error {
+		pi["status"] = "canceled"
+		return nil
+	}))
+	mux.HandleFunc("POST /v1/payment_intents/{id}/increment_authorization", api.intent(func(pi map[string]any, form map[string][]string) error {
+		amount, _ := strconv.ParseInt(first(form["amount"]), 10, 64)
+		pi["amount"], pi["amount_capturable"] = amount, amount
+		return nil
+	}))
+	mux.HandleFunc("POST /v1/refunds", api.createRefund)
+	mux.HandleFunc("GET /v1/refunds", api.listRefunds)
+	mux.HandleFunc("GET /v1/balance_transactions", api.listBalanceTransactions)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		_, pattern := mux.Handler(r)
+		api.mu.Lock()
+		api.requests[strings.Replace(pattern, "{id}", "id", 1)] = r
+		api.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	api.url = srv.URL
+	return api
+}
+
+func (a *standIn) request(route string) *http.Request {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.requests[route]
+}
+
+func (a *standIn) createIntent(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	amount, _ := strconv.ParseInt(r.PostForm.Get("amount"), 10, 64)
+	id := fmt.Sprintf("pi_%d", len(a.intents)+1)
+	pi := map[string]any{
+		"id": id, "object": "payment_intent", "amount": amount, "currency": r.PostForm.Get("currency"),
+		"capture_method": r.PostForm.Get("capture_method"), "client_secret": id + "_secret",
+		"metadata": metadata(r.PostForm), "status": "succeeded", "amount_received": amount,
+		"payment_method_options": map[string]any{"card": map[string]any{
+			"request_multicapture": r.PostForm.Get("payment_method_options[card][request_multicapture]"),
+		}},
+	}
+	if pi["capture_method"] == "manual" {
+		pi["status"], pi["amount_received"], pi["amount_capturable"] = "requires_capture", int64(0), amount
+	}
+	a.intents[id] = pi
+	writeJSON(w, http.StatusOK, pi)
+}
+
+// capture captures amount_to_capture; like Stripe, it keeps the hold open only on intents
+// created with request_multicapture.
+func capture(pi map[string]any, form map[string][]string) error {
+	amount, _ := strconv.ParseInt(first(form["amount_to_capture"]), 10, 64)
+	card, _ := pi["payment_method_options"].(map[string]any)["card"].(map[string]any)
+	if first(form["final_capture"]) == "false" && card["request_multicapture"] != "if_available" {
+		return errors.New("final_capture=false requires payment_method_options[card][request_multicapture]")
+	}
+
+	pi["amount_received"] = pi["amount_received"].(int64) + amount
+	pi["amount_capturable"] = pi["amount_capturable"].(int64) - amount
+	if first(form["final_capture"]) != "false" {
+		pi["status"], pi["amount_capturable"] = "succeeded", int64(0)
+	}
+	return nil
+}
+
+// intent serves a payment intent, applying update to it first.
+func (a *standIn) intent(update func(pi map[string]any, form map[string][]string) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		pi, ok := a.intents[r.PathValue("id")]
+		if !ok {
+			writeJSON(w, http.StatusNotFound, map[string]any{"error": map[string]any{
+				"type": "invalid_request_error", "code": "resource_missing", "message": "No such payment_intent",
+			}})
+			return
+		}
+		if update != nil {
+			if err := update(pi, r.PostForm); err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"error": map[string]any{
+					"type": "invalid_request_error", "message": err.Error(),
+				}})
+				return
+			}
+		}
+		writeJSON(w, http.StatusOK, pi)
+	}
+}
+
+func (a *standIn) createRefund(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	amount, _ := strconv.ParseInt(r.PostForm.Get("amount"), 10, 64)
+	pi := a.intents[r.PostForm.Get("payment_intent")]
+	refund := map[string]any{
+		"id": fmt.Sprintf("re_%d", len(a.refunds)+1), "object": "refund", "amount": amount, "currency": pi["currency"],
+		"status": "succeeded", "payment_intent": pi["id"], "reason": r.PostForm.Get("reason"), "metadata": metadata(r.PostForm),
+	}
+	a.refunds = append([]map[string]any{refund}, a.refunds...)
+	writeJSON(w, http.StatusOK, refund)
+}
+
+func (a *standIn) listRefunds(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	data := []map[string]any{}
+	for _, refund := range a.refunds {
+		if refund["payment_intent"] == r.URL.Query().Get("payment_intent") {
+			data = append(data, refund)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"object": "list", "url": "/v1/refunds", "has_more": false, "data": data})
+}
+
+// payout adds the balance transactions paid out with id.
+func (a *standIn) payout(id string, txns ...map[string]any) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.payouts[id] = append(a.payouts[id], txns...)
+}
+
+func (a *standIn) listBalanceTransactions(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	data := append([]map[string]any{}, a.payouts[r.URL.Query().Get("payout")]...)
+	writeJSON(w, http.StatusOK, map[string]any{"object": "list", "url": "/v1/balance_transactions", "has_more": false, "data": data})
+}
+
+func metadata(form map[string][]string) map[string]string {
+	out := make(map[string]string)
+	for k, v := range form {
+		if key, ok := strings.CutPrefix(k, "metadata["); ok {
+			out[strings.TrimSuffix(key, "]")] = first(v)
+		}
+	}
+	return out
+}
+
+func first(v []string) string {
+	if len(v) == 0 {
+		return ""
+	}
+	return v[0]
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,53 @@
+package stripeadp
+
+import (
+	"context"
+
+	"github.com/stripe/stripe-go/v82"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/dto"
+)
+
+// GetPayment re-fetches a payment intent from Stripe, e.g. after the customer returned from 3DS.
+func (p *Provider) GetPayment(ctx context.Context, in ports.GetPaymentIn) (ports.GetPaymentOut, error) {
+	pi, err := p.client.V1PaymentIntents.Retrieve(ctx, in.ProviderID, nil)
+	if err != nil {
+		return ports.GetPaymentOut{}, err
+	}
+
+	out := ports.GetPaymentOut{
+		Provider: ports.ProviderStripe,
+		Status:   dto.MapPIStatus(pi),
+	}
+
+	// A failed attempt (declined card, rejected 3DS) sends the intent back to
+	// requires_payment_method with the reason in last_payment_error.
+	if pi.Status == stripe.PaymentIntentStatusRequiresPaymentMethod && pi.LastPaymentError != nil {
+		out.Status = ports.ProviderStatusFailed
+		out.SCA = scaOutcome(pi.LastPaymentError)
+	}
+
+	switch out.Status {
+	case ports.ProviderStatusRequiresCapture:
+		out.Authorized = dto.FromMinor(pi.Currency, pi.AmountCapturable)
+	case ports.ProviderStatusSucceeded:
+		out.Captured = dto.FromMinor(pi.Currency, pi.AmountReceived)
+	}
+
+	return out, nil
+}
+
+// scaOutcome tells a rejected or abandoned SCA/3DS challenge apart from a plain decline.
+func scaOutcome(lastErr *stripe.Error) ports.SCAOutcome {
+	switch {
+	case lastErr == nil:
+		return ports.SCAOutcomeNone
+	case lastErr.Code == stripe.ErrorCodePaymentIntentAuthenticationFailure:
+		return ports.SCAOutcomeFailed
+	case lastErr.DeclineCode == stripe.DeclineCodeAuthenticationRequired:
+		return ports.SCAOutcomeNotCompleted
+	default:
+		return ports.SCAOutcomeNone
+	}
+}
@@ -0,0 +1,50 @@
+package stripeadp
+
+import (
+	"context"
+
+	"github.com/stripe/stripe-go/v82"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+	"github.com/shortlink-org/billing/payments/internal/dto"
+)
+
+var _ ports.AuthorizationIncrementer = (*Provider)(nil)
+
+// IncrementAuthorization raises the hold of a manual-capture payment intent through Stripe.
+// The card must support it; the intent is created with request_incremental_authorization=if_available.
+func (p *Provider) IncrementAuthorization(ctx context.Context, in ports.IncrementAuthorizationIn) (ports.IncrementAuthorizationOut, error) {
+	total, err := ledger.AmountToMinorUnits(in.Total)
+	if err != nil {
+		return ports.IncrementAuthorizationOut{}, err
+	}
+
+	// Stripe takes the new total, not the increment.
+	params := &stripe.PaymentIntentIncrementAuthorizationParams{
+		Amount: stripe.Int64(total),
+	}
+	if in.IdempotencyKey != "" {
+		params.SetIdempotencyKey(in.IdempotencyKey)
+	}
+
+	// Metadata (use AddMetadata on embedded stripe.Params).
+	for k, v := range in.Metadata {
+		params.AddMetadata(k, v)
+	}
+
+	pi, err := p.client.V1PaymentIntents.IncrementAuthorization(ctx, in.ProviderID, params)
+	if err != nil {
+		return ports.IncrementAuthorizationOut{}, err
+	}
+
+	out := ports.IncrementAuthorizationOut{
+		Provider: ports.ProviderStripe,
+		Status:   dto.MapPIStatus(pi),
+	}
+	if out.Status == ports.ProviderStatusRequiresCapture {
+		out.Authorized = dto.FromMinor(pi.Currency, pi.AmountCapturable)
+	}
+
+	return out, nil
+}
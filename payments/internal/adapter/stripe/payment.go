@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/stripe/stripe-go/v82"
-	"github.com/stripe/stripe-go/v82/paymentintent"
 
 	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
 	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
@@ -25,20 +24,18 @@ func (p *Provider) CreatePayment(ctx context.Context, in ports.CreatePaymentIn)
 		captureMethod = stripe.String(string(stripe.PaymentIntentCaptureMethodManual))
 	}
 
-	params := &stripe.PaymentIntentParams{
+	params := &stripe.PaymentIntentCreateParams{
 		Amount:        stripe.Int64(minor),
 		Currency:      stripe.String(currency),
 		CaptureMethod: captureMethod,
-		AutomaticPaymentMethods: &stripe.PaymentIntentAutomaticPaymentMethodsParams{
+		AutomaticPaymentMethods: &stripe.PaymentIntentCreateAutomaticPaymentMethodsParams{
 			Enabled: stripe.Bool(true),
 		},
 		Description: stripe.String(in.Description),
 	}
-	// Attach context properly.
-	params.Context = ctx
-
-	// Optional: strong idempotency key (highly recommended).
-	params.SetIdempotencyKey(in.PaymentID.String())
+	if in.IdempotencyKey != "" {
+		params.SetIdempotencyKey(in.IdempotencyKey)
+	}
 
 	// Metadata (use AddMetadata on embedded stripe.Params).
 	for k, v := range in.Metadata {
@@ -49,7 +46,24 @@ func (p *Provider) CreatePayment(ctx context.Context, in ports.CreatePaymentIn)
 		params.ReturnURL = stripe.String(in.ReturnURL)
 	}
 
-	pi, err := paymentintent.New(params)
+	card := &stripe.PaymentIntentCreatePaymentMethodOptionsCardParams{}
+	// Without it Stripe requests 3DS only when required by its own Radar rules or the issuer.
+	if in.RequireSCA {
+		card.RequestThreeDSecure = stripe.String(string(stripe.PaymentIntentPaymentMethodOptionsCardRequestThreeDSecureAny))
+	}
+	// A hold can only be raised later (IncrementAuthorization) if it was asked for up front.
+	if in.CaptureManual {
+		card.RequestIncrementalAuthorization = stripe.String(string(stripe.PaymentIntentPaymentMethodOptionsCardRequestIncrementalAuthorizationIfAvailable))
+	}
+	// Stripe refuses final_capture=false on intents that did not ask for multicapture.
+	if in.CaptureManual && p.multicapture {
+		card.RequestMulticapture = stripe.String(string(stripe.PaymentIntentPaymentMethodOptionsCardRequestMulticaptureIfAvailable))
+	}
+	if *card != (stripe.PaymentIntentCreatePaymentMethodOptionsCardParams{}) {
+		params.PaymentMethodOptions = &stripe.PaymentIntentCreatePaymentMethodOptionsParams{Card: card}
+	}
+
+	pi, err := p.client.V1PaymentIntents.Create(ctx, params)
 	if err != nil {
 		return ports.CreatePaymentOut{}, err
 	}
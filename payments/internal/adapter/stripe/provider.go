@@ -2,9 +2,13 @@ package stripeadp
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/spf13/viper"
 	"github.com/stripe/stripe-go/v82"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
 )
 
 var (
@@ -14,10 +18,49 @@ var (
 
 // Provider implements PaymentProvider interface for Stripe.
 type Provider struct {
-	client *stripe.Client
+	client       *stripe.Client
+	multicapture bool // several captures of one hold
+}
+
+type config struct {
+	backend      stripe.BackendConfig
+	multicapture bool
+}
+
+// Option configures a Provider.
+type Option func(*config)
+
+// WithBackendURL sends API requests to url instead of api.stripe.com, e.g. a local stripe-mock.
+func WithBackendURL(url string) Option {
+	return func(cfg *config) { cfg.backend.URL = stripe.String(url) }
 }
 
-// New creates a Stripe client using STRIPE_API_KEY from env.
+// WithHTTPClient sets the HTTP client of API requests.
+func WithHTTPClient(client *http.Client) Option {
+	return func(cfg *config) { cfg.backend.HTTPClient = client }
+}
+
+// WithMulticapture requests multicapture on manual-capture payment intents, so a hold can be
+// captured in several parts. Stripe offers it only to some accounts and cards.
+func WithMulticapture() Option {
+	return func(cfg *config) { cfg.multicapture = true }
+}
+
+// NewClient creates a provider calling Stripe with apiKey.
+func NewClient(apiKey string, opts ...Option) *Provider {
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &Provider{
+		client:       stripe.NewClient(apiKey, stripe.WithBackends(stripe.NewBackendsWithConfig(&cfg.backend))),
+		multicapture: cfg.multicapture,
+	}
+}
+
+// New creates a Stripe client using STRIPE_API_KEY from env; STRIPE_BACKEND_URL optionally
+// points it at another API, e.g. stripe-mock, and STRIPE_MULTICAPTURE=true enables multicapture.
 // Example: export STRIPE_API_KEY=sk_test_123...
 func New() (*Provider, error) {
 	viper.AutomaticEnv()
@@ -27,7 +70,40 @@ func New() (*Provider, error) {
 		return nil, ErrMissingAPIKey
 	}
 
-	client := stripe.NewClient(apiKey)
+	var opts []Option
+	if url := viper.GetString("STRIPE_BACKEND_URL"); url != "" {
+		opts = append(opts, WithBackendURL(url))
+	}
+	if viper.GetBool("STRIPE_MULTICAPTURE") {
+		opts = append(opts, WithMulticapture())
+	}
 
-	return &Provider{client: client}, nil
+	return NewClient(apiKey, opts...), nil
+}
+
+// Capabilities implements ports.PaymentProvider. Stripe converts between currencies itself,
+// so any currency is accepted here and rejected by Stripe if the account cannot charge it.
+// Partial captures that keep the hold open need multicapture; without it a capture is final.
+func (p *Provider) Capabilities() ports.Capabilities {
+	return ports.Capabilities{
+		CaptureModes: []eventv1.CaptureMode{
+			eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE,
+			eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+		},
+		PartialCapture:           p.multicapture,
+		Refund:                   true,
+		PartialRefund:            true,
+		IncrementalAuthorization: true,
+	}
+}
+
+// Retryable reports whether a Stripe API error may succeed when the call is repeated with the
+// same idempotency key: rate limiting and lock timeouts (429) and Stripe-side failures (5xx).
+// Card and invalid-request errors are final.
+func (p *Provider) Retryable(err error) bool {
+	var stripeErr *stripe.Error
+	if !errors.As(err, &stripeErr) {
+		return false
+	}
+	return stripeErr.HTTPStatusCode == http.StatusTooManyRequests || stripeErr.HTTPStatusCode >= http.StatusInternalServerError
 }
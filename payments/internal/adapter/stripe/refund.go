@@ -2,12 +2,12 @@ package stripeadp
 
 import (
 	"context"
-	"strings"
 
+	"github.com/google/uuid"
 	"github.com/stripe/stripe-go/v82"
-	"github.com/stripe/stripe-go/v82/refund"
 
 	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
 	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
 	"github.com/shortlink-org/billing/payments/internal/dto"
 )
@@ -19,35 +19,21 @@ func (p *Provider) RefundPayment(ctx context.Context, in ports.RefundPaymentIn)
 		return ports.RefundPaymentOut{}, err
 	}
 
-	params := &stripe.RefundParams{
+	params := &stripe.RefundCreateParams{
 		PaymentIntent: stripe.String(in.ProviderID),
 		Amount:        stripe.Int64(minor),
-		Reason:        stripe.String(stripe.RefundReasonRequestedByCustomer), // default reason
+		Reason:        stripe.String(string(refundReason(in.Reason))),
+	}
+	if in.IdempotencyKey != "" {
+		params.SetIdempotencyKey(in.IdempotencyKey)
 	}
-	// Attach context properly.
-	params.Context = ctx
-
-	// Optional: strong idempotency key (highly recommended).
-	params.SetIdempotencyKey(in.PaymentID.String() + "_refund")
 
 	// Metadata (use AddMetadata on embedded stripe.Params).
 	for k, v := range in.Metadata {
 		params.AddMetadata(k, v)
 	}
 
-	if in.Reason != "" {
-		// Map common reasons to Stripe-specific reasons
-		switch strings.ToLower(in.Reason) {
-		case "duplicate", "duplicated":
-			params.Reason = stripe.String(stripe.RefundReasonDuplicate)
-		case "fraud", "fraudulent":
-			params.Reason = stripe.String(stripe.RefundReasonFraudulent)
-		default:
-			params.Reason = stripe.String(stripe.RefundReasonRequestedByCustomer)
-		}
-	}
-
-	r, err := refund.New(params)
+	r, err := p.client.V1Refunds.Create(ctx, params)
 	if err != nil {
 		return ports.RefundPaymentOut{}, err
 	}
@@ -62,3 +48,38 @@ func (p *Provider) RefundPayment(ctx context.Context, in ports.RefundPaymentIn)
 	return out, nil
 }
 
+// refundReason maps our reason onto the three Stripe knows; the rest is a customer request.
+func refundReason(reason eventv1.RefundReason) stripe.RefundReason {
+	switch reason {
+	case eventv1.RefundReason_REFUND_REASON_DUPLICATE:
+		return stripe.RefundReasonDuplicate
+	case eventv1.RefundReason_REFUND_REASON_FRAUDULENT:
+		return stripe.RefundReasonFraudulent
+	default:
+		return stripe.RefundReasonRequestedByCustomer
+	}
+}
+
+var _ ports.RefundLister = (*Provider)(nil)
+
+// ListRefunds lists the refunds of a payment intent through Stripe, newest first.
+func (p *Provider) ListRefunds(ctx context.Context, in ports.ListRefundsIn) (ports.ListRefundsOut, error) {
+	params := &stripe.RefundListParams{PaymentIntent: stripe.String(in.ProviderID)}
+
+	out := ports.ListRefundsOut{Provider: ports.ProviderStripe}
+	for r, err := range p.client.V1Refunds.List(ctx, params) {
+		if err != nil {
+			return ports.ListRefundsOut{}, err
+		}
+
+		id, _ := uuid.Parse(r.Metadata["refund_id"])
+		out.Refunds = append(out.Refunds, ports.ProviderRefund{
+			ID:       id,
+			RefundID: r.ID,
+			Status:   dto.MapRefundStatus(r),
+			Amount:   dto.FromMinor(r.Currency, r.Amount),
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,107 @@
+package stripeadp
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stripe/stripe-go/v82"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/dto"
+)
+
+var _ ports.SettlementLister = (*Provider)(nil)
+
+// ListSettlement lists the balance transactions of an automatic payout through Stripe. Charges,
+// refunds and disputes become entries of their payment; any other transaction is skipped.
+func (p *Provider) ListSettlement(ctx context.Context, in ports.ListSettlementIn) (ports.ListSettlementOut, error) {
+	params := &stripe.BalanceTransactionListParams{Payout: stripe.String(in.PayoutID)}
+	params.AddExpand("data.source")
+
+	out := ports.ListSettlementOut{Provider: ports.ProviderStripe, PayoutID: in.PayoutID}
+	payments := make(map[string]uuid.UUID) // PaymentIntent -> payment, resolved once per payout
+	for bt, err := range p.client.V1BalanceTransactions.List(ctx, params) {
+		if err != nil {
+			return ports.ListSettlementOut{}, err
+		}
+
+		entry, ok := settlementEntry(bt)
+		if !ok {
+			out.Skipped++
+			continue
+		}
+
+		id, seen := payments[entry.ProviderID]
+		if !seen {
+			resolved, err := p.ResolvePayment(ctx, ports.ResolvePaymentIn{Provider: ports.ProviderStripe, ProviderID: entry.ProviderID})
+			if err != nil {
+				return ports.ListSettlementOut{}, err
+			}
+			id = resolved.PaymentID
+			payments[entry.ProviderID] = id
+		}
+		entry.PaymentID = id
+		out.Entries = append(out.Entries, entry)
+	}
+
+	return out, nil
+}
+
+// settlementEntry reads the movement of a PaymentIntent's funds from a balance transaction with
+// its source expanded. Stripe reports the amounts in the balance currency and the source in the
+// currency of the payment.
+func settlementEntry(bt *stripe.BalanceTransaction) (ports.SettlementEntry, bool) {
+	entry := ports.SettlementEntry{
+		TransactionID: bt.ID,
+		Gross:         dto.FromMinor(bt.Currency, bt.Amount),
+		Fee:           dto.FromMinor(bt.Currency, bt.Fee),
+		Net:           dto.FromMinor(bt.Currency, bt.Net),
+		SettledAt:     time.Unix(bt.AvailableOn, 0).UTC(),
+	}
+
+	src := bt.Source
+	switch {
+	case src == nil:
+		return ports.SettlementEntry{}, false
+	case src.Charge != nil && src.Charge.PaymentIntent != nil:
+		c := src.Charge
+		entry.ProviderID = c.PaymentIntent.ID
+		switch bt.Type {
+		case stripe.BalanceTransactionTypeCharge, stripe.BalanceTransactionTypePayment:
+			entry.Type = eventv1.SettlementType_SETTLEMENT_TYPE_CHARGE
+			entry.Amount = dto.FromMinor(c.Currency, c.AmountCaptured)
+		default:
+			entry.Type = eventv1.SettlementType_SETTLEMENT_TYPE_ADJUSTMENT
+			entry.Amount = dto.FromMinor(c.Currency, 0)
+		}
+	case src.Refund != nil && src.Refund.PaymentIntent != nil:
+		r := src.Refund
+		entry.ProviderID = r.PaymentIntent.ID
+		entry.Type = eventv1.SettlementType_SETTLEMENT_TYPE_REFUND
+		// A failed refund gives the funds back.
+		entry.Amount = dto.FromMinor(r.Currency, sign(bt.Amount)*r.Amount)
+	case src.Dispute != nil && src.Dispute.PaymentIntent != nil:
+		d := src.Dispute
+		entry.ProviderID = d.PaymentIntent.ID
+		entry.Type = eventv1.SettlementType_SETTLEMENT_TYPE_DISPUTE
+		// Withdrawn when opened, reinstated when won; a dispute fee alone moves no funds.
+		entry.Amount = dto.FromMinor(d.Currency, sign(bt.Amount)*d.Amount)
+	default:
+		return ports.SettlementEntry{}, false
+	}
+
+	return entry, true
+}
+
+func sign(v int64) int64 {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	default:
+		return 0
+	}
+}
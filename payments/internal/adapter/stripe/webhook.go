@@ -0,0 +1,320 @@
+package stripeadp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/shortlink-org/go-sdk/logger"
+	"github.com/stripe/stripe-go/v82"
+	stripewebhook "github.com/stripe/stripe-go/v82/webhook"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/webhook"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/dto"
+)
+
+// maxWebhookBody is the payload limit recommended by Stripe.
+const maxWebhookBody = 65536
+
+// PaymentResolver finds our payment ID for a PaymentIntent whose event payload
+// does not carry the payment_id metadata (charges and disputes).
+type PaymentResolver func(ctx context.Context, paymentIntentID string) (uuid.UUID, error)
+
+// WebhookOption configures a WebhookHandler.
+type WebhookOption func(*WebhookHandler)
+
+// WithPaymentResolver overrides the default resolver, which looks the PaymentIntent up through the provider.
+func WithPaymentResolver(resolve PaymentResolver) WebhookOption {
+	return func(h *WebhookHandler) {
+		h.resolve = resolve
+	}
+}
+
+// WebhookHandler receives Stripe webhooks, verifies the Stripe-Signature header
+// and hands the translated events to the webhook use case.
+type WebhookHandler struct {
+	log     logger.Logger
+	secret  string
+	handler *webhook.Handler
+	resolve PaymentResolver
+}
+
+// NewWebhookHandler creates an http.Handler for the endpoint signed with secret (whsec_...).
+// Payment intents without payment_id metadata are looked up through provider, e.g. the Stripe
+// provider as wired with retries and a circuit breaker.
+func NewWebhookHandler(log logger.Logger, secret string, provider ports.PaymentResolver, handler *webhook.Handler, opts ...WebhookOption) *WebhookHandler {
+	h := &WebhookHandler{
+		log:     log,
+		secret:  secret,
+		handler: handler,
+	}
+	if provider != nil {
+		h.resolve = func(ctx context.Context, paymentIntentID string) (uuid.UUID, error) {
+			out, err := provider.ResolvePayment(ctx, ports.ResolvePaymentIn{Provider: ports.ProviderStripe, ProviderID: paymentIntentID})
+			return out.PaymentID, err
+		}
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// ServeHTTP answers 2xx only once the event is safely recorded, so Stripe redelivers anything else.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "read body", http.StatusServiceUnavailable)
+		return
+	}
+
+	// The endpoint API version may lag behind the SDK; the fields we read are stable.
+	evt, err := stripewebhook.ConstructEventWithOptions(payload, r.Header.Get("Stripe-Signature"), h.secret,
+		stripewebhook.ConstructEventOptions{IgnoreAPIVersionMismatch: true})
+	if err != nil {
+		h.log.WarnWithContext(ctx, "stripe webhook: rejected payload", "error", err)
+		http.Error(w, "invalid signature", http.StatusBadRequest)
+		return
+	}
+
+	in, err := h.Translate(ctx, evt)
+	if err != nil {
+		h.log.ErrorWithContext(ctx, "stripe webhook: translate failed", "event_id", evt.ID, "type", evt.Type, "error", err)
+		http.Error(w, "invalid event", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.handler.Handle(ctx, in); err != nil {
+		// Typically the payment is not saved yet or lost a version race: let Stripe retry.
+		h.log.ErrorWithContext(ctx, "stripe webhook: handle failed", "event_id", evt.ID, "type", evt.Type, "error", err)
+		http.Error(w, "handle event", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Translate maps a verified Stripe event onto the provider-agnostic webhook event.
+// Unknown types come back as webhook.KindIgnored so they still land in the inbox.
+func (h *WebhookHandler) Translate(ctx context.Context, evt stripe.Event) (webhook.Event, error) {
+	out := webhook.Event{
+		ID:       evt.ID,
+		Provider: ports.ProviderStripe,
+		Type:     string(evt.Type),
+		Kind:     webhook.KindIgnored,
+	}
+	if evt.Data == nil {
+		return out, fmt.Errorf("%w: %s has no data", webhook.ErrInvalidEvent, evt.ID)
+	}
+
+	switch evt.Type {
+	case stripe.EventTypePaymentIntentRequiresAction,
+		stripe.EventTypePaymentIntentAmountCapturableUpdated,
+		stripe.EventTypePaymentIntentSucceeded,
+		stripe.EventTypePaymentIntentPaymentFailed,
+		stripe.EventTypePaymentIntentCanceled:
+		var pi stripe.PaymentIntent
+		if err := json.Unmarshal(evt.Data.Raw, &pi); err != nil {
+			return out, fmt.Errorf("%w: %w", webhook.ErrInvalidEvent, err)
+		}
+		translatePaymentIntent(&out, evt.Type, &pi)
+
+	case stripe.EventTypeChargeRefunded:
+		var ch stripe.Charge
+		if err := json.Unmarshal(evt.Data.Raw, &ch); err != nil {
+			return out, fmt.Errorf("%w: %w", webhook.ErrInvalidEvent, err)
+		}
+		out.Kind = webhook.KindRefunded
+		out.ProviderID = paymentIntentID(ch.PaymentIntent)
+		out.Captured = dto.FromMinor(ch.Currency, ch.AmountCaptured)
+
+		id, err := h.paymentID(ctx, ch.Metadata, out.ProviderID)
+		if err != nil {
+			return out, err
+		}
+		out.PaymentID = id
+
+	case stripe.EventTypeRefundCreated,
+		stripe.EventTypeRefundUpdated,
+		stripe.EventTypeRefundFailed,
+		stripe.EventTypeChargeRefundUpdated:
+		var r stripe.Refund
+		if err := json.Unmarshal(evt.Data.Raw, &r); err != nil {
+			return out, fmt.Errorf("%w: %w", webhook.ErrInvalidEvent, err)
+		}
+		out.Kind = refundKind(r.Status)
+		if out.Kind == webhook.KindIgnored {
+			return out, nil
+		}
+		out.ProviderID = paymentIntentID(r.PaymentIntent)
+		out.ProviderRefundID = r.ID
+		out.RefundAmount = dto.FromMinor(r.Currency, r.Amount)
+		if id, err := uuid.Parse(r.Metadata["refund_id"]); err == nil {
+			out.RefundID = id
+		}
+
+		id, err := h.paymentID(ctx, r.Metadata, out.ProviderID)
+		if err != nil {
+			return out, err
+		}
+		out.PaymentID = id
+
+	case stripe.EventTypeChargeDisputeCreated,
+		stripe.EventTypeChargeDisputeUpdated,
+		stripe.EventTypeChargeDisputeClosed:
+		var d stripe.Dispute
+		if err := json.Unmarshal(evt.Data.Raw, &d); err != nil {
+			return out, fmt.Errorf("%w: %w", webhook.ErrInvalidEvent, err)
+		}
+		out.Kind = disputeKind(evt.Type, d.Status)
+		if out.Kind == webhook.KindIgnored {
+			return out, nil
+		}
+		out.ProviderID = paymentIntentID(d.PaymentIntent)
+		out.Disputed = dto.FromMinor(d.Currency, d.Amount)
+		out.DisputeReason = disputeReason(d.Reason)
+		out.DisputeID = d.ID
+
+		id, err := h.paymentID(ctx, d.Metadata, out.ProviderID)
+		if err != nil {
+			return out, err
+		}
+		out.PaymentID = id
+	}
+
+	return out, nil
+}
+
+func translatePaymentIntent(out *webhook.Event, typ stripe.EventType, pi *stripe.PaymentIntent) {
+	out.ProviderID = pi.ID
+	// Intents created outside this service carry no payment_id and are ignored.
+	out.PaymentID, _ = uuid.Parse(pi.Metadata["payment_id"])
+
+	switch typ {
+	case stripe.EventTypePaymentIntentRequiresAction:
+		out.Kind = webhook.KindRequiresAction
+	case stripe.EventTypePaymentIntentAmountCapturableUpdated:
+		out.Kind = webhook.KindAuthorized
+		out.Authorized = dto.FromMinor(pi.Currency, pi.AmountCapturable)
+	case stripe.EventTypePaymentIntentSucceeded:
+		out.Kind = webhook.KindSucceeded
+		out.Captured = dto.FromMinor(pi.Currency, pi.AmountReceived)
+	case stripe.EventTypePaymentIntentPaymentFailed:
+		out.Kind = webhook.KindFailed
+		out.SCA = scaOutcome(pi.LastPaymentError)
+	case stripe.EventTypePaymentIntentCanceled:
+		out.Kind = webhook.KindCanceled
+		out.CancelReason = cancelReason(pi.CancellationReason)
+	}
+}
+
+// paymentID reads payment_id from metadata, falling back to the resolver.
+func (h *WebhookHandler) paymentID(ctx context.Context, meta map[string]string, piID string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(meta["payment_id"]); err == nil {
+		return id, nil
+	}
+	if piID == "" || h.resolve == nil {
+		return uuid.Nil, nil
+	}
+
+	id, err := h.resolve(ctx, piID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("resolve payment intent %s: %w", piID, err)
+	}
+	return id, nil
+}
+
+var _ ports.PaymentResolver = (*Provider)(nil)
+
+// ResolvePayment reads payment_id from the PaymentIntent metadata set by CreatePayment.
+// A PaymentIntent that does not exist in the account is not one of ours.
+func (p *Provider) ResolvePayment(ctx context.Context, in ports.ResolvePaymentIn) (ports.ResolvePaymentOut, error) {
+	pi, err := p.client.V1PaymentIntents.Retrieve(ctx, in.ProviderID, nil)
+	if err != nil {
+		var stripeErr *stripe.Error
+		if errors.As(err, &stripeErr) && stripeErr.HTTPStatusCode == http.StatusNotFound {
+			return ports.ResolvePaymentOut{}, nil
+		}
+		return ports.ResolvePaymentOut{}, err
+	}
+
+	id, _ := uuid.Parse(pi.Metadata["payment_id"])
+	return ports.ResolvePaymentOut{PaymentID: id}, nil
+}
+
+func paymentIntentID(pi *stripe.PaymentIntent) string {
+	if pi == nil {
+		return ""
+	}
+	return pi.ID
+}
+
+func cancelReason(reason stripe.PaymentIntentCancellationReason) eventv1.CancelReason {
+	switch reason {
+	case stripe.PaymentIntentCancellationReasonDuplicate:
+		return eventv1.CancelReason_CANCEL_REASON_DUPLICATE
+	case stripe.PaymentIntentCancellationReasonRequestedByCustomer:
+		return eventv1.CancelReason_CANCEL_REASON_USER
+	default:
+		return eventv1.CancelReason_CANCEL_REASON_SYSTEM
+	}
+}
+
+// refundKind maps a refund status onto its outcome so far.
+func refundKind(status stripe.RefundStatus) webhook.Kind {
+	switch status {
+	case stripe.RefundStatusPending, stripe.RefundStatusRequiresAction:
+		return webhook.KindRefundPending
+	case stripe.RefundStatusSucceeded:
+		return webhook.KindRefundSucceeded
+	case stripe.RefundStatusFailed, stripe.RefundStatusCanceled:
+		return webhook.KindRefundFailed
+	default:
+		return webhook.KindIgnored
+	}
+}
+
+// disputeKind maps a dispute event onto its lifecycle step.
+// Inquiries (warning_*) and prevented disputes never withhold funds and are ignored.
+func disputeKind(typ stripe.EventType, status stripe.DisputeStatus) webhook.Kind {
+	switch {
+	case typ == stripe.EventTypeChargeDisputeCreated && status == stripe.DisputeStatusNeedsResponse:
+		return webhook.KindDisputeOpened
+	case typ == stripe.EventTypeChargeDisputeUpdated && status == stripe.DisputeStatusUnderReview:
+		return webhook.KindDisputeEvidence
+	case typ == stripe.EventTypeChargeDisputeClosed && status == stripe.DisputeStatusWon:
+		return webhook.KindDisputeWon
+	case typ == stripe.EventTypeChargeDisputeClosed && status == stripe.DisputeStatusLost:
+		return webhook.KindDisputeLost
+	default:
+		return webhook.KindIgnored
+	}
+}
+
+func disputeReason(reason stripe.DisputeReason) eventv1.DisputeReason {
+	switch reason {
+	case stripe.DisputeReasonFraudulent, stripe.DisputeReasonUnrecognized, stripe.DisputeReasonDebitNotAuthorized:
+		return eventv1.DisputeReason_DISPUTE_REASON_FRAUDULENT
+	case stripe.DisputeReasonProductNotReceived:
+		return eventv1.DisputeReason_DISPUTE_REASON_PRODUCT_NOT_RECEIVED
+	case stripe.DisputeReasonProductUnacceptable:
+		return eventv1.DisputeReason_DISPUTE_REASON_PRODUCT_UNACCEPTABLE
+	case stripe.DisputeReasonDuplicate:
+		return eventv1.DisputeReason_DISPUTE_REASON_DUPLICATE
+	case stripe.DisputeReasonSubscriptionCanceled:
+		return eventv1.DisputeReason_DISPUTE_REASON_SUBSCRIPTION_CANCELED
+	case stripe.DisputeReasonCreditNotProcessed:
+		return eventv1.DisputeReason_DISPUTE_REASON_CREDIT_NOT_PROCESSED
+	default:
+		return eventv1.DisputeReason_DISPUTE_REASON_GENERAL
+	}
+}
@@ -0,0 +1,167 @@
+package stripeadp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shortlink-org/go-sdk/logger"
+	"github.com/stretchr/testify/require"
+	stripewebhook "github.com/stripe/stripe-go/v82/webhook"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/webhook"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+const testSecret = "whsec_test_secret"
+
+// fixturePaymentID is the payment_id carried by the fixtures in testdata.
+var fixturePaymentID = uuid.MustParse("0b9d3f8e-6a51-4c8a-9f43-2d1e7c5a9b10")
+
+func newWebhook(t *testing.T) (*WebhookHandler, *memory.InMemory) {
+	t.Helper()
+
+	log, err := logger.New(logger.Configuration{Writer: io.Discard})
+	require.NoError(t, err)
+
+	repo := memory.New()
+	p, err := payment.New(fixturePaymentID, uuid.New(), &money.Money{CurrencyCode: "EUR", Units: 40},
+		eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(context.Background(), p, 0))
+
+	resolver := func(_ context.Context, paymentIntentID string) (uuid.UUID, error) {
+		require.Equal(t, "pi_3PkTest", paymentIntentID)
+		return fixturePaymentID, nil
+	}
+
+	h := NewWebhookHandler(log, testSecret, nil, &webhook.Handler{Repo: repo, Inbox: repo}, WithPaymentResolver(resolver))
+	return h, repo
+}
+
+// deliver posts a fixture the way Stripe does, signed with secret.
+func deliver(t *testing.T, h http.Handler, fixture, secret string) int {
+	t.Helper()
+
+	payload, err := os.ReadFile(filepath.Join("testdata", fixture+".json"))
+	require.NoError(t, err)
+
+	signed := stripewebhook.GenerateTestSignedPayload(&stripewebhook.UnsignedPayload{Payload: payload, Secret: secret})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/stripe", bytes.NewReader(payload))
+	req.Header.Set("Stripe-Signature", signed.Header)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestWebhookHandler_ServeHTTP(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("capture, refund and lost dispute", func(t *testing.T) {
+		h, repo := newWebhook(t)
+
+		require.Equal(t, http.StatusOK, deliver(t, h, "payment_intent.succeeded", testSecret))
+		require.Equal(t, http.StatusOK, deliver(t, h, "refund.created", testSecret))
+		require.Equal(t, http.StatusOK, deliver(t, h, "charge.refunded", testSecret))
+		require.Equal(t, http.StatusOK, deliver(t, h, "charge.dispute.created", testSecret))
+
+		got, err := repo.Load(ctx, fixturePaymentID)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED, got.State())
+		require.Equal(t, "dp_3PkTest", got.DisputeID())
+
+		require.Equal(t, http.StatusOK, deliver(t, h, "charge.dispute.closed", testSecret))
+
+		got, err = repo.Load(ctx, fixturePaymentID)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_CHARGED_BACK, got.State())
+		require.Equal(t, int64(15), got.Ledger.TotalRefunded.GetUnits())
+		require.Equal(t, int64(25), got.Ledger.Reversed.GetUnits())
+	})
+
+	t.Run("pending refund settles", func(t *testing.T) {
+		h, repo := newWebhook(t)
+
+		require.Equal(t, http.StatusOK, deliver(t, h, "payment_intent.succeeded", testSecret))
+
+		p, err := repo.Load(ctx, fixturePaymentID)
+		require.NoError(t, err)
+		expected := p.Version()
+		_, err = p.RequestRefund(ctx, payment.Refund{
+			ID:               uuid.MustParse("5c0a7e52-3b1f-4d6e-8a90-1f2e3d4c5b6a"),
+			ProviderRefundID: "re_3PkTest",
+			Amount:           &money.Money{CurrencyCode: "EUR", Units: 15},
+			Reason:           eventv1.RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER,
+			Status:           payment.RefundStatusPending,
+		})
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, p, expected))
+
+		require.Equal(t, http.StatusOK, deliver(t, h, "charge.refunded", testSecret))
+		require.Equal(t, http.StatusOK, deliver(t, h, "refund.updated", testSecret))
+
+		got, err := repo.Load(ctx, fixturePaymentID)
+		require.NoError(t, err)
+		require.Equal(t, int64(15), got.Ledger.TotalRefunded.GetUnits())
+		require.Nil(t, got.Ledger.PendingRefunded)
+		require.Len(t, got.Refunds(), 1)
+	})
+
+	t.Run("out of order and redelivered", func(t *testing.T) {
+		h, repo := newWebhook(t)
+
+		// A dashboard refund before the capture is known is redelivered by Stripe.
+		require.Equal(t, http.StatusInternalServerError, deliver(t, h, "refund.created", testSecret))
+		require.Equal(t, http.StatusOK, deliver(t, h, "charge.refunded", testSecret))
+		require.Equal(t, http.StatusOK, deliver(t, h, "charge.refunded", testSecret))
+		require.Equal(t, http.StatusOK, deliver(t, h, "refund.created", testSecret))
+		require.Equal(t, http.StatusOK, deliver(t, h, "refund.created", testSecret))
+		require.Equal(t, http.StatusOK, deliver(t, h, "payment_intent.succeeded", testSecret))
+
+		got, err := repo.Load(ctx, fixturePaymentID)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, got.State())
+		require.Equal(t, int64(40), got.Ledger.Captured.GetUnits())
+		require.Equal(t, int64(15), got.Ledger.TotalRefunded.GetUnits())
+		require.Len(t, got.Refunds(), 1)
+		require.Equal(t, "re_3PkDashboard", got.Refunds()[0].ProviderRefundID)
+	})
+
+	t.Run("failed challenge", func(t *testing.T) {
+		h, repo := newWebhook(t)
+
+		require.Equal(t, http.StatusOK, deliver(t, h, "payment_intent.payment_failed", testSecret))
+
+		got, err := repo.Load(ctx, fixturePaymentID)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_FAILED, got.State())
+	})
+
+	t.Run("bad signature", func(t *testing.T) {
+		h, repo := newWebhook(t)
+
+		require.Equal(t, http.StatusBadRequest, deliver(t, h, "payment_intent.succeeded", "whsec_other"))
+
+		got, err := repo.Load(ctx, fixturePaymentID)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_CREATED, got.State())
+	})
+
+	t.Run("unknown payment is retried", func(t *testing.T) {
+		h, _ := newWebhook(t)
+		h.handler.Repo = memory.New()
+
+		require.Equal(t, http.StatusInternalServerError, deliver(t, h, "payment_intent.succeeded", testSecret))
+	})
+}
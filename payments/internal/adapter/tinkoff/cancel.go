@@ -0,0 +1,32 @@
+package tinkoffadp
+
+import (
+	"context"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/dto"
+)
+
+// cancelRequest cancels, reverses or refunds a payment, depending on its status.
+type cancelRequest struct {
+	PaymentID         string `json:"PaymentId"`
+	Amount            int64  `json:"Amount,omitempty"` // omitted → the whole payment
+	ExternalRequestID string `json:"ExternalRequestId,omitempty"`
+}
+
+// CancelPayment voids a payment through Tinkoff API, releasing any authorization hold.
+func (p *Provider) CancelPayment(ctx context.Context, in ports.CancelPaymentIn) (ports.CancelPaymentOut, error) {
+	resp, err := p.call(ctx, "Cancel", cancelRequest{
+		PaymentID:         in.ProviderID,
+		ExternalRequestID: in.IdempotencyKey,
+	})
+	if err != nil {
+		return ports.CancelPaymentOut{}, err
+	}
+
+	// CANCELED (before authorization) and REVERSED (hold released) both end the payment.
+	return ports.CancelPaymentOut{
+		Provider: ports.ProviderTinkoff,
+		Status:   dto.MapTinkoffStatus(resp.Status),
+	}, nil
+}
@@ -0,0 +1,38 @@
+package tinkoffadp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/dto"
+)
+
+type confirmRequest struct {
+	PaymentID string `json:"PaymentId"`
+	Amount    int64  `json:"Amount"`
+}
+
+// CapturePayment confirms a two-stage payment through Tinkoff API. A hold is confirmed once:
+// T-Bank releases whatever is not captured.
+func (p *Provider) CapturePayment(ctx context.Context, in ports.CapturePaymentIn) (ports.CapturePaymentOut, error) {
+	amount, err := dto.FormatAmountForTinkoff(in.Amount)
+	if err != nil {
+		return ports.CapturePaymentOut{}, fmt.Errorf("format amount: %w", err)
+	}
+
+	resp, err := p.call(ctx, "Confirm", confirmRequest{PaymentID: in.ProviderID, Amount: amount})
+	if err != nil {
+		return ports.CapturePaymentOut{}, err
+	}
+
+	out := ports.CapturePaymentOut{
+		Provider: ports.ProviderTinkoff,
+		Status:   dto.MapTinkoffStatus(resp.Status),
+	}
+	if out.Status == ports.ProviderStatusSucceeded {
+		out.Captured = dto.FromMinorTinkoff(in.Currency, amount)
+	}
+
+	return out, nil
+}
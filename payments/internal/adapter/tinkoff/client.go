@@ -0,0 +1,141 @@
+package tinkoffadp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// response holds the fields of the acquiring methods' responses used here.
+type response struct {
+	Success   bool      `json:"Success"`
+	ErrorCode string    `json:"ErrorCode"`
+	Message   string    `json:"Message"`
+	Details   string    `json:"Details"`
+	Status    string    `json:"Status"`
+	PaymentID paymentID `json:"PaymentId"`
+	Amount    int64     `json:"Amount"`
+
+	PaymentURL string `json:"PaymentURL"` // Init
+	NewAmount  int64  `json:"NewAmount"`  // Cancel: amount left after the cancel or refund
+}
+
+// paymentID is sent as a number by some methods and as a string by others.
+type paymentID string
+
+func (id *paymentID) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*id = paymentID(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	*id = paymentID(n)
+	return nil
+}
+
+// call signs req and posts it to method, e.g. "Init".
+func (p *Provider) call(ctx context.Context, method string, req any) (*response, error) {
+	params, err := p.sign(req)
+	if err != nil {
+		return nil, err
+	}
+	reqBody, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/"+method, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Overload and outage pages are not JSON.
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	var out response
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return nil, fmt.Errorf("parse response: %w", err)
+	}
+
+	// Check for API errors
+	if !out.Success || out.ErrorCode != "0" {
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Code:       out.ErrorCode,
+			Message:    strings.TrimSpace(out.Message + " " + out.Details),
+		}
+	}
+
+	return &out, nil
+}
+
+// sign returns the parameters of req with the terminal key and the request token.
+func (p *Provider) sign(req any) (map[string]any, error) {
+	raw, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber() // amounts are signed as sent
+	var params map[string]any
+	if err := dec.Decode(&params); err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	params["TerminalKey"] = p.terminalKey
+	params["Token"] = token(params, p.password)
+	return params, nil
+}
+
+// token is the SHA-256 of the values of the root scalar parameters and the password,
+// concatenated in the order of their keys. Nested objects such as DATA and Receipt are not signed.
+func token(params map[string]any, password string) string {
+	values := map[string]string{"Password": password}
+	for k, v := range params {
+		switch v := v.(type) {
+		case string:
+			values[k] = v
+		case json.Number:
+			values[k] = v.String()
+		case bool:
+			values[k] = strconv.FormatBool(v)
+		}
+	}
+	delete(values, "Token")
+
+	var b strings.Builder
+	for _, k := range slices.Sorted(maps.Keys(values)) {
+		b.WriteString(values[k])
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
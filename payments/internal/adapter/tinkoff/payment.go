@@ -0,0 +1,103 @@
+package tinkoffadp
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/dto"
+)
+
+// Pay types of Init.
+const (
+	payTypeOneStage = "O" // captured on authorization
+	payTypeTwoStage = "T" // held until Confirm
+)
+
+type initRequest struct {
+	Amount          int64             `json:"Amount"`
+	OrderID         string            `json:"OrderId"`
+	Description     string            `json:"Description,omitempty"`
+	PayType         string            `json:"PayType"`
+	NotificationURL string            `json:"NotificationURL,omitempty"`
+	SuccessURL      string            `json:"SuccessURL,omitempty"`
+	FailURL         string            `json:"FailURL,omitempty"`
+	Data            map[string]string `json:"DATA,omitempty"`
+}
+
+type getStateRequest struct {
+	PaymentID string `json:"PaymentId"`
+}
+
+// CreatePayment initiates a payment through Tinkoff API. The customer pays on the payment
+// form, whose URL is returned as the client secret.
+func (p *Provider) CreatePayment(ctx context.Context, in ports.CreatePaymentIn) (ports.CreatePaymentOut, error) {
+	amount, err := dto.FormatAmountForTinkoff(in.Amount)
+	if err != nil {
+		return ports.CreatePaymentOut{}, fmt.Errorf("format amount: %w", err)
+	}
+
+	req := initRequest{
+		Amount:          amount,
+		OrderID:         in.PaymentID.String(), // repeated Init calls of a payment keep one order
+		Description:     in.Description,
+		PayType:         payTypeOneStage,
+		NotificationURL: p.notificationURL,
+		SuccessURL:      in.ReturnURL,
+		FailURL:         in.ReturnURL,
+		Data:            in.Metadata,
+	}
+	if in.CaptureManual {
+		req.PayType = payTypeTwoStage
+	}
+
+	resp, err := p.call(ctx, "Init", req)
+	if err != nil {
+		return ports.CreatePaymentOut{}, err
+	}
+
+	status := dto.MapTinkoffStatus(resp.Status)
+	return ports.CreatePaymentOut{
+		Provider:     ports.ProviderTinkoff,
+		ProviderID:   string(resp.PaymentID),
+		ClientSecret: resp.PaymentURL,
+		Status:       status,
+		Authorized:   held(status, in.Currency, resp.Amount),
+		Captured:     paid(status, in.Currency, resp.Amount),
+	}, nil
+}
+
+// GetPayment re-fetches a payment from Tinkoff API, e.g. after the customer returned from the payment form.
+func (p *Provider) GetPayment(ctx context.Context, in ports.GetPaymentIn) (ports.GetPaymentOut, error) {
+	resp, err := p.call(ctx, "GetState", getStateRequest{PaymentID: in.ProviderID})
+	if err != nil {
+		return ports.GetPaymentOut{}, err
+	}
+
+	// Terminals charge RUB only; GetState does not repeat the currency.
+	status := dto.MapTinkoffStatus(resp.Status)
+	return ports.GetPaymentOut{
+		Provider:   ports.ProviderTinkoff,
+		Status:     status,
+		Authorized: held(status, "RUB", resp.Amount),
+		Captured:   paid(status, "RUB", resp.Amount),
+	}, nil
+}
+
+// held is the hold of a payment awaiting capture.
+func held(status ports.ProviderStatus, currency string, amount int64) *money.Money {
+	if status != ports.ProviderStatusRequiresCapture {
+		return nil
+	}
+	return dto.FromMinorTinkoff(currency, amount)
+}
+
+// paid is the captured amount of a payment that succeeded.
+func paid(status ports.ProviderStatus, currency string, amount int64) *money.Money {
+	if status != ports.ProviderStatusSucceeded {
+		return nil
+	}
+	return dto.FromMinorTinkoff(currency, amount)
+}
@@ -1,5118 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.8
-// 	protoc        (unknown)
-// source: adapter/tinkoff/proto/operations.proto
-
-package investapi
-
-import (
-	_ "google.golang.org/genproto/googleapis/api/annotations"
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Статус запрашиваемых операций.
-type OperationState int32
-
-const (
-	OperationState_OPERATION_STATE_UNSPECIFIED OperationState = 0 //Статус операции не определен.
-	OperationState_OPERATION_STATE_EXECUTED    OperationState = 1 //Исполнена частично или полностью.
-	OperationState_OPERATION_STATE_CANCELED    OperationState = 2 //Отменена.
-	OperationState_OPERATION_STATE_PROGRESS    OperationState = 3 //Исполняется.
-)
-
-// Enum value maps for OperationState.
-var (
-	OperationState_name = map[int32]string{
-		0: "OPERATION_STATE_UNSPECIFIED",
-		1: "OPERATION_STATE_EXECUTED",
-		2: "OPERATION_STATE_CANCELED",
-		3: "OPERATION_STATE_PROGRESS",
-	}
-	OperationState_value = map[string]int32{
-		"OPERATION_STATE_UNSPECIFIED": 0,
-		"OPERATION_STATE_EXECUTED":    1,
-		"OPERATION_STATE_CANCELED":    2,
-		"OPERATION_STATE_PROGRESS":    3,
-	}
-)
-
-func (x OperationState) Enum() *OperationState {
-	p := new(OperationState)
-	*p = x
-	return p
-}
-
-func (x OperationState) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (OperationState) Descriptor() protoreflect.EnumDescriptor {
-	return file_adapter_tinkoff_proto_operations_proto_enumTypes[0].Descriptor()
-}
-
-func (OperationState) Type() protoreflect.EnumType {
-	return &file_adapter_tinkoff_proto_operations_proto_enumTypes[0]
-}
-
-func (x OperationState) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use OperationState.Descriptor instead.
-func (OperationState) EnumDescriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{0}
-}
-
-// Тип операции.
-type OperationType int32
-
-const (
-	OperationType_OPERATION_TYPE_UNSPECIFIED                 OperationType = 0  //Тип операции не определен.
-	OperationType_OPERATION_TYPE_INPUT                       OperationType = 1  //Пополнение брокерского счета.
-	OperationType_OPERATION_TYPE_BOND_TAX                    OperationType = 2  //Удержание НДФЛ по купонам.
-	OperationType_OPERATION_TYPE_OUTPUT_SECURITIES           OperationType = 3  //Вывод ЦБ.
-	OperationType_OPERATION_TYPE_OVERNIGHT                   OperationType = 4  //Доход по сделке РЕПО овернайт.
-	OperationType_OPERATION_TYPE_TAX                         OperationType = 5  //Удержание налога.
-	OperationType_OPERATION_TYPE_BOND_REPAYMENT_FULL         OperationType = 6  //Полное погашение облигаций.
-	OperationType_OPERATION_TYPE_SELL_CARD                   OperationType = 7  //Продажа ЦБ с карты.
-	OperationType_OPERATION_TYPE_DIVIDEND_TAX                OperationType = 8  //Удержание налога по дивидендам.
-	OperationType_OPERATION_TYPE_OUTPUT                      OperationType = 9  //Вывод денежных средств.
-	OperationType_OPERATION_TYPE_BOND_REPAYMENT              OperationType = 10 //Частичное погашение облигаций.
-	OperationType_OPERATION_TYPE_TAX_CORRECTION              OperationType = 11 //Корректировка налога.
-	OperationType_OPERATION_TYPE_SERVICE_FEE                 OperationType = 12 //Удержание комиссии за обслуживание брокерского счета.
-	OperationType_OPERATION_TYPE_BENEFIT_TAX                 OperationType = 13 //Удержание налога за материальную выгоду.
-	OperationType_OPERATION_TYPE_MARGIN_FEE                  OperationType = 14 //Удержание комиссии за непокрытую позицию.
-	OperationType_OPERATION_TYPE_BUY                         OperationType = 15 //Покупка ЦБ.
-	OperationType_OPERATION_TYPE_BUY_CARD                    OperationType = 16 //Покупка ЦБ с карты.
-	OperationType_OPERATION_TYPE_INPUT_SECURITIES            OperationType = 17 //Перевод ценных бумаг из другого депозитария.
-	OperationType_OPERATION_TYPE_SELL_MARGIN                 OperationType = 18 //Продажа в результате Margin-call.
-	OperationType_OPERATION_TYPE_BROKER_FEE                  OperationType = 19 //Удержание комиссии за операцию.
-	OperationType_OPERATION_TYPE_BUY_MARGIN                  OperationType = 20 //Покупка в результате Margin-call.
-	OperationType_OPERATION_TYPE_DIVIDEND                    OperationType = 21 //Выплата дивидендов.
-	OperationType_OPERATION_TYPE_SELL                        OperationType = 22 //Продажа ЦБ.
-	OperationType_OPERATION_TYPE_COUPON                      OperationType = 23 //Выплата купонов.
-	OperationType_OPERATION_TYPE_SUCCESS_FEE                 OperationType = 24 //Удержание комиссии SuccessFee.
-	OperationType_OPERATION_TYPE_DIVIDEND_TRANSFER           OperationType = 25 //Передача дивидендного дохода.
-	OperationType_OPERATION_TYPE_ACCRUING_VARMARGIN          OperationType = 26 //Зачисление вариационной маржи.
-	OperationType_OPERATION_TYPE_WRITING_OFF_VARMARGIN       OperationType = 27 //Списание вариационной маржи.
-	OperationType_OPERATION_TYPE_DELIVERY_BUY                OperationType = 28 //Покупка в рамках экспирации фьючерсного контракта.
-	OperationType_OPERATION_TYPE_DELIVERY_SELL               OperationType = 29 //Продажа в рамках экспирации фьючерсного контракта.
-	OperationType_OPERATION_TYPE_TRACK_MFEE                  OperationType = 30 //Комиссия за управление по счету автоследования.
-	OperationType_OPERATION_TYPE_TRACK_PFEE                  OperationType = 31 //Комиссия за результат по счету автоследования.
-	OperationType_OPERATION_TYPE_TAX_PROGRESSIVE             OperationType = 32 //Удержание налога по ставке 15%.
-	OperationType_OPERATION_TYPE_BOND_TAX_PROGRESSIVE        OperationType = 33 //Удержание налога по купонам по ставке 15%.
-	OperationType_OPERATION_TYPE_DIVIDEND_TAX_PROGRESSIVE    OperationType = 34 //Удержание налога по дивидендам по ставке 15%.
-	OperationType_OPERATION_TYPE_BENEFIT_TAX_PROGRESSIVE     OperationType = 35 //Удержание налога за материальную выгоду по ставке 15%.
-	OperationType_OPERATION_TYPE_TAX_CORRECTION_PROGRESSIVE  OperationType = 36 //Корректировка налога по ставке 15%.
-	OperationType_OPERATION_TYPE_TAX_REPO_PROGRESSIVE        OperationType = 37 //Удержание налога за возмещение по сделкам РЕПО по ставке 15%.
-	OperationType_OPERATION_TYPE_TAX_REPO                    OperationType = 38 //Удержание налога за возмещение по сделкам РЕПО.
-	OperationType_OPERATION_TYPE_TAX_REPO_HOLD               OperationType = 39 //Удержание налога по сделкам РЕПО.
-	OperationType_OPERATION_TYPE_TAX_REPO_REFUND             OperationType = 40 //Возврат налога по сделкам РЕПО.
-	OperationType_OPERATION_TYPE_TAX_REPO_HOLD_PROGRESSIVE   OperationType = 41 //Удержание налога по сделкам РЕПО по ставке 15%.
-	OperationType_OPERATION_TYPE_TAX_REPO_REFUND_PROGRESSIVE OperationType = 42 //Возврат налога по сделкам РЕПО по ставке 15%.
-	OperationType_OPERATION_TYPE_DIV_EXT                     OperationType = 43 //Выплата дивидендов на карту.
-	OperationType_OPERATION_TYPE_TAX_CORRECTION_COUPON       OperationType = 44 //Корректировка налога по купонам.
-	OperationType_OPERATION_TYPE_CASH_FEE                    OperationType = 45 //Комиссия за валютный остаток.
-	OperationType_OPERATION_TYPE_OUT_FEE                     OperationType = 46 //Комиссия за вывод валюты с брокерского счета.
-	OperationType_OPERATION_TYPE_OUT_STAMP_DUTY              OperationType = 47 //Гербовый сбор.
-	OperationType_OPERATION_TYPE_OUTPUT_SWIFT                OperationType = 50 //	SWIFT-перевод.
-	OperationType_OPERATION_TYPE_INPUT_SWIFT                 OperationType = 51 //	SWIFT-перевод.
-	OperationType_OPERATION_TYPE_OUTPUT_ACQUIRING            OperationType = 53 //  Перевод на карту.
-	OperationType_OPERATION_TYPE_INPUT_ACQUIRING             OperationType = 54 //	Перевод с карты.
-	OperationType_OPERATION_TYPE_OUTPUT_PENALTY              OperationType = 55 //	Комиссия за вывод средств.
-	OperationType_OPERATION_TYPE_ADVICE_FEE                  OperationType = 56 //	Списание оплаты за сервис Советов.
-	OperationType_OPERATION_TYPE_TRANS_IIS_BS                OperationType = 57 //  Перевод ценных бумаг с ИИС на брокерский счет.
-	OperationType_OPERATION_TYPE_TRANS_BS_BS                 OperationType = 58 //  Перевод ценных бумаг с одного брокерского счета на другой.
-	OperationType_OPERATION_TYPE_OUT_MULTI                   OperationType = 59 //  Вывод денежных средств со счета.
-	OperationType_OPERATION_TYPE_INP_MULTI                   OperationType = 60 //  Пополнение денежных средств со счета.
-	OperationType_OPERATION_TYPE_OVER_PLACEMENT              OperationType = 61 //  Размещение биржевого овернайта.
-	OperationType_OPERATION_TYPE_OVER_COM                    OperationType = 62 //  Списание комиссии.
-	OperationType_OPERATION_TYPE_OVER_INCOME                 OperationType = 63 //  Доход от оверанайта.
-	OperationType_OPERATION_TYPE_OPTION_EXPIRATION           OperationType = 64 // Экспирация опциона.
-	OperationType_OPERATION_TYPE_FUTURE_EXPIRATION           OperationType = 65 // Экспирация фьючерса.
-)
-
-// Enum value maps for OperationType.
-var (
-	OperationType_name = map[int32]string{
-		0:  "OPERATION_TYPE_UNSPECIFIED",
-		1:  "OPERATION_TYPE_INPUT",
-		2:  "OPERATION_TYPE_BOND_TAX",
-		3:  "OPERATION_TYPE_OUTPUT_SECURITIES",
-		4:  "OPERATION_TYPE_OVERNIGHT",
-		5:  "OPERATION_TYPE_TAX",
-		6:  "OPERATION_TYPE_BOND_REPAYMENT_FULL",
-		7:  "OPERATION_TYPE_SELL_CARD",
-		8:  "OPERATION_TYPE_DIVIDEND_TAX",
-		9:  "OPERATION_TYPE_OUTPUT",
-		10: "OPERATION_TYPE_BOND_REPAYMENT",
-		11: "OPERATION_TYPE_TAX_CORRECTION",
-		12: "OPERATION_TYPE_SERVICE_FEE",
-		13: "OPERATION_TYPE_BENEFIT_TAX",
-		14: "OPERATION_TYPE_MARGIN_FEE",
-		15: "OPERATION_TYPE_BUY",
-		16: "OPERATION_TYPE_BUY_CARD",
-		17: "OPERATION_TYPE_INPUT_SECURITIES",
-		18: "OPERATION_TYPE_SELL_MARGIN",
-		19: "OPERATION_TYPE_BROKER_FEE",
-		20: "OPERATION_TYPE_BUY_MARGIN",
-		21: "OPERATION_TYPE_DIVIDEND",
-		22: "OPERATION_TYPE_SELL",
-		23: "OPERATION_TYPE_COUPON",
-		24: "OPERATION_TYPE_SUCCESS_FEE",
-		25: "OPERATION_TYPE_DIVIDEND_TRANSFER",
-		26: "OPERATION_TYPE_ACCRUING_VARMARGIN",
-		27: "OPERATION_TYPE_WRITING_OFF_VARMARGIN",
-		28: "OPERATION_TYPE_DELIVERY_BUY",
-		29: "OPERATION_TYPE_DELIVERY_SELL",
-		30: "OPERATION_TYPE_TRACK_MFEE",
-		31: "OPERATION_TYPE_TRACK_PFEE",
-		32: "OPERATION_TYPE_TAX_PROGRESSIVE",
-		33: "OPERATION_TYPE_BOND_TAX_PROGRESSIVE",
-		34: "OPERATION_TYPE_DIVIDEND_TAX_PROGRESSIVE",
-		35: "OPERATION_TYPE_BENEFIT_TAX_PROGRESSIVE",
-		36: "OPERATION_TYPE_TAX_CORRECTION_PROGRESSIVE",
-		37: "OPERATION_TYPE_TAX_REPO_PROGRESSIVE",
-		38: "OPERATION_TYPE_TAX_REPO",
-		39: "OPERATION_TYPE_TAX_REPO_HOLD",
-		40: "OPERATION_TYPE_TAX_REPO_REFUND",
-		41: "OPERATION_TYPE_TAX_REPO_HOLD_PROGRESSIVE",
-		42: "OPERATION_TYPE_TAX_REPO_REFUND_PROGRESSIVE",
-		43: "OPERATION_TYPE_DIV_EXT",
-		44: "OPERATION_TYPE_TAX_CORRECTION_COUPON",
-		45: "OPERATION_TYPE_CASH_FEE",
-		46: "OPERATION_TYPE_OUT_FEE",
-		47: "OPERATION_TYPE_OUT_STAMP_DUTY",
-		50: "OPERATION_TYPE_OUTPUT_SWIFT",
-		51: "OPERATION_TYPE_INPUT_SWIFT",
-		53: "OPERATION_TYPE_OUTPUT_ACQUIRING",
-		54: "OPERATION_TYPE_INPUT_ACQUIRING",
-		55: "OPERATION_TYPE_OUTPUT_PENALTY",
-		56: "OPERATION_TYPE_ADVICE_FEE",
-		57: "OPERATION_TYPE_TRANS_IIS_BS",
-		58: "OPERATION_TYPE_TRANS_BS_BS",
-		59: "OPERATION_TYPE_OUT_MULTI",
-		60: "OPERATION_TYPE_INP_MULTI",
-		61: "OPERATION_TYPE_OVER_PLACEMENT",
-		62: "OPERATION_TYPE_OVER_COM",
-		63: "OPERATION_TYPE_OVER_INCOME",
-		64: "OPERATION_TYPE_OPTION_EXPIRATION",
-		65: "OPERATION_TYPE_FUTURE_EXPIRATION",
-	}
-	OperationType_value = map[string]int32{
-		"OPERATION_TYPE_UNSPECIFIED":                 0,
-		"OPERATION_TYPE_INPUT":                       1,
-		"OPERATION_TYPE_BOND_TAX":                    2,
-		"OPERATION_TYPE_OUTPUT_SECURITIES":           3,
-		"OPERATION_TYPE_OVERNIGHT":                   4,
-		"OPERATION_TYPE_TAX":                         5,
-		"OPERATION_TYPE_BOND_REPAYMENT_FULL":         6,
-		"OPERATION_TYPE_SELL_CARD":                   7,
-		"OPERATION_TYPE_DIVIDEND_TAX":                8,
-		"OPERATION_TYPE_OUTPUT":                      9,
-		"OPERATION_TYPE_BOND_REPAYMENT":              10,
-		"OPERATION_TYPE_TAX_CORRECTION":              11,
-		"OPERATION_TYPE_SERVICE_FEE":                 12,
-		"OPERATION_TYPE_BENEFIT_TAX":                 13,
-		"OPERATION_TYPE_MARGIN_FEE":                  14,
-		"OPERATION_TYPE_BUY":                         15,
-		"OPERATION_TYPE_BUY_CARD":                    16,
-		"OPERATION_TYPE_INPUT_SECURITIES":            17,
-		"OPERATION_TYPE_SELL_MARGIN":                 18,
-		"OPERATION_TYPE_BROKER_FEE":                  19,
-		"OPERATION_TYPE_BUY_MARGIN":                  20,
-		"OPERATION_TYPE_DIVIDEND":                    21,
-		"OPERATION_TYPE_SELL":                        22,
-		"OPERATION_TYPE_COUPON":                      23,
-		"OPERATION_TYPE_SUCCESS_FEE":                 24,
-		"OPERATION_TYPE_DIVIDEND_TRANSFER":           25,
-		"OPERATION_TYPE_ACCRUING_VARMARGIN":          26,
-		"OPERATION_TYPE_WRITING_OFF_VARMARGIN":       27,
-		"OPERATION_TYPE_DELIVERY_BUY":                28,
-		"OPERATION_TYPE_DELIVERY_SELL":               29,
-		"OPERATION_TYPE_TRACK_MFEE":                  30,
-		"OPERATION_TYPE_TRACK_PFEE":                  31,
-		"OPERATION_TYPE_TAX_PROGRESSIVE":             32,
-		"OPERATION_TYPE_BOND_TAX_PROGRESSIVE":        33,
-		"OPERATION_TYPE_DIVIDEND_TAX_PROGRESSIVE":    34,
-		"OPERATION_TYPE_BENEFIT_TAX_PROGRESSIVE":     35,
-		"OPERATION_TYPE_TAX_CORRECTION_PROGRESSIVE":  36,
-		"OPERATION_TYPE_TAX_REPO_PROGRESSIVE":        37,
-		"OPERATION_TYPE_TAX_REPO":                    38,
-		"OPERATION_TYPE_TAX_REPO_HOLD":               39,
-		"OPERATION_TYPE_TAX_REPO_REFUND":             40,
-		"OPERATION_TYPE_TAX_REPO_HOLD_PROGRESSIVE":   41,
-		"OPERATION_TYPE_TAX_REPO_REFUND_PROGRESSIVE": 42,
-		"OPERATION_TYPE_DIV_EXT":                     43,
-		"OPERATION_TYPE_TAX_CORRECTION_COUPON":       44,
-		"OPERATION_TYPE_CASH_FEE":                    45,
-		"OPERATION_TYPE_OUT_FEE":                     46,
-		"OPERATION_TYPE_OUT_STAMP_DUTY":              47,
-		"OPERATION_TYPE_OUTPUT_SWIFT":                50,
-		"OPERATION_TYPE_INPUT_SWIFT":                 51,
-		"OPERATION_TYPE_OUTPUT_ACQUIRING":            53,
-		"OPERATION_TYPE_INPUT_ACQUIRING":             54,
-		"OPERATION_TYPE_OUTPUT_PENALTY":              55,
-		"OPERATION_TYPE_ADVICE_FEE":                  56,
-		"OPERATION_TYPE_TRANS_IIS_BS":                57,
-		"OPERATION_TYPE_TRANS_BS_BS":                 58,
-		"OPERATION_TYPE_OUT_MULTI":                   59,
-		"OPERATION_TYPE_INP_MULTI":                   60,
-		"OPERATION_TYPE_OVER_PLACEMENT":              61,
-		"OPERATION_TYPE_OVER_COM":                    62,
-		"OPERATION_TYPE_OVER_INCOME":                 63,
-		"OPERATION_TYPE_OPTION_EXPIRATION":           64,
-		"OPERATION_TYPE_FUTURE_EXPIRATION":           65,
-	}
-)
-
-func (x OperationType) Enum() *OperationType {
-	p := new(OperationType)
-	*p = x
-	return p
-}
-
-func (x OperationType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (OperationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_adapter_tinkoff_proto_operations_proto_enumTypes[1].Descriptor()
-}
-
-func (OperationType) Type() protoreflect.EnumType {
-	return &file_adapter_tinkoff_proto_operations_proto_enumTypes[1]
-}
-
-func (x OperationType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use OperationType.Descriptor instead.
-func (OperationType) EnumDescriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{1}
-}
-
-// Результат подписки.
-type PortfolioSubscriptionStatus int32
-
-const (
-	PortfolioSubscriptionStatus_PORTFOLIO_SUBSCRIPTION_STATUS_UNSPECIFIED       PortfolioSubscriptionStatus = 0 //Тип не определен.
-	PortfolioSubscriptionStatus_PORTFOLIO_SUBSCRIPTION_STATUS_SUCCESS           PortfolioSubscriptionStatus = 1 //Успешно.
-	PortfolioSubscriptionStatus_PORTFOLIO_SUBSCRIPTION_STATUS_ACCOUNT_NOT_FOUND PortfolioSubscriptionStatus = 2 //Счет не найден или недостаточно прав.
-	PortfolioSubscriptionStatus_PORTFOLIO_SUBSCRIPTION_STATUS_INTERNAL_ERROR    PortfolioSubscriptionStatus = 3 //Произошла ошибка.
-)
-
-// Enum value maps for PortfolioSubscriptionStatus.
-var (
-	PortfolioSubscriptionStatus_name = map[int32]string{
-		0: "PORTFOLIO_SUBSCRIPTION_STATUS_UNSPECIFIED",
-		1: "PORTFOLIO_SUBSCRIPTION_STATUS_SUCCESS",
-		2: "PORTFOLIO_SUBSCRIPTION_STATUS_ACCOUNT_NOT_FOUND",
-		3: "PORTFOLIO_SUBSCRIPTION_STATUS_INTERNAL_ERROR",
-	}
-	PortfolioSubscriptionStatus_value = map[string]int32{
-		"PORTFOLIO_SUBSCRIPTION_STATUS_UNSPECIFIED":       0,
-		"PORTFOLIO_SUBSCRIPTION_STATUS_SUCCESS":           1,
-		"PORTFOLIO_SUBSCRIPTION_STATUS_ACCOUNT_NOT_FOUND": 2,
-		"PORTFOLIO_SUBSCRIPTION_STATUS_INTERNAL_ERROR":    3,
-	}
-)
-
-func (x PortfolioSubscriptionStatus) Enum() *PortfolioSubscriptionStatus {
-	p := new(PortfolioSubscriptionStatus)
-	*p = x
-	return p
-}
-
-func (x PortfolioSubscriptionStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (PortfolioSubscriptionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_adapter_tinkoff_proto_operations_proto_enumTypes[2].Descriptor()
-}
-
-func (PortfolioSubscriptionStatus) Type() protoreflect.EnumType {
-	return &file_adapter_tinkoff_proto_operations_proto_enumTypes[2]
-}
-
-func (x PortfolioSubscriptionStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use PortfolioSubscriptionStatus.Descriptor instead.
-func (PortfolioSubscriptionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{2}
-}
-
-// Результат подписки.
-type PositionsAccountSubscriptionStatus int32
-
-const (
-	PositionsAccountSubscriptionStatus_POSITIONS_SUBSCRIPTION_STATUS_UNSPECIFIED       PositionsAccountSubscriptionStatus = 0 //Тип не определен.
-	PositionsAccountSubscriptionStatus_POSITIONS_SUBSCRIPTION_STATUS_SUCCESS           PositionsAccountSubscriptionStatus = 1 //Успешно.
-	PositionsAccountSubscriptionStatus_POSITIONS_SUBSCRIPTION_STATUS_ACCOUNT_NOT_FOUND PositionsAccountSubscriptionStatus = 2 //Счет не найден или недостаточно прав.
-	PositionsAccountSubscriptionStatus_POSITIONS_SUBSCRIPTION_STATUS_INTERNAL_ERROR    PositionsAccountSubscriptionStatus = 3 //Произошла ошибка.
-)
-
-// Enum value maps for PositionsAccountSubscriptionStatus.
-var (
-	PositionsAccountSubscriptionStatus_name = map[int32]string{
-		0: "POSITIONS_SUBSCRIPTION_STATUS_UNSPECIFIED",
-		1: "POSITIONS_SUBSCRIPTION_STATUS_SUCCESS",
-		2: "POSITIONS_SUBSCRIPTION_STATUS_ACCOUNT_NOT_FOUND",
-		3: "POSITIONS_SUBSCRIPTION_STATUS_INTERNAL_ERROR",
-	}
-	PositionsAccountSubscriptionStatus_value = map[string]int32{
-		"POSITIONS_SUBSCRIPTION_STATUS_UNSPECIFIED":       0,
-		"POSITIONS_SUBSCRIPTION_STATUS_SUCCESS":           1,
-		"POSITIONS_SUBSCRIPTION_STATUS_ACCOUNT_NOT_FOUND": 2,
-		"POSITIONS_SUBSCRIPTION_STATUS_INTERNAL_ERROR":    3,
-	}
-)
-
-func (x PositionsAccountSubscriptionStatus) Enum() *PositionsAccountSubscriptionStatus {
-	p := new(PositionsAccountSubscriptionStatus)
-	*p = x
-	return p
-}
-
-func (x PositionsAccountSubscriptionStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (PositionsAccountSubscriptionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_adapter_tinkoff_proto_operations_proto_enumTypes[3].Descriptor()
-}
-
-func (PositionsAccountSubscriptionStatus) Type() protoreflect.EnumType {
-	return &file_adapter_tinkoff_proto_operations_proto_enumTypes[3]
-}
-
-func (x PositionsAccountSubscriptionStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use PositionsAccountSubscriptionStatus.Descriptor instead.
-func (PositionsAccountSubscriptionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{3}
-}
-
-type PortfolioRequest_CurrencyRequest int32
-
-const (
-	PortfolioRequest_RUB PortfolioRequest_CurrencyRequest = 0 //Рубли
-	PortfolioRequest_USD PortfolioRequest_CurrencyRequest = 1 //Доллары
-	PortfolioRequest_EUR PortfolioRequest_CurrencyRequest = 2 //Евро
-)
-
-// Enum value maps for PortfolioRequest_CurrencyRequest.
-var (
-	PortfolioRequest_CurrencyRequest_name = map[int32]string{
-		0: "RUB",
-		1: "USD",
-		2: "EUR",
-	}
-	PortfolioRequest_CurrencyRequest_value = map[string]int32{
-		"RUB": 0,
-		"USD": 1,
-		"EUR": 2,
-	}
-)
-
-func (x PortfolioRequest_CurrencyRequest) Enum() *PortfolioRequest_CurrencyRequest {
-	p := new(PortfolioRequest_CurrencyRequest)
-	*p = x
-	return p
-}
-
-func (x PortfolioRequest_CurrencyRequest) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (PortfolioRequest_CurrencyRequest) Descriptor() protoreflect.EnumDescriptor {
-	return file_adapter_tinkoff_proto_operations_proto_enumTypes[4].Descriptor()
-}
-
-func (PortfolioRequest_CurrencyRequest) Type() protoreflect.EnumType {
-	return &file_adapter_tinkoff_proto_operations_proto_enumTypes[4]
-}
-
-func (x PortfolioRequest_CurrencyRequest) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use PortfolioRequest_CurrencyRequest.Descriptor instead.
-func (PortfolioRequest_CurrencyRequest) EnumDescriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{4, 0}
-}
-
-// Запрос получения списка операций по счету.
-type OperationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                                         //Идентификатор счета клиента.
-	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3,oneof" json:"from,omitempty"`                                                              //Начало периода по UTC.
-	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3,oneof" json:"to,omitempty"`                                                                  //Окончание периода по UTC.
-	State         *OperationState        `protobuf:"varint,4,opt,name=state,proto3,enum=tinkoff.public.invest.api.contract.v1.OperationState,oneof" json:"state,omitempty"` //Статус запрашиваемых операций.
-	Figi          *string                `protobuf:"bytes,5,opt,name=figi,proto3,oneof" json:"figi,omitempty"`                                                              //FIGI-идентификатор инструмента для фильтрации.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *OperationsRequest) Reset() {
-	*x = OperationsRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *OperationsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OperationsRequest) ProtoMessage() {}
-
-func (x *OperationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OperationsRequest.ProtoReflect.Descriptor instead.
-func (*OperationsRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *OperationsRequest) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *OperationsRequest) GetFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.From
-	}
-	return nil
-}
-
-func (x *OperationsRequest) GetTo() *timestamppb.Timestamp {
-	if x != nil {
-		return x.To
-	}
-	return nil
-}
-
-func (x *OperationsRequest) GetState() OperationState {
-	if x != nil && x.State != nil {
-		return *x.State
-	}
-	return OperationState_OPERATION_STATE_UNSPECIFIED
-}
-
-func (x *OperationsRequest) GetFigi() string {
-	if x != nil && x.Figi != nil {
-		return *x.Figi
-	}
-	return ""
-}
-
-// Список операций.
-type OperationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Operations    []*Operation           `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"` //Массив операций.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *OperationsResponse) Reset() {
-	*x = OperationsResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *OperationsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OperationsResponse) ProtoMessage() {}
-
-func (x *OperationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OperationsResponse.ProtoReflect.Descriptor instead.
-func (*OperationsResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *OperationsResponse) GetOperations() []*Operation {
-	if x != nil {
-		return x.Operations
-	}
-	return nil
-}
-
-// Данные по операции.
-type Operation struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                                       //Идентификатор операции.
-	ParentOperationId string                 `protobuf:"bytes,2,opt,name=parent_operation_id,json=parentOperationId,proto3" json:"parent_operation_id,omitempty"`                                              //Идентификатор родительской операции.
-	Currency          string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`                                                                                           //Валюта операции.
-	Payment           *MoneyValue            `protobuf:"bytes,4,opt,name=payment,proto3" json:"payment,omitempty"`                                                                                             //Сумма операции.
-	Price             *MoneyValue            `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`                                                                                                 //Цена операции за 1 инструмент. Чтобы получить стоимость лота, нужно умножить на лотность инструмента.
-	State             OperationState         `protobuf:"varint,6,opt,name=state,proto3,enum=tinkoff.public.invest.api.contract.v1.OperationState" json:"state,omitempty"`                                      //Статус операции.
-	Quantity          int64                  `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`                                                                                          //Количество единиц инструмента.
-	QuantityRest      int64                  `protobuf:"varint,8,opt,name=quantity_rest,json=quantityRest,proto3" json:"quantity_rest,omitempty"`                                                              //Неисполненный остаток по сделке.
-	Figi              string                 `protobuf:"bytes,9,opt,name=figi,proto3" json:"figi,omitempty"`                                                                                                   //FIGI-идентификатор инструмента, связанного с операцией.
-	InstrumentType    string                 `protobuf:"bytes,10,opt,name=instrument_type,json=instrumentType,proto3" json:"instrument_type,omitempty"`                                                        //Тип инструмента. Возможные значения: <br/><br/>`bond` — облигация; <br/>`share` — акция; <br/>`currency` — валюта; <br/>`etf` — фонд; <br/>`futures` — фьючерс.
-	Date              *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=date,proto3" json:"date,omitempty"`                                                                                                  //Дата и время операции в формате часовом поясе UTC.
-	Type              string                 `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`                                                                                                  //Текстовое описание типа операции.
-	OperationType     OperationType          `protobuf:"varint,13,opt,name=operation_type,json=operationType,proto3,enum=tinkoff.public.invest.api.contract.v1.OperationType" json:"operation_type,omitempty"` //Тип операции.
-	Trades            []*OperationTrade      `protobuf:"bytes,14,rep,name=trades,proto3" json:"trades,omitempty"`                                                                                              //Массив сделок.
-	AssetUid          string                 `protobuf:"bytes,16,opt,name=asset_uid,json=assetUid,proto3" json:"asset_uid,omitempty"`                                                                          //Идентификатор актива
-	PositionUid       string                 `protobuf:"bytes,17,opt,name=position_uid,json=positionUid,proto3" json:"position_uid,omitempty"`                                                                 //Уникальный идентификатор позиции.
-	InstrumentUid     string                 `protobuf:"bytes,18,opt,name=instrument_uid,json=instrumentUid,proto3" json:"instrument_uid,omitempty"`                                                           //Уникальный идентификатор инструмента.
-	ChildOperations   []*ChildOperationItem  `protobuf:"bytes,19,rep,name=child_operations,json=childOperations,proto3" json:"child_operations,omitempty"`                                                     //Массив дочерних операций.
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *Operation) Reset() {
-	*x = Operation{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Operation) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Operation) ProtoMessage() {}
-
-func (x *Operation) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
-func (*Operation) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *Operation) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Operation) GetParentOperationId() string {
-	if x != nil {
-		return x.ParentOperationId
-	}
-	return ""
-}
-
-func (x *Operation) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
-}
-
-func (x *Operation) GetPayment() *MoneyValue {
-	if x != nil {
-		return x.Payment
-	}
-	return nil
-}
-
-func (x *Operation) GetPrice() *MoneyValue {
-	if x != nil {
-		return x.Price
-	}
-	return nil
-}
-
-func (x *Operation) GetState() OperationState {
-	if x != nil {
-		return x.State
-	}
-	return OperationState_OPERATION_STATE_UNSPECIFIED
-}
-
-func (x *Operation) GetQuantity() int64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *Operation) GetQuantityRest() int64 {
-	if x != nil {
-		return x.QuantityRest
-	}
-	return 0
-}
-
-func (x *Operation) GetFigi() string {
-	if x != nil {
-		return x.Figi
-	}
-	return ""
-}
-
-func (x *Operation) GetInstrumentType() string {
-	if x != nil {
-		return x.InstrumentType
-	}
-	return ""
-}
-
-func (x *Operation) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-func (x *Operation) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *Operation) GetOperationType() OperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return OperationType_OPERATION_TYPE_UNSPECIFIED
-}
-
-func (x *Operation) GetTrades() []*OperationTrade {
-	if x != nil {
-		return x.Trades
-	}
-	return nil
-}
-
-func (x *Operation) GetAssetUid() string {
-	if x != nil {
-		return x.AssetUid
-	}
-	return ""
-}
-
-func (x *Operation) GetPositionUid() string {
-	if x != nil {
-		return x.PositionUid
-	}
-	return ""
-}
-
-func (x *Operation) GetInstrumentUid() string {
-	if x != nil {
-		return x.InstrumentUid
-	}
-	return ""
-}
-
-func (x *Operation) GetChildOperations() []*ChildOperationItem {
-	if x != nil {
-		return x.ChildOperations
-	}
-	return nil
-}
-
-// Сделка по операции.
-type OperationTrade struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TradeId       string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`    //Идентификатор сделки.
-	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"` //Дата и время сделки по UTC.
-	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                //Количество инструментов.
-	Price         *MoneyValue            `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`                       //Цена за 1 инструмент. Чтобы получить стоимость лота, нужно умножить на лотность инструмента.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *OperationTrade) Reset() {
-	*x = OperationTrade{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *OperationTrade) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OperationTrade) ProtoMessage() {}
-
-func (x *OperationTrade) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OperationTrade.ProtoReflect.Descriptor instead.
-func (*OperationTrade) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *OperationTrade) GetTradeId() string {
-	if x != nil {
-		return x.TradeId
-	}
-	return ""
-}
-
-func (x *OperationTrade) GetDateTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DateTime
-	}
-	return nil
-}
-
-func (x *OperationTrade) GetQuantity() int64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *OperationTrade) GetPrice() *MoneyValue {
-	if x != nil {
-		return x.Price
-	}
-	return nil
-}
-
-// Запрос получения текущего портфеля по счету.
-type PortfolioRequest struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	AccountId     string                            `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                                                                 //Идентификатор счета пользователя.
-	Currency      *PortfolioRequest_CurrencyRequest `protobuf:"varint,2,opt,name=currency,proto3,enum=tinkoff.public.invest.api.contract.v1.PortfolioRequest_CurrencyRequest,oneof" json:"currency,omitempty"` //Валюта, в которой нужно рассчитать портфель.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PortfolioRequest) Reset() {
-	*x = PortfolioRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PortfolioRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PortfolioRequest) ProtoMessage() {}
-
-func (x *PortfolioRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PortfolioRequest.ProtoReflect.Descriptor instead.
-func (*PortfolioRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *PortfolioRequest) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *PortfolioRequest) GetCurrency() PortfolioRequest_CurrencyRequest {
-	if x != nil && x.Currency != nil {
-		return *x.Currency
-	}
-	return PortfolioRequest_RUB
-}
-
-// Текущий портфель по счету.
-type PortfolioResponse struct {
-	state                 protoimpl.MessageState      `protogen:"open.v1"`
-	TotalAmountShares     *MoneyValue                 `protobuf:"bytes,1,opt,name=total_amount_shares,json=totalAmountShares,proto3" json:"total_amount_shares,omitempty"`             //Общая стоимость акций в портфеле.
-	TotalAmountBonds      *MoneyValue                 `protobuf:"bytes,2,opt,name=total_amount_bonds,json=totalAmountBonds,proto3" json:"total_amount_bonds,omitempty"`                //Общая стоимость облигаций в портфеле.
-	TotalAmountEtf        *MoneyValue                 `protobuf:"bytes,3,opt,name=total_amount_etf,json=totalAmountEtf,proto3" json:"total_amount_etf,omitempty"`                      //Общая стоимость фондов в портфеле.
-	TotalAmountCurrencies *MoneyValue                 `protobuf:"bytes,4,opt,name=total_amount_currencies,json=totalAmountCurrencies,proto3" json:"total_amount_currencies,omitempty"` //Общая стоимость валют в портфеле.
-	TotalAmountFutures    *MoneyValue                 `protobuf:"bytes,5,opt,name=total_amount_futures,json=totalAmountFutures,proto3" json:"total_amount_futures,omitempty"`          //Общая стоимость фьючерсов в портфеле.
-	ExpectedYield         *Quotation                  `protobuf:"bytes,6,opt,name=expected_yield,json=expectedYield,proto3" json:"expected_yield,omitempty"`                           //Текущая относительная доходность портфеля в %.
-	Positions             []*PortfolioPosition        `protobuf:"bytes,7,rep,name=positions,proto3" json:"positions,omitempty"`                                                        //Список позиций портфеля.
-	AccountId             string                      `protobuf:"bytes,8,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                                       //Идентификатор счета пользователя.
-	TotalAmountOptions    *MoneyValue                 `protobuf:"bytes,9,opt,name=total_amount_options,json=totalAmountOptions,proto3" json:"total_amount_options,omitempty"`          //Общая стоимость опционов в портфеле.
-	TotalAmountSp         *MoneyValue                 `protobuf:"bytes,10,opt,name=total_amount_sp,json=totalAmountSp,proto3" json:"total_amount_sp,omitempty"`                        //Общая стоимость структурных нот в портфеле.
-	TotalAmountPortfolio  *MoneyValue                 `protobuf:"bytes,11,opt,name=total_amount_portfolio,json=totalAmountPortfolio,proto3" json:"total_amount_portfolio,omitempty"`   //Общая стоимость портфеля.
-	VirtualPositions      []*VirtualPortfolioPosition `protobuf:"bytes,12,rep,name=virtual_positions,json=virtualPositions,proto3" json:"virtual_positions,omitempty"`                 //Массив виртуальных позиций портфеля.
-	DailyYield            *MoneyValue                 `protobuf:"bytes,15,opt,name=daily_yield,json=dailyYield,proto3" json:"daily_yield,omitempty"`                                   // Рассчитанная доходность портфеля за день в рублях.
-	DailyYieldRelative    *Quotation                  `protobuf:"bytes,16,opt,name=daily_yield_relative,json=dailyYieldRelative,proto3" json:"daily_yield_relative,omitempty"`         //Относительная доходность в день в %.
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
-}
-
-func (x *PortfolioResponse) Reset() {
-	*x = PortfolioResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PortfolioResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PortfolioResponse) ProtoMessage() {}
-
-func (x *PortfolioResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[5]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PortfolioResponse.ProtoReflect.Descriptor instead.
-func (*PortfolioResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *PortfolioResponse) GetTotalAmountShares() *MoneyValue {
-	if x != nil {
-		return x.TotalAmountShares
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetTotalAmountBonds() *MoneyValue {
-	if x != nil {
-		return x.TotalAmountBonds
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetTotalAmountEtf() *MoneyValue {
-	if x != nil {
-		return x.TotalAmountEtf
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetTotalAmountCurrencies() *MoneyValue {
-	if x != nil {
-		return x.TotalAmountCurrencies
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetTotalAmountFutures() *MoneyValue {
-	if x != nil {
-		return x.TotalAmountFutures
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetExpectedYield() *Quotation {
-	if x != nil {
-		return x.ExpectedYield
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetPositions() []*PortfolioPosition {
-	if x != nil {
-		return x.Positions
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *PortfolioResponse) GetTotalAmountOptions() *MoneyValue {
-	if x != nil {
-		return x.TotalAmountOptions
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetTotalAmountSp() *MoneyValue {
-	if x != nil {
-		return x.TotalAmountSp
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetTotalAmountPortfolio() *MoneyValue {
-	if x != nil {
-		return x.TotalAmountPortfolio
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetVirtualPositions() []*VirtualPortfolioPosition {
-	if x != nil {
-		return x.VirtualPositions
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetDailyYield() *MoneyValue {
-	if x != nil {
-		return x.DailyYield
-	}
-	return nil
-}
-
-func (x *PortfolioResponse) GetDailyYieldRelative() *Quotation {
-	if x != nil {
-		return x.DailyYieldRelative
-	}
-	return nil
-}
-
-// Запрос позиций портфеля по счету.
-type PositionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` //Идентификатор счета пользователя.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PositionsRequest) Reset() {
-	*x = PositionsRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PositionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PositionsRequest) ProtoMessage() {}
-
-func (x *PositionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[6]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PositionsRequest.ProtoReflect.Descriptor instead.
-func (*PositionsRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *PositionsRequest) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-// Список позиций по счету.
-type PositionsResponse struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Money                   []*MoneyValue          `protobuf:"bytes,1,rep,name=money,proto3" json:"money,omitempty"`                                                                         //Массив валютных позиций портфеля.
-	Blocked                 []*MoneyValue          `protobuf:"bytes,2,rep,name=blocked,proto3" json:"blocked,omitempty"`                                                                     //Массив заблокированных валютных позиций портфеля.
-	Securities              []*PositionsSecurities `protobuf:"bytes,3,rep,name=securities,proto3" json:"securities,omitempty"`                                                               //Список ценно-бумажных позиций портфеля.
-	LimitsLoadingInProgress bool                   `protobuf:"varint,4,opt,name=limits_loading_in_progress,json=limitsLoadingInProgress,proto3" json:"limits_loading_in_progress,omitempty"` //Признак идущей выгрузки лимитов в данный момент.
-	Futures                 []*PositionsFutures    `protobuf:"bytes,5,rep,name=futures,proto3" json:"futures,omitempty"`                                                                     //Список фьючерсов портфеля.
-	Options                 []*PositionsOptions    `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`                                                                     //Список опционов портфеля.
-	AccountId               string                 `protobuf:"bytes,15,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                                               //Идентификатор счёта пользователя.
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
-}
-
-func (x *PositionsResponse) Reset() {
-	*x = PositionsResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PositionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PositionsResponse) ProtoMessage() {}
-
-func (x *PositionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[7]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PositionsResponse.ProtoReflect.Descriptor instead.
-func (*PositionsResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *PositionsResponse) GetMoney() []*MoneyValue {
-	if x != nil {
-		return x.Money
-	}
-	return nil
-}
-
-func (x *PositionsResponse) GetBlocked() []*MoneyValue {
-	if x != nil {
-		return x.Blocked
-	}
-	return nil
-}
-
-func (x *PositionsResponse) GetSecurities() []*PositionsSecurities {
-	if x != nil {
-		return x.Securities
-	}
-	return nil
-}
-
-func (x *PositionsResponse) GetLimitsLoadingInProgress() bool {
-	if x != nil {
-		return x.LimitsLoadingInProgress
-	}
-	return false
-}
-
-func (x *PositionsResponse) GetFutures() []*PositionsFutures {
-	if x != nil {
-		return x.Futures
-	}
-	return nil
-}
-
-func (x *PositionsResponse) GetOptions() []*PositionsOptions {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-func (x *PositionsResponse) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-// Запрос доступного остатка для вывода.
-type WithdrawLimitsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` //Идентификатор счета пользователя.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *WithdrawLimitsRequest) Reset() {
-	*x = WithdrawLimitsRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *WithdrawLimitsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*WithdrawLimitsRequest) ProtoMessage() {}
-
-func (x *WithdrawLimitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[8]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use WithdrawLimitsRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawLimitsRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *WithdrawLimitsRequest) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-// Доступный остаток для вывода.
-type WithdrawLimitsResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Money            []*MoneyValue          `protobuf:"bytes,1,rep,name=money,proto3" json:"money,omitempty"`                                               //Массив валютных позиций портфеля.
-	Blocked          []*MoneyValue          `protobuf:"bytes,2,rep,name=blocked,proto3" json:"blocked,omitempty"`                                           //Массив заблокированных валютных позиций портфеля.
-	BlockedGuarantee []*MoneyValue          `protobuf:"bytes,3,rep,name=blocked_guarantee,json=blockedGuarantee,proto3" json:"blocked_guarantee,omitempty"` //Заблокировано под гарантийное обеспечение фьючерсов.
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *WithdrawLimitsResponse) Reset() {
-	*x = WithdrawLimitsResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *WithdrawLimitsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*WithdrawLimitsResponse) ProtoMessage() {}
-
-func (x *WithdrawLimitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[9]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use WithdrawLimitsResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawLimitsResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *WithdrawLimitsResponse) GetMoney() []*MoneyValue {
-	if x != nil {
-		return x.Money
-	}
-	return nil
-}
-
-func (x *WithdrawLimitsResponse) GetBlocked() []*MoneyValue {
-	if x != nil {
-		return x.Blocked
-	}
-	return nil
-}
-
-func (x *WithdrawLimitsResponse) GetBlockedGuarantee() []*MoneyValue {
-	if x != nil {
-		return x.BlockedGuarantee
-	}
-	return nil
-}
-
-// Позиции портфеля.
-type PortfolioPosition struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Figi                 string                 `protobuf:"bytes,1,opt,name=figi,proto3" json:"figi,omitempty"`                                                               //FIGI-идентификатор инструмента.
-	InstrumentType       string                 `protobuf:"bytes,2,opt,name=instrument_type,json=instrumentType,proto3" json:"instrument_type,omitempty"`                     //Тип инструмента.
-	Quantity             *Quotation             `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                                                       //Количество инструмента в портфеле в штуках.
-	AveragePositionPrice *MoneyValue            `protobuf:"bytes,4,opt,name=average_position_price,json=averagePositionPrice,proto3" json:"average_position_price,omitempty"` //Средневзвешенная цена позиции. Для пересчета возможна задержка до одной секунды.
-	ExpectedYield        *Quotation             `protobuf:"bytes,5,opt,name=expected_yield,json=expectedYield,proto3" json:"expected_yield,omitempty"`                        //Текущая рассчитанная доходность позиции.
-	CurrentNkd           *MoneyValue            `protobuf:"bytes,6,opt,name=current_nkd,json=currentNkd,proto3" json:"current_nkd,omitempty"`                                 // Текущий НКД.
-	// Deprecated: Marked as deprecated in adapter/tinkoff/proto/operations.proto.
-	AveragePositionPricePt   *Quotation  `protobuf:"bytes,7,opt,name=average_position_price_pt,json=averagePositionPricePt,proto3" json:"average_position_price_pt,omitempty"`       // Deprecated Средняя цена позиции в пунктах (для фьючерсов). Для пересчета возможна задержка до одной секунды.
-	CurrentPrice             *MoneyValue `protobuf:"bytes,8,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`                                         //Текущая цена за 1 инструмент. Чтобы получить стоимость лота, нужно умножить на лотность инструмента.
-	AveragePositionPriceFifo *MoneyValue `protobuf:"bytes,9,opt,name=average_position_price_fifo,json=averagePositionPriceFifo,proto3" json:"average_position_price_fifo,omitempty"` //Средняя цена позиции по методу FIFO. Для пересчета возможна задержка до одной секунды.
-	// Deprecated: Marked as deprecated in adapter/tinkoff/proto/operations.proto.
-	QuantityLots      *Quotation  `protobuf:"bytes,10,opt,name=quantity_lots,json=quantityLots,proto3" json:"quantity_lots,omitempty"`                  //Deprecated Количество лотов в портфеле.
-	Blocked           bool        `protobuf:"varint,21,opt,name=blocked,proto3" json:"blocked,omitempty"`                                               //Заблокировано на бирже.
-	BlockedLots       *Quotation  `protobuf:"bytes,22,opt,name=blocked_lots,json=blockedLots,proto3" json:"blocked_lots,omitempty"`                     //Количество бумаг, заблокированных выставленными заявками.
-	PositionUid       string      `protobuf:"bytes,24,opt,name=position_uid,json=positionUid,proto3" json:"position_uid,omitempty"`                     //Уникальный идентификатор позиции.
-	InstrumentUid     string      `protobuf:"bytes,25,opt,name=instrument_uid,json=instrumentUid,proto3" json:"instrument_uid,omitempty"`               //Уникальный идентификатор инструмента.
-	VarMargin         *MoneyValue `protobuf:"bytes,26,opt,name=var_margin,json=varMargin,proto3" json:"var_margin,omitempty"`                           //Вариационная маржа.
-	ExpectedYieldFifo *Quotation  `protobuf:"bytes,27,opt,name=expected_yield_fifo,json=expectedYieldFifo,proto3" json:"expected_yield_fifo,omitempty"` //Текущая рассчитанная доходность позиции.
-	DailyYield        *MoneyValue `protobuf:"bytes,31,opt,name=daily_yield,json=dailyYield,proto3" json:"daily_yield,omitempty"`                        // Рассчитанная доходность портфеля за день.
-	Ticker            string      `protobuf:"bytes,32,opt,name=ticker,proto3" json:"ticker,omitempty"`                                                  //Тикер инструмента.
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *PortfolioPosition) Reset() {
-	*x = PortfolioPosition{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PortfolioPosition) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PortfolioPosition) ProtoMessage() {}
-
-func (x *PortfolioPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[10]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PortfolioPosition.ProtoReflect.Descriptor instead.
-func (*PortfolioPosition) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *PortfolioPosition) GetFigi() string {
-	if x != nil {
-		return x.Figi
-	}
-	return ""
-}
-
-func (x *PortfolioPosition) GetInstrumentType() string {
-	if x != nil {
-		return x.InstrumentType
-	}
-	return ""
-}
-
-func (x *PortfolioPosition) GetQuantity() *Quotation {
-	if x != nil {
-		return x.Quantity
-	}
-	return nil
-}
-
-func (x *PortfolioPosition) GetAveragePositionPrice() *MoneyValue {
-	if x != nil {
-		return x.AveragePositionPrice
-	}
-	return nil
-}
-
-func (x *PortfolioPosition) GetExpectedYield() *Quotation {
-	if x != nil {
-		return x.ExpectedYield
-	}
-	return nil
-}
-
-func (x *PortfolioPosition) GetCurrentNkd() *MoneyValue {
-	if x != nil {
-		return x.CurrentNkd
-	}
-	return nil
-}
-
-// Deprecated: Marked as deprecated in adapter/tinkoff/proto/operations.proto.
-func (x *PortfolioPosition) GetAveragePositionPricePt() *Quotation {
-	if x != nil {
-		return x.AveragePositionPricePt
-	}
-	return nil
-}
-
-func (x *PortfolioPosition) GetCurrentPrice() *MoneyValue {
-	if x != nil {
-		return x.CurrentPrice
-	}
-	return nil
-}
-
-func (x *PortfolioPosition) GetAveragePositionPriceFifo() *MoneyValue {
-	if x != nil {
-		return x.AveragePositionPriceFifo
-	}
-	return nil
-}
-
-// Deprecated: Marked as deprecated in adapter/tinkoff/proto/operations.proto.
-func (x *PortfolioPosition) GetQuantityLots() *Quotation {
-	if x != nil {
-		return x.QuantityLots
-	}
-	return nil
-}
-
-func (x *PortfolioPosition) GetBlocked() bool {
-	if x != nil {
-		return x.Blocked
-	}
-	return false
-}
-
-func (x *PortfolioPosition) GetBlockedLots() *Quotation {
-	if x != nil {
-		return x.BlockedLots
-	}
-	return nil
-}
-
-func (x *PortfolioPosition) GetPositionUid() string {
-	if x != nil {
-		return x.PositionUid
-	}
-	return ""
-}
-
-func (x *PortfolioPosition) GetInstrumentUid() string {
-	if x != nil {
-		return x.InstrumentUid
-	}
-	return ""
-}
-
-func (x *PortfolioPosition) GetVarMargin() *MoneyValue {
-	if x != nil {
-		return x.VarMargin
-	}
-	return nil
-}
-
-func (x *PortfolioPosition) GetExpectedYieldFifo() *Quotation {
-	if x != nil {
-		return x.ExpectedYieldFifo
-	}
-	return nil
-}
-
-func (x *PortfolioPosition) GetDailyYield() *MoneyValue {
-	if x != nil {
-		return x.DailyYield
-	}
-	return nil
-}
-
-func (x *PortfolioPosition) GetTicker() string {
-	if x != nil {
-		return x.Ticker
-	}
-	return ""
-}
-
-type VirtualPortfolioPosition struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	PositionUid              string                 `protobuf:"bytes,1,opt,name=position_uid,json=positionUid,proto3" json:"position_uid,omitempty"`                                             //Уникальный идентификатор позиции.
-	InstrumentUid            string                 `protobuf:"bytes,2,opt,name=instrument_uid,json=instrumentUid,proto3" json:"instrument_uid,omitempty"`                                       //Уникальный идентификатор инструмента.
-	Figi                     string                 `protobuf:"bytes,3,opt,name=figi,proto3" json:"figi,omitempty"`                                                                              //FIGI-идентификатор инструмента.
-	InstrumentType           string                 `protobuf:"bytes,4,opt,name=instrument_type,json=instrumentType,proto3" json:"instrument_type,omitempty"`                                    //Тип инструмента.
-	Quantity                 *Quotation             `protobuf:"bytes,5,opt,name=quantity,proto3" json:"quantity,omitempty"`                                                                      //Количество инструмента в портфеле в штуках.
-	AveragePositionPrice     *MoneyValue            `protobuf:"bytes,6,opt,name=average_position_price,json=averagePositionPrice,proto3" json:"average_position_price,omitempty"`                //Средневзвешенная цена позиции. Для пересчета возможна задержка до одной секунды.
-	ExpectedYield            *Quotation             `protobuf:"bytes,7,opt,name=expected_yield,json=expectedYield,proto3" json:"expected_yield,omitempty"`                                       //Текущая рассчитанная доходность позиции.
-	ExpectedYieldFifo        *Quotation             `protobuf:"bytes,8,opt,name=expected_yield_fifo,json=expectedYieldFifo,proto3" json:"expected_yield_fifo,omitempty"`                         //Текущая рассчитанная доходность позиции.
-	ExpireDate               *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expire_date,json=expireDate,proto3" json:"expire_date,omitempty"`                                                //Дата, до которой нужно продать виртуальные бумаги. После этой даты виртуальная позиция «сгораетт».
-	CurrentPrice             *MoneyValue            `protobuf:"bytes,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`                                         //Текущая цена за 1 инструмент. Чтобы получить стоимость лота, нужно умножить на лотность инструмента.
-	AveragePositionPriceFifo *MoneyValue            `protobuf:"bytes,11,opt,name=average_position_price_fifo,json=averagePositionPriceFifo,proto3" json:"average_position_price_fifo,omitempty"` //Средняя цена позиции по методу FIFO. Для пересчета возможна задержка до одной секунды.
-	DailyYield               *MoneyValue            `protobuf:"bytes,31,opt,name=daily_yield,json=dailyYield,proto3" json:"daily_yield,omitempty"`                                               // Рассчитанная доходность портфеля за день.
-	Ticker                   string                 `protobuf:"bytes,32,opt,name=ticker,proto3" json:"ticker,omitempty"`                                                                         //Тикер инструмента.
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
-}
-
-func (x *VirtualPortfolioPosition) Reset() {
-	*x = VirtualPortfolioPosition{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *VirtualPortfolioPosition) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*VirtualPortfolioPosition) ProtoMessage() {}
-
-func (x *VirtualPortfolioPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[11]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use VirtualPortfolioPosition.ProtoReflect.Descriptor instead.
-func (*VirtualPortfolioPosition) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *VirtualPortfolioPosition) GetPositionUid() string {
-	if x != nil {
-		return x.PositionUid
-	}
-	return ""
-}
-
-func (x *VirtualPortfolioPosition) GetInstrumentUid() string {
-	if x != nil {
-		return x.InstrumentUid
-	}
-	return ""
-}
-
-func (x *VirtualPortfolioPosition) GetFigi() string {
-	if x != nil {
-		return x.Figi
-	}
-	return ""
-}
-
-func (x *VirtualPortfolioPosition) GetInstrumentType() string {
-	if x != nil {
-		return x.InstrumentType
-	}
-	return ""
-}
-
-func (x *VirtualPortfolioPosition) GetQuantity() *Quotation {
-	if x != nil {
-		return x.Quantity
-	}
-	return nil
-}
-
-func (x *VirtualPortfolioPosition) GetAveragePositionPrice() *MoneyValue {
-	if x != nil {
-		return x.AveragePositionPrice
-	}
-	return nil
-}
-
-func (x *VirtualPortfolioPosition) GetExpectedYield() *Quotation {
-	if x != nil {
-		return x.ExpectedYield
-	}
-	return nil
-}
-
-func (x *VirtualPortfolioPosition) GetExpectedYieldFifo() *Quotation {
-	if x != nil {
-		return x.ExpectedYieldFifo
-	}
-	return nil
-}
-
-func (x *VirtualPortfolioPosition) GetExpireDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpireDate
-	}
-	return nil
-}
-
-func (x *VirtualPortfolioPosition) GetCurrentPrice() *MoneyValue {
-	if x != nil {
-		return x.CurrentPrice
-	}
-	return nil
-}
-
-func (x *VirtualPortfolioPosition) GetAveragePositionPriceFifo() *MoneyValue {
-	if x != nil {
-		return x.AveragePositionPriceFifo
-	}
-	return nil
-}
-
-func (x *VirtualPortfolioPosition) GetDailyYield() *MoneyValue {
-	if x != nil {
-		return x.DailyYield
-	}
-	return nil
-}
-
-func (x *VirtualPortfolioPosition) GetTicker() string {
-	if x != nil {
-		return x.Ticker
-	}
-	return ""
-}
-
-// Баланс позиции ценной бумаги.
-type PositionsSecurities struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Figi            string                 `protobuf:"bytes,1,opt,name=figi,proto3" json:"figi,omitempty"`                                                //FIGI-идентификатор бумаги.
-	Blocked         int64                  `protobuf:"varint,2,opt,name=blocked,proto3" json:"blocked,omitempty"`                                         //Количество бумаг, заблокированных выставленными заявками.
-	Balance         int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`                                         //Текущий незаблокированный баланс.
-	PositionUid     string                 `protobuf:"bytes,4,opt,name=position_uid,json=positionUid,proto3" json:"position_uid,omitempty"`               //Уникальный идентификатор позиции.
-	InstrumentUid   string                 `protobuf:"bytes,5,opt,name=instrument_uid,json=instrumentUid,proto3" json:"instrument_uid,omitempty"`         //Уникальный идентификатор инструмента.
-	Ticker          string                 `protobuf:"bytes,6,opt,name=ticker,proto3" json:"ticker,omitempty"`                                            //Тикер инструмента.
-	ExchangeBlocked bool                   `protobuf:"varint,11,opt,name=exchange_blocked,json=exchangeBlocked,proto3" json:"exchange_blocked,omitempty"` //Заблокировано на бирже.
-	InstrumentType  string                 `protobuf:"bytes,16,opt,name=instrument_type,json=instrumentType,proto3" json:"instrument_type,omitempty"`     //Тип инструмента.
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *PositionsSecurities) Reset() {
-	*x = PositionsSecurities{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PositionsSecurities) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PositionsSecurities) ProtoMessage() {}
-
-func (x *PositionsSecurities) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[12]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PositionsSecurities.ProtoReflect.Descriptor instead.
-func (*PositionsSecurities) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *PositionsSecurities) GetFigi() string {
-	if x != nil {
-		return x.Figi
-	}
-	return ""
-}
-
-func (x *PositionsSecurities) GetBlocked() int64 {
-	if x != nil {
-		return x.Blocked
-	}
-	return 0
-}
-
-func (x *PositionsSecurities) GetBalance() int64 {
-	if x != nil {
-		return x.Balance
-	}
-	return 0
-}
-
-func (x *PositionsSecurities) GetPositionUid() string {
-	if x != nil {
-		return x.PositionUid
-	}
-	return ""
-}
-
-func (x *PositionsSecurities) GetInstrumentUid() string {
-	if x != nil {
-		return x.InstrumentUid
-	}
-	return ""
-}
-
-func (x *PositionsSecurities) GetTicker() string {
-	if x != nil {
-		return x.Ticker
-	}
-	return ""
-}
-
-func (x *PositionsSecurities) GetExchangeBlocked() bool {
-	if x != nil {
-		return x.ExchangeBlocked
-	}
-	return false
-}
-
-func (x *PositionsSecurities) GetInstrumentType() string {
-	if x != nil {
-		return x.InstrumentType
-	}
-	return ""
-}
-
-// Баланс фьючерса.
-type PositionsFutures struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Figi          string                 `protobuf:"bytes,1,opt,name=figi,proto3" json:"figi,omitempty"`                                        //FIGI-идентификатор фьючерса.
-	Blocked       int64                  `protobuf:"varint,2,opt,name=blocked,proto3" json:"blocked,omitempty"`                                 //Количество бумаг, заблокированных выставленными заявками.
-	Balance       int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`                                 //Текущий незаблокированный баланс.
-	PositionUid   string                 `protobuf:"bytes,4,opt,name=position_uid,json=positionUid,proto3" json:"position_uid,omitempty"`       //Уникальный идентификатор позиции.
-	InstrumentUid string                 `protobuf:"bytes,5,opt,name=instrument_uid,json=instrumentUid,proto3" json:"instrument_uid,omitempty"` //Уникальный идентификатор  инструмента.
-	Ticker        string                 `protobuf:"bytes,6,opt,name=ticker,proto3" json:"ticker,omitempty"`                                    //Тикер инструмента.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PositionsFutures) Reset() {
-	*x = PositionsFutures{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PositionsFutures) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PositionsFutures) ProtoMessage() {}
-
-func (x *PositionsFutures) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[13]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PositionsFutures.ProtoReflect.Descriptor instead.
-func (*PositionsFutures) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *PositionsFutures) GetFigi() string {
-	if x != nil {
-		return x.Figi
-	}
-	return ""
-}
-
-func (x *PositionsFutures) GetBlocked() int64 {
-	if x != nil {
-		return x.Blocked
-	}
-	return 0
-}
-
-func (x *PositionsFutures) GetBalance() int64 {
-	if x != nil {
-		return x.Balance
-	}
-	return 0
-}
-
-func (x *PositionsFutures) GetPositionUid() string {
-	if x != nil {
-		return x.PositionUid
-	}
-	return ""
-}
-
-func (x *PositionsFutures) GetInstrumentUid() string {
-	if x != nil {
-		return x.InstrumentUid
-	}
-	return ""
-}
-
-func (x *PositionsFutures) GetTicker() string {
-	if x != nil {
-		return x.Ticker
-	}
-	return ""
-}
-
-// Баланс опциона.
-type PositionsOptions struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PositionUid   string                 `protobuf:"bytes,1,opt,name=position_uid,json=positionUid,proto3" json:"position_uid,omitempty"`       //Уникальный идентификатор позиции опциона.
-	InstrumentUid string                 `protobuf:"bytes,2,opt,name=instrument_uid,json=instrumentUid,proto3" json:"instrument_uid,omitempty"` //Уникальный идентификатор  инструмента.
-	Ticker        string                 `protobuf:"bytes,3,opt,name=ticker,proto3" json:"ticker,omitempty"`                                    //Тикер инструмента.
-	Blocked       int64                  `protobuf:"varint,11,opt,name=blocked,proto3" json:"blocked,omitempty"`                                //Количество бумаг, заблокированных выставленными заявками.
-	Balance       int64                  `protobuf:"varint,21,opt,name=balance,proto3" json:"balance,omitempty"`                                //Текущий незаблокированный баланс.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PositionsOptions) Reset() {
-	*x = PositionsOptions{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PositionsOptions) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PositionsOptions) ProtoMessage() {}
-
-func (x *PositionsOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[14]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PositionsOptions.ProtoReflect.Descriptor instead.
-func (*PositionsOptions) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *PositionsOptions) GetPositionUid() string {
-	if x != nil {
-		return x.PositionUid
-	}
-	return ""
-}
-
-func (x *PositionsOptions) GetInstrumentUid() string {
-	if x != nil {
-		return x.InstrumentUid
-	}
-	return ""
-}
-
-func (x *PositionsOptions) GetTicker() string {
-	if x != nil {
-		return x.Ticker
-	}
-	return ""
-}
-
-func (x *PositionsOptions) GetBlocked() int64 {
-	if x != nil {
-		return x.Blocked
-	}
-	return 0
-}
-
-func (x *PositionsOptions) GetBalance() int64 {
-	if x != nil {
-		return x.Balance
-	}
-	return 0
-}
-
-type BrokerReportRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*BrokerReportRequest_GenerateBrokerReportRequest
-	//	*BrokerReportRequest_GetBrokerReportRequest
-	Payload       isBrokerReportRequest_Payload `protobuf_oneof:"payload"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *BrokerReportRequest) Reset() {
-	*x = BrokerReportRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *BrokerReportRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BrokerReportRequest) ProtoMessage() {}
-
-func (x *BrokerReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[15]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BrokerReportRequest.ProtoReflect.Descriptor instead.
-func (*BrokerReportRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *BrokerReportRequest) GetPayload() isBrokerReportRequest_Payload {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
-}
-
-func (x *BrokerReportRequest) GetGenerateBrokerReportRequest() *GenerateBrokerReportRequest {
-	if x != nil {
-		if x, ok := x.Payload.(*BrokerReportRequest_GenerateBrokerReportRequest); ok {
-			return x.GenerateBrokerReportRequest
-		}
-	}
-	return nil
-}
-
-func (x *BrokerReportRequest) GetGetBrokerReportRequest() *GetBrokerReportRequest {
-	if x != nil {
-		if x, ok := x.Payload.(*BrokerReportRequest_GetBrokerReportRequest); ok {
-			return x.GetBrokerReportRequest
-		}
-	}
-	return nil
-}
-
-type isBrokerReportRequest_Payload interface {
-	isBrokerReportRequest_Payload()
-}
-
-type BrokerReportRequest_GenerateBrokerReportRequest struct {
-	GenerateBrokerReportRequest *GenerateBrokerReportRequest `protobuf:"bytes,1,opt,name=generate_broker_report_request,json=generateBrokerReportRequest,proto3,oneof"`
-}
-
-type BrokerReportRequest_GetBrokerReportRequest struct {
-	GetBrokerReportRequest *GetBrokerReportRequest `protobuf:"bytes,2,opt,name=get_broker_report_request,json=getBrokerReportRequest,proto3,oneof"`
-}
-
-func (*BrokerReportRequest_GenerateBrokerReportRequest) isBrokerReportRequest_Payload() {}
-
-func (*BrokerReportRequest_GetBrokerReportRequest) isBrokerReportRequest_Payload() {}
-
-type BrokerReportResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*BrokerReportResponse_GenerateBrokerReportResponse
-	//	*BrokerReportResponse_GetBrokerReportResponse
-	Payload       isBrokerReportResponse_Payload `protobuf_oneof:"payload"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *BrokerReportResponse) Reset() {
-	*x = BrokerReportResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *BrokerReportResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BrokerReportResponse) ProtoMessage() {}
-
-func (x *BrokerReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[16]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BrokerReportResponse.ProtoReflect.Descriptor instead.
-func (*BrokerReportResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *BrokerReportResponse) GetPayload() isBrokerReportResponse_Payload {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
-}
-
-func (x *BrokerReportResponse) GetGenerateBrokerReportResponse() *GenerateBrokerReportResponse {
-	if x != nil {
-		if x, ok := x.Payload.(*BrokerReportResponse_GenerateBrokerReportResponse); ok {
-			return x.GenerateBrokerReportResponse
-		}
-	}
-	return nil
-}
-
-func (x *BrokerReportResponse) GetGetBrokerReportResponse() *GetBrokerReportResponse {
-	if x != nil {
-		if x, ok := x.Payload.(*BrokerReportResponse_GetBrokerReportResponse); ok {
-			return x.GetBrokerReportResponse
-		}
-	}
-	return nil
-}
-
-type isBrokerReportResponse_Payload interface {
-	isBrokerReportResponse_Payload()
-}
-
-type BrokerReportResponse_GenerateBrokerReportResponse struct {
-	GenerateBrokerReportResponse *GenerateBrokerReportResponse `protobuf:"bytes,1,opt,name=generate_broker_report_response,json=generateBrokerReportResponse,proto3,oneof"`
-}
-
-type BrokerReportResponse_GetBrokerReportResponse struct {
-	GetBrokerReportResponse *GetBrokerReportResponse `protobuf:"bytes,2,opt,name=get_broker_report_response,json=getBrokerReportResponse,proto3,oneof"`
-}
-
-func (*BrokerReportResponse_GenerateBrokerReportResponse) isBrokerReportResponse_Payload() {}
-
-func (*BrokerReportResponse_GetBrokerReportResponse) isBrokerReportResponse_Payload() {}
-
-type GenerateBrokerReportRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` //Идентификатор счета клиента.
-	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                            //Начало периода по UTC.
-	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                                //Окончание периода по UTC.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GenerateBrokerReportRequest) Reset() {
-	*x = GenerateBrokerReportRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GenerateBrokerReportRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GenerateBrokerReportRequest) ProtoMessage() {}
-
-func (x *GenerateBrokerReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[17]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GenerateBrokerReportRequest.ProtoReflect.Descriptor instead.
-func (*GenerateBrokerReportRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *GenerateBrokerReportRequest) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *GenerateBrokerReportRequest) GetFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.From
-	}
-	return nil
-}
-
-func (x *GenerateBrokerReportRequest) GetTo() *timestamppb.Timestamp {
-	if x != nil {
-		return x.To
-	}
-	return nil
-}
-
-type GenerateBrokerReportResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` //Идентификатор задачи формирования брокерского отчета.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GenerateBrokerReportResponse) Reset() {
-	*x = GenerateBrokerReportResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GenerateBrokerReportResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GenerateBrokerReportResponse) ProtoMessage() {}
-
-func (x *GenerateBrokerReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[18]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GenerateBrokerReportResponse.ProtoReflect.Descriptor instead.
-func (*GenerateBrokerReportResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *GenerateBrokerReportResponse) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
-
-type GetBrokerReportRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` //Идентификатор задачи формирования брокерского отчета.
-	Page          *int32                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`            //Номер страницы отчета, начинается с 1. Значение по умолчанию — 0.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetBrokerReportRequest) Reset() {
-	*x = GetBrokerReportRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetBrokerReportRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetBrokerReportRequest) ProtoMessage() {}
-
-func (x *GetBrokerReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[19]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetBrokerReportRequest.ProtoReflect.Descriptor instead.
-func (*GetBrokerReportRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *GetBrokerReportRequest) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
-
-func (x *GetBrokerReportRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
-	}
-	return 0
-}
-
-type GetBrokerReportResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BrokerReport  []*BrokerReport        `protobuf:"bytes,1,rep,name=broker_report,json=brokerReport,proto3" json:"broker_report,omitempty"`
-	ItemsCount    int32                  `protobuf:"varint,2,opt,name=itemsCount,proto3" json:"itemsCount,omitempty"` //Количество записей в отчете.
-	PagesCount    int32                  `protobuf:"varint,3,opt,name=pagesCount,proto3" json:"pagesCount,omitempty"` //Количество страниц с данными отчета, начинается с 0.
-	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`             //Текущая страница, начинается с 0.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetBrokerReportResponse) Reset() {
-	*x = GetBrokerReportResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetBrokerReportResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetBrokerReportResponse) ProtoMessage() {}
-
-func (x *GetBrokerReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[20]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetBrokerReportResponse.ProtoReflect.Descriptor instead.
-func (*GetBrokerReportResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *GetBrokerReportResponse) GetBrokerReport() []*BrokerReport {
-	if x != nil {
-		return x.BrokerReport
-	}
-	return nil
-}
-
-func (x *GetBrokerReportResponse) GetItemsCount() int32 {
-	if x != nil {
-		return x.ItemsCount
-	}
-	return 0
-}
-
-func (x *GetBrokerReportResponse) GetPagesCount() int32 {
-	if x != nil {
-		return x.PagesCount
-	}
-	return 0
-}
-
-func (x *GetBrokerReportResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-type BrokerReport struct {
-	state                      protoimpl.MessageState `protogen:"open.v1"`
-	TradeId                    string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`                                                             //Номер сделки.
-	OrderId                    string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                                                             //Номер поручения.
-	Figi                       string                 `protobuf:"bytes,3,opt,name=figi,proto3" json:"figi,omitempty"`                                                                                  //FIGI-идентификаторинструмента.
-	ExecuteSign                string                 `protobuf:"bytes,4,opt,name=execute_sign,json=executeSign,proto3" json:"execute_sign,omitempty"`                                                 //Признак исполнения.
-	TradeDatetime              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=trade_datetime,json=tradeDatetime,proto3" json:"trade_datetime,omitempty"`                                           //Дата и время заключения по UTC.
-	Exchange                   string                 `protobuf:"bytes,6,opt,name=exchange,proto3" json:"exchange,omitempty"`                                                                          //Торговая площадка.
-	ClassCode                  string                 `protobuf:"bytes,7,opt,name=class_code,json=classCode,proto3" json:"class_code,omitempty"`                                                       //Режим торгов.
-	Direction                  string                 `protobuf:"bytes,8,opt,name=direction,proto3" json:"direction,omitempty"`                                                                        //Вид сделки.
-	Name                       string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`                                                                                  //Сокращенное наименование актива.
-	Ticker                     string                 `protobuf:"bytes,10,opt,name=ticker,proto3" json:"ticker,omitempty"`                                                                             //Код актива.
-	Price                      *MoneyValue            `protobuf:"bytes,11,opt,name=price,proto3" json:"price,omitempty"`                                                                               //Цена за единицу.
-	Quantity                   int64                  `protobuf:"varint,12,opt,name=quantity,proto3" json:"quantity,omitempty"`                                                                        //Количество.
-	OrderAmount                *MoneyValue            `protobuf:"bytes,13,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`                                                //Сумма без НКД.
-	AciValue                   *Quotation             `protobuf:"bytes,14,opt,name=aci_value,json=aciValue,proto3" json:"aci_value,omitempty"`                                                         //НКД.
-	TotalOrderAmount           *MoneyValue            `protobuf:"bytes,15,opt,name=total_order_amount,json=totalOrderAmount,proto3" json:"total_order_amount,omitempty"`                               //Сумма сделки.
-	BrokerCommission           *MoneyValue            `protobuf:"bytes,16,opt,name=broker_commission,json=brokerCommission,proto3" json:"broker_commission,omitempty"`                                 //Комиссия брокера.
-	ExchangeCommission         *MoneyValue            `protobuf:"bytes,17,opt,name=exchange_commission,json=exchangeCommission,proto3" json:"exchange_commission,omitempty"`                           //Комиссия биржи.
-	ExchangeClearingCommission *MoneyValue            `protobuf:"bytes,18,opt,name=exchange_clearing_commission,json=exchangeClearingCommission,proto3" json:"exchange_clearing_commission,omitempty"` //Комиссия клирингового центра.
-	RepoRate                   *Quotation             `protobuf:"bytes,19,opt,name=repo_rate,json=repoRate,proto3" json:"repo_rate,omitempty"`                                                         //Ставка РЕПО, %.
-	Party                      string                 `protobuf:"bytes,20,opt,name=party,proto3" json:"party,omitempty"`                                                                               //Контрагент или брокерарокер.
-	ClearValueDate             *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=clear_value_date,json=clearValueDate,proto3" json:"clear_value_date,omitempty"`                                     //Дата расчетов по UTC.
-	SecValueDate               *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=sec_value_date,json=secValueDate,proto3" json:"sec_value_date,omitempty"`                                           //Дата поставки по UTC.
-	BrokerStatus               string                 `protobuf:"bytes,23,opt,name=broker_status,json=brokerStatus,proto3" json:"broker_status,omitempty"`                                             //Статус брокера.
-	SeparateAgreementType      string                 `protobuf:"bytes,24,opt,name=separate_agreement_type,json=separateAgreementType,proto3" json:"separate_agreement_type,omitempty"`                //Тип договора.
-	SeparateAgreementNumber    string                 `protobuf:"bytes,25,opt,name=separate_agreement_number,json=separateAgreementNumber,proto3" json:"separate_agreement_number,omitempty"`          //Номер договора.
-	SeparateAgreementDate      string                 `protobuf:"bytes,26,opt,name=separate_agreement_date,json=separateAgreementDate,proto3" json:"separate_agreement_date,omitempty"`                //Дата договора.
-	DeliveryType               string                 `protobuf:"bytes,27,opt,name=delivery_type,json=deliveryType,proto3" json:"delivery_type,omitempty"`                                             //Тип расчета по сделке.
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
-}
-
-func (x *BrokerReport) Reset() {
-	*x = BrokerReport{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *BrokerReport) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BrokerReport) ProtoMessage() {}
-
-func (x *BrokerReport) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[21]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BrokerReport.ProtoReflect.Descriptor instead.
-func (*BrokerReport) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *BrokerReport) GetTradeId() string {
-	if x != nil {
-		return x.TradeId
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetFigi() string {
-	if x != nil {
-		return x.Figi
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetExecuteSign() string {
-	if x != nil {
-		return x.ExecuteSign
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetTradeDatetime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.TradeDatetime
-	}
-	return nil
-}
-
-func (x *BrokerReport) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetClassCode() string {
-	if x != nil {
-		return x.ClassCode
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetDirection() string {
-	if x != nil {
-		return x.Direction
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetTicker() string {
-	if x != nil {
-		return x.Ticker
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetPrice() *MoneyValue {
-	if x != nil {
-		return x.Price
-	}
-	return nil
-}
-
-func (x *BrokerReport) GetQuantity() int64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *BrokerReport) GetOrderAmount() *MoneyValue {
-	if x != nil {
-		return x.OrderAmount
-	}
-	return nil
-}
-
-func (x *BrokerReport) GetAciValue() *Quotation {
-	if x != nil {
-		return x.AciValue
-	}
-	return nil
-}
-
-func (x *BrokerReport) GetTotalOrderAmount() *MoneyValue {
-	if x != nil {
-		return x.TotalOrderAmount
-	}
-	return nil
-}
-
-func (x *BrokerReport) GetBrokerCommission() *MoneyValue {
-	if x != nil {
-		return x.BrokerCommission
-	}
-	return nil
-}
-
-func (x *BrokerReport) GetExchangeCommission() *MoneyValue {
-	if x != nil {
-		return x.ExchangeCommission
-	}
-	return nil
-}
-
-func (x *BrokerReport) GetExchangeClearingCommission() *MoneyValue {
-	if x != nil {
-		return x.ExchangeClearingCommission
-	}
-	return nil
-}
-
-func (x *BrokerReport) GetRepoRate() *Quotation {
-	if x != nil {
-		return x.RepoRate
-	}
-	return nil
-}
-
-func (x *BrokerReport) GetParty() string {
-	if x != nil {
-		return x.Party
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetClearValueDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ClearValueDate
-	}
-	return nil
-}
-
-func (x *BrokerReport) GetSecValueDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.SecValueDate
-	}
-	return nil
-}
-
-func (x *BrokerReport) GetBrokerStatus() string {
-	if x != nil {
-		return x.BrokerStatus
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetSeparateAgreementType() string {
-	if x != nil {
-		return x.SeparateAgreementType
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetSeparateAgreementNumber() string {
-	if x != nil {
-		return x.SeparateAgreementNumber
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetSeparateAgreementDate() string {
-	if x != nil {
-		return x.SeparateAgreementDate
-	}
-	return ""
-}
-
-func (x *BrokerReport) GetDeliveryType() string {
-	if x != nil {
-		return x.DeliveryType
-	}
-	return ""
-}
-
-type GetDividendsForeignIssuerRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*GetDividendsForeignIssuerRequest_GenerateDivForeignIssuerReport
-	//	*GetDividendsForeignIssuerRequest_GetDivForeignIssuerReport
-	Payload       isGetDividendsForeignIssuerRequest_Payload `protobuf_oneof:"payload"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetDividendsForeignIssuerRequest) Reset() {
-	*x = GetDividendsForeignIssuerRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetDividendsForeignIssuerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetDividendsForeignIssuerRequest) ProtoMessage() {}
-
-func (x *GetDividendsForeignIssuerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[22]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetDividendsForeignIssuerRequest.ProtoReflect.Descriptor instead.
-func (*GetDividendsForeignIssuerRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *GetDividendsForeignIssuerRequest) GetPayload() isGetDividendsForeignIssuerRequest_Payload {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
-}
-
-func (x *GetDividendsForeignIssuerRequest) GetGenerateDivForeignIssuerReport() *GenerateDividendsForeignIssuerReportRequest {
-	if x != nil {
-		if x, ok := x.Payload.(*GetDividendsForeignIssuerRequest_GenerateDivForeignIssuerReport); ok {
-			return x.GenerateDivForeignIssuerReport
-		}
-	}
-	return nil
-}
-
-func (x *GetDividendsForeignIssuerRequest) GetGetDivForeignIssuerReport() *GetDividendsForeignIssuerReportRequest {
-	if x != nil {
-		if x, ok := x.Payload.(*GetDividendsForeignIssuerRequest_GetDivForeignIssuerReport); ok {
-			return x.GetDivForeignIssuerReport
-		}
-	}
-	return nil
-}
-
-type isGetDividendsForeignIssuerRequest_Payload interface {
-	isGetDividendsForeignIssuerRequest_Payload()
-}
-
-type GetDividendsForeignIssuerRequest_GenerateDivForeignIssuerReport struct {
-	GenerateDivForeignIssuerReport *GenerateDividendsForeignIssuerReportRequest `protobuf:"bytes,1,opt,name=generate_div_foreign_issuer_report,json=generateDivForeignIssuerReport,proto3,oneof"` //Объект запроса формирования отчета.
-}
-
-type GetDividendsForeignIssuerRequest_GetDivForeignIssuerReport struct {
-	GetDivForeignIssuerReport *GetDividendsForeignIssuerReportRequest `protobuf:"bytes,2,opt,name=get_div_foreign_issuer_report,json=getDivForeignIssuerReport,proto3,oneof"` //Объект запроса сформированного отчета.
-}
-
-func (*GetDividendsForeignIssuerRequest_GenerateDivForeignIssuerReport) isGetDividendsForeignIssuerRequest_Payload() {
-}
-
-func (*GetDividendsForeignIssuerRequest_GetDivForeignIssuerReport) isGetDividendsForeignIssuerRequest_Payload() {
-}
-
-type GetDividendsForeignIssuerResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*GetDividendsForeignIssuerResponse_GenerateDivForeignIssuerReportResponse
-	//	*GetDividendsForeignIssuerResponse_DivForeignIssuerReport
-	Payload       isGetDividendsForeignIssuerResponse_Payload `protobuf_oneof:"payload"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetDividendsForeignIssuerResponse) Reset() {
-	*x = GetDividendsForeignIssuerResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetDividendsForeignIssuerResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetDividendsForeignIssuerResponse) ProtoMessage() {}
-
-func (x *GetDividendsForeignIssuerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[23]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetDividendsForeignIssuerResponse.ProtoReflect.Descriptor instead.
-func (*GetDividendsForeignIssuerResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *GetDividendsForeignIssuerResponse) GetPayload() isGetDividendsForeignIssuerResponse_Payload {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
-}
-
-func (x *GetDividendsForeignIssuerResponse) GetGenerateDivForeignIssuerReportResponse() *GenerateDividendsForeignIssuerReportResponse {
-	if x != nil {
-		if x, ok := x.Payload.(*GetDividendsForeignIssuerResponse_GenerateDivForeignIssuerReportResponse); ok {
-			return x.GenerateDivForeignIssuerReportResponse
-		}
-	}
-	return nil
-}
-
-func (x *GetDividendsForeignIssuerResponse) GetDivForeignIssuerReport() *GetDividendsForeignIssuerReportResponse {
-	if x != nil {
-		if x, ok := x.Payload.(*GetDividendsForeignIssuerResponse_DivForeignIssuerReport); ok {
-			return x.DivForeignIssuerReport
-		}
-	}
-	return nil
-}
-
-type isGetDividendsForeignIssuerResponse_Payload interface {
-	isGetDividendsForeignIssuerResponse_Payload()
-}
-
-type GetDividendsForeignIssuerResponse_GenerateDivForeignIssuerReportResponse struct {
-	GenerateDivForeignIssuerReportResponse *GenerateDividendsForeignIssuerReportResponse `protobuf:"bytes,1,opt,name=generate_div_foreign_issuer_report_response,json=generateDivForeignIssuerReportResponse,proto3,oneof"` //Объект результата задачи запуска формирования отчета.
-}
-
-type GetDividendsForeignIssuerResponse_DivForeignIssuerReport struct {
-	DivForeignIssuerReport *GetDividendsForeignIssuerReportResponse `protobuf:"bytes,2,opt,name=div_foreign_issuer_report,json=divForeignIssuerReport,proto3,oneof"` //Отчет «Справка о доходах за пределами РФ».
-}
-
-func (*GetDividendsForeignIssuerResponse_GenerateDivForeignIssuerReportResponse) isGetDividendsForeignIssuerResponse_Payload() {
-}
-
-func (*GetDividendsForeignIssuerResponse_DivForeignIssuerReport) isGetDividendsForeignIssuerResponse_Payload() {
-}
-
-// Объект запроса формирования отчета «Справка о доходах за пределами РФ».
-type GenerateDividendsForeignIssuerReportRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` //Идентификатор счета клиента.
-	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                            //Начало периода по UTC.
-	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                                //Окончание периода по UTC. Как правило, можно сформировать отчет по дату на несколько дней меньше текущей. Начало и окончание периода должны быть в рамках одного календарного года.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GenerateDividendsForeignIssuerReportRequest) Reset() {
-	*x = GenerateDividendsForeignIssuerReportRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GenerateDividendsForeignIssuerReportRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GenerateDividendsForeignIssuerReportRequest) ProtoMessage() {}
-
-func (x *GenerateDividendsForeignIssuerReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[24]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GenerateDividendsForeignIssuerReportRequest.ProtoReflect.Descriptor instead.
-func (*GenerateDividendsForeignIssuerReportRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *GenerateDividendsForeignIssuerReportRequest) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *GenerateDividendsForeignIssuerReportRequest) GetFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.From
-	}
-	return nil
-}
-
-func (x *GenerateDividendsForeignIssuerReportRequest) GetTo() *timestamppb.Timestamp {
-	if x != nil {
-		return x.To
-	}
-	return nil
-}
-
-// Объект запроса сформированного отчета «Справка о доходах за пределами РФ».
-type GetDividendsForeignIssuerReportRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` //Идентификатор задачи формирования отчета.
-	Page          *int32                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`            //Номер страницы отчета (начинается с 0), значение по умолчанию: 0.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetDividendsForeignIssuerReportRequest) Reset() {
-	*x = GetDividendsForeignIssuerReportRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[25]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetDividendsForeignIssuerReportRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetDividendsForeignIssuerReportRequest) ProtoMessage() {}
-
-func (x *GetDividendsForeignIssuerReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[25]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetDividendsForeignIssuerReportRequest.ProtoReflect.Descriptor instead.
-func (*GetDividendsForeignIssuerReportRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *GetDividendsForeignIssuerReportRequest) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
-
-func (x *GetDividendsForeignIssuerReportRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
-	}
-	return 0
-}
-
-// Объект результата задачи запуска формирования отчета «Справка о доходах за пределами РФ».
-type GenerateDividendsForeignIssuerReportResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` //Идентификатор задачи формирования отчета.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GenerateDividendsForeignIssuerReportResponse) Reset() {
-	*x = GenerateDividendsForeignIssuerReportResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GenerateDividendsForeignIssuerReportResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GenerateDividendsForeignIssuerReportResponse) ProtoMessage() {}
-
-func (x *GenerateDividendsForeignIssuerReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[26]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GenerateDividendsForeignIssuerReportResponse.ProtoReflect.Descriptor instead.
-func (*GenerateDividendsForeignIssuerReportResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *GenerateDividendsForeignIssuerReportResponse) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
-}
-
-type GetDividendsForeignIssuerReportResponse struct {
-	state                        protoimpl.MessageState          `protogen:"open.v1"`
-	DividendsForeignIssuerReport []*DividendsForeignIssuerReport `protobuf:"bytes,1,rep,name=dividends_foreign_issuer_report,json=dividendsForeignIssuerReport,proto3" json:"dividends_foreign_issuer_report,omitempty"`
-	ItemsCount                   int32                           `protobuf:"varint,2,opt,name=itemsCount,proto3" json:"itemsCount,omitempty"` //Количество записей в отчете.
-	PagesCount                   int32                           `protobuf:"varint,3,opt,name=pagesCount,proto3" json:"pagesCount,omitempty"` //Количество страниц с данными отчета, начинается с 0.
-	Page                         int32                           `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`             //Текущая страница, начинается с 0.
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
-}
-
-func (x *GetDividendsForeignIssuerReportResponse) Reset() {
-	*x = GetDividendsForeignIssuerReportResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetDividendsForeignIssuerReportResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetDividendsForeignIssuerReportResponse) ProtoMessage() {}
-
-func (x *GetDividendsForeignIssuerReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[27]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetDividendsForeignIssuerReportResponse.ProtoReflect.Descriptor instead.
-func (*GetDividendsForeignIssuerReportResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *GetDividendsForeignIssuerReportResponse) GetDividendsForeignIssuerReport() []*DividendsForeignIssuerReport {
-	if x != nil {
-		return x.DividendsForeignIssuerReport
-	}
-	return nil
-}
-
-func (x *GetDividendsForeignIssuerReportResponse) GetItemsCount() int32 {
-	if x != nil {
-		return x.ItemsCount
-	}
-	return 0
-}
-
-func (x *GetDividendsForeignIssuerReportResponse) GetPagesCount() int32 {
-	if x != nil {
-		return x.PagesCount
-	}
-	return 0
-}
-
-func (x *GetDividendsForeignIssuerReportResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-// Отчет «Справка о доходах за пределами РФ».
-type DividendsForeignIssuerReport struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	RecordDate         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`                         //Дата фиксации реестра.
-	PaymentDate        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`                      //Дата выплаты.
-	SecurityName       string                 `protobuf:"bytes,3,opt,name=security_name,json=securityName,proto3" json:"security_name,omitempty"`                   //Наименование ценной бумаги.
-	Isin               string                 `protobuf:"bytes,4,opt,name=isin,proto3" json:"isin,omitempty"`                                                       //ISIN-идентификатор ценной бумаги.
-	IssuerCountry      string                 `protobuf:"bytes,5,opt,name=issuer_country,json=issuerCountry,proto3" json:"issuer_country,omitempty"`                //Страна эмитента. Для депозитарных расписок указывается страна эмитента базового актива.
-	Quantity           int64                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`                                              //Количество ценных бумаг.
-	Dividend           *Quotation             `protobuf:"bytes,7,opt,name=dividend,proto3" json:"dividend,omitempty"`                                               //Выплаты на одну бумагу
-	ExternalCommission *Quotation             `protobuf:"bytes,8,opt,name=external_commission,json=externalCommission,proto3" json:"external_commission,omitempty"` //Комиссия внешних платежных агентов.
-	DividendGross      *Quotation             `protobuf:"bytes,9,opt,name=dividend_gross,json=dividendGross,proto3" json:"dividend_gross,omitempty"`                //Сумма до удержания налога.
-	Tax                *Quotation             `protobuf:"bytes,10,opt,name=tax,proto3" json:"tax,omitempty"`                                                        //Сумма налога, удержанного агентом.
-	DividendAmount     *Quotation             `protobuf:"bytes,11,opt,name=dividend_amount,json=dividendAmount,proto3" json:"dividend_amount,omitempty"`            //Итоговая сумма выплаты.
-	Currency           string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`                                              //Валюта.
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *DividendsForeignIssuerReport) Reset() {
-	*x = DividendsForeignIssuerReport{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DividendsForeignIssuerReport) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DividendsForeignIssuerReport) ProtoMessage() {}
-
-func (x *DividendsForeignIssuerReport) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[28]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DividendsForeignIssuerReport.ProtoReflect.Descriptor instead.
-func (*DividendsForeignIssuerReport) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *DividendsForeignIssuerReport) GetRecordDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.RecordDate
-	}
-	return nil
-}
-
-func (x *DividendsForeignIssuerReport) GetPaymentDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.PaymentDate
-	}
-	return nil
-}
-
-func (x *DividendsForeignIssuerReport) GetSecurityName() string {
-	if x != nil {
-		return x.SecurityName
-	}
-	return ""
-}
-
-func (x *DividendsForeignIssuerReport) GetIsin() string {
-	if x != nil {
-		return x.Isin
-	}
-	return ""
-}
-
-func (x *DividendsForeignIssuerReport) GetIssuerCountry() string {
-	if x != nil {
-		return x.IssuerCountry
-	}
-	return ""
-}
-
-func (x *DividendsForeignIssuerReport) GetQuantity() int64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *DividendsForeignIssuerReport) GetDividend() *Quotation {
-	if x != nil {
-		return x.Dividend
-	}
-	return nil
-}
-
-func (x *DividendsForeignIssuerReport) GetExternalCommission() *Quotation {
-	if x != nil {
-		return x.ExternalCommission
-	}
-	return nil
-}
-
-func (x *DividendsForeignIssuerReport) GetDividendGross() *Quotation {
-	if x != nil {
-		return x.DividendGross
-	}
-	return nil
-}
-
-func (x *DividendsForeignIssuerReport) GetTax() *Quotation {
-	if x != nil {
-		return x.Tax
-	}
-	return nil
-}
-
-func (x *DividendsForeignIssuerReport) GetDividendAmount() *Quotation {
-	if x != nil {
-		return x.DividendAmount
-	}
-	return nil
-}
-
-func (x *DividendsForeignIssuerReport) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
-}
-
-// Запрос установки stream-соединения.
-type PortfolioStreamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Accounts      []string               `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`                              //Массив идентификаторов счетов пользователя.
-	PingSettings  *PingDelaySettings     `protobuf:"bytes,15,opt,name=ping_settings,json=pingSettings,proto3" json:"ping_settings,omitempty"` //Запрос настройки пинга.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PortfolioStreamRequest) Reset() {
-	*x = PortfolioStreamRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PortfolioStreamRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PortfolioStreamRequest) ProtoMessage() {}
-
-func (x *PortfolioStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[29]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PortfolioStreamRequest.ProtoReflect.Descriptor instead.
-func (*PortfolioStreamRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *PortfolioStreamRequest) GetAccounts() []string {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
-}
-
-func (x *PortfolioStreamRequest) GetPingSettings() *PingDelaySettings {
-	if x != nil {
-		return x.PingSettings
-	}
-	return nil
-}
-
-// Информация по позициям и доходностям портфелей.
-type PortfolioStreamResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*PortfolioStreamResponse_Subscriptions
-	//	*PortfolioStreamResponse_Portfolio
-	//	*PortfolioStreamResponse_Ping
-	Payload       isPortfolioStreamResponse_Payload `protobuf_oneof:"payload"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PortfolioStreamResponse) Reset() {
-	*x = PortfolioStreamResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PortfolioStreamResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PortfolioStreamResponse) ProtoMessage() {}
-
-func (x *PortfolioStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[30]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PortfolioStreamResponse.ProtoReflect.Descriptor instead.
-func (*PortfolioStreamResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *PortfolioStreamResponse) GetPayload() isPortfolioStreamResponse_Payload {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
-}
-
-func (x *PortfolioStreamResponse) GetSubscriptions() *PortfolioSubscriptionResult {
-	if x != nil {
-		if x, ok := x.Payload.(*PortfolioStreamResponse_Subscriptions); ok {
-			return x.Subscriptions
-		}
-	}
-	return nil
-}
-
-func (x *PortfolioStreamResponse) GetPortfolio() *PortfolioResponse {
-	if x != nil {
-		if x, ok := x.Payload.(*PortfolioStreamResponse_Portfolio); ok {
-			return x.Portfolio
-		}
-	}
-	return nil
-}
-
-func (x *PortfolioStreamResponse) GetPing() *Ping {
-	if x != nil {
-		if x, ok := x.Payload.(*PortfolioStreamResponse_Ping); ok {
-			return x.Ping
-		}
-	}
-	return nil
-}
-
-type isPortfolioStreamResponse_Payload interface {
-	isPortfolioStreamResponse_Payload()
-}
-
-type PortfolioStreamResponse_Subscriptions struct {
-	Subscriptions *PortfolioSubscriptionResult `protobuf:"bytes,1,opt,name=subscriptions,proto3,oneof"` //Объект результата подписки.
-}
-
-type PortfolioStreamResponse_Portfolio struct {
-	Portfolio *PortfolioResponse `protobuf:"bytes,2,opt,name=portfolio,proto3,oneof"` //Объект стриминга портфеля.
-}
-
-type PortfolioStreamResponse_Ping struct {
-	Ping *Ping `protobuf:"bytes,3,opt,name=ping,proto3,oneof"` //Проверка активности стрима.
-}
-
-func (*PortfolioStreamResponse_Subscriptions) isPortfolioStreamResponse_Payload() {}
-
-func (*PortfolioStreamResponse_Portfolio) isPortfolioStreamResponse_Payload() {}
-
-func (*PortfolioStreamResponse_Ping) isPortfolioStreamResponse_Payload() {}
-
-// Объект результата подписки.
-type PortfolioSubscriptionResult struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Accounts      []*AccountSubscriptionStatus `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`                       //Массив счетов клиента.
-	TrackingId    string                       `protobuf:"bytes,7,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"` //Уникальный идентификатор запроса, подробнее: [tracking_id](/invest/intro/developer/protocols/grpc#tracking-id).
-	StreamId      string                       `protobuf:"bytes,8,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`       //Идентификатор открытого соединения
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PortfolioSubscriptionResult) Reset() {
-	*x = PortfolioSubscriptionResult{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PortfolioSubscriptionResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PortfolioSubscriptionResult) ProtoMessage() {}
-
-func (x *PortfolioSubscriptionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[31]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PortfolioSubscriptionResult.ProtoReflect.Descriptor instead.
-func (*PortfolioSubscriptionResult) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *PortfolioSubscriptionResult) GetAccounts() []*AccountSubscriptionStatus {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
-}
-
-func (x *PortfolioSubscriptionResult) GetTrackingId() string {
-	if x != nil {
-		return x.TrackingId
-	}
-	return ""
-}
-
-func (x *PortfolioSubscriptionResult) GetStreamId() string {
-	if x != nil {
-		return x.StreamId
-	}
-	return ""
-}
-
-// Счет клиента.
-type AccountSubscriptionStatus struct {
-	state              protoimpl.MessageState      `protogen:"open.v1"`
-	AccountId          string                      `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                                                                                                    //Идентификатор счета.
-	SubscriptionStatus PortfolioSubscriptionStatus `protobuf:"varint,6,opt,name=subscription_status,json=subscriptionStatus,proto3,enum=tinkoff.public.invest.api.contract.v1.PortfolioSubscriptionStatus" json:"subscription_status,omitempty"` //Результат подписки.
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *AccountSubscriptionStatus) Reset() {
-	*x = AccountSubscriptionStatus{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AccountSubscriptionStatus) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AccountSubscriptionStatus) ProtoMessage() {}
-
-func (x *AccountSubscriptionStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[32]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AccountSubscriptionStatus.ProtoReflect.Descriptor instead.
-func (*AccountSubscriptionStatus) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *AccountSubscriptionStatus) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *AccountSubscriptionStatus) GetSubscriptionStatus() PortfolioSubscriptionStatus {
-	if x != nil {
-		return x.SubscriptionStatus
-	}
-	return PortfolioSubscriptionStatus_PORTFOLIO_SUBSCRIPTION_STATUS_UNSPECIFIED
-}
-
-// Запрос списка операций по счету с пагинацией.
-type GetOperationsByCursorRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	AccountId          string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                                                                                  //Идентификатор счета клиента, обязательный параметр. Остальные параметры опциональны.
-	InstrumentId       *string                `protobuf:"bytes,2,opt,name=instrument_id,json=instrumentId,proto3,oneof" json:"instrument_id,omitempty"`                                                                   //Идентификатор инструмента — FIGI или UID инструмента.
-	From               *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from,proto3,oneof" json:"from,omitempty"`                                                                                                       //Начало периода по UTC.
-	To                 *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=to,proto3,oneof" json:"to,omitempty"`                                                                                                           //Окончание периода по UTC.
-	Cursor             *string                `protobuf:"bytes,11,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`                                                                                                  //Идентификатор элемента, с которого начать формировать ответ.
-	Limit              *int32                 `protobuf:"varint,12,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                                                                                                   //Лимит количества операций. По умолчанию — `100`, максимальное значение — `1000`.
-	OperationTypes     []OperationType        `protobuf:"varint,13,rep,packed,name=operation_types,json=operationTypes,proto3,enum=tinkoff.public.invest.api.contract.v1.OperationType" json:"operation_types,omitempty"` //Тип операции. Принимает значение из списка `OperationType`.
-	State              *OperationState        `protobuf:"varint,14,opt,name=state,proto3,enum=tinkoff.public.invest.api.contract.v1.OperationState,oneof" json:"state,omitempty"`                                         //Статус запрашиваемых операций. Возможные значения указаны в `OperationState`.
-	WithoutCommissions *bool                  `protobuf:"varint,15,opt,name=without_commissions,json=withoutCommissions,proto3,oneof" json:"without_commissions,omitempty"`                                               //Флаг возврата комиссии. По умолчанию — `false`.
-	WithoutTrades      *bool                  `protobuf:"varint,16,opt,name=without_trades,json=withoutTrades,proto3,oneof" json:"without_trades,omitempty"`                                                              //Флаг получения ответа без массива сделок.
-	WithoutOvernights  *bool                  `protobuf:"varint,17,opt,name=without_overnights,json=withoutOvernights,proto3,oneof" json:"without_overnights,omitempty"`                                                  //Флаг показа overnight операций.
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *GetOperationsByCursorRequest) Reset() {
-	*x = GetOperationsByCursorRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[33]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetOperationsByCursorRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetOperationsByCursorRequest) ProtoMessage() {}
-
-func (x *GetOperationsByCursorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[33]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetOperationsByCursorRequest.ProtoReflect.Descriptor instead.
-func (*GetOperationsByCursorRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *GetOperationsByCursorRequest) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *GetOperationsByCursorRequest) GetInstrumentId() string {
-	if x != nil && x.InstrumentId != nil {
-		return *x.InstrumentId
-	}
-	return ""
-}
-
-func (x *GetOperationsByCursorRequest) GetFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.From
-	}
-	return nil
-}
-
-func (x *GetOperationsByCursorRequest) GetTo() *timestamppb.Timestamp {
-	if x != nil {
-		return x.To
-	}
-	return nil
-}
-
-func (x *GetOperationsByCursorRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
-	}
-	return ""
-}
-
-func (x *GetOperationsByCursorRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
-	}
-	return 0
-}
-
-func (x *GetOperationsByCursorRequest) GetOperationTypes() []OperationType {
-	if x != nil {
-		return x.OperationTypes
-	}
-	return nil
-}
-
-func (x *GetOperationsByCursorRequest) GetState() OperationState {
-	if x != nil && x.State != nil {
-		return *x.State
-	}
-	return OperationState_OPERATION_STATE_UNSPECIFIED
-}
-
-func (x *GetOperationsByCursorRequest) GetWithoutCommissions() bool {
-	if x != nil && x.WithoutCommissions != nil {
-		return *x.WithoutCommissions
-	}
-	return false
-}
-
-func (x *GetOperationsByCursorRequest) GetWithoutTrades() bool {
-	if x != nil && x.WithoutTrades != nil {
-		return *x.WithoutTrades
-	}
-	return false
-}
-
-func (x *GetOperationsByCursorRequest) GetWithoutOvernights() bool {
-	if x != nil && x.WithoutOvernights != nil {
-		return *x.WithoutOvernights
-	}
-	return false
-}
-
-// Список операций по счету с пагинацией.
-type GetOperationsByCursorResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HasNext       bool                   `protobuf:"varint,1,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`         //Признак, есть ли следующий элемент.
-	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` //Следующий курсор.
-	Items         []*OperationItem       `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`                             //Список операций.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetOperationsByCursorResponse) Reset() {
-	*x = GetOperationsByCursorResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetOperationsByCursorResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetOperationsByCursorResponse) ProtoMessage() {}
-
-func (x *GetOperationsByCursorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[34]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetOperationsByCursorResponse.ProtoReflect.Descriptor instead.
-func (*GetOperationsByCursorResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *GetOperationsByCursorResponse) GetHasNext() bool {
-	if x != nil {
-		return x.HasNext
-	}
-	return false
-}
-
-func (x *GetOperationsByCursorResponse) GetNextCursor() string {
-	if x != nil {
-		return x.NextCursor
-	}
-	return ""
-}
-
-func (x *GetOperationsByCursorResponse) GetItems() []*OperationItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
-}
-
-// Данные об операции.
-type OperationItem struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Cursor            string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                                                                   //Курсор.
-	BrokerAccountId   string                 `protobuf:"bytes,6,opt,name=broker_account_id,json=brokerAccountId,proto3" json:"broker_account_id,omitempty"`                                                        //Номер счета клиента.
-	Id                string                 `protobuf:"bytes,16,opt,name=id,proto3" json:"id,omitempty"`                                                                                                          //Идентификатор операции, может меняться с течением времени.
-	ParentOperationId string                 `protobuf:"bytes,17,opt,name=parent_operation_id,json=parentOperationId,proto3" json:"parent_operation_id,omitempty"`                                                 //Идентификатор родительской операции. Может измениться, если изменился ID родительской операции.
-	Name              string                 `protobuf:"bytes,18,opt,name=name,proto3" json:"name,omitempty"`                                                                                                      //Название операции.
-	Date              *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=date,proto3" json:"date,omitempty"`                                                                                                      //Дата поручения.
-	Type              OperationType          `protobuf:"varint,22,opt,name=type,proto3,enum=tinkoff.public.invest.api.contract.v1.OperationType" json:"type,omitempty"`                                            //Тип операции.
-	Description       string                 `protobuf:"bytes,23,opt,name=description,proto3" json:"description,omitempty"`                                                                                        //Описание операции.
-	State             OperationState         `protobuf:"varint,24,opt,name=state,proto3,enum=tinkoff.public.invest.api.contract.v1.OperationState" json:"state,omitempty"`                                         //Статус поручения.
-	InstrumentUid     string                 `protobuf:"bytes,31,opt,name=instrument_uid,json=instrumentUid,proto3" json:"instrument_uid,omitempty"`                                                               //Уникальный идентификатор инструмента.
-	Figi              string                 `protobuf:"bytes,32,opt,name=figi,proto3" json:"figi,omitempty"`                                                                                                      //FIGI.
-	InstrumentType    string                 `protobuf:"bytes,33,opt,name=instrument_type,json=instrumentType,proto3" json:"instrument_type,omitempty"`                                                            //Тип инструмента.
-	InstrumentKind    InstrumentType         `protobuf:"varint,34,opt,name=instrument_kind,json=instrumentKind,proto3,enum=tinkoff.public.invest.api.contract.v1.InstrumentType" json:"instrument_kind,omitempty"` //Тип инструмента.
-	PositionUid       string                 `protobuf:"bytes,35,opt,name=position_uid,json=positionUid,proto3" json:"position_uid,omitempty"`                                                                     //Уникальный идентификатор позиции.
-	Payment           *MoneyValue            `protobuf:"bytes,41,opt,name=payment,proto3" json:"payment,omitempty"`                                                                                                //Сумма операции.
-	Price             *MoneyValue            `protobuf:"bytes,42,opt,name=price,proto3" json:"price,omitempty"`                                                                                                    //Цена операции за 1 инструмент.
-	Commission        *MoneyValue            `protobuf:"bytes,43,opt,name=commission,proto3" json:"commission,omitempty"`                                                                                          //Комиссия.
-	Yield             *MoneyValue            `protobuf:"bytes,44,opt,name=yield,proto3" json:"yield,omitempty"`                                                                                                    //Доходность.
-	YieldRelative     *Quotation             `protobuf:"bytes,45,opt,name=yield_relative,json=yieldRelative,proto3" json:"yield_relative,omitempty"`                                                               //Относительная доходность.
-	AccruedInt        *MoneyValue            `protobuf:"bytes,46,opt,name=accrued_int,json=accruedInt,proto3" json:"accrued_int,omitempty"`                                                                        //Накопленный купонный доход.
-	Quantity          int64                  `protobuf:"varint,51,opt,name=quantity,proto3" json:"quantity,omitempty"`                                                                                             //Количество единиц инструмента.
-	QuantityRest      int64                  `protobuf:"varint,52,opt,name=quantity_rest,json=quantityRest,proto3" json:"quantity_rest,omitempty"`                                                                 //Неисполненный остаток по сделке.
-	QuantityDone      int64                  `protobuf:"varint,53,opt,name=quantity_done,json=quantityDone,proto3" json:"quantity_done,omitempty"`                                                                 //Исполненный остаток.
-	CancelDateTime    *timestamppb.Timestamp `protobuf:"bytes,56,opt,name=cancel_date_time,json=cancelDateTime,proto3" json:"cancel_date_time,omitempty"`                                                          //Дата и время снятия заявки.
-	CancelReason      string                 `protobuf:"bytes,57,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`                                                                  //Причина отмены операции.
-	TradesInfo        *OperationItemTrades   `protobuf:"bytes,61,opt,name=trades_info,json=tradesInfo,proto3" json:"trades_info,omitempty"`                                                                        //Массив сделок.
-	AssetUid          string                 `protobuf:"bytes,64,opt,name=asset_uid,json=assetUid,proto3" json:"asset_uid,omitempty"`                                                                              //Идентификатор актива.
-	ChildOperations   []*ChildOperationItem  `protobuf:"bytes,65,rep,name=child_operations,json=childOperations,proto3" json:"child_operations,omitempty"`                                                         //Массив дочерних операций.
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *OperationItem) Reset() {
-	*x = OperationItem{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[35]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *OperationItem) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OperationItem) ProtoMessage() {}
-
-func (x *OperationItem) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[35]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OperationItem.ProtoReflect.Descriptor instead.
-func (*OperationItem) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *OperationItem) GetCursor() string {
-	if x != nil {
-		return x.Cursor
-	}
-	return ""
-}
-
-func (x *OperationItem) GetBrokerAccountId() string {
-	if x != nil {
-		return x.BrokerAccountId
-	}
-	return ""
-}
-
-func (x *OperationItem) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *OperationItem) GetParentOperationId() string {
-	if x != nil {
-		return x.ParentOperationId
-	}
-	return ""
-}
-
-func (x *OperationItem) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *OperationItem) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-func (x *OperationItem) GetType() OperationType {
-	if x != nil {
-		return x.Type
-	}
-	return OperationType_OPERATION_TYPE_UNSPECIFIED
-}
-
-func (x *OperationItem) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *OperationItem) GetState() OperationState {
-	if x != nil {
-		return x.State
-	}
-	return OperationState_OPERATION_STATE_UNSPECIFIED
-}
-
-func (x *OperationItem) GetInstrumentUid() string {
-	if x != nil {
-		return x.InstrumentUid
-	}
-	return ""
-}
-
-func (x *OperationItem) GetFigi() string {
-	if x != nil {
-		return x.Figi
-	}
-	return ""
-}
-
-func (x *OperationItem) GetInstrumentType() string {
-	if x != nil {
-		return x.InstrumentType
-	}
-	return ""
-}
-
-func (x *OperationItem) GetInstrumentKind() InstrumentType {
-	if x != nil {
-		return x.InstrumentKind
-	}
-	return InstrumentType_INSTRUMENT_TYPE_UNSPECIFIED
-}
-
-func (x *OperationItem) GetPositionUid() string {
-	if x != nil {
-		return x.PositionUid
-	}
-	return ""
-}
-
-func (x *OperationItem) GetPayment() *MoneyValue {
-	if x != nil {
-		return x.Payment
-	}
-	return nil
-}
-
-func (x *OperationItem) GetPrice() *MoneyValue {
-	if x != nil {
-		return x.Price
-	}
-	return nil
-}
-
-func (x *OperationItem) GetCommission() *MoneyValue {
-	if x != nil {
-		return x.Commission
-	}
-	return nil
-}
-
-func (x *OperationItem) GetYield() *MoneyValue {
-	if x != nil {
-		return x.Yield
-	}
-	return nil
-}
-
-func (x *OperationItem) GetYieldRelative() *Quotation {
-	if x != nil {
-		return x.YieldRelative
-	}
-	return nil
-}
-
-func (x *OperationItem) GetAccruedInt() *MoneyValue {
-	if x != nil {
-		return x.AccruedInt
-	}
-	return nil
-}
-
-func (x *OperationItem) GetQuantity() int64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *OperationItem) GetQuantityRest() int64 {
-	if x != nil {
-		return x.QuantityRest
-	}
-	return 0
-}
-
-func (x *OperationItem) GetQuantityDone() int64 {
-	if x != nil {
-		return x.QuantityDone
-	}
-	return 0
-}
-
-func (x *OperationItem) GetCancelDateTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CancelDateTime
-	}
-	return nil
-}
-
-func (x *OperationItem) GetCancelReason() string {
-	if x != nil {
-		return x.CancelReason
-	}
-	return ""
-}
-
-func (x *OperationItem) GetTradesInfo() *OperationItemTrades {
-	if x != nil {
-		return x.TradesInfo
-	}
-	return nil
-}
-
-func (x *OperationItem) GetAssetUid() string {
-	if x != nil {
-		return x.AssetUid
-	}
-	return ""
-}
-
-func (x *OperationItem) GetChildOperations() []*ChildOperationItem {
-	if x != nil {
-		return x.ChildOperations
-	}
-	return nil
-}
-
-// Массив с информацией о сделках.
-type OperationItemTrades struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Trades        []*OperationItemTrade  `protobuf:"bytes,6,rep,name=trades,proto3" json:"trades,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *OperationItemTrades) Reset() {
-	*x = OperationItemTrades{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *OperationItemTrades) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OperationItemTrades) ProtoMessage() {}
-
-func (x *OperationItemTrades) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[36]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OperationItemTrades.ProtoReflect.Descriptor instead.
-func (*OperationItemTrades) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *OperationItemTrades) GetTrades() []*OperationItemTrade {
-	if x != nil {
-		return x.Trades
-	}
-	return nil
-}
-
-// Сделка по операции.
-type OperationItemTrade struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Num           string                 `protobuf:"bytes,1,opt,name=num,proto3" json:"num,omitempty"`                                           //Номер сделки.
-	Date          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`                                         //Дата сделки.
-	Quantity      int64                  `protobuf:"varint,11,opt,name=quantity,proto3" json:"quantity,omitempty"`                               //Количество в единицах.
-	Price         *MoneyValue            `protobuf:"bytes,16,opt,name=price,proto3" json:"price,omitempty"`                                      //Цена.
-	Yield         *MoneyValue            `protobuf:"bytes,21,opt,name=yield,proto3" json:"yield,omitempty"`                                      //Доходность.
-	YieldRelative *Quotation             `protobuf:"bytes,22,opt,name=yield_relative,json=yieldRelative,proto3" json:"yield_relative,omitempty"` //Относительная доходность.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *OperationItemTrade) Reset() {
-	*x = OperationItemTrade{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[37]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *OperationItemTrade) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OperationItemTrade) ProtoMessage() {}
-
-func (x *OperationItemTrade) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[37]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OperationItemTrade.ProtoReflect.Descriptor instead.
-func (*OperationItemTrade) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *OperationItemTrade) GetNum() string {
-	if x != nil {
-		return x.Num
-	}
-	return ""
-}
-
-func (x *OperationItemTrade) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-func (x *OperationItemTrade) GetQuantity() int64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *OperationItemTrade) GetPrice() *MoneyValue {
-	if x != nil {
-		return x.Price
-	}
-	return nil
-}
-
-func (x *OperationItemTrade) GetYield() *MoneyValue {
-	if x != nil {
-		return x.Yield
-	}
-	return nil
-}
-
-func (x *OperationItemTrade) GetYieldRelative() *Quotation {
-	if x != nil {
-		return x.YieldRelative
-	}
-	return nil
-}
-
-// Запрос установки stream-соединения позиций.
-type PositionsStreamRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Accounts             []string               `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`                                                        //Массив идентификаторов счетов пользователя.
-	WithInitialPositions bool                   `protobuf:"varint,3,opt,name=with_initial_positions,json=withInitialPositions,proto3" json:"with_initial_positions,omitempty"` //Получение состояния позиций на момент подключения.
-	PingSettings         *PingDelaySettings     `protobuf:"bytes,15,opt,name=ping_settings,json=pingSettings,proto3" json:"ping_settings,omitempty"`                           //Запрос настройки пинга.
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
-}
-
-func (x *PositionsStreamRequest) Reset() {
-	*x = PositionsStreamRequest{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[38]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PositionsStreamRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PositionsStreamRequest) ProtoMessage() {}
-
-func (x *PositionsStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[38]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PositionsStreamRequest.ProtoReflect.Descriptor instead.
-func (*PositionsStreamRequest) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *PositionsStreamRequest) GetAccounts() []string {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
-}
-
-func (x *PositionsStreamRequest) GetWithInitialPositions() bool {
-	if x != nil {
-		return x.WithInitialPositions
-	}
-	return false
-}
-
-func (x *PositionsStreamRequest) GetPingSettings() *PingDelaySettings {
-	if x != nil {
-		return x.PingSettings
-	}
-	return nil
-}
-
-// Информация по изменению позиций портфеля.
-type PositionsStreamResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*PositionsStreamResponse_Subscriptions
-	//	*PositionsStreamResponse_Position
-	//	*PositionsStreamResponse_Ping
-	//	*PositionsStreamResponse_InitialPositions
-	Payload       isPositionsStreamResponse_Payload `protobuf_oneof:"payload"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PositionsStreamResponse) Reset() {
-	*x = PositionsStreamResponse{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PositionsStreamResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PositionsStreamResponse) ProtoMessage() {}
-
-func (x *PositionsStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[39]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PositionsStreamResponse.ProtoReflect.Descriptor instead.
-func (*PositionsStreamResponse) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *PositionsStreamResponse) GetPayload() isPositionsStreamResponse_Payload {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
-}
-
-func (x *PositionsStreamResponse) GetSubscriptions() *PositionsSubscriptionResult {
-	if x != nil {
-		if x, ok := x.Payload.(*PositionsStreamResponse_Subscriptions); ok {
-			return x.Subscriptions
-		}
-	}
-	return nil
-}
-
-func (x *PositionsStreamResponse) GetPosition() *PositionData {
-	if x != nil {
-		if x, ok := x.Payload.(*PositionsStreamResponse_Position); ok {
-			return x.Position
-		}
-	}
-	return nil
-}
-
-func (x *PositionsStreamResponse) GetPing() *Ping {
-	if x != nil {
-		if x, ok := x.Payload.(*PositionsStreamResponse_Ping); ok {
-			return x.Ping
-		}
-	}
-	return nil
-}
-
-func (x *PositionsStreamResponse) GetInitialPositions() *PositionsResponse {
-	if x != nil {
-		if x, ok := x.Payload.(*PositionsStreamResponse_InitialPositions); ok {
-			return x.InitialPositions
-		}
-	}
-	return nil
-}
-
-type isPositionsStreamResponse_Payload interface {
-	isPositionsStreamResponse_Payload()
-}
-
-type PositionsStreamResponse_Subscriptions struct {
-	Subscriptions *PositionsSubscriptionResult `protobuf:"bytes,1,opt,name=subscriptions,proto3,oneof"` //Объект результата подписки.
-}
-
-type PositionsStreamResponse_Position struct {
-	Position *PositionData `protobuf:"bytes,2,opt,name=position,proto3,oneof"` //Объект стриминга позиций.
-}
-
-type PositionsStreamResponse_Ping struct {
-	Ping *Ping `protobuf:"bytes,3,opt,name=ping,proto3,oneof"` //Проверка активности стрима.
-}
-
-type PositionsStreamResponse_InitialPositions struct {
-	InitialPositions *PositionsResponse `protobuf:"bytes,5,opt,name=initial_positions,json=initialPositions,proto3,oneof"` //Текущие позиции.
-}
-
-func (*PositionsStreamResponse_Subscriptions) isPositionsStreamResponse_Payload() {}
-
-func (*PositionsStreamResponse_Position) isPositionsStreamResponse_Payload() {}
-
-func (*PositionsStreamResponse_Ping) isPositionsStreamResponse_Payload() {}
-
-func (*PositionsStreamResponse_InitialPositions) isPositionsStreamResponse_Payload() {}
-
-// Объект результата подписки.
-type PositionsSubscriptionResult struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Accounts      []*PositionsSubscriptionStatus `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`                       //Массив счетов клиента.
-	TrackingId    string                         `protobuf:"bytes,7,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"` //Уникальный идентификатор запроса, подробнее: [tracking_id](/invest/intro/developer/protocols/grpc#tracking-id).
-	StreamId      string                         `protobuf:"bytes,8,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`       //Идентификатор открытого соединения
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PositionsSubscriptionResult) Reset() {
-	*x = PositionsSubscriptionResult{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PositionsSubscriptionResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PositionsSubscriptionResult) ProtoMessage() {}
-
-func (x *PositionsSubscriptionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[40]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PositionsSubscriptionResult.ProtoReflect.Descriptor instead.
-func (*PositionsSubscriptionResult) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *PositionsSubscriptionResult) GetAccounts() []*PositionsSubscriptionStatus {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
-}
-
-func (x *PositionsSubscriptionResult) GetTrackingId() string {
-	if x != nil {
-		return x.TrackingId
-	}
-	return ""
-}
-
-func (x *PositionsSubscriptionResult) GetStreamId() string {
-	if x != nil {
-		return x.StreamId
-	}
-	return ""
-}
-
-// Счет клиента.
-type PositionsSubscriptionStatus struct {
-	state              protoimpl.MessageState             `protogen:"open.v1"`
-	AccountId          string                             `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                                                                                                           //Идентификатор счета.
-	SubscriptionStatus PositionsAccountSubscriptionStatus `protobuf:"varint,6,opt,name=subscription_status,json=subscriptionStatus,proto3,enum=tinkoff.public.invest.api.contract.v1.PositionsAccountSubscriptionStatus" json:"subscription_status,omitempty"` //Результат подписки.
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *PositionsSubscriptionStatus) Reset() {
-	*x = PositionsSubscriptionStatus{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[41]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PositionsSubscriptionStatus) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PositionsSubscriptionStatus) ProtoMessage() {}
-
-func (x *PositionsSubscriptionStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[41]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PositionsSubscriptionStatus.ProtoReflect.Descriptor instead.
-func (*PositionsSubscriptionStatus) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *PositionsSubscriptionStatus) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *PositionsSubscriptionStatus) GetSubscriptionStatus() PositionsAccountSubscriptionStatus {
-	if x != nil {
-		return x.SubscriptionStatus
-	}
-	return PositionsAccountSubscriptionStatus_POSITIONS_SUBSCRIPTION_STATUS_UNSPECIFIED
-}
-
-// Данные о позиции портфеля.
-type PositionData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` //Идентификатор счета.
-	Money         []*PositionsMoney      `protobuf:"bytes,2,rep,name=money,proto3" json:"money,omitempty"`                          //Массив валютных позиций портфеля.
-	Securities    []*PositionsSecurities `protobuf:"bytes,3,rep,name=securities,proto3" json:"securities,omitempty"`                //Список ценно-бумажных позиций портфеля.
-	Futures       []*PositionsFutures    `protobuf:"bytes,4,rep,name=futures,proto3" json:"futures,omitempty"`                      //Список фьючерсов портфеля.
-	Options       []*PositionsOptions    `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`                      //Список опционов портфеля.
-	Date          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`                            //Дата и время операции в формате UTC.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PositionData) Reset() {
-	*x = PositionData{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PositionData) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PositionData) ProtoMessage() {}
-
-func (x *PositionData) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[42]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PositionData.ProtoReflect.Descriptor instead.
-func (*PositionData) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *PositionData) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *PositionData) GetMoney() []*PositionsMoney {
-	if x != nil {
-		return x.Money
-	}
-	return nil
-}
-
-func (x *PositionData) GetSecurities() []*PositionsSecurities {
-	if x != nil {
-		return x.Securities
-	}
-	return nil
-}
-
-func (x *PositionData) GetFutures() []*PositionsFutures {
-	if x != nil {
-		return x.Futures
-	}
-	return nil
-}
-
-func (x *PositionData) GetOptions() []*PositionsOptions {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-func (x *PositionData) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-// Валютная позиция портфеля.
-type PositionsMoney struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	AvailableValue *MoneyValue            `protobuf:"bytes,1,opt,name=available_value,json=availableValue,proto3" json:"available_value,omitempty"` //Доступное количество валютный позиций.
-	BlockedValue   *MoneyValue            `protobuf:"bytes,2,opt,name=blocked_value,json=blockedValue,proto3" json:"blocked_value,omitempty"`       //Заблокированное количество валютных позиций.
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *PositionsMoney) Reset() {
-	*x = PositionsMoney{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[43]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PositionsMoney) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PositionsMoney) ProtoMessage() {}
-
-func (x *PositionsMoney) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[43]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PositionsMoney.ProtoReflect.Descriptor instead.
-func (*PositionsMoney) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *PositionsMoney) GetAvailableValue() *MoneyValue {
-	if x != nil {
-		return x.AvailableValue
-	}
-	return nil
-}
-
-func (x *PositionsMoney) GetBlockedValue() *MoneyValue {
-	if x != nil {
-		return x.BlockedValue
-	}
-	return nil
-}
-
-type ChildOperationItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	InstrumentUid string                 `protobuf:"bytes,1,opt,name=instrument_uid,json=instrumentUid,proto3" json:"instrument_uid,omitempty"` //Уникальный идентификатор инструмента.
-	Payment       *MoneyValue            `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment,omitempty"`                                  //Сумма операции.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ChildOperationItem) Reset() {
-	*x = ChildOperationItem{}
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[44]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ChildOperationItem) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ChildOperationItem) ProtoMessage() {}
-
-func (x *ChildOperationItem) ProtoReflect() protoreflect.Message {
-	mi := &file_adapter_tinkoff_proto_operations_proto_msgTypes[44]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ChildOperationItem.ProtoReflect.Descriptor instead.
-func (*ChildOperationItem) Descriptor() ([]byte, []int) {
-	return file_adapter_tinkoff_proto_operations_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *ChildOperationItem) GetInstrumentUid() string {
-	if x != nil {
-		return x.InstrumentUid
-	}
-	return ""
-}
-
-func (x *ChildOperationItem) GetPayment() *MoneyValue {
-	if x != nil {
-		return x.Payment
-	}
-	return nil
-}
-
-var File_adapter_tinkoff_proto_operations_proto protoreflect.FileDescriptor
-
-const file_adapter_tinkoff_proto_operations_proto_rawDesc = "" +
-	"\n" +
-	"&adapter/tinkoff/proto/operations.proto\x12%tinkoff.public.invest.api.contract.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"adapter/tinkoff/proto/common.proto\x1a\x1fgoogle/api/field_behavior.proto\"\xab\x02\n" +
-	"\x11OperationsRequest\x12\"\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\x123\n" +
-	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x04from\x88\x01\x01\x12/\n" +
-	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x02to\x88\x01\x01\x12P\n" +
-	"\x05state\x18\x04 \x01(\x0e25.tinkoff.public.invest.api.contract.v1.OperationStateH\x02R\x05state\x88\x01\x01\x12\x17\n" +
-	"\x04figi\x18\x05 \x01(\tH\x03R\x04figi\x88\x01\x01B\a\n" +
-	"\x05_fromB\x05\n" +
-	"\x03_toB\b\n" +
-	"\x06_stateB\a\n" +
-	"\x05_figi\"f\n" +
-	"\x12OperationsResponse\x12P\n" +
-	"\n" +
-	"operations\x18\x01 \x03(\v20.tinkoff.public.invest.api.contract.v1.OperationR\n" +
-	"operations\"\x85\a\n" +
-	"\tOperation\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
-	"\x13parent_operation_id\x18\x02 \x01(\tR\x11parentOperationId\x12\x1a\n" +
-	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12K\n" +
-	"\apayment\x18\x04 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\apayment\x12G\n" +
-	"\x05price\x18\x05 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x05price\x12K\n" +
-	"\x05state\x18\x06 \x01(\x0e25.tinkoff.public.invest.api.contract.v1.OperationStateR\x05state\x12\x1a\n" +
-	"\bquantity\x18\a \x01(\x03R\bquantity\x12#\n" +
-	"\rquantity_rest\x18\b \x01(\x03R\fquantityRest\x12\x12\n" +
-	"\x04figi\x18\t \x01(\tR\x04figi\x12'\n" +
-	"\x0finstrument_type\x18\n" +
-	" \x01(\tR\x0einstrumentType\x12.\n" +
-	"\x04date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x12\n" +
-	"\x04type\x18\f \x01(\tR\x04type\x12[\n" +
-	"\x0eoperation_type\x18\r \x01(\x0e24.tinkoff.public.invest.api.contract.v1.OperationTypeR\roperationType\x12M\n" +
-	"\x06trades\x18\x0e \x03(\v25.tinkoff.public.invest.api.contract.v1.OperationTradeR\x06trades\x12\x1b\n" +
-	"\tasset_uid\x18\x10 \x01(\tR\bassetUid\x12!\n" +
-	"\fposition_uid\x18\x11 \x01(\tR\vpositionUid\x12%\n" +
-	"\x0einstrument_uid\x18\x12 \x01(\tR\rinstrumentUid\x12d\n" +
-	"\x10child_operations\x18\x13 \x03(\v29.tinkoff.public.invest.api.contract.v1.ChildOperationItemR\x0fchildOperations\"\xc9\x01\n" +
-	"\x0eOperationTrade\x12\x19\n" +
-	"\btrade_id\x18\x01 \x01(\tR\atradeId\x127\n" +
-	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12G\n" +
-	"\x05price\x18\x04 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x05price\"\xdb\x01\n" +
-	"\x10PortfolioRequest\x12\"\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\x12h\n" +
-	"\bcurrency\x18\x02 \x01(\x0e2G.tinkoff.public.invest.api.contract.v1.PortfolioRequest.CurrencyRequestH\x00R\bcurrency\x88\x01\x01\",\n" +
-	"\x0fCurrencyRequest\x12\a\n" +
-	"\x03RUB\x10\x00\x12\a\n" +
-	"\x03USD\x10\x01\x12\a\n" +
-	"\x03EUR\x10\x02B\v\n" +
-	"\t_currency\"\xa3\n" +
-	"\n" +
-	"\x11PortfolioResponse\x12a\n" +
-	"\x13total_amount_shares\x18\x01 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x11totalAmountShares\x12_\n" +
-	"\x12total_amount_bonds\x18\x02 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x10totalAmountBonds\x12[\n" +
-	"\x10total_amount_etf\x18\x03 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x0etotalAmountEtf\x12i\n" +
-	"\x17total_amount_currencies\x18\x04 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x15totalAmountCurrencies\x12c\n" +
-	"\x14total_amount_futures\x18\x05 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x12totalAmountFutures\x12W\n" +
-	"\x0eexpected_yield\x18\x06 \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\rexpectedYield\x12V\n" +
-	"\tpositions\x18\a \x03(\v28.tinkoff.public.invest.api.contract.v1.PortfolioPositionR\tpositions\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\b \x01(\tR\taccountId\x12c\n" +
-	"\x14total_amount_options\x18\t \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x12totalAmountOptions\x12Y\n" +
-	"\x0ftotal_amount_sp\x18\n" +
-	" \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\rtotalAmountSp\x12g\n" +
-	"\x16total_amount_portfolio\x18\v \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x14totalAmountPortfolio\x12l\n" +
-	"\x11virtual_positions\x18\f \x03(\v2?.tinkoff.public.invest.api.contract.v1.VirtualPortfolioPositionR\x10virtualPositions\x12R\n" +
-	"\vdaily_yield\x18\x0f \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\n" +
-	"dailyYield\x12b\n" +
-	"\x14daily_yield_relative\x18\x10 \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\x12dailyYieldRelative\"6\n" +
-	"\x10PositionsRequest\x12\"\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\"\x87\x04\n" +
-	"\x11PositionsResponse\x12G\n" +
-	"\x05money\x18\x01 \x03(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x05money\x12K\n" +
-	"\ablocked\x18\x02 \x03(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\ablocked\x12Z\n" +
-	"\n" +
-	"securities\x18\x03 \x03(\v2:.tinkoff.public.invest.api.contract.v1.PositionsSecuritiesR\n" +
-	"securities\x12;\n" +
-	"\x1alimits_loading_in_progress\x18\x04 \x01(\bR\x17limitsLoadingInProgress\x12Q\n" +
-	"\afutures\x18\x05 \x03(\v27.tinkoff.public.invest.api.contract.v1.PositionsFuturesR\afutures\x12Q\n" +
-	"\aoptions\x18\x06 \x03(\v27.tinkoff.public.invest.api.contract.v1.PositionsOptionsR\aoptions\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x0f \x01(\tR\taccountId\";\n" +
-	"\x15WithdrawLimitsRequest\x12\"\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\"\x8e\x02\n" +
-	"\x16WithdrawLimitsResponse\x12G\n" +
-	"\x05money\x18\x01 \x03(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x05money\x12K\n" +
-	"\ablocked\x18\x02 \x03(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\ablocked\x12^\n" +
-	"\x11blocked_guarantee\x18\x03 \x03(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x10blockedGuarantee\"\xa3\n" +
-	"\n" +
-	"\x11PortfolioPosition\x12\x12\n" +
-	"\x04figi\x18\x01 \x01(\tR\x04figi\x12'\n" +
-	"\x0finstrument_type\x18\x02 \x01(\tR\x0einstrumentType\x12L\n" +
-	"\bquantity\x18\x03 \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\bquantity\x12g\n" +
-	"\x16average_position_price\x18\x04 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x14averagePositionPrice\x12W\n" +
-	"\x0eexpected_yield\x18\x05 \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\rexpectedYield\x12R\n" +
-	"\vcurrent_nkd\x18\x06 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\n" +
-	"currentNkd\x12o\n" +
-	"\x19average_position_price_pt\x18\a \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationB\x02\x18\x01R\x16averagePositionPricePt\x12V\n" +
-	"\rcurrent_price\x18\b \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\fcurrentPrice\x12p\n" +
-	"\x1baverage_position_price_fifo\x18\t \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x18averagePositionPriceFifo\x12Y\n" +
-	"\rquantity_lots\x18\n" +
-	" \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationB\x02\x18\x01R\fquantityLots\x12\x18\n" +
-	"\ablocked\x18\x15 \x01(\bR\ablocked\x12S\n" +
-	"\fblocked_lots\x18\x16 \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\vblockedLots\x12!\n" +
-	"\fposition_uid\x18\x18 \x01(\tR\vpositionUid\x12%\n" +
-	"\x0einstrument_uid\x18\x19 \x01(\tR\rinstrumentUid\x12P\n" +
-	"\n" +
-	"var_margin\x18\x1a \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\tvarMargin\x12`\n" +
-	"\x13expected_yield_fifo\x18\x1b \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\x11expectedYieldFifo\x12R\n" +
-	"\vdaily_yield\x18\x1f \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\n" +
-	"dailyYield\x12\x16\n" +
-	"\x06ticker\x18  \x01(\tR\x06ticker\"\x86\a\n" +
-	"\x18VirtualPortfolioPosition\x12!\n" +
-	"\fposition_uid\x18\x01 \x01(\tR\vpositionUid\x12%\n" +
-	"\x0einstrument_uid\x18\x02 \x01(\tR\rinstrumentUid\x12\x12\n" +
-	"\x04figi\x18\x03 \x01(\tR\x04figi\x12'\n" +
-	"\x0finstrument_type\x18\x04 \x01(\tR\x0einstrumentType\x12L\n" +
-	"\bquantity\x18\x05 \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\bquantity\x12g\n" +
-	"\x16average_position_price\x18\x06 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x14averagePositionPrice\x12W\n" +
-	"\x0eexpected_yield\x18\a \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\rexpectedYield\x12`\n" +
-	"\x13expected_yield_fifo\x18\b \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\x11expectedYieldFifo\x12;\n" +
-	"\vexpire_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"expireDate\x12V\n" +
-	"\rcurrent_price\x18\n" +
-	" \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\fcurrentPrice\x12p\n" +
-	"\x1baverage_position_price_fifo\x18\v \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x18averagePositionPriceFifo\x12R\n" +
-	"\vdaily_yield\x18\x1f \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\n" +
-	"dailyYield\x12\x16\n" +
-	"\x06ticker\x18  \x01(\tR\x06ticker\"\x93\x02\n" +
-	"\x13PositionsSecurities\x12\x12\n" +
-	"\x04figi\x18\x01 \x01(\tR\x04figi\x12\x18\n" +
-	"\ablocked\x18\x02 \x01(\x03R\ablocked\x12\x18\n" +
-	"\abalance\x18\x03 \x01(\x03R\abalance\x12!\n" +
-	"\fposition_uid\x18\x04 \x01(\tR\vpositionUid\x12%\n" +
-	"\x0einstrument_uid\x18\x05 \x01(\tR\rinstrumentUid\x12\x16\n" +
-	"\x06ticker\x18\x06 \x01(\tR\x06ticker\x12)\n" +
-	"\x10exchange_blocked\x18\v \x01(\bR\x0fexchangeBlocked\x12'\n" +
-	"\x0finstrument_type\x18\x10 \x01(\tR\x0einstrumentType\"\xbc\x01\n" +
-	"\x10PositionsFutures\x12\x12\n" +
-	"\x04figi\x18\x01 \x01(\tR\x04figi\x12\x18\n" +
-	"\ablocked\x18\x02 \x01(\x03R\ablocked\x12\x18\n" +
-	"\abalance\x18\x03 \x01(\x03R\abalance\x12!\n" +
-	"\fposition_uid\x18\x04 \x01(\tR\vpositionUid\x12%\n" +
-	"\x0einstrument_uid\x18\x05 \x01(\tR\rinstrumentUid\x12\x16\n" +
-	"\x06ticker\x18\x06 \x01(\tR\x06ticker\"\xa8\x01\n" +
-	"\x10PositionsOptions\x12!\n" +
-	"\fposition_uid\x18\x01 \x01(\tR\vpositionUid\x12%\n" +
-	"\x0einstrument_uid\x18\x02 \x01(\tR\rinstrumentUid\x12\x16\n" +
-	"\x06ticker\x18\x03 \x01(\tR\x06ticker\x12\x18\n" +
-	"\ablocked\x18\v \x01(\x03R\ablocked\x12\x18\n" +
-	"\abalance\x18\x15 \x01(\x03R\abalance\"\xa8\x02\n" +
-	"\x13BrokerReportRequest\x12\x89\x01\n" +
-	"\x1egenerate_broker_report_request\x18\x01 \x01(\v2B.tinkoff.public.invest.api.contract.v1.GenerateBrokerReportRequestH\x00R\x1bgenerateBrokerReportRequest\x12z\n" +
-	"\x19get_broker_report_request\x18\x02 \x01(\v2=.tinkoff.public.invest.api.contract.v1.GetBrokerReportRequestH\x00R\x16getBrokerReportRequestB\t\n" +
-	"\apayload\"\xaf\x02\n" +
-	"\x14BrokerReportResponse\x12\x8c\x01\n" +
-	"\x1fgenerate_broker_report_response\x18\x01 \x01(\v2C.tinkoff.public.invest.api.contract.v1.GenerateBrokerReportResponseH\x00R\x1cgenerateBrokerReportResponse\x12}\n" +
-	"\x1aget_broker_report_response\x18\x02 \x01(\v2>.tinkoff.public.invest.api.contract.v1.GetBrokerReportResponseH\x00R\x17getBrokerReportResponseB\t\n" +
-	"\apayload\"\xa7\x01\n" +
-	"\x1bGenerateBrokerReportRequest\x12\"\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\x123\n" +
-	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\x04from\x12/\n" +
-	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\x02to\"7\n" +
-	"\x1cGenerateBrokerReportResponse\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\"X\n" +
-	"\x16GetBrokerReportRequest\x12\x1c\n" +
-	"\atask_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06taskId\x12\x17\n" +
-	"\x04page\x18\x02 \x01(\x05H\x00R\x04page\x88\x01\x01B\a\n" +
-	"\x05_page\"\xc7\x01\n" +
-	"\x17GetBrokerReportResponse\x12X\n" +
-	"\rbroker_report\x18\x01 \x03(\v23.tinkoff.public.invest.api.contract.v1.BrokerReportR\fbrokerReport\x12\x1e\n" +
-	"\n" +
-	"itemsCount\x18\x02 \x01(\x05R\n" +
-	"itemsCount\x12\x1e\n" +
-	"\n" +
-	"pagesCount\x18\x03 \x01(\x05R\n" +
-	"pagesCount\x12\x12\n" +
-	"\x04page\x18\x04 \x01(\x05R\x04page\"\xca\v\n" +
-	"\fBrokerReport\x12\x19\n" +
-	"\btrade_id\x18\x01 \x01(\tR\atradeId\x12\x19\n" +
-	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x12\n" +
-	"\x04figi\x18\x03 \x01(\tR\x04figi\x12!\n" +
-	"\fexecute_sign\x18\x04 \x01(\tR\vexecuteSign\x12A\n" +
-	"\x0etrade_datetime\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rtradeDatetime\x12\x1a\n" +
-	"\bexchange\x18\x06 \x01(\tR\bexchange\x12\x1d\n" +
-	"\n" +
-	"class_code\x18\a \x01(\tR\tclassCode\x12\x1c\n" +
-	"\tdirection\x18\b \x01(\tR\tdirection\x12\x12\n" +
-	"\x04name\x18\t \x01(\tR\x04name\x12\x16\n" +
-	"\x06ticker\x18\n" +
-	" \x01(\tR\x06ticker\x12G\n" +
-	"\x05price\x18\v \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x05price\x12\x1a\n" +
-	"\bquantity\x18\f \x01(\x03R\bquantity\x12T\n" +
-	"\forder_amount\x18\r \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\vorderAmount\x12M\n" +
-	"\taci_value\x18\x0e \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\baciValue\x12_\n" +
-	"\x12total_order_amount\x18\x0f \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x10totalOrderAmount\x12^\n" +
-	"\x11broker_commission\x18\x10 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x10brokerCommission\x12b\n" +
-	"\x13exchange_commission\x18\x11 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x12exchangeCommission\x12s\n" +
-	"\x1cexchange_clearing_commission\x18\x12 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x1aexchangeClearingCommission\x12M\n" +
-	"\trepo_rate\x18\x13 \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\brepoRate\x12\x14\n" +
-	"\x05party\x18\x14 \x01(\tR\x05party\x12D\n" +
-	"\x10clear_value_date\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\x0eclearValueDate\x12@\n" +
-	"\x0esec_value_date\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\fsecValueDate\x12#\n" +
-	"\rbroker_status\x18\x17 \x01(\tR\fbrokerStatus\x126\n" +
-	"\x17separate_agreement_type\x18\x18 \x01(\tR\x15separateAgreementType\x12:\n" +
-	"\x19separate_agreement_number\x18\x19 \x01(\tR\x17separateAgreementNumber\x126\n" +
-	"\x17separate_agreement_date\x18\x1a \x01(\tR\x15separateAgreementDate\x12#\n" +
-	"\rdelivery_type\x18\x1b \x01(\tR\fdeliveryType\"\xe4\x02\n" +
-	" GetDividendsForeignIssuerRequest\x12\xa0\x01\n" +
-	"\"generate_div_foreign_issuer_report\x18\x01 \x01(\v2R.tinkoff.public.invest.api.contract.v1.GenerateDividendsForeignIssuerReportRequestH\x00R\x1egenerateDivForeignIssuerReport\x12\x91\x01\n" +
-	"\x1dget_div_foreign_issuer_report\x18\x02 \x01(\v2M.tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerReportRequestH\x00R\x19getDivForeignIssuerReportB\t\n" +
-	"\apayload\"\xf1\x02\n" +
-	"!GetDividendsForeignIssuerResponse\x12\xb2\x01\n" +
-	"+generate_div_foreign_issuer_report_response\x18\x01 \x01(\v2S.tinkoff.public.invest.api.contract.v1.GenerateDividendsForeignIssuerReportResponseH\x00R&generateDivForeignIssuerReportResponse\x12\x8b\x01\n" +
-	"\x19div_foreign_issuer_report\x18\x02 \x01(\v2N.tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerReportResponseH\x00R\x16divForeignIssuerReportB\t\n" +
-	"\apayload\"\xb7\x01\n" +
-	"+GenerateDividendsForeignIssuerReportRequest\x12\"\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\x123\n" +
-	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\x04from\x12/\n" +
-	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\x02to\"h\n" +
-	"&GetDividendsForeignIssuerReportRequest\x12\x1c\n" +
-	"\atask_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06taskId\x12\x17\n" +
-	"\x04page\x18\x02 \x01(\x05H\x00R\x04page\x88\x01\x01B\a\n" +
-	"\x05_page\"G\n" +
-	",GenerateDividendsForeignIssuerReportResponse\x12\x17\n" +
-	"\atask_id\x18\x01 \x01(\tR\x06taskId\"\x8a\x02\n" +
-	"'GetDividendsForeignIssuerReportResponse\x12\x8a\x01\n" +
-	"\x1fdividends_foreign_issuer_report\x18\x01 \x03(\v2C.tinkoff.public.invest.api.contract.v1.DividendsForeignIssuerReportR\x1cdividendsForeignIssuerReport\x12\x1e\n" +
-	"\n" +
-	"itemsCount\x18\x02 \x01(\x05R\n" +
-	"itemsCount\x12\x1e\n" +
-	"\n" +
-	"pagesCount\x18\x03 \x01(\x05R\n" +
-	"pagesCount\x12\x12\n" +
-	"\x04page\x18\x04 \x01(\x05R\x04page\"\xdb\x05\n" +
-	"\x1cDividendsForeignIssuerReport\x12;\n" +
-	"\vrecord_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"recordDate\x12=\n" +
-	"\fpayment_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vpaymentDate\x12#\n" +
-	"\rsecurity_name\x18\x03 \x01(\tR\fsecurityName\x12\x12\n" +
-	"\x04isin\x18\x04 \x01(\tR\x04isin\x12%\n" +
-	"\x0eissuer_country\x18\x05 \x01(\tR\rissuerCountry\x12\x1a\n" +
-	"\bquantity\x18\x06 \x01(\x03R\bquantity\x12L\n" +
-	"\bdividend\x18\a \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\bdividend\x12a\n" +
-	"\x13external_commission\x18\b \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\x12externalCommission\x12W\n" +
-	"\x0edividend_gross\x18\t \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\rdividendGross\x12B\n" +
-	"\x03tax\x18\n" +
-	" \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\x03tax\x12Y\n" +
-	"\x0fdividend_amount\x18\v \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\x0edividendAmount\x12\x1a\n" +
-	"\bcurrency\x18\f \x01(\tR\bcurrency\"\x93\x01\n" +
-	"\x16PortfolioStreamRequest\x12\x1a\n" +
-	"\baccounts\x18\x01 \x03(\tR\baccounts\x12]\n" +
-	"\rping_settings\x18\x0f \x01(\v28.tinkoff.public.invest.api.contract.v1.PingDelaySettingsR\fpingSettings\"\xad\x02\n" +
-	"\x17PortfolioStreamResponse\x12j\n" +
-	"\rsubscriptions\x18\x01 \x01(\v2B.tinkoff.public.invest.api.contract.v1.PortfolioSubscriptionResultH\x00R\rsubscriptions\x12X\n" +
-	"\tportfolio\x18\x02 \x01(\v28.tinkoff.public.invest.api.contract.v1.PortfolioResponseH\x00R\tportfolio\x12A\n" +
-	"\x04ping\x18\x03 \x01(\v2+.tinkoff.public.invest.api.contract.v1.PingH\x00R\x04pingB\t\n" +
-	"\apayload\"\xb9\x01\n" +
-	"\x1bPortfolioSubscriptionResult\x12\\\n" +
-	"\baccounts\x18\x01 \x03(\v2@.tinkoff.public.invest.api.contract.v1.AccountSubscriptionStatusR\baccounts\x12\x1f\n" +
-	"\vtracking_id\x18\a \x01(\tR\n" +
-	"trackingId\x12\x1b\n" +
-	"\tstream_id\x18\b \x01(\tR\bstreamId\"\xaf\x01\n" +
-	"\x19AccountSubscriptionStatus\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\x12s\n" +
-	"\x13subscription_status\x18\x06 \x01(\x0e2B.tinkoff.public.invest.api.contract.v1.PortfolioSubscriptionStatusR\x12subscriptionStatus\"\xd4\x05\n" +
-	"\x1cGetOperationsByCursorRequest\x12\"\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tB\x03\xe0A\x02R\taccountId\x12(\n" +
-	"\rinstrument_id\x18\x02 \x01(\tH\x00R\finstrumentId\x88\x01\x01\x123\n" +
-	"\x04from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x04from\x88\x01\x01\x12/\n" +
-	"\x02to\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x02to\x88\x01\x01\x12\x1b\n" +
-	"\x06cursor\x18\v \x01(\tH\x03R\x06cursor\x88\x01\x01\x12\x19\n" +
-	"\x05limit\x18\f \x01(\x05H\x04R\x05limit\x88\x01\x01\x12]\n" +
-	"\x0foperation_types\x18\r \x03(\x0e24.tinkoff.public.invest.api.contract.v1.OperationTypeR\x0eoperationTypes\x12P\n" +
-	"\x05state\x18\x0e \x01(\x0e25.tinkoff.public.invest.api.contract.v1.OperationStateH\x05R\x05state\x88\x01\x01\x124\n" +
-	"\x13without_commissions\x18\x0f \x01(\bH\x06R\x12withoutCommissions\x88\x01\x01\x12*\n" +
-	"\x0ewithout_trades\x18\x10 \x01(\bH\aR\rwithoutTrades\x88\x01\x01\x122\n" +
-	"\x12without_overnights\x18\x11 \x01(\bH\bR\x11withoutOvernights\x88\x01\x01B\x10\n" +
-	"\x0e_instrument_idB\a\n" +
-	"\x05_fromB\x05\n" +
-	"\x03_toB\t\n" +
-	"\a_cursorB\b\n" +
-	"\x06_limitB\b\n" +
-	"\x06_stateB\x16\n" +
-	"\x14_without_commissionsB\x11\n" +
-	"\x0f_without_tradesB\x15\n" +
-	"\x13_without_overnights\"\xa7\x01\n" +
-	"\x1dGetOperationsByCursorResponse\x12\x19\n" +
-	"\bhas_next\x18\x01 \x01(\bR\ahasNext\x12\x1f\n" +
-	"\vnext_cursor\x18\x02 \x01(\tR\n" +
-	"nextCursor\x12J\n" +
-	"\x05items\x18\x06 \x03(\v24.tinkoff.public.invest.api.contract.v1.OperationItemR\x05items\"\x87\f\n" +
-	"\rOperationItem\x12\x16\n" +
-	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12*\n" +
-	"\x11broker_account_id\x18\x06 \x01(\tR\x0fbrokerAccountId\x12\x0e\n" +
-	"\x02id\x18\x10 \x01(\tR\x02id\x12.\n" +
-	"\x13parent_operation_id\x18\x11 \x01(\tR\x11parentOperationId\x12\x12\n" +
-	"\x04name\x18\x12 \x01(\tR\x04name\x12.\n" +
-	"\x04date\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12H\n" +
-	"\x04type\x18\x16 \x01(\x0e24.tinkoff.public.invest.api.contract.v1.OperationTypeR\x04type\x12 \n" +
-	"\vdescription\x18\x17 \x01(\tR\vdescription\x12K\n" +
-	"\x05state\x18\x18 \x01(\x0e25.tinkoff.public.invest.api.contract.v1.OperationStateR\x05state\x12%\n" +
-	"\x0einstrument_uid\x18\x1f \x01(\tR\rinstrumentUid\x12\x12\n" +
-	"\x04figi\x18  \x01(\tR\x04figi\x12'\n" +
-	"\x0finstrument_type\x18! \x01(\tR\x0einstrumentType\x12^\n" +
-	"\x0finstrument_kind\x18\" \x01(\x0e25.tinkoff.public.invest.api.contract.v1.InstrumentTypeR\x0einstrumentKind\x12!\n" +
-	"\fposition_uid\x18# \x01(\tR\vpositionUid\x12K\n" +
-	"\apayment\x18) \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\apayment\x12G\n" +
-	"\x05price\x18* \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x05price\x12Q\n" +
-	"\n" +
-	"commission\x18+ \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\n" +
-	"commission\x12G\n" +
-	"\x05yield\x18, \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x05yield\x12W\n" +
-	"\x0eyield_relative\x18- \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\ryieldRelative\x12R\n" +
-	"\vaccrued_int\x18. \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\n" +
-	"accruedInt\x12\x1a\n" +
-	"\bquantity\x183 \x01(\x03R\bquantity\x12#\n" +
-	"\rquantity_rest\x184 \x01(\x03R\fquantityRest\x12#\n" +
-	"\rquantity_done\x185 \x01(\x03R\fquantityDone\x12D\n" +
-	"\x10cancel_date_time\x188 \x01(\v2\x1a.google.protobuf.TimestampR\x0ecancelDateTime\x12#\n" +
-	"\rcancel_reason\x189 \x01(\tR\fcancelReason\x12[\n" +
-	"\vtrades_info\x18= \x01(\v2:.tinkoff.public.invest.api.contract.v1.OperationItemTradesR\n" +
-	"tradesInfo\x12\x1b\n" +
-	"\tasset_uid\x18@ \x01(\tR\bassetUid\x12d\n" +
-	"\x10child_operations\x18A \x03(\v29.tinkoff.public.invest.api.contract.v1.ChildOperationItemR\x0fchildOperations\"h\n" +
-	"\x13OperationItemTrades\x12Q\n" +
-	"\x06trades\x18\x06 \x03(\v29.tinkoff.public.invest.api.contract.v1.OperationItemTradeR\x06trades\"\xdd\x02\n" +
-	"\x12OperationItemTrade\x12\x10\n" +
-	"\x03num\x18\x01 \x01(\tR\x03num\x12.\n" +
-	"\x04date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x1a\n" +
-	"\bquantity\x18\v \x01(\x03R\bquantity\x12G\n" +
-	"\x05price\x18\x10 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x05price\x12G\n" +
-	"\x05yield\x18\x15 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x05yield\x12W\n" +
-	"\x0eyield_relative\x18\x16 \x01(\v20.tinkoff.public.invest.api.contract.v1.QuotationR\ryieldRelative\"\xc9\x01\n" +
-	"\x16PositionsStreamRequest\x12\x1a\n" +
-	"\baccounts\x18\x01 \x03(\tR\baccounts\x124\n" +
-	"\x16with_initial_positions\x18\x03 \x01(\bR\x14withInitialPositions\x12]\n" +
-	"\rping_settings\x18\x0f \x01(\v28.tinkoff.public.invest.api.contract.v1.PingDelaySettingsR\fpingSettings\"\x8f\x03\n" +
-	"\x17PositionsStreamResponse\x12j\n" +
-	"\rsubscriptions\x18\x01 \x01(\v2B.tinkoff.public.invest.api.contract.v1.PositionsSubscriptionResultH\x00R\rsubscriptions\x12Q\n" +
-	"\bposition\x18\x02 \x01(\v23.tinkoff.public.invest.api.contract.v1.PositionDataH\x00R\bposition\x12A\n" +
-	"\x04ping\x18\x03 \x01(\v2+.tinkoff.public.invest.api.contract.v1.PingH\x00R\x04ping\x12g\n" +
-	"\x11initial_positions\x18\x05 \x01(\v28.tinkoff.public.invest.api.contract.v1.PositionsResponseH\x00R\x10initialPositionsB\t\n" +
-	"\apayload\"\xbb\x01\n" +
-	"\x1bPositionsSubscriptionResult\x12^\n" +
-	"\baccounts\x18\x01 \x03(\v2B.tinkoff.public.invest.api.contract.v1.PositionsSubscriptionStatusR\baccounts\x12\x1f\n" +
-	"\vtracking_id\x18\a \x01(\tR\n" +
-	"trackingId\x12\x1b\n" +
-	"\tstream_id\x18\b \x01(\tR\bstreamId\"\xb8\x01\n" +
-	"\x1bPositionsSubscriptionStatus\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\x12z\n" +
-	"\x13subscription_status\x18\x06 \x01(\x0e2I.tinkoff.public.invest.api.contract.v1.PositionsAccountSubscriptionStatusR\x12subscriptionStatus\"\xac\x03\n" +
-	"\fPositionData\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\x12K\n" +
-	"\x05money\x18\x02 \x03(\v25.tinkoff.public.invest.api.contract.v1.PositionsMoneyR\x05money\x12Z\n" +
-	"\n" +
-	"securities\x18\x03 \x03(\v2:.tinkoff.public.invest.api.contract.v1.PositionsSecuritiesR\n" +
-	"securities\x12Q\n" +
-	"\afutures\x18\x04 \x03(\v27.tinkoff.public.invest.api.contract.v1.PositionsFuturesR\afutures\x12Q\n" +
-	"\aoptions\x18\x05 \x03(\v27.tinkoff.public.invest.api.contract.v1.PositionsOptionsR\aoptions\x12.\n" +
-	"\x04date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\xc4\x01\n" +
-	"\x0ePositionsMoney\x12Z\n" +
-	"\x0favailable_value\x18\x01 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\x0eavailableValue\x12V\n" +
-	"\rblocked_value\x18\x02 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\fblockedValue\"\x88\x01\n" +
-	"\x12ChildOperationItem\x12%\n" +
-	"\x0einstrument_uid\x18\x01 \x01(\tR\rinstrumentUid\x12K\n" +
-	"\apayment\x18\x02 \x01(\v21.tinkoff.public.invest.api.contract.v1.MoneyValueR\apayment*\x8b\x01\n" +
-	"\x0eOperationState\x12\x1f\n" +
-	"\x1bOPERATION_STATE_UNSPECIFIED\x10\x00\x12\x1c\n" +
-	"\x18OPERATION_STATE_EXECUTED\x10\x01\x12\x1c\n" +
-	"\x18OPERATION_STATE_CANCELED\x10\x02\x12\x1c\n" +
-	"\x18OPERATION_STATE_PROGRESS\x10\x03*\xe0\x10\n" +
-	"\rOperationType\x12\x1e\n" +
-	"\x1aOPERATION_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
-	"\x14OPERATION_TYPE_INPUT\x10\x01\x12\x1b\n" +
-	"\x17OPERATION_TYPE_BOND_TAX\x10\x02\x12$\n" +
-	" OPERATION_TYPE_OUTPUT_SECURITIES\x10\x03\x12\x1c\n" +
-	"\x18OPERATION_TYPE_OVERNIGHT\x10\x04\x12\x16\n" +
-	"\x12OPERATION_TYPE_TAX\x10\x05\x12&\n" +
-	"\"OPERATION_TYPE_BOND_REPAYMENT_FULL\x10\x06\x12\x1c\n" +
-	"\x18OPERATION_TYPE_SELL_CARD\x10\a\x12\x1f\n" +
-	"\x1bOPERATION_TYPE_DIVIDEND_TAX\x10\b\x12\x19\n" +
-	"\x15OPERATION_TYPE_OUTPUT\x10\t\x12!\n" +
-	"\x1dOPERATION_TYPE_BOND_REPAYMENT\x10\n" +
-	"\x12!\n" +
-	"\x1dOPERATION_TYPE_TAX_CORRECTION\x10\v\x12\x1e\n" +
-	"\x1aOPERATION_TYPE_SERVICE_FEE\x10\f\x12\x1e\n" +
-	"\x1aOPERATION_TYPE_BENEFIT_TAX\x10\r\x12\x1d\n" +
-	"\x19OPERATION_TYPE_MARGIN_FEE\x10\x0e\x12\x16\n" +
-	"\x12OPERATION_TYPE_BUY\x10\x0f\x12\x1b\n" +
-	"\x17OPERATION_TYPE_BUY_CARD\x10\x10\x12#\n" +
-	"\x1fOPERATION_TYPE_INPUT_SECURITIES\x10\x11\x12\x1e\n" +
-	"\x1aOPERATION_TYPE_SELL_MARGIN\x10\x12\x12\x1d\n" +
-	"\x19OPERATION_TYPE_BROKER_FEE\x10\x13\x12\x1d\n" +
-	"\x19OPERATION_TYPE_BUY_MARGIN\x10\x14\x12\x1b\n" +
-	"\x17OPERATION_TYPE_DIVIDEND\x10\x15\x12\x17\n" +
-	"\x13OPERATION_TYPE_SELL\x10\x16\x12\x19\n" +
-	"\x15OPERATION_TYPE_COUPON\x10\x17\x12\x1e\n" +
-	"\x1aOPERATION_TYPE_SUCCESS_FEE\x10\x18\x12$\n" +
-	" OPERATION_TYPE_DIVIDEND_TRANSFER\x10\x19\x12%\n" +
-	"!OPERATION_TYPE_ACCRUING_VARMARGIN\x10\x1a\x12(\n" +
-	"$OPERATION_TYPE_WRITING_OFF_VARMARGIN\x10\x1b\x12\x1f\n" +
-	"\x1bOPERATION_TYPE_DELIVERY_BUY\x10\x1c\x12 \n" +
-	"\x1cOPERATION_TYPE_DELIVERY_SELL\x10\x1d\x12\x1d\n" +
-	"\x19OPERATION_TYPE_TRACK_MFEE\x10\x1e\x12\x1d\n" +
-	"\x19OPERATION_TYPE_TRACK_PFEE\x10\x1f\x12\"\n" +
-	"\x1eOPERATION_TYPE_TAX_PROGRESSIVE\x10 \x12'\n" +
-	"#OPERATION_TYPE_BOND_TAX_PROGRESSIVE\x10!\x12+\n" +
-	"'OPERATION_TYPE_DIVIDEND_TAX_PROGRESSIVE\x10\"\x12*\n" +
-	"&OPERATION_TYPE_BENEFIT_TAX_PROGRESSIVE\x10#\x12-\n" +
-	")OPERATION_TYPE_TAX_CORRECTION_PROGRESSIVE\x10$\x12'\n" +
-	"#OPERATION_TYPE_TAX_REPO_PROGRESSIVE\x10%\x12\x1b\n" +
-	"\x17OPERATION_TYPE_TAX_REPO\x10&\x12 \n" +
-	"\x1cOPERATION_TYPE_TAX_REPO_HOLD\x10'\x12\"\n" +
-	"\x1eOPERATION_TYPE_TAX_REPO_REFUND\x10(\x12,\n" +
-	"(OPERATION_TYPE_TAX_REPO_HOLD_PROGRESSIVE\x10)\x12.\n" +
-	"*OPERATION_TYPE_TAX_REPO_REFUND_PROGRESSIVE\x10*\x12\x1a\n" +
-	"\x16OPERATION_TYPE_DIV_EXT\x10+\x12(\n" +
-	"$OPERATION_TYPE_TAX_CORRECTION_COUPON\x10,\x12\x1b\n" +
-	"\x17OPERATION_TYPE_CASH_FEE\x10-\x12\x1a\n" +
-	"\x16OPERATION_TYPE_OUT_FEE\x10.\x12!\n" +
-	"\x1dOPERATION_TYPE_OUT_STAMP_DUTY\x10/\x12\x1f\n" +
-	"\x1bOPERATION_TYPE_OUTPUT_SWIFT\x102\x12\x1e\n" +
-	"\x1aOPERATION_TYPE_INPUT_SWIFT\x103\x12#\n" +
-	"\x1fOPERATION_TYPE_OUTPUT_ACQUIRING\x105\x12\"\n" +
-	"\x1eOPERATION_TYPE_INPUT_ACQUIRING\x106\x12!\n" +
-	"\x1dOPERATION_TYPE_OUTPUT_PENALTY\x107\x12\x1d\n" +
-	"\x19OPERATION_TYPE_ADVICE_FEE\x108\x12\x1f\n" +
-	"\x1bOPERATION_TYPE_TRANS_IIS_BS\x109\x12\x1e\n" +
-	"\x1aOPERATION_TYPE_TRANS_BS_BS\x10:\x12\x1c\n" +
-	"\x18OPERATION_TYPE_OUT_MULTI\x10;\x12\x1c\n" +
-	"\x18OPERATION_TYPE_INP_MULTI\x10<\x12!\n" +
-	"\x1dOPERATION_TYPE_OVER_PLACEMENT\x10=\x12\x1b\n" +
-	"\x17OPERATION_TYPE_OVER_COM\x10>\x12\x1e\n" +
-	"\x1aOPERATION_TYPE_OVER_INCOME\x10?\x12$\n" +
-	" OPERATION_TYPE_OPTION_EXPIRATION\x10@\x12$\n" +
-	" OPERATION_TYPE_FUTURE_EXPIRATION\x10A*\xde\x01\n" +
-	"\x1bPortfolioSubscriptionStatus\x12-\n" +
-	")PORTFOLIO_SUBSCRIPTION_STATUS_UNSPECIFIED\x10\x00\x12)\n" +
-	"%PORTFOLIO_SUBSCRIPTION_STATUS_SUCCESS\x10\x01\x123\n" +
-	"/PORTFOLIO_SUBSCRIPTION_STATUS_ACCOUNT_NOT_FOUND\x10\x02\x120\n" +
-	",PORTFOLIO_SUBSCRIPTION_STATUS_INTERNAL_ERROR\x10\x03*\xe5\x01\n" +
-	"\"PositionsAccountSubscriptionStatus\x12-\n" +
-	")POSITIONS_SUBSCRIPTION_STATUS_UNSPECIFIED\x10\x00\x12)\n" +
-	"%POSITIONS_SUBSCRIPTION_STATUS_SUCCESS\x10\x01\x123\n" +
-	"/POSITIONS_SUBSCRIPTION_STATUS_ACCOUNT_NOT_FOUND\x10\x02\x120\n" +
-	",POSITIONS_SUBSCRIPTION_STATUS_INTERNAL_ERROR\x10\x032\x98\b\n" +
-	"\x11OperationsService\x12\x84\x01\n" +
-	"\rGetOperations\x128.tinkoff.public.invest.api.contract.v1.OperationsRequest\x1a9.tinkoff.public.invest.api.contract.v1.OperationsResponse\x12\x81\x01\n" +
-	"\fGetPortfolio\x127.tinkoff.public.invest.api.contract.v1.PortfolioRequest\x1a8.tinkoff.public.invest.api.contract.v1.PortfolioResponse\x12\x81\x01\n" +
-	"\fGetPositions\x127.tinkoff.public.invest.api.contract.v1.PositionsRequest\x1a8.tinkoff.public.invest.api.contract.v1.PositionsResponse\x12\x90\x01\n" +
-	"\x11GetWithdrawLimits\x12<.tinkoff.public.invest.api.contract.v1.WithdrawLimitsRequest\x1a=.tinkoff.public.invest.api.contract.v1.WithdrawLimitsResponse\x12\x8a\x01\n" +
-	"\x0fGetBrokerReport\x12:.tinkoff.public.invest.api.contract.v1.BrokerReportRequest\x1a;.tinkoff.public.invest.api.contract.v1.BrokerReportResponse\x12\xae\x01\n" +
-	"\x19GetDividendsForeignIssuer\x12G.tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerRequest\x1aH.tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerResponse\x12\xa2\x01\n" +
-	"\x15GetOperationsByCursor\x12C.tinkoff.public.invest.api.contract.v1.GetOperationsByCursorRequest\x1aD.tinkoff.public.invest.api.contract.v1.GetOperationsByCursorResponse2\xc3\x02\n" +
-	"\x17OperationsStreamService\x12\x92\x01\n" +
-	"\x0fPortfolioStream\x12=.tinkoff.public.invest.api.contract.v1.PortfolioStreamRequest\x1a>.tinkoff.public.invest.api.contract.v1.PortfolioStreamResponse0\x01\x12\x92\x01\n" +
-	"\x0fPositionsStream\x12=.tinkoff.public.invest.api.contract.v1.PositionsStreamRequest\x1a>.tinkoff.public.invest.api.contract.v1.PositionsStreamResponse0\x01B\x87\x02\n" +
-	")com.tinkoff.public.invest.api.contract.v1B\x0fOperationsProtoP\x01Z\f./;investapi\xa2\x02\x05TPIAC\xaa\x02%Tinkoff.Public.Invest.Api.Contract.V1\xca\x02&Tinkoff\\Public_\\Invest\\Api\\Contract\\V1\xe2\x022Tinkoff\\Public_\\Invest\\Api\\Contract\\V1\\GPBMetadata\xea\x02*Tinkoff::Public::Invest::Api::Contract::V1b\x06proto3"
-
-var (
-	file_adapter_tinkoff_proto_operations_proto_rawDescOnce sync.Once
-	file_adapter_tinkoff_proto_operations_proto_rawDescData []byte
-)
-
-func file_adapter_tinkoff_proto_operations_proto_rawDescGZIP() []byte {
-	file_adapter_tinkoff_proto_operations_proto_rawDescOnce.Do(func() {
-		file_adapter_tinkoff_proto_operations_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_adapter_tinkoff_proto_operations_proto_rawDesc), len(file_adapter_tinkoff_proto_operations_proto_rawDesc)))
-	})
-	return file_adapter_tinkoff_proto_operations_proto_rawDescData
-}
-
-var file_adapter_tinkoff_proto_operations_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_adapter_tinkoff_proto_operations_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
-var file_adapter_tinkoff_proto_operations_proto_goTypes = []any{
-	(OperationState)(0),                                  // 0: tinkoff.public.invest.api.contract.v1.OperationState
-	(OperationType)(0),                                   // 1: tinkoff.public.invest.api.contract.v1.OperationType
-	(PortfolioSubscriptionStatus)(0),                     // 2: tinkoff.public.invest.api.contract.v1.PortfolioSubscriptionStatus
-	(PositionsAccountSubscriptionStatus)(0),              // 3: tinkoff.public.invest.api.contract.v1.PositionsAccountSubscriptionStatus
-	(PortfolioRequest_CurrencyRequest)(0),                // 4: tinkoff.public.invest.api.contract.v1.PortfolioRequest.CurrencyRequest
-	(*OperationsRequest)(nil),                            // 5: tinkoff.public.invest.api.contract.v1.OperationsRequest
-	(*OperationsResponse)(nil),                           // 6: tinkoff.public.invest.api.contract.v1.OperationsResponse
-	(*Operation)(nil),                                    // 7: tinkoff.public.invest.api.contract.v1.Operation
-	(*OperationTrade)(nil),                               // 8: tinkoff.public.invest.api.contract.v1.OperationTrade
-	(*PortfolioRequest)(nil),                             // 9: tinkoff.public.invest.api.contract.v1.PortfolioRequest
-	(*PortfolioResponse)(nil),                            // 10: tinkoff.public.invest.api.contract.v1.PortfolioResponse
-	(*PositionsRequest)(nil),                             // 11: tinkoff.public.invest.api.contract.v1.PositionsRequest
-	(*PositionsResponse)(nil),                            // 12: tinkoff.public.invest.api.contract.v1.PositionsResponse
-	(*WithdrawLimitsRequest)(nil),                        // 13: tinkoff.public.invest.api.contract.v1.WithdrawLimitsRequest
-	(*WithdrawLimitsResponse)(nil),                       // 14: tinkoff.public.invest.api.contract.v1.WithdrawLimitsResponse
-	(*PortfolioPosition)(nil),                            // 15: tinkoff.public.invest.api.contract.v1.PortfolioPosition
-	(*VirtualPortfolioPosition)(nil),                     // 16: tinkoff.public.invest.api.contract.v1.VirtualPortfolioPosition
-	(*PositionsSecurities)(nil),                          // 17: tinkoff.public.invest.api.contract.v1.PositionsSecurities
-	(*PositionsFutures)(nil),                             // 18: tinkoff.public.invest.api.contract.v1.PositionsFutures
-	(*PositionsOptions)(nil),                             // 19: tinkoff.public.invest.api.contract.v1.PositionsOptions
-	(*BrokerReportRequest)(nil),                          // 20: tinkoff.public.invest.api.contract.v1.BrokerReportRequest
-	(*BrokerReportResponse)(nil),                         // 21: tinkoff.public.invest.api.contract.v1.BrokerReportResponse
-	(*GenerateBrokerReportRequest)(nil),                  // 22: tinkoff.public.invest.api.contract.v1.GenerateBrokerReportRequest
-	(*GenerateBrokerReportResponse)(nil),                 // 23: tinkoff.public.invest.api.contract.v1.GenerateBrokerReportResponse
-	(*GetBrokerReportRequest)(nil),                       // 24: tinkoff.public.invest.api.contract.v1.GetBrokerReportRequest
-	(*GetBrokerReportResponse)(nil),                      // 25: tinkoff.public.invest.api.contract.v1.GetBrokerReportResponse
-	(*BrokerReport)(nil),                                 // 26: tinkoff.public.invest.api.contract.v1.BrokerReport
-	(*GetDividendsForeignIssuerRequest)(nil),             // 27: tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerRequest
-	(*GetDividendsForeignIssuerResponse)(nil),            // 28: tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerResponse
-	(*GenerateDividendsForeignIssuerReportRequest)(nil),  // 29: tinkoff.public.invest.api.contract.v1.GenerateDividendsForeignIssuerReportRequest
-	(*GetDividendsForeignIssuerReportRequest)(nil),       // 30: tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerReportRequest
-	(*GenerateDividendsForeignIssuerReportResponse)(nil), // 31: tinkoff.public.invest.api.contract.v1.GenerateDividendsForeignIssuerReportResponse
-	(*GetDividendsForeignIssuerReportResponse)(nil),      // 32: tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerReportResponse
-	(*DividendsForeignIssuerReport)(nil),                 // 33: tinkoff.public.invest.api.contract.v1.DividendsForeignIssuerReport
-	(*PortfolioStreamRequest)(nil),                       // 34: tinkoff.public.invest.api.contract.v1.PortfolioStreamRequest
-	(*PortfolioStreamResponse)(nil),                      // 35: tinkoff.public.invest.api.contract.v1.PortfolioStreamResponse
-	(*PortfolioSubscriptionResult)(nil),                  // 36: tinkoff.public.invest.api.contract.v1.PortfolioSubscriptionResult
-	(*AccountSubscriptionStatus)(nil),                    // 37: tinkoff.public.invest.api.contract.v1.AccountSubscriptionStatus
-	(*GetOperationsByCursorRequest)(nil),                 // 38: tinkoff.public.invest.api.contract.v1.GetOperationsByCursorRequest
-	(*GetOperationsByCursorResponse)(nil),                // 39: tinkoff.public.invest.api.contract.v1.GetOperationsByCursorResponse
-	(*OperationItem)(nil),                                // 40: tinkoff.public.invest.api.contract.v1.OperationItem
-	(*OperationItemTrades)(nil),                          // 41: tinkoff.public.invest.api.contract.v1.OperationItemTrades
-	(*OperationItemTrade)(nil),                           // 42: tinkoff.public.invest.api.contract.v1.OperationItemTrade
-	(*PositionsStreamRequest)(nil),                       // 43: tinkoff.public.invest.api.contract.v1.PositionsStreamRequest
-	(*PositionsStreamResponse)(nil),                      // 44: tinkoff.public.invest.api.contract.v1.PositionsStreamResponse
-	(*PositionsSubscriptionResult)(nil),                  // 45: tinkoff.public.invest.api.contract.v1.PositionsSubscriptionResult
-	(*PositionsSubscriptionStatus)(nil),                  // 46: tinkoff.public.invest.api.contract.v1.PositionsSubscriptionStatus
-	(*PositionData)(nil),                                 // 47: tinkoff.public.invest.api.contract.v1.PositionData
-	(*PositionsMoney)(nil),                               // 48: tinkoff.public.invest.api.contract.v1.PositionsMoney
-	(*ChildOperationItem)(nil),                           // 49: tinkoff.public.invest.api.contract.v1.ChildOperationItem
-	(*timestamppb.Timestamp)(nil),                        // 50: google.protobuf.Timestamp
-	(*MoneyValue)(nil),                                   // 51: tinkoff.public.invest.api.contract.v1.MoneyValue
-	(*Quotation)(nil),                                    // 52: tinkoff.public.invest.api.contract.v1.Quotation
-	(*PingDelaySettings)(nil),                            // 53: tinkoff.public.invest.api.contract.v1.PingDelaySettings
-	(*Ping)(nil),                                         // 54: tinkoff.public.invest.api.contract.v1.Ping
-	(InstrumentType)(0),                                  // 55: tinkoff.public.invest.api.contract.v1.InstrumentType
-}
-var file_adapter_tinkoff_proto_operations_proto_depIdxs = []int32{
-	50,  // 0: tinkoff.public.invest.api.contract.v1.OperationsRequest.from:type_name -> google.protobuf.Timestamp
-	50,  // 1: tinkoff.public.invest.api.contract.v1.OperationsRequest.to:type_name -> google.protobuf.Timestamp
-	0,   // 2: tinkoff.public.invest.api.contract.v1.OperationsRequest.state:type_name -> tinkoff.public.invest.api.contract.v1.OperationState
-	7,   // 3: tinkoff.public.invest.api.contract.v1.OperationsResponse.operations:type_name -> tinkoff.public.invest.api.contract.v1.Operation
-	51,  // 4: tinkoff.public.invest.api.contract.v1.Operation.payment:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 5: tinkoff.public.invest.api.contract.v1.Operation.price:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	0,   // 6: tinkoff.public.invest.api.contract.v1.Operation.state:type_name -> tinkoff.public.invest.api.contract.v1.OperationState
-	50,  // 7: tinkoff.public.invest.api.contract.v1.Operation.date:type_name -> google.protobuf.Timestamp
-	1,   // 8: tinkoff.public.invest.api.contract.v1.Operation.operation_type:type_name -> tinkoff.public.invest.api.contract.v1.OperationType
-	8,   // 9: tinkoff.public.invest.api.contract.v1.Operation.trades:type_name -> tinkoff.public.invest.api.contract.v1.OperationTrade
-	49,  // 10: tinkoff.public.invest.api.contract.v1.Operation.child_operations:type_name -> tinkoff.public.invest.api.contract.v1.ChildOperationItem
-	50,  // 11: tinkoff.public.invest.api.contract.v1.OperationTrade.date_time:type_name -> google.protobuf.Timestamp
-	51,  // 12: tinkoff.public.invest.api.contract.v1.OperationTrade.price:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	4,   // 13: tinkoff.public.invest.api.contract.v1.PortfolioRequest.currency:type_name -> tinkoff.public.invest.api.contract.v1.PortfolioRequest.CurrencyRequest
-	51,  // 14: tinkoff.public.invest.api.contract.v1.PortfolioResponse.total_amount_shares:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 15: tinkoff.public.invest.api.contract.v1.PortfolioResponse.total_amount_bonds:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 16: tinkoff.public.invest.api.contract.v1.PortfolioResponse.total_amount_etf:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 17: tinkoff.public.invest.api.contract.v1.PortfolioResponse.total_amount_currencies:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 18: tinkoff.public.invest.api.contract.v1.PortfolioResponse.total_amount_futures:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 19: tinkoff.public.invest.api.contract.v1.PortfolioResponse.expected_yield:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	15,  // 20: tinkoff.public.invest.api.contract.v1.PortfolioResponse.positions:type_name -> tinkoff.public.invest.api.contract.v1.PortfolioPosition
-	51,  // 21: tinkoff.public.invest.api.contract.v1.PortfolioResponse.total_amount_options:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 22: tinkoff.public.invest.api.contract.v1.PortfolioResponse.total_amount_sp:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 23: tinkoff.public.invest.api.contract.v1.PortfolioResponse.total_amount_portfolio:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	16,  // 24: tinkoff.public.invest.api.contract.v1.PortfolioResponse.virtual_positions:type_name -> tinkoff.public.invest.api.contract.v1.VirtualPortfolioPosition
-	51,  // 25: tinkoff.public.invest.api.contract.v1.PortfolioResponse.daily_yield:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 26: tinkoff.public.invest.api.contract.v1.PortfolioResponse.daily_yield_relative:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	51,  // 27: tinkoff.public.invest.api.contract.v1.PositionsResponse.money:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 28: tinkoff.public.invest.api.contract.v1.PositionsResponse.blocked:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	17,  // 29: tinkoff.public.invest.api.contract.v1.PositionsResponse.securities:type_name -> tinkoff.public.invest.api.contract.v1.PositionsSecurities
-	18,  // 30: tinkoff.public.invest.api.contract.v1.PositionsResponse.futures:type_name -> tinkoff.public.invest.api.contract.v1.PositionsFutures
-	19,  // 31: tinkoff.public.invest.api.contract.v1.PositionsResponse.options:type_name -> tinkoff.public.invest.api.contract.v1.PositionsOptions
-	51,  // 32: tinkoff.public.invest.api.contract.v1.WithdrawLimitsResponse.money:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 33: tinkoff.public.invest.api.contract.v1.WithdrawLimitsResponse.blocked:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 34: tinkoff.public.invest.api.contract.v1.WithdrawLimitsResponse.blocked_guarantee:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 35: tinkoff.public.invest.api.contract.v1.PortfolioPosition.quantity:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	51,  // 36: tinkoff.public.invest.api.contract.v1.PortfolioPosition.average_position_price:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 37: tinkoff.public.invest.api.contract.v1.PortfolioPosition.expected_yield:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	51,  // 38: tinkoff.public.invest.api.contract.v1.PortfolioPosition.current_nkd:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 39: tinkoff.public.invest.api.contract.v1.PortfolioPosition.average_position_price_pt:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	51,  // 40: tinkoff.public.invest.api.contract.v1.PortfolioPosition.current_price:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 41: tinkoff.public.invest.api.contract.v1.PortfolioPosition.average_position_price_fifo:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 42: tinkoff.public.invest.api.contract.v1.PortfolioPosition.quantity_lots:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	52,  // 43: tinkoff.public.invest.api.contract.v1.PortfolioPosition.blocked_lots:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	51,  // 44: tinkoff.public.invest.api.contract.v1.PortfolioPosition.var_margin:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 45: tinkoff.public.invest.api.contract.v1.PortfolioPosition.expected_yield_fifo:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	51,  // 46: tinkoff.public.invest.api.contract.v1.PortfolioPosition.daily_yield:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 47: tinkoff.public.invest.api.contract.v1.VirtualPortfolioPosition.quantity:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	51,  // 48: tinkoff.public.invest.api.contract.v1.VirtualPortfolioPosition.average_position_price:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 49: tinkoff.public.invest.api.contract.v1.VirtualPortfolioPosition.expected_yield:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	52,  // 50: tinkoff.public.invest.api.contract.v1.VirtualPortfolioPosition.expected_yield_fifo:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	50,  // 51: tinkoff.public.invest.api.contract.v1.VirtualPortfolioPosition.expire_date:type_name -> google.protobuf.Timestamp
-	51,  // 52: tinkoff.public.invest.api.contract.v1.VirtualPortfolioPosition.current_price:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 53: tinkoff.public.invest.api.contract.v1.VirtualPortfolioPosition.average_position_price_fifo:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 54: tinkoff.public.invest.api.contract.v1.VirtualPortfolioPosition.daily_yield:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	22,  // 55: tinkoff.public.invest.api.contract.v1.BrokerReportRequest.generate_broker_report_request:type_name -> tinkoff.public.invest.api.contract.v1.GenerateBrokerReportRequest
-	24,  // 56: tinkoff.public.invest.api.contract.v1.BrokerReportRequest.get_broker_report_request:type_name -> tinkoff.public.invest.api.contract.v1.GetBrokerReportRequest
-	23,  // 57: tinkoff.public.invest.api.contract.v1.BrokerReportResponse.generate_broker_report_response:type_name -> tinkoff.public.invest.api.contract.v1.GenerateBrokerReportResponse
-	25,  // 58: tinkoff.public.invest.api.contract.v1.BrokerReportResponse.get_broker_report_response:type_name -> tinkoff.public.invest.api.contract.v1.GetBrokerReportResponse
-	50,  // 59: tinkoff.public.invest.api.contract.v1.GenerateBrokerReportRequest.from:type_name -> google.protobuf.Timestamp
-	50,  // 60: tinkoff.public.invest.api.contract.v1.GenerateBrokerReportRequest.to:type_name -> google.protobuf.Timestamp
-	26,  // 61: tinkoff.public.invest.api.contract.v1.GetBrokerReportResponse.broker_report:type_name -> tinkoff.public.invest.api.contract.v1.BrokerReport
-	50,  // 62: tinkoff.public.invest.api.contract.v1.BrokerReport.trade_datetime:type_name -> google.protobuf.Timestamp
-	51,  // 63: tinkoff.public.invest.api.contract.v1.BrokerReport.price:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 64: tinkoff.public.invest.api.contract.v1.BrokerReport.order_amount:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 65: tinkoff.public.invest.api.contract.v1.BrokerReport.aci_value:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	51,  // 66: tinkoff.public.invest.api.contract.v1.BrokerReport.total_order_amount:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 67: tinkoff.public.invest.api.contract.v1.BrokerReport.broker_commission:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 68: tinkoff.public.invest.api.contract.v1.BrokerReport.exchange_commission:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 69: tinkoff.public.invest.api.contract.v1.BrokerReport.exchange_clearing_commission:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 70: tinkoff.public.invest.api.contract.v1.BrokerReport.repo_rate:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	50,  // 71: tinkoff.public.invest.api.contract.v1.BrokerReport.clear_value_date:type_name -> google.protobuf.Timestamp
-	50,  // 72: tinkoff.public.invest.api.contract.v1.BrokerReport.sec_value_date:type_name -> google.protobuf.Timestamp
-	29,  // 73: tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerRequest.generate_div_foreign_issuer_report:type_name -> tinkoff.public.invest.api.contract.v1.GenerateDividendsForeignIssuerReportRequest
-	30,  // 74: tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerRequest.get_div_foreign_issuer_report:type_name -> tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerReportRequest
-	31,  // 75: tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerResponse.generate_div_foreign_issuer_report_response:type_name -> tinkoff.public.invest.api.contract.v1.GenerateDividendsForeignIssuerReportResponse
-	32,  // 76: tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerResponse.div_foreign_issuer_report:type_name -> tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerReportResponse
-	50,  // 77: tinkoff.public.invest.api.contract.v1.GenerateDividendsForeignIssuerReportRequest.from:type_name -> google.protobuf.Timestamp
-	50,  // 78: tinkoff.public.invest.api.contract.v1.GenerateDividendsForeignIssuerReportRequest.to:type_name -> google.protobuf.Timestamp
-	33,  // 79: tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerReportResponse.dividends_foreign_issuer_report:type_name -> tinkoff.public.invest.api.contract.v1.DividendsForeignIssuerReport
-	50,  // 80: tinkoff.public.invest.api.contract.v1.DividendsForeignIssuerReport.record_date:type_name -> google.protobuf.Timestamp
-	50,  // 81: tinkoff.public.invest.api.contract.v1.DividendsForeignIssuerReport.payment_date:type_name -> google.protobuf.Timestamp
-	52,  // 82: tinkoff.public.invest.api.contract.v1.DividendsForeignIssuerReport.dividend:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	52,  // 83: tinkoff.public.invest.api.contract.v1.DividendsForeignIssuerReport.external_commission:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	52,  // 84: tinkoff.public.invest.api.contract.v1.DividendsForeignIssuerReport.dividend_gross:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	52,  // 85: tinkoff.public.invest.api.contract.v1.DividendsForeignIssuerReport.tax:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	52,  // 86: tinkoff.public.invest.api.contract.v1.DividendsForeignIssuerReport.dividend_amount:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	53,  // 87: tinkoff.public.invest.api.contract.v1.PortfolioStreamRequest.ping_settings:type_name -> tinkoff.public.invest.api.contract.v1.PingDelaySettings
-	36,  // 88: tinkoff.public.invest.api.contract.v1.PortfolioStreamResponse.subscriptions:type_name -> tinkoff.public.invest.api.contract.v1.PortfolioSubscriptionResult
-	10,  // 89: tinkoff.public.invest.api.contract.v1.PortfolioStreamResponse.portfolio:type_name -> tinkoff.public.invest.api.contract.v1.PortfolioResponse
-	54,  // 90: tinkoff.public.invest.api.contract.v1.PortfolioStreamResponse.ping:type_name -> tinkoff.public.invest.api.contract.v1.Ping
-	37,  // 91: tinkoff.public.invest.api.contract.v1.PortfolioSubscriptionResult.accounts:type_name -> tinkoff.public.invest.api.contract.v1.AccountSubscriptionStatus
-	2,   // 92: tinkoff.public.invest.api.contract.v1.AccountSubscriptionStatus.subscription_status:type_name -> tinkoff.public.invest.api.contract.v1.PortfolioSubscriptionStatus
-	50,  // 93: tinkoff.public.invest.api.contract.v1.GetOperationsByCursorRequest.from:type_name -> google.protobuf.Timestamp
-	50,  // 94: tinkoff.public.invest.api.contract.v1.GetOperationsByCursorRequest.to:type_name -> google.protobuf.Timestamp
-	1,   // 95: tinkoff.public.invest.api.contract.v1.GetOperationsByCursorRequest.operation_types:type_name -> tinkoff.public.invest.api.contract.v1.OperationType
-	0,   // 96: tinkoff.public.invest.api.contract.v1.GetOperationsByCursorRequest.state:type_name -> tinkoff.public.invest.api.contract.v1.OperationState
-	40,  // 97: tinkoff.public.invest.api.contract.v1.GetOperationsByCursorResponse.items:type_name -> tinkoff.public.invest.api.contract.v1.OperationItem
-	50,  // 98: tinkoff.public.invest.api.contract.v1.OperationItem.date:type_name -> google.protobuf.Timestamp
-	1,   // 99: tinkoff.public.invest.api.contract.v1.OperationItem.type:type_name -> tinkoff.public.invest.api.contract.v1.OperationType
-	0,   // 100: tinkoff.public.invest.api.contract.v1.OperationItem.state:type_name -> tinkoff.public.invest.api.contract.v1.OperationState
-	55,  // 101: tinkoff.public.invest.api.contract.v1.OperationItem.instrument_kind:type_name -> tinkoff.public.invest.api.contract.v1.InstrumentType
-	51,  // 102: tinkoff.public.invest.api.contract.v1.OperationItem.payment:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 103: tinkoff.public.invest.api.contract.v1.OperationItem.price:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 104: tinkoff.public.invest.api.contract.v1.OperationItem.commission:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 105: tinkoff.public.invest.api.contract.v1.OperationItem.yield:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 106: tinkoff.public.invest.api.contract.v1.OperationItem.yield_relative:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	51,  // 107: tinkoff.public.invest.api.contract.v1.OperationItem.accrued_int:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	50,  // 108: tinkoff.public.invest.api.contract.v1.OperationItem.cancel_date_time:type_name -> google.protobuf.Timestamp
-	41,  // 109: tinkoff.public.invest.api.contract.v1.OperationItem.trades_info:type_name -> tinkoff.public.invest.api.contract.v1.OperationItemTrades
-	49,  // 110: tinkoff.public.invest.api.contract.v1.OperationItem.child_operations:type_name -> tinkoff.public.invest.api.contract.v1.ChildOperationItem
-	42,  // 111: tinkoff.public.invest.api.contract.v1.OperationItemTrades.trades:type_name -> tinkoff.public.invest.api.contract.v1.OperationItemTrade
-	50,  // 112: tinkoff.public.invest.api.contract.v1.OperationItemTrade.date:type_name -> google.protobuf.Timestamp
-	51,  // 113: tinkoff.public.invest.api.contract.v1.OperationItemTrade.price:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 114: tinkoff.public.invest.api.contract.v1.OperationItemTrade.yield:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	52,  // 115: tinkoff.public.invest.api.contract.v1.OperationItemTrade.yield_relative:type_name -> tinkoff.public.invest.api.contract.v1.Quotation
-	53,  // 116: tinkoff.public.invest.api.contract.v1.PositionsStreamRequest.ping_settings:type_name -> tinkoff.public.invest.api.contract.v1.PingDelaySettings
-	45,  // 117: tinkoff.public.invest.api.contract.v1.PositionsStreamResponse.subscriptions:type_name -> tinkoff.public.invest.api.contract.v1.PositionsSubscriptionResult
-	47,  // 118: tinkoff.public.invest.api.contract.v1.PositionsStreamResponse.position:type_name -> tinkoff.public.invest.api.contract.v1.PositionData
-	54,  // 119: tinkoff.public.invest.api.contract.v1.PositionsStreamResponse.ping:type_name -> tinkoff.public.invest.api.contract.v1.Ping
-	12,  // 120: tinkoff.public.invest.api.contract.v1.PositionsStreamResponse.initial_positions:type_name -> tinkoff.public.invest.api.contract.v1.PositionsResponse
-	46,  // 121: tinkoff.public.invest.api.contract.v1.PositionsSubscriptionResult.accounts:type_name -> tinkoff.public.invest.api.contract.v1.PositionsSubscriptionStatus
-	3,   // 122: tinkoff.public.invest.api.contract.v1.PositionsSubscriptionStatus.subscription_status:type_name -> tinkoff.public.invest.api.contract.v1.PositionsAccountSubscriptionStatus
-	48,  // 123: tinkoff.public.invest.api.contract.v1.PositionData.money:type_name -> tinkoff.public.invest.api.contract.v1.PositionsMoney
-	17,  // 124: tinkoff.public.invest.api.contract.v1.PositionData.securities:type_name -> tinkoff.public.invest.api.contract.v1.PositionsSecurities
-	18,  // 125: tinkoff.public.invest.api.contract.v1.PositionData.futures:type_name -> tinkoff.public.invest.api.contract.v1.PositionsFutures
-	19,  // 126: tinkoff.public.invest.api.contract.v1.PositionData.options:type_name -> tinkoff.public.invest.api.contract.v1.PositionsOptions
-	50,  // 127: tinkoff.public.invest.api.contract.v1.PositionData.date:type_name -> google.protobuf.Timestamp
-	51,  // 128: tinkoff.public.invest.api.contract.v1.PositionsMoney.available_value:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 129: tinkoff.public.invest.api.contract.v1.PositionsMoney.blocked_value:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	51,  // 130: tinkoff.public.invest.api.contract.v1.ChildOperationItem.payment:type_name -> tinkoff.public.invest.api.contract.v1.MoneyValue
-	5,   // 131: tinkoff.public.invest.api.contract.v1.OperationsService.GetOperations:input_type -> tinkoff.public.invest.api.contract.v1.OperationsRequest
-	9,   // 132: tinkoff.public.invest.api.contract.v1.OperationsService.GetPortfolio:input_type -> tinkoff.public.invest.api.contract.v1.PortfolioRequest
-	11,  // 133: tinkoff.public.invest.api.contract.v1.OperationsService.GetPositions:input_type -> tinkoff.public.invest.api.contract.v1.PositionsRequest
-	13,  // 134: tinkoff.public.invest.api.contract.v1.OperationsService.GetWithdrawLimits:input_type -> tinkoff.public.invest.api.contract.v1.WithdrawLimitsRequest
-	20,  // 135: tinkoff.public.invest.api.contract.v1.OperationsService.GetBrokerReport:input_type -> tinkoff.public.invest.api.contract.v1.BrokerReportRequest
-	27,  // 136: tinkoff.public.invest.api.contract.v1.OperationsService.GetDividendsForeignIssuer:input_type -> tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerRequest
-	38,  // 137: tinkoff.public.invest.api.contract.v1.OperationsService.GetOperationsByCursor:input_type -> tinkoff.public.invest.api.contract.v1.GetOperationsByCursorRequest
-	34,  // 138: tinkoff.public.invest.api.contract.v1.OperationsStreamService.PortfolioStream:input_type -> tinkoff.public.invest.api.contract.v1.PortfolioStreamRequest
-	43,  // 139: tinkoff.public.invest.api.contract.v1.OperationsStreamService.PositionsStream:input_type -> tinkoff.public.invest.api.contract.v1.PositionsStreamRequest
-	6,   // 140: tinkoff.public.invest.api.contract.v1.OperationsService.GetOperations:output_type -> tinkoff.public.invest.api.contract.v1.OperationsResponse
-	10,  // 141: tinkoff.public.invest.api.contract.v1.OperationsService.GetPortfolio:output_type -> tinkoff.public.invest.api.contract.v1.PortfolioResponse
-	12,  // 142: tinkoff.public.invest.api.contract.v1.OperationsService.GetPositions:output_type -> tinkoff.public.invest.api.contract.v1.PositionsResponse
-	14,  // 143: tinkoff.public.invest.api.contract.v1.OperationsService.GetWithdrawLimits:output_type -> tinkoff.public.invest.api.contract.v1.WithdrawLimitsResponse
-	21,  // 144: tinkoff.public.invest.api.contract.v1.OperationsService.GetBrokerReport:output_type -> tinkoff.public.invest.api.contract.v1.BrokerReportResponse
-	28,  // 145: tinkoff.public.invest.api.contract.v1.OperationsService.GetDividendsForeignIssuer:output_type -> tinkoff.public.invest.api.contract.v1.GetDividendsForeignIssuerResponse
-	39,  // 146: tinkoff.public.invest.api.contract.v1.OperationsService.GetOperationsByCursor:output_type -> tinkoff.public.invest.api.contract.v1.GetOperationsByCursorResponse
-	35,  // 147: tinkoff.public.invest.api.contract.v1.OperationsStreamService.PortfolioStream:output_type -> tinkoff.public.invest.api.contract.v1.PortfolioStreamResponse
-	44,  // 148: tinkoff.public.invest.api.contract.v1.OperationsStreamService.PositionsStream:output_type -> tinkoff.public.invest.api.contract.v1.PositionsStreamResponse
-	140, // [140:149] is the sub-list for method output_type
-	131, // [131:140] is the sub-list for method input_type
-	131, // [131:131] is the sub-list for extension type_name
-	131, // [131:131] is the sub-list for extension extendee
-	0,   // [0:131] is the sub-list for field type_name
-}
-
-func init() { file_adapter_tinkoff_proto_operations_proto_init() }
-func file_adapter_tinkoff_proto_operations_proto_init() {
-	if File_adapter_tinkoff_proto_operations_proto != nil {
-		return
-	}
-	file_adapter_tinkoff_proto_common_proto_init()
-	file_adapter_tinkoff_proto_operations_proto_msgTypes[0].OneofWrappers = []any{}
-	file_adapter_tinkoff_proto_operations_proto_msgTypes[4].OneofWrappers = []any{}
-	file_adapter_tinkoff_proto_operations_proto_msgTypes[15].OneofWrappers = []any{
-		(*BrokerReportRequest_GenerateBrokerReportRequest)(nil),
-		(*BrokerReportRequest_GetBrokerReportRequest)(nil),
-	}
-	file_adapter_tinkoff_proto_operations_proto_msgTypes[16].OneofWrappers = []any{
-		(*BrokerReportResponse_GenerateBrokerReportResponse)(nil),
-		(*BrokerReportResponse_GetBrokerReportResponse)(nil),
-	}
-	file_adapter_tinkoff_proto_operations_proto_msgTypes[19].OneofWrappers = []any{}
-	file_adapter_tinkoff_proto_operations_proto_msgTypes[22].OneofWrappers = []any{
-		(*GetDividendsForeignIssuerRequest_GenerateDivForeignIssuerReport)(nil),
-		(*GetDividendsForeignIssuerRequest_GetDivForeignIssuerReport)(nil),
-	}
-	file_adapter_tinkoff_proto_operations_proto_msgTypes[23].OneofWrappers = []any{
-		(*GetDividendsForeignIssuerResponse_GenerateDivForeignIssuerReportResponse)(nil),
-		(*GetDividendsForeignIssuerResponse_DivForeignIssuerReport)(nil),
-	}
-	file_adapter_tinkoff_proto_operations_proto_msgTypes[25].OneofWrappers = []any{}
-	file_adapter_tinkoff_proto_operations_proto_msgTypes[30].OneofWrappers = []any{
-		(*PortfolioStreamResponse_Subscriptions)(nil),
-		(*PortfolioStreamResponse_Portfolio)(nil),
-		(*PortfolioStreamResponse_Ping)(nil),
-	}
-	file_adapter_tinkoff_proto_operations_proto_msgTypes[33].OneofWrappers = []any{}
-	file_adapter_tinkoff_proto_operations_proto_msgTypes[39].OneofWrappers = []any{
-		(*PositionsStreamResponse_Subscriptions)(nil),
-		(*PositionsStreamResponse_Position)(nil),
-		(*PositionsStreamResponse_Ping)(nil),
-		(*PositionsStreamResponse_InitialPositions)(nil),
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_adapter_tinkoff_proto_operations_proto_rawDesc), len(file_adapter_tinkoff_proto_operations_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   45,
-			NumExtensions: 0,
-			NumServices:   2,
-		},
-		GoTypes:           file_adapter_tinkoff_proto_operations_proto_goTypes,
-		DependencyIndexes: file_adapter_tinkoff_proto_operations_proto_depIdxs,
-		EnumInfos:         file_adapter_tinkoff_proto_operations_proto_enumTypes,
-		MessageInfos:      file_adapter_tinkoff_proto_operations_proto_msgTypes,
-	}.Build()
-	File_adapter_tinkoff_proto_operations_proto = out.File
-	file_adapter_tinkoff_proto_operations_proto_goTypes = nil
-	file_adapter_tinkoff_proto_operations_proto_depIdxs = nil
-}
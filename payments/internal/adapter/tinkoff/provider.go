@@ -1,83 +1,136 @@
 package tinkoffadp
 
 import (
-	"crypto/tls"
 	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/spf13/viper"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
 )
 
+// DefaultBaseURL is the T-Bank acquiring API v2.
+const DefaultBaseURL = "https://securepay.tinkoff.ru/v2"
+
 var (
-	// ErrMissingAPIKey is returned when TINKOFF_API_KEY is not set.
-	ErrMissingAPIKey = errors.New("tinkoff: missing TINKOFF_API_KEY")
-	// ErrMissingCertFile is returned when TINKOFF_CERT_FILE is not set.
-	ErrMissingCertFile = errors.New("tinkoff: missing TINKOFF_CERT_FILE")
-	// ErrMissingKeyFile is returned when TINKOFF_KEY_FILE is not set.
-	ErrMissingKeyFile = errors.New("tinkoff: missing TINKOFF_KEY_FILE")
+	// ErrMissingTerminalKey is returned when TINKOFF_TERMINAL_KEY is not set.
+	ErrMissingTerminalKey = errors.New("tinkoff: missing TINKOFF_TERMINAL_KEY")
+	// ErrMissingPassword is returned when TINKOFF_PASSWORD is not set.
+	ErrMissingPassword = errors.New("tinkoff: missing TINKOFF_PASSWORD")
 )
 
-// Provider implements PaymentProvider interface for Tinkoff.
+// APIError is an error response of the Tinkoff API.
+type APIError struct {
+	StatusCode int // HTTP status of the response
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("tinkoff api error: %s - %s", e.Code, e.Message)
+}
+
+// Provider implements PaymentProvider interface for T-Bank (Tinkoff) internet acquiring.
 type Provider struct {
-	client   *http.Client
-	apiKey   string
-	baseURL  string
-	certFile string
-	keyFile  string
+	client          *http.Client
+	baseURL         string
+	terminalKey     string
+	password        string
+	notificationURL string
+	reportDir       string // settlement reports, one <payout ID>.csv per payment order
+}
+
+// Option configures a Provider.
+type Option func(*Provider)
+
+// WithHTTPClient sets the HTTP client; it defaults to one with a 30s timeout.
+func WithHTTPClient(client *http.Client) Option {
+	return func(p *Provider) { p.client = client }
+}
+
+// WithBaseURL points the provider at another API, e.g. a test server. It defaults to DefaultBaseURL.
+func WithBaseURL(baseURL string) Option {
+	return func(p *Provider) { p.baseURL = baseURL }
+}
+
+// WithNotificationURL sets where T-Bank sends payment notifications; empty uses the terminal settings.
+func WithNotificationURL(url string) Option {
+	return func(p *Provider) { p.notificationURL = url }
+}
+
+// WithReportDir reads settlement reports from dir; without it payouts cannot be listed.
+func WithReportDir(dir string) Option {
+	return func(p *Provider) { p.reportDir = dir }
+}
+
+// NewClient creates a provider for the terminal; password signs its requests.
+func NewClient(terminalKey, password string, opts ...Option) *Provider {
+	p := &Provider{
+		client:      &http.Client{Timeout: 30 * time.Second},
+		baseURL:     DefaultBaseURL,
+		terminalKey: terminalKey,
+		password:    password,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // New creates a Tinkoff client using environment variables.
 // Required environment variables:
-// - TINKOFF_API_KEY: API key for authentication
-// - TINKOFF_CERT_FILE: Path to client certificate file (.pem)
-// - TINKOFF_KEY_FILE: Path to client private key file (.key)
+// - TINKOFF_TERMINAL_KEY: terminal key from the merchant account
+// - TINKOFF_PASSWORD: terminal password, signing requests
 // Optional:
-// - TINKOFF_BASE_URL: Base URL (defaults to https://secured-openapi.tbank.ru)
+// - TINKOFF_BASE_URL: Base URL (defaults to https://securepay.tinkoff.ru/v2)
+// - TINKOFF_NOTIFICATION_URL: URL of payment notifications (defaults to the terminal settings)
+// - TINKOFF_REPORT_DIR: directory of the settlement reports (no settlement import if unset)
 func New() (*Provider, error) {
 	viper.AutomaticEnv()
 
-	apiKey := viper.GetString("TINKOFF_API_KEY")
-	if apiKey == "" {
-		return nil, ErrMissingAPIKey
+	terminalKey := viper.GetString("TINKOFF_TERMINAL_KEY")
+	if terminalKey == "" {
+		return nil, ErrMissingTerminalKey
 	}
 
-	certFile := viper.GetString("TINKOFF_CERT_FILE")
-	if certFile == "" {
-		return nil, ErrMissingCertFile
+	password := viper.GetString("TINKOFF_PASSWORD")
+	if password == "" {
+		return nil, ErrMissingPassword
 	}
 
-	keyFile := viper.GetString("TINKOFF_KEY_FILE")
-	if keyFile == "" {
-		return nil, ErrMissingKeyFile
+	opts := []Option{
+		WithNotificationURL(viper.GetString("TINKOFF_NOTIFICATION_URL")),
+		WithReportDir(viper.GetString("TINKOFF_REPORT_DIR")),
 	}
-
-	baseURL := viper.GetString("TINKOFF_BASE_URL")
-	if baseURL == "" {
-		baseURL = "https://secured-openapi.tbank.ru"
+	if baseURL := viper.GetString("TINKOFF_BASE_URL"); baseURL != "" {
+		opts = append(opts, WithBaseURL(baseURL))
 	}
 
-	// Load client certificate
-	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
-	if err != nil {
-		return nil, err
-	}
+	return NewClient(terminalKey, password, opts...), nil
+}
 
-	// Configure TLS transport
-	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			Certificates: []tls.Certificate{cert},
+// Capabilities implements ports.PaymentProvider. Terminals charge RUB only. A hold is
+// confirmed once, so the remainder of a partial capture is released; refunds go through
+// Cancel and may be repeated until the payment is refunded in full.
+func (p *Provider) Capabilities() ports.Capabilities {
+	return ports.Capabilities{
+		Currencies: []string{"RUB"},
+		CaptureModes: []eventv1.CaptureMode{
+			eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE,
+			eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
 		},
+		Refund:        true,
+		PartialRefund: true,
 	}
+}
 
-	client := &http.Client{
-		Transport: transport,
-	}
-
-	return &Provider{
-		client:   client,
-		apiKey:   apiKey,
-		baseURL:  baseURL,
-		certFile: certFile,
-		keyFile:  keyFile,
-	}, nil
+// Retryable reports whether a Tinkoff API error may succeed when the call is repeated:
+// rate limiting (429) and Tinkoff-side failures (5xx).
+func (p *Provider) Retryable(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError)
 }
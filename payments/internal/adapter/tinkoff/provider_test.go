@@ -0,0 +1,240 @@
+package tinkoffadp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+)
+
+const (
+	terminalKey = "TinkoffBankTest"
+	password    = "usaf8fw8fsw21g"
+)
+
+func rub(units int64, nanos int32) *money.Money {
+	return &money.Money{CurrencyCode: "RUB", Units: units, Nanos: nanos}
+}
+
+// acquiring is an httptest stand-in for the acquiring API: it checks the request token
+// and answers each method with a canned body.
+type acquiring struct {
+	t         *testing.T
+	responses map[string]string   // method → JSON response
+	requests  map[string]map[string]any
+	status    int // HTTP status of every response; 0 → 200
+}
+
+func (a *acquiring) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	method := strings.TrimPrefix(r.URL.Path, "/v2/")
+
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	var params map[string]any
+	require.NoError(a.t, dec.Decode(&params))
+	require.Equal(a.t, terminalKey, params["TerminalKey"])
+	require.Equal(a.t, token(params, password), params["Token"], "request token of %s", method)
+	a.requests[method] = params
+
+	if a.status != 0 {
+		w.WriteHeader(a.status)
+		return
+	}
+	body, ok := a.responses[method]
+	require.True(a.t, ok, "unexpected method %s", method)
+	_, _ = w.Write([]byte(body))
+}
+
+func newProvider(t *testing.T, responses map[string]string) (*Provider, *acquiring) {
+	t.Helper()
+
+	api := &acquiring{t: t, responses: responses, requests: make(map[string]map[string]any)}
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+	return NewClient(terminalKey, password, WithBaseURL(srv.URL+"/v2"), WithHTTPClient(srv.Client())), api
+}
+
+func TestToken(t *testing.T) {
+	// The example of the T-Bank documentation: nested objects are left out, the rest is sorted by key.
+	params := map[string]any{
+		"TerminalKey": terminalKey,
+		"Amount":      json.Number("19200"),
+		"OrderId":     "21090",
+		"Description": "Подарочная карта на 1000 рублей",
+		"DATA":        map[string]any{"Phone": "+71234567890"},
+		"Token":       "ignored",
+	}
+	sum := sha256.Sum256([]byte("19200" + "Подарочная карта на 1000 рублей" + "21090" + password + terminalKey))
+
+	require.Equal(t, hex.EncodeToString(sum[:]), token(params, password))
+}
+
+func TestProvider_CreatePayment(t *testing.T) {
+	p, api := newProvider(t, map[string]string{
+		"Init": `{"Success":true,"ErrorCode":"0","TerminalKey":"TinkoffBankTest","Status":"NEW","PaymentId":"3093639567",
+			"OrderId":"21090","Amount":150050,"PaymentURL":"https://securepay.tinkoff.ru/new/fU1ppgqa"}`,
+	})
+	id := uuid.New()
+
+	out, err := p.CreatePayment(context.Background(), ports.CreatePaymentIn{
+		PaymentID:     id,
+		Amount:        rub(1500, 500_000_000),
+		Currency:      "RUB",
+		CaptureManual: true,
+		Metadata:      map[string]string{"payment_id": id.String()},
+		ReturnURL:     "https://shop.example/return",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ports.CreatePaymentOut{
+		Provider:     ports.ProviderTinkoff,
+		ProviderID:   "3093639567",
+		ClientSecret: "https://securepay.tinkoff.ru/new/fU1ppgqa",
+		Status:       ports.ProviderStatusRequiresAction,
+	}, out)
+
+	req := api.requests["Init"]
+	require.Equal(t, json.Number("150050"), req["Amount"])
+	require.Equal(t, id.String(), req["OrderId"])
+	require.Equal(t, "T", req["PayType"], "manual capture is two-stage")
+	require.Equal(t, map[string]any{"payment_id": id.String()}, req["DATA"])
+}
+
+func TestProvider_GetPayment(t *testing.T) {
+	tests := []struct {
+		status string
+		want   ports.GetPaymentOut
+	}{
+		{status: "NEW", want: ports.GetPaymentOut{Status: ports.ProviderStatusRequiresAction}},
+		{status: "FORM_SHOWED", want: ports.GetPaymentOut{Status: ports.ProviderStatusRequiresAction}},
+		{status: "AUTHORIZED", want: ports.GetPaymentOut{Status: ports.ProviderStatusRequiresCapture, Authorized: rub(1000, 0)}},
+		{status: "CONFIRMED", want: ports.GetPaymentOut{Status: ports.ProviderStatusSucceeded, Captured: rub(1000, 0)}},
+		{status: "REJECTED", want: ports.GetPaymentOut{Status: ports.ProviderStatusFailed}},
+		{status: "REFUNDED", want: ports.GetPaymentOut{Status: ports.ProviderStatusSucceeded, Captured: rub(1000, 0)}},
+		{status: "REVERSED", want: ports.GetPaymentOut{Status: ports.ProviderStatusCanceled}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			// PaymentId comes back as a number from GetState.
+			p, api := newProvider(t, map[string]string{
+				"GetState": `{"Success":true,"ErrorCode":"0","Status":"` + tt.status + `","PaymentId":13660,"Amount":100000}`,
+			})
+
+			out, err := p.GetPayment(context.Background(), ports.GetPaymentIn{ProviderID: "13660"})
+			require.NoError(t, err)
+			tt.want.Provider = ports.ProviderTinkoff
+			require.Equal(t, tt.want, out)
+			require.Equal(t, "13660", api.requests["GetState"]["PaymentId"])
+		})
+	}
+}
+
+func TestProvider_CaptureRefundCancel(t *testing.T) {
+	ctx := context.Background()
+	p, api := newProvider(t, map[string]string{
+		"Confirm": `{"Success":true,"ErrorCode":"0","Status":"CONFIRMED","PaymentId":"13660"}`,
+		"Cancel":  `{"Success":true,"ErrorCode":"0","Status":"PARTIAL_REFUNDED","PaymentId":"13660","OriginalAmount":100000,"NewAmount":70000}`,
+	})
+
+	captured, err := p.CapturePayment(ctx, ports.CapturePaymentIn{ProviderID: "13660", Amount: rub(1000, 0), Currency: "RUB"})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStatusSucceeded, captured.Status)
+	require.Equal(t, rub(1000, 0), captured.Captured)
+	require.Equal(t, json.Number("100000"), api.requests["Confirm"]["Amount"])
+
+	refunded, err := p.RefundPayment(ctx, ports.RefundPaymentIn{ProviderID: "13660", Amount: rub(300, 0), Currency: "RUB", IdempotencyKey: "k1"})
+	require.NoError(t, err)
+	require.Equal(t, ports.RefundPaymentOut{
+		Provider: ports.ProviderTinkoff,
+		RefundID: "13660-70000",
+		Status:   ports.ProviderStatusSucceeded,
+		Amount:   rub(300, 0),
+	}, refunded)
+	require.Equal(t, "k1", api.requests["Cancel"]["ExternalRequestId"])
+
+	api.responses["Cancel"] = `{"Success":true,"ErrorCode":"0","Status":"REVERSED","PaymentId":"13661","OriginalAmount":5000,"NewAmount":0}`
+	canceled, err := p.CancelPayment(ctx, ports.CancelPaymentIn{ProviderID: "13661"})
+	require.NoError(t, err)
+	require.Equal(t, ports.ProviderStatusCanceled, canceled.Status)
+	require.NotContains(t, api.requests["Cancel"], "Amount", "the whole hold is released")
+}
+
+func TestProvider_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("declined", func(t *testing.T) {
+		p, _ := newProvider(t, map[string]string{
+			"Confirm": `{"Success":false,"ErrorCode":"9","Message":"Неверный статус транзакции.","Details":"Payment is not authorized"}`,
+		})
+		_, err := p.CapturePayment(ctx, ports.CapturePaymentIn{ProviderID: "13660", Amount: rub(10, 0)})
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		require.Equal(t, "9", apiErr.Code)
+		require.False(t, p.Retryable(err))
+	})
+
+	t.Run("outage", func(t *testing.T) {
+		p, api := newProvider(t, nil)
+		api.status = http.StatusServiceUnavailable
+		_, err := p.GetPayment(ctx, ports.GetPaymentIn{ProviderID: "13660"})
+		require.True(t, p.Retryable(err))
+	})
+}
+
+func TestProvider_ListSettlement(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	order := uuid.New()
+	report := "Date;PaymentId;OrderId;Operation;Amount;Fee;Net\n" +
+		"2026-10-16T09:00:00+03:00;13660;" + order.String() + ";PAYMENT;50000;1250;48750\n" +
+		"2026-10-16T12:00:00+03:00;13660;" + order.String() + ";REFUND;-30000;0;-30000\n" +
+		"2026-10-16T12:00:00+03:00;;;PAYOUT;0;0;-18750\n" +
+		"2026-10-16T13:00:00+03:00;13661;not-ours;PAYMENT;1000;25;975\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "4521.csv"), []byte(report), 0o600))
+
+	p, _ := newProvider(t, nil)
+	_, err := p.ListSettlement(ctx, ports.ListSettlementIn{PayoutID: "4521"})
+	require.ErrorIs(t, err, ports.ErrUnsupportedOperation, "no report directory")
+
+	p.reportDir = dir
+	out, err := p.ListSettlement(ctx, ports.ListSettlementIn{PayoutID: "4521"})
+	require.NoError(t, err)
+	require.Equal(t, 1, out.Skipped)
+	require.Len(t, out.Entries, 3)
+	require.Equal(t, ports.SettlementEntry{
+		TransactionID: "13660:payment:4521:1",
+		PaymentID:     order,
+		ProviderID:    "13660",
+		Type:          eventv1.SettlementType_SETTLEMENT_TYPE_CHARGE,
+		Amount:        rub(500, 0),
+		Gross:         rub(500, 0),
+		Fee:           rub(12, 500_000_000),
+		Net:           rub(487, 500_000_000),
+		SettledAt:     time.Date(2026, 10, 16, 6, 0, 0, 0, time.UTC),
+	}, out.Entries[0])
+	require.Equal(t, eventv1.SettlementType_SETTLEMENT_TYPE_REFUND, out.Entries[1].Type)
+	require.Equal(t, rub(-300, 0), out.Entries[1].Amount)
+	require.Equal(t, uuid.Nil, out.Entries[2].PaymentID, "an order of another integration")
+
+	_, err = p.ListSettlement(ctx, ports.ListSettlementIn{PayoutID: "../4521"})
+	require.ErrorIs(t, err, ErrInvalidPayoutID)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "4522.csv"), []byte("PaymentId;Amount\n13660;100\n"), 0o600))
+	_, err = p.ListSettlement(ctx, ports.ListSettlementIn{PayoutID: "4522"})
+	require.ErrorIs(t, err, ErrMalformedReport)
+}
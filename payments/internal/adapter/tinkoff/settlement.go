@@ -0,0 +1,137 @@
+package tinkoffadp
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/dto"
+)
+
+var (
+	// ErrInvalidPayoutID is returned for a payout ID that cannot name a report file.
+	ErrInvalidPayoutID = errors.New("tinkoff: invalid payout ID")
+	// ErrMalformedReport is returned for a settlement report that cannot be read.
+	ErrMalformedReport = errors.New("tinkoff: malformed settlement report")
+)
+
+// reportColumns are the columns a settlement report must have, in any order.
+var reportColumns = []string{"PaymentId", "OrderId", "Operation", "Amount", "Fee", "Net", "Date"}
+
+var _ ports.SettlementLister = (*Provider)(nil)
+
+// ListSettlement reads the settlement report of a payout: T-Bank delivers one registry per
+// payment order, stored as <report dir>/<payout ID>.csv.
+func (p *Provider) ListSettlement(ctx context.Context, in ports.ListSettlementIn) (ports.ListSettlementOut, error) {
+	_ = ctx
+	if p.reportDir == "" {
+		return ports.ListSettlementOut{}, fmt.Errorf("%w: no settlement report directory", ports.ErrUnsupportedOperation)
+	}
+	if in.PayoutID == "" || filepath.Base(in.PayoutID) != in.PayoutID || strings.HasPrefix(in.PayoutID, ".") {
+		return ports.ListSettlementOut{}, fmt.Errorf("%w: %q", ErrInvalidPayoutID, in.PayoutID)
+	}
+
+	f, err := os.Open(filepath.Join(p.reportDir, in.PayoutID+".csv"))
+	if err != nil {
+		return ports.ListSettlementOut{}, fmt.Errorf("open settlement report: %w", err)
+	}
+	defer f.Close()
+
+	return readReport(in.PayoutID, f)
+}
+
+// readReport reads a semicolon-separated report with a header row. Amounts are in kopecks and
+// signed: negative when funds went back, e.g. a refund. T-Bank has no transaction IDs; an entry
+// is named by its payment, operation, payout and occurrence, stable as long as the report is.
+func readReport(payoutID string, r io.Reader) (ports.ListSettlementOut, error) {
+	cr := csv.NewReader(r)
+	cr.Comma = ';'
+
+	header, err := cr.Read()
+	if err != nil {
+		return ports.ListSettlementOut{}, fmt.Errorf("%w: header: %w", ErrMalformedReport, err)
+	}
+	col := make(map[string]int, len(header))
+	for i, name := range header {
+		col[strings.TrimSpace(name)] = i
+	}
+	for _, name := range reportColumns {
+		if _, ok := col[name]; !ok {
+			return ports.ListSettlementOut{}, fmt.Errorf("%w: no %s column", ErrMalformedReport, name)
+		}
+	}
+
+	out := ports.ListSettlementOut{Provider: ports.ProviderTinkoff, PayoutID: payoutID}
+	seen := make(map[string]int)
+	for line := 2; ; line++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return ports.ListSettlementOut{}, fmt.Errorf("%w: %w", ErrMalformedReport, err)
+		}
+		field := func(name string) string { return strings.TrimSpace(rec[col[name]]) }
+
+		typ, ok := operationType(field("Operation"))
+		if !ok || field("PaymentId") == "" {
+			out.Skipped++
+			continue
+		}
+
+		var amounts [3]int64
+		for i, name := range []string{"Amount", "Fee", "Net"} {
+			if amounts[i], err = strconv.ParseInt(field(name), 10, 64); err != nil {
+				return ports.ListSettlementOut{}, fmt.Errorf("%w: line %d: %s: %w", ErrMalformedReport, line, name, err)
+			}
+		}
+		date, err := time.Parse(time.RFC3339, field("Date"))
+		if err != nil {
+			return ports.ListSettlementOut{}, fmt.Errorf("%w: line %d: Date: %w", ErrMalformedReport, line, err)
+		}
+
+		key := field("PaymentId") + ":" + strings.ToLower(field("Operation")) + ":" + payoutID
+		seen[key]++
+		id, _ := uuid.Parse(field("OrderId")) // orders of other integrations are no payments of ours
+
+		// Terminals settle in RUB, the currency they charge in.
+		out.Entries = append(out.Entries, ports.SettlementEntry{
+			TransactionID: key + ":" + strconv.Itoa(seen[key]),
+			PaymentID:     id,
+			ProviderID:    field("PaymentId"),
+			Type:          typ,
+			Amount:        dto.FromMinorTinkoff("RUB", amounts[0]),
+			Gross:         dto.FromMinorTinkoff("RUB", amounts[0]),
+			Fee:           dto.FromMinorTinkoff("RUB", amounts[1]),
+			Net:           dto.FromMinorTinkoff("RUB", amounts[2]),
+			SettledAt:     date.UTC(),
+		})
+	}
+}
+
+// operationType maps a report operation; anything else, e.g. the payment order itself, is skipped.
+func operationType(op string) (eventv1.SettlementType, bool) {
+	switch strings.ToUpper(op) {
+	case "PAYMENT":
+		return eventv1.SettlementType_SETTLEMENT_TYPE_CHARGE, true
+	case "REFUND":
+		return eventv1.SettlementType_SETTLEMENT_TYPE_REFUND, true
+	case "CHARGEBACK":
+		return eventv1.SettlementType_SETTLEMENT_TYPE_DISPUTE, true
+	case "ADJUSTMENT":
+		return eventv1.SettlementType_SETTLEMENT_TYPE_ADJUSTMENT, true
+	default:
+		return eventv1.SettlementType_SETTLEMENT_TYPE_UNSPECIFIED, false
+	}
+}
@@ -0,0 +1,53 @@
+// Package deadline expires payments that stay too long in an open state.
+//
+// A deadline is planned by the repository whenever a payment is saved: entering CREATED,
+// WAITING_FOR_CONFIRMATION or AUTHORIZED stores one in the same transaction as the events,
+// leaving the state drops it. The Scheduler fires due deadlines:
+//
+//	CREATED                  -> Cancel(SYSTEM)             abandoned checkout
+//	WAITING_FOR_CONFIRMATION -> Fail(SCA_NOT_COMPLETED)    SCA/3DS challenge timed out
+//	AUTHORIZED               -> Fail(AUTH_EXPIRED)         provider released the hold
+package deadline
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+var (
+	// ErrDeadlineNotFound is returned by a Store when the payment has no deadline.
+	ErrDeadlineNotFound = errors.New("deadline: not found")
+	// ErrNotVoided is returned when the provider did not cancel an expired payment.
+	ErrNotVoided = errors.New("deadline: provider did not void the payment")
+)
+
+// Deadline expires a payment that is still in State at DueAt.
+type Deadline struct {
+	PaymentID uuid.UUID
+	State     flowv1.PaymentFlow // state the deadline guards; any later transition cancels it
+	DueAt     time.Time
+}
+
+// Planner decides the deadline of a payment in its current state.
+// Repositories call it on every Save; false means the state has no deadline.
+type Planner interface {
+	Plan(p *payment.Payment, now time.Time) (Deadline, bool)
+}
+
+// Store persists deadlines. At most one deadline exists per payment.
+// Repositories that own the payments schema implement it and keep it in sync on Save:
+// a deadline for the same state keeps its original DueAt, another state replaces it.
+type Store interface {
+	// Due returns deadlines with DueAt <= now, earliest first.
+	Due(ctx context.Context, now time.Time, limit int) ([]Deadline, error)
+	// Postpone moves the deadline of paymentID to next, e.g. after a failed attempt.
+	Postpone(ctx context.Context, paymentID uuid.UUID, next time.Time) error
+	// Remove drops the deadline of paymentID if it still guards state.
+	Remove(ctx context.Context, paymentID uuid.UUID, state flowv1.PaymentFlow) error
+}
@@ -0,0 +1,81 @@
+package deadline
+
+import (
+	"time"
+
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+// Windows are the expiry windows of the open states. Zero disables the deadline.
+type Windows struct {
+	Created    time.Duration // CREATED: checkout abandoned
+	SCA        time.Duration // WAITING_FOR_CONFIRMATION: challenge not completed
+	Authorized time.Duration // AUTHORIZED: hold released by the provider
+}
+
+// Scope selects payments an override applies to. Empty fields match everything.
+type Scope struct {
+	Provider string // e.g. "stripe"
+	Mode     eventv1.CaptureMode
+}
+
+// Policy plans deadlines from configurable windows.
+// The most specific override wins: provider and mode, then provider, then mode, then Default.
+type Policy struct {
+	Default   Windows
+	Overrides map[Scope]Windows
+}
+
+// DefaultPolicy follows the Stripe defaults: an uncaptured card authorization is released
+// after 7 days, a PaymentIntent waiting for 3DS is abandoned within a day.
+var DefaultPolicy = Policy{
+	Default: Windows{
+		Created:    24 * time.Hour,
+		SCA:        time.Hour,
+		Authorized: 7 * 24 * time.Hour,
+	},
+}
+
+var _ Planner = Policy{}
+
+// For returns the windows of payments made through provider in mode.
+func (p Policy) For(provider string, mode eventv1.CaptureMode) Windows {
+	for _, scope := range []Scope{
+		{Provider: provider, Mode: mode},
+		{Provider: provider},
+		{Mode: mode},
+	} {
+		if w, ok := p.Overrides[scope]; ok {
+			return w
+		}
+	}
+	return p.Default
+}
+
+// Plan implements Planner.
+func (p Policy) Plan(agg *payment.Payment, now time.Time) (Deadline, bool) {
+	w := p.For(agg.Provider(), agg.CaptureMode())
+
+	var window time.Duration
+	switch agg.State() {
+	case flowv1.PaymentFlow_PAYMENT_FLOW_CREATED:
+		window = w.Created
+	case flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION:
+		window = w.SCA
+	case flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED:
+		window = w.Authorized
+	default:
+		return Deadline{}, false
+	}
+	if window <= 0 {
+		return Deadline{}, false
+	}
+
+	return Deadline{
+		PaymentID: agg.ID(),
+		State:     agg.State(),
+		DueAt:     now.Add(window),
+	}, true
+}
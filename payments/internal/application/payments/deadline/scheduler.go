@@ -0,0 +1,206 @@
+package deadline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shortlink-org/go-sdk/logger"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+const (
+	defaultBatchSize  = 100
+	defaultInterval   = 10 * time.Second
+	defaultRetryDelay = time.Minute
+)
+
+// Scheduler fires due deadlines.
+//
+// Firing is safe to repeat: the payment is reloaded and a deadline whose state was left in
+// the meantime is dropped. Several schedulers may run; the loser of a race gets a version
+// conflict and retries after the retry delay, when the deadline is already gone.
+type Scheduler struct {
+	log      logger.Logger
+	store    Store
+	repo     repository.PaymentRepository
+	provider ports.PaymentProvider
+
+	batchSize  int
+	interval   time.Duration
+	retryDelay time.Duration
+	now        func() time.Time
+}
+
+type SchedulerOption func(*Scheduler)
+
+// WithProvider voids expired CREATED and WAITING_FOR_CONFIRMATION payments at the provider
+// before recording them, so a late customer cannot complete them.
+func WithProvider(provider ports.PaymentProvider) SchedulerOption {
+	return func(s *Scheduler) { s.provider = provider }
+}
+
+// WithBatchSize limits how many deadlines a single Fire reads.
+func WithBatchSize(n int) SchedulerOption {
+	return func(s *Scheduler) { s.batchSize = n }
+}
+
+// WithInterval sets the polling interval of Run.
+func WithInterval(d time.Duration) SchedulerOption {
+	return func(s *Scheduler) { s.interval = d }
+}
+
+// WithRetryDelay sets how long a deadline that failed to fire waits for the next attempt.
+func WithRetryDelay(d time.Duration) SchedulerOption {
+	return func(s *Scheduler) { s.retryDelay = d }
+}
+
+// WithClock replaces time.Now, mainly for tests.
+func WithClock(now func() time.Time) SchedulerOption {
+	return func(s *Scheduler) { s.now = now }
+}
+
+// NewScheduler wires a scheduler over the deadline store and the payment repository.
+func NewScheduler(log logger.Logger, store Store, repo repository.PaymentRepository, opts ...SchedulerOption) *Scheduler {
+	s := &Scheduler{
+		log:        log,
+		store:      store,
+		repo:       repo,
+		batchSize:  defaultBatchSize,
+		interval:   defaultInterval,
+		retryDelay: defaultRetryDelay,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Run fires due deadlines every interval until ctx is canceled.
+func (s *Scheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Fire(ctx); err != nil && ctx.Err() == nil {
+			// Store is unavailable; try again on the next tick.
+			s.log.ErrorWithContext(ctx, "deadline scheduler: fire failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Fire expires the payments of one batch of due deadlines and returns how many expired.
+// A deadline that fails is postponed by the retry delay; the others still fire.
+func (s *Scheduler) Fire(ctx context.Context) (int, error) {
+	due, err := s.store.Due(ctx, s.now(), s.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("deadline: read due: %w", err)
+	}
+
+	expired := 0
+	for _, d := range due {
+		fired, err := s.fire(ctx, d)
+		if err != nil {
+			s.log.WarnWithContext(ctx, "deadline scheduler: fire failed, postponed",
+				"payment_id", d.PaymentID.String(), "state", d.State.String(), "error", err)
+			err := s.store.Postpone(ctx, d.PaymentID, s.now().Add(s.retryDelay))
+			if err != nil && !errors.Is(err, ErrDeadlineNotFound) {
+				return expired, fmt.Errorf("deadline: postpone: %w", err)
+			}
+			continue
+		}
+		if fired {
+			expired++
+		}
+	}
+
+	return expired, nil
+}
+
+// fire expires the payment of d. Saving the payment drops the deadline with it.
+func (s *Scheduler) fire(ctx context.Context, d Deadline) (bool, error) {
+	agg, err := s.repo.Load(ctx, d.PaymentID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return false, s.remove(ctx, d)
+	}
+	if err != nil {
+		return false, fmt.Errorf("load payment: %w", err)
+	}
+	expectedVersion := agg.Version()
+
+	// The payment moved on; the deadline should have been dropped with that save.
+	if agg.State() != d.State {
+		return false, s.remove(ctx, d)
+	}
+
+	if err := s.void(ctx, agg, expectedVersion); err != nil {
+		return false, err
+	}
+
+	switch d.State {
+	case flowv1.PaymentFlow_PAYMENT_FLOW_CREATED:
+		err = agg.Cancel(ctx, eventv1.CancelReason_CANCEL_REASON_SYSTEM)
+	case flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION:
+		err = agg.Fail(ctx, eventv1.FailureReason_FAILURE_REASON_SCA_NOT_COMPLETED)
+	case flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED:
+		err = agg.Fail(ctx, eventv1.FailureReason_FAILURE_REASON_AUTH_EXPIRED)
+	default:
+		return false, s.remove(ctx, d)
+	}
+	if err != nil {
+		return false, fmt.Errorf("expire %s: %w", d.State, err)
+	}
+
+	if err := agg.Invariants(); err != nil {
+		return false, fmt.Errorf("domain invariants violated: %w", err)
+	}
+	if err := s.repo.Save(ctx, agg, expectedVersion); err != nil {
+		return false, fmt.Errorf("save expired payment: %w", err)
+	}
+
+	return true, nil
+}
+
+// void cancels a payment the customer could still complete at the provider.
+// An authorization that expired has already been released by the provider.
+func (s *Scheduler) void(ctx context.Context, agg *payment.Payment, expectedVersion uint64) error {
+	if s.provider == nil || agg.ProviderID() == "" || agg.State() == flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED {
+		return nil
+	}
+
+	out, err := s.provider.CancelPayment(ctx, ports.CancelPaymentIn{
+		PaymentID:      agg.ID(),
+		Provider:       ports.Provider(agg.Provider()),
+		ProviderID:     agg.ProviderID(),
+		Reason:         "system",
+		IdempotencyKey: fmt.Sprintf("%s:expire:%d", agg.ID(), expectedVersion),
+	})
+	if err != nil {
+		return fmt.Errorf("provider cancel: %w", err)
+	}
+	// E.g. the customer completed the payment just now; its webhook moves the payment on.
+	if out.Status != ports.ProviderStatusCanceled {
+		return fmt.Errorf("%w: provider status %d", ErrNotVoided, out.Status)
+	}
+	return nil
+}
+
+func (s *Scheduler) remove(ctx context.Context, d Deadline) error {
+	if err := s.store.Remove(ctx, d.PaymentID, d.State); err != nil && !errors.Is(err, ErrDeadlineNotFound) {
+		return fmt.Errorf("remove stale deadline: %w", err)
+	}
+	return nil
+}
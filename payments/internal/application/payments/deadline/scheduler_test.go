@@ -0,0 +1,255 @@
+package deadline_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shortlink-org/go-sdk/logger"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/deadline"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+)
+
+var amount = &money.Money{CurrencyCode: "EUR", Units: 40}
+
+// clock is a fake time source shared by the repository and the scheduler.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+var policy = deadline.Policy{
+	Default: deadline.DefaultPolicy.Default,
+	Overrides: map[deadline.Scope]deadline.Windows{
+		{Provider: "tinkoff"}: {Created: time.Hour, SCA: 15 * time.Minute, Authorized: 72 * time.Hour},
+	},
+}
+
+func setup(t *testing.T, opts ...deadline.SchedulerOption) (*deadline.Scheduler, *memory.InMemory, *clock) {
+	t.Helper()
+
+	log, err := logger.New(logger.Configuration{Writer: io.Discard})
+	require.NoError(t, err)
+
+	clk := &clock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	repo := memory.New(memory.WithDeadlines(policy), memory.WithClock(clk.Now))
+	s := deadline.NewScheduler(log, repo, repo, append(opts, deadline.WithClock(clk.Now))...)
+	return s, repo, clk
+}
+
+func stored(t *testing.T, repo *memory.InMemory, provider string, steps ...func(*payment.Payment) error) *payment.Payment {
+	t.Helper()
+	ctx := context.Background()
+
+	p, err := payment.New(uuid.New(), uuid.New(), amount,
+		eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+	require.NoError(t, err)
+	if provider != "" {
+		require.NoError(t, p.AttachProvider(ctx, provider, "pi_1"))
+	}
+	for _, step := range steps {
+		require.NoError(t, step(p))
+	}
+	require.NoError(t, repo.Save(ctx, p, 0))
+	return p
+}
+
+func authorize(p *payment.Payment) error { return p.Authorize(context.Background(), amount) }
+
+func requireSCA(p *payment.Payment) error { return p.RequireSCA(context.Background()) }
+
+func lastEvent(t *testing.T, repo *memory.InMemory, msg proto.Message) {
+	t.Helper()
+
+	pending, err := repo.Pending(context.Background(), time.Now().Add(time.Hour), 1000)
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(pending[len(pending)-1].Payload, msg))
+}
+
+func TestScheduler_Fire(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("abandoned payment is voided and canceled", func(t *testing.T) {
+		provider := mocks.NewMockPaymentProvider(t)
+		s, repo, clk := setup(t, deadline.WithProvider(provider))
+		p := stored(t, repo, "stripe")
+
+		clk.Advance(23 * time.Hour)
+		n, err := s.Fire(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n)
+
+		provider.EXPECT().CancelPayment(mock.Anything, mock.MatchedBy(func(in ports.CancelPaymentIn) bool {
+			return in.ProviderID == "pi_1" && in.Reason == "system" && in.IdempotencyKey != ""
+		})).Return(ports.CancelPaymentOut{Status: ports.ProviderStatusCanceled}, nil).Once()
+
+		clk.Advance(time.Hour)
+		n, err = s.Fire(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_CANCELED, got.State())
+
+		evt := &eventv1.PaymentCanceled{}
+		lastEvent(t, repo, evt)
+		require.Equal(t, eventv1.CancelReason_CANCEL_REASON_SYSTEM, evt.GetReason())
+
+		due, err := repo.Due(ctx, clk.Now().Add(30*24*time.Hour), 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+	})
+
+	t.Run("SCA timeout uses the provider window", func(t *testing.T) {
+		provider := mocks.NewMockPaymentProvider(t)
+		s, repo, clk := setup(t, deadline.WithProvider(provider))
+		p := stored(t, repo, "tinkoff", requireSCA)
+
+		provider.EXPECT().CancelPayment(mock.Anything, mock.Anything).
+			Return(ports.CancelPaymentOut{Status: ports.ProviderStatusCanceled}, nil).Once()
+
+		clk.Advance(15 * time.Minute)
+		n, err := s.Fire(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_FAILED, got.State())
+
+		evt := &eventv1.PaymentFailed{}
+		lastEvent(t, repo, evt)
+		require.Equal(t, eventv1.FailureReason_FAILURE_REASON_SCA_NOT_COMPLETED, evt.GetReason())
+	})
+
+	t.Run("authorization expires without a provider call", func(t *testing.T) {
+		s, repo, clk := setup(t, deadline.WithProvider(mocks.NewMockPaymentProvider(t)))
+		p := stored(t, repo, "stripe", authorize)
+
+		clk.Advance(7 * 24 * time.Hour)
+		n, err := s.Fire(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		evt := &eventv1.PaymentFailed{}
+		lastEvent(t, repo, evt)
+		require.Equal(t, eventv1.FailureReason_FAILURE_REASON_AUTH_EXPIRED, evt.GetReason())
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_FAILED, got.State())
+	})
+
+	t.Run("later transition cancels the deadline", func(t *testing.T) {
+		s, repo, clk := setup(t)
+		p := stored(t, repo, "", authorize)
+
+		clk.Advance(24 * time.Hour)
+		expected := p.Version()
+		require.NoError(t, p.Capture(ctx, amount))
+		require.NoError(t, repo.Save(ctx, p, expected))
+
+		clk.Advance(30 * 24 * time.Hour)
+		n, err := s.Fire(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, got.State())
+	})
+
+	t.Run("entering a new state restarts the window", func(t *testing.T) {
+		s, repo, clk := setup(t)
+		p := stored(t, repo, "")
+
+		clk.Advance(23 * time.Hour)
+		expected := p.Version()
+		require.NoError(t, authorize(p))
+		require.NoError(t, repo.Save(ctx, p, expected))
+
+		clk.Advance(2 * time.Hour)
+		n, err := s.Fire(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n)
+
+		due, err := repo.Due(ctx, clk.Now().Add(7*24*time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED, due[0].State)
+	})
+
+	t.Run("failed void is postponed", func(t *testing.T) {
+		provider := mocks.NewMockPaymentProvider(t)
+		s, repo, clk := setup(t, deadline.WithProvider(provider), deadline.WithRetryDelay(time.Minute))
+		p := stored(t, repo, "stripe")
+
+		provider.EXPECT().CancelPayment(mock.Anything, mock.Anything).
+			Return(ports.CancelPaymentOut{}, errors.New("stripe: api_connection_error")).Once()
+
+		clk.Advance(24 * time.Hour)
+		n, err := s.Fire(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_CREATED, got.State())
+
+		due, err := repo.Due(ctx, clk.Now(), 10)
+		require.NoError(t, err)
+		require.Empty(t, due, "postponed by the retry delay")
+
+		provider.EXPECT().CancelPayment(mock.Anything, mock.Anything).
+			Return(ports.CancelPaymentOut{Status: ports.ProviderStatusCanceled}, nil).Once()
+
+		clk.Advance(time.Minute)
+		n, err = s.Fire(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+	})
+}
+
+func TestPolicy_For(t *testing.T) {
+	manual := eventv1.CaptureMode_CAPTURE_MODE_MANUAL
+	p := deadline.Policy{
+		Default: deadline.Windows{Authorized: 7 * 24 * time.Hour},
+		Overrides: map[deadline.Scope]deadline.Windows{
+			{Provider: "stripe", Mode: manual}: {Authorized: 6 * 24 * time.Hour},
+			{Provider: "stripe"}:               {Authorized: 5 * 24 * time.Hour},
+			{Mode: manual}:                     {Authorized: 4 * 24 * time.Hour},
+		},
+	}
+
+	require.Equal(t, 6*24*time.Hour, p.For("stripe", manual).Authorized)
+	require.Equal(t, 5*24*time.Hour, p.For("stripe", eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE).Authorized)
+	require.Equal(t, 4*24*time.Hour, p.For("tinkoff", manual).Authorized)
+	require.Equal(t, 7*24*time.Hour, p.For("tinkoff", eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE).Authorized)
+}
@@ -0,0 +1,154 @@
+// Package idempotency de-duplicates client commands by a caller-supplied key.
+//
+// The first request with a key reserves it together with a fingerprint of the request;
+// its result is stored once the command succeeds. A retry with the same key and payload
+// gets the stored result back, a retry with another payload is rejected.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrKeyReused is returned when a key is replayed with a different request.
+	ErrKeyReused = errors.New("idempotency: key reused with a different request")
+	// ErrInProgress is returned while the first request with the key is still running.
+	ErrInProgress = errors.New("idempotency: request with this key is in progress")
+	// ErrRecordNotFound is returned by a Store when the key was never reserved.
+	ErrRecordNotFound = errors.New("idempotency: record not found")
+)
+
+// DefaultLease is how long a reservation without a result blocks retries.
+// After it, the request is considered abandoned (e.g. the process crashed) and may run again.
+const DefaultLease = time.Minute
+
+// Key scopes a client key to its caller, so two callers never collide.
+type Key struct {
+	Caller string // authenticated client, e.g. service name or API key ID
+	Key    string // client-supplied, e.g. the Idempotency-Key header
+}
+
+// IsZero reports whether no key was supplied.
+func (k Key) IsZero() bool { return k.Key == "" }
+
+// Record is a stored reservation.
+type Record struct {
+	Key
+	Fingerprint []byte
+	Response    []byte // nil until the request completed
+	CreatedAt   time.Time
+	CompletedAt time.Time // zero until the request completed
+}
+
+// Completed reports whether the request finished and its response was stored.
+func (r *Record) Completed() bool { return !r.CompletedAt.IsZero() }
+
+// Store persists idempotency records. Repositories that own the payments schema implement it.
+type Store interface {
+	// Reserve claims key for a request with fingerprint. It returns the existing record
+	// when the key is already known and nil when the claim is new.
+	Reserve(ctx context.Context, key Key, fingerprint []byte, at time.Time) (*Record, error)
+	// Complete stores the response of a reserved key.
+	Complete(ctx context.Context, key Key, response []byte, at time.Time) error
+	// Release drops a reservation that has no response yet, so the request can run again.
+	Release(ctx context.Context, key Key) error
+}
+
+// Fingerprint hashes the JSON encoding of request.
+// Maps are encoded with sorted keys, so equal requests give equal fingerprints.
+func Fingerprint(request any) ([]byte, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency: fingerprint: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
+
+// namespace of the IDs derived from keys. It never changes: derived IDs must stay stable.
+var namespace = uuid.MustParse("5f0c8a43-3b8e-4d53-9f0e-6f1d2b7c9a10")
+
+// DeriveID returns an ID that every retry of request with key shares. Do releases the key of a
+// failed request, so a retry runs fn again; a side effect named by the derived ID, e.g. a
+// provider object created with an idempotency key built from it, still happens only once.
+func DeriveID(key Key, request any) (uuid.UUID, error) {
+	fingerprint, err := Fingerprint(request)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	name := append([]byte(key.Caller+"\x00"+key.Key+"\x00"), fingerprint...)
+	return uuid.NewSHA1(namespace, name), nil
+}
+
+// Do runs fn at most once per key and replays its stored result for retries.
+// A failed fn releases the key: errors are not replayed, the client may retry.
+func Do[T any](ctx context.Context, store Store, key Key, request any, fn func(context.Context) (*T, error)) (*T, error) {
+	fingerprint, err := Fingerprint(request)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	rec, err := store.Reserve(ctx, key, fingerprint, now)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency: reserve: %w", err)
+	}
+
+	// Take over a reservation whose owner never finished.
+	if rec != nil && !rec.Completed() && rec.CreatedAt.Before(now.Add(-DefaultLease)) {
+		if err := store.Release(ctx, key); err != nil && !errors.Is(err, ErrRecordNotFound) {
+			return nil, fmt.Errorf("idempotency: release abandoned: %w", err)
+		}
+		rec, err = store.Reserve(ctx, key, fingerprint, now)
+		if err != nil {
+			return nil, fmt.Errorf("idempotency: reserve: %w", err)
+		}
+	}
+
+	if rec != nil {
+		return replay[T](rec, fingerprint)
+	}
+
+	res, err := fn(ctx)
+	if err != nil {
+		if relErr := store.Release(ctx, key); relErr != nil {
+			return nil, fmt.Errorf("%w (release key: %v)", err, relErr)
+		}
+		return nil, err
+	}
+
+	response, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency: encode response: %w", err)
+	}
+	if err := store.Complete(ctx, key, response, time.Now()); err != nil {
+		// The command is done; only its replay is lost. Report it rather than hide it.
+		return res, fmt.Errorf("idempotency: complete: %w", err)
+	}
+
+	return res, nil
+}
+
+func replay[T any](rec *Record, fingerprint []byte) (*T, error) {
+	if string(rec.Fingerprint) != string(fingerprint) {
+		return nil, fmt.Errorf("%w: %s", ErrKeyReused, rec.Key.Key)
+	}
+	if !rec.Completed() {
+		return nil, fmt.Errorf("%w: %s", ErrInProgress, rec.Key.Key)
+	}
+
+	res := new(T)
+	if err := json.Unmarshal(rec.Response, res); err != nil {
+		return nil, fmt.Errorf("idempotency: decode stored response: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,121 @@
+package idempotency_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+)
+
+type request struct {
+	Amount int64
+}
+
+type response struct {
+	ID string
+}
+
+func TestDo(t *testing.T) {
+	ctx := context.Background()
+	key := idempotency.Key{Caller: "billing", Key: "k1"}
+
+	t.Run("replays the stored result", func(t *testing.T) {
+		store := memory.New()
+		calls := 0
+		fn := func(context.Context) (*response, error) {
+			calls++
+			return &response{ID: "pay_1"}, nil
+		}
+
+		first, err := idempotency.Do(ctx, store, key, request{Amount: 10}, fn)
+		require.NoError(t, err)
+		second, err := idempotency.Do(ctx, store, key, request{Amount: 10}, fn)
+		require.NoError(t, err)
+
+		require.Equal(t, first, second)
+		require.Equal(t, 1, calls)
+
+		// Another caller with the same key is a different request.
+		_, err = idempotency.Do(ctx, store, idempotency.Key{Caller: "other", Key: "k1"}, request{Amount: 10}, fn)
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("rejects a different payload", func(t *testing.T) {
+		store := memory.New()
+		fn := func(context.Context) (*response, error) { return &response{ID: "pay_1"}, nil }
+
+		_, err := idempotency.Do(ctx, store, key, request{Amount: 10}, fn)
+		require.NoError(t, err)
+
+		_, err = idempotency.Do(ctx, store, key, request{Amount: 20}, fn)
+		require.ErrorIs(t, err, idempotency.ErrKeyReused)
+	})
+
+	t.Run("failure releases the key", func(t *testing.T) {
+		store := memory.New()
+		boom := errors.New("boom")
+
+		_, err := idempotency.Do(ctx, store, key, request{Amount: 10}, func(context.Context) (*response, error) {
+			return nil, boom
+		})
+		require.ErrorIs(t, err, boom)
+
+		res, err := idempotency.Do(ctx, store, key, request{Amount: 10}, func(context.Context) (*response, error) {
+			return &response{ID: "pay_2"}, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "pay_2", res.ID)
+	})
+
+	t.Run("in progress and abandoned", func(t *testing.T) {
+		store := memory.New()
+		fp, err := idempotency.Fingerprint(request{Amount: 10})
+		require.NoError(t, err)
+
+		fn := func(context.Context) (*response, error) { return &response{ID: "pay_3"}, nil }
+
+		// A fresh reservation blocks retries.
+		_, err = store.Reserve(ctx, key, fp, time.Now())
+		require.NoError(t, err)
+		_, err = idempotency.Do(ctx, store, key, request{Amount: 10}, fn)
+		require.ErrorIs(t, err, idempotency.ErrInProgress)
+
+		// One older than the lease is taken over.
+		other := idempotency.Key{Caller: "billing", Key: "k2"}
+		_, err = store.Reserve(ctx, other, fp, time.Now().Add(-2*idempotency.DefaultLease))
+		require.NoError(t, err)
+		res, err := idempotency.Do(ctx, store, other, request{Amount: 10}, fn)
+		require.NoError(t, err)
+		require.Equal(t, "pay_3", res.ID)
+	})
+}
+
+func TestDeriveID(t *testing.T) {
+	key := idempotency.Key{Caller: "orders", Key: "k1"}
+	req := request{Amount: 10}
+
+	id, err := idempotency.DeriveID(key, req)
+	require.NoError(t, err)
+	again, err := idempotency.DeriveID(key, req)
+	require.NoError(t, err)
+	require.Equal(t, id, again, "a retry gets the same ID")
+
+	for _, other := range []struct {
+		key idempotency.Key
+		req request
+	}{
+		{idempotency.Key{Caller: "billing", Key: "k1"}, req},
+		{idempotency.Key{Caller: "orders", Key: "k2"}, req},
+		{key, request{Amount: 20}},
+	} {
+		derived, err := idempotency.DeriveID(other.key, other.req)
+		require.NoError(t, err)
+		require.NotEqual(t, id, derived)
+	}
+}
@@ -0,0 +1,33 @@
+// Package inbox de-duplicates inbound provider events (webhooks) by their provider event ID.
+package inbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrMessageNotFound is returned by a Store when the message was never received.
+var ErrMessageNotFound = errors.New("inbox: message not found")
+
+// Message identifies one inbound provider event.
+type Message struct {
+	Provider  string // e.g. "stripe"
+	EventID   string // provider event ID, e.g. "evt_..."
+	EventType string // provider event type, e.g. "payment_intent.succeeded"
+	PaymentID uuid.UUID
+}
+
+// Store is the persisted inbox. Repositories that own the payments schema implement it.
+//
+// A message is processed at least once: Receive records it, MarkProcessed closes it.
+// A message that was received but never marked (crash, failed save) is handed out again
+// on the next delivery, so handlers must be safe to re-run.
+type Store interface {
+	// Receive records msg unless it is already known and reports whether it was processed.
+	Receive(ctx context.Context, msg Message, at time.Time) (processed bool, err error)
+	// MarkProcessed closes a received message; later deliveries are duplicates.
+	MarkProcessed(ctx context.Context, provider, eventID string, at time.Time) error
+}
@@ -0,0 +1,61 @@
+package integration
+
+import (
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	integrationeventv1 "github.com/shortlink-org/billing/payments/internal/domain/integration_event/v1"
+)
+
+// Every domain enum value must appear here; translator_test enforces it.
+
+var paymentKinds = map[eventv1.PaymentKind]integrationeventv1.PaymentKind{
+	eventv1.PaymentKind_PAYMENT_KIND_UNSPECIFIED: integrationeventv1.PaymentKind_PAYMENT_KIND_UNSPECIFIED,
+	eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME:    integrationeventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+	// Internal "recurring" is published as "subscription".
+	eventv1.PaymentKind_PAYMENT_KIND_RECURRING: integrationeventv1.PaymentKind_PAYMENT_KIND_SUBSCRIPTION,
+}
+
+var captureModes = map[eventv1.CaptureMode]integrationeventv1.CaptureMode{
+	eventv1.CaptureMode_CAPTURE_MODE_UNSPECIFIED: integrationeventv1.CaptureMode_CAPTURE_MODE_UNSPECIFIED,
+	eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE:   integrationeventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE,
+	eventv1.CaptureMode_CAPTURE_MODE_MANUAL:      integrationeventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+}
+
+var cancelReasons = map[eventv1.CancelReason]integrationeventv1.CancelReason{
+	eventv1.CancelReason_CANCEL_REASON_UNSPECIFIED: integrationeventv1.CancelReason_CANCEL_REASON_UNSPECIFIED,
+	eventv1.CancelReason_CANCEL_REASON_USER:        integrationeventv1.CancelReason_CANCEL_REASON_USER,
+	eventv1.CancelReason_CANCEL_REASON_SYSTEM:      integrationeventv1.CancelReason_CANCEL_REASON_SYSTEM,
+	eventv1.CancelReason_CANCEL_REASON_AUTH_VOID:   integrationeventv1.CancelReason_CANCEL_REASON_AUTH_VOID,
+	eventv1.CancelReason_CANCEL_REASON_DUPLICATE:   integrationeventv1.CancelReason_CANCEL_REASON_DUPLICATE,
+}
+
+var failureReasons = map[eventv1.FailureReason]integrationeventv1.FailureReason{
+	eventv1.FailureReason_FAILURE_REASON_UNSPECIFIED:   integrationeventv1.FailureReason_FAILURE_REASON_UNSPECIFIED,
+	eventv1.FailureReason_FAILURE_REASON_DECLINED:      integrationeventv1.FailureReason_FAILURE_REASON_DECLINED,
+	eventv1.FailureReason_FAILURE_REASON_NETWORK_ERROR: integrationeventv1.FailureReason_FAILURE_REASON_NETWORK_ERROR,
+	// The public contract has no reversal/expiry buckets: consumers treat both as a
+	// decline. Note that REVERSED(2) and INSUFFICIENT_FUNDS(2) share a number.
+	eventv1.FailureReason_FAILURE_REASON_REVERSED:     integrationeventv1.FailureReason_FAILURE_REASON_DECLINED,
+	eventv1.FailureReason_FAILURE_REASON_AUTH_EXPIRED: integrationeventv1.FailureReason_FAILURE_REASON_DECLINED,
+	// Consumers only need to know the customer did not pass SCA, not why.
+	eventv1.FailureReason_FAILURE_REASON_SCA_NOT_COMPLETED: integrationeventv1.FailureReason_FAILURE_REASON_SCA_NOT_COMPLETED,
+	eventv1.FailureReason_FAILURE_REASON_SCA_FAILED:        integrationeventv1.FailureReason_FAILURE_REASON_SCA_NOT_COMPLETED,
+}
+
+var disputeReasons = map[eventv1.DisputeReason]integrationeventv1.DisputeReason{
+	eventv1.DisputeReason_DISPUTE_REASON_UNSPECIFIED:           integrationeventv1.DisputeReason_DISPUTE_REASON_UNSPECIFIED,
+	eventv1.DisputeReason_DISPUTE_REASON_FRAUDULENT:            integrationeventv1.DisputeReason_DISPUTE_REASON_FRAUDULENT,
+	eventv1.DisputeReason_DISPUTE_REASON_PRODUCT_NOT_RECEIVED:  integrationeventv1.DisputeReason_DISPUTE_REASON_PRODUCT_NOT_RECEIVED,
+	eventv1.DisputeReason_DISPUTE_REASON_PRODUCT_UNACCEPTABLE:  integrationeventv1.DisputeReason_DISPUTE_REASON_PRODUCT_UNACCEPTABLE,
+	eventv1.DisputeReason_DISPUTE_REASON_DUPLICATE:             integrationeventv1.DisputeReason_DISPUTE_REASON_DUPLICATE,
+	eventv1.DisputeReason_DISPUTE_REASON_SUBSCRIPTION_CANCELED: integrationeventv1.DisputeReason_DISPUTE_REASON_SUBSCRIPTION_CANCELED,
+	eventv1.DisputeReason_DISPUTE_REASON_CREDIT_NOT_PROCESSED:  integrationeventv1.DisputeReason_DISPUTE_REASON_CREDIT_NOT_PROCESSED,
+	eventv1.DisputeReason_DISPUTE_REASON_GENERAL:               integrationeventv1.DisputeReason_DISPUTE_REASON_GENERAL,
+}
+
+var refundReasons = map[eventv1.RefundReason]integrationeventv1.RefundReason{
+	eventv1.RefundReason_REFUND_REASON_UNSPECIFIED:           integrationeventv1.RefundReason_REFUND_REASON_UNSPECIFIED,
+	eventv1.RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER: integrationeventv1.RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER,
+	eventv1.RefundReason_REFUND_REASON_DUPLICATE:             integrationeventv1.RefundReason_REFUND_REASON_DUPLICATE,
+	eventv1.RefundReason_REFUND_REASON_FRAUDULENT:            integrationeventv1.RefundReason_REFUND_REASON_FRAUDULENT,
+	eventv1.RefundReason_REFUND_REASON_ORDER_CANCELED:        integrationeventv1.RefundReason_REFUND_REASON_ORDER_CANCELED,
+}
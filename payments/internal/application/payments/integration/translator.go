@@ -0,0 +1,196 @@
+// Package integration is the anti-corruption layer between the internal domain
+// events (domain/event/v1) and the public integration contract (integration_event/v1).
+//
+// The two contracts evolve independently: enum values are never converted by number,
+// every value is mapped explicitly and an unmapped value is an error, so drift
+// surfaces as a failed publish (and a failing test) rather than as wrong data.
+package integration
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	integrationeventv1 "github.com/shortlink-org/billing/payments/internal/domain/integration_event/v1"
+)
+
+var (
+	// ErrUnmappedEvent is returned for a domain event without a public counterpart.
+	ErrUnmappedEvent = errors.New("integration: unmapped domain event")
+	// ErrInternalEvent is returned (wrapped with ErrUnmappedEvent) for domain events that
+	// are deliberately kept internal; the relay skips them instead of parking them.
+	ErrInternalEvent = errors.New("integration: internal-only domain event")
+	// ErrUnmappedEnum is returned for a domain enum value without a public counterpart.
+	ErrUnmappedEnum = errors.New("integration: unmapped enum value")
+	// ErrMissingMeta is returned when a domain event carries no EventMeta.
+	ErrMissingMeta = errors.New("integration: missing event meta")
+)
+
+// domainEvent is implemented by every message in domain/event/v1.
+type domainEvent interface {
+	proto.Message
+	GetMeta() *eventv1.EventMeta
+}
+
+// ToPaymentEvent translates a domain event into the public PaymentEvent wrapper.
+func ToPaymentEvent(evt proto.Message) (*integrationeventv1.PaymentEvent, error) {
+	de, ok := evt.(domainEvent)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnmappedEvent, evt)
+	}
+	if de.GetMeta() == nil {
+		return nil, fmt.Errorf("%w: %T", ErrMissingMeta, evt)
+	}
+
+	out := &integrationeventv1.PaymentEvent{Meta: toMeta(de.GetMeta())}
+
+	switch e := evt.(type) {
+	case *eventv1.PaymentProviderAttached:
+		// Provider references are an implementation detail of this service.
+		return nil, fmt.Errorf("%w: %w: %T", ErrUnmappedEvent, ErrInternalEvent, evt)
+	case *eventv1.PaymentSCAEvaluated:
+		// Policy rules and exemptions are risk internals; consumers see the resulting state.
+		return nil, fmt.Errorf("%w: %w: %T", ErrUnmappedEvent, ErrInternalEvent, evt)
+	case *eventv1.PaymentSettled:
+		// Fees and payouts are finance internals, queried by payout; the payment state is unchanged.
+		return nil, fmt.Errorf("%w: %w: %T", ErrUnmappedEvent, ErrInternalEvent, evt)
+	case *eventv1.PaymentCreated:
+		kind, err := mapEnum(paymentKinds, e.GetKind())
+		if err != nil {
+			return nil, err
+		}
+		mode, err := mapEnum(captureModes, e.GetCaptureMode())
+		if err != nil {
+			return nil, err
+		}
+		out.Event = &integrationeventv1.PaymentEvent_Created{Created: &integrationeventv1.PaymentCreated{
+			Amount:      e.GetAmount(),
+			Kind:        kind,
+			CaptureMode: mode,
+		}}
+	case *eventv1.PaymentWaitingForConfirmation:
+		out.Event = &integrationeventv1.PaymentEvent_WaitingForConfirmation{
+			WaitingForConfirmation: &integrationeventv1.PaymentWaitingForConfirmation{},
+		}
+	case *eventv1.PaymentAuthorized:
+		out.Event = &integrationeventv1.PaymentEvent_Authorized{Authorized: &integrationeventv1.PaymentAuthorized{
+			AuthorizedAmount: e.GetAuthorizedAmount(),
+			Amount:           e.GetAmount(),
+		}}
+	case *eventv1.PaymentPaid:
+		out.Event = &integrationeventv1.PaymentEvent_Paid{Paid: &integrationeventv1.PaymentPaid{
+			CapturedAmount: e.GetCapturedAmount(),
+		}}
+	case *eventv1.PaymentAuthorizationReleased:
+		out.Event = &integrationeventv1.PaymentEvent_AuthorizationReleased{
+			AuthorizationReleased: &integrationeventv1.PaymentAuthorizationReleased{ReleasedAmount: e.GetReleasedAmount()},
+		}
+	case *eventv1.PaymentRefundRequested:
+		// The provider refund ID stays internal.
+		reason, err := mapEnum(refundReasons, e.GetReason())
+		if err != nil {
+			return nil, err
+		}
+		out.Event = &integrationeventv1.PaymentEvent_RefundPending{RefundPending: &integrationeventv1.PaymentRefundPending{
+			RefundId: e.GetRefundId(),
+			Amount:   e.GetAmount(),
+			Reason:   reason,
+		}}
+	case *eventv1.PaymentRefunded:
+		reason, err := mapEnum(refundReasons, e.GetReason())
+		if err != nil {
+			return nil, err
+		}
+		out.Event = &integrationeventv1.PaymentEvent_Refunded{Refunded: &integrationeventv1.PaymentRefunded{
+			RefundAmount:  e.GetRefundAmount(),
+			TotalRefunded: e.GetTotalRefunded(),
+			Full:          e.GetFull(),
+			RefundId:      e.GetRefundId(),
+			Reason:        reason,
+		}}
+	case *eventv1.PaymentRefundFailed:
+		reason, err := mapEnum(failureReasons, e.GetReason())
+		if err != nil {
+			return nil, err
+		}
+		out.Event = &integrationeventv1.PaymentEvent_RefundFailed{RefundFailed: &integrationeventv1.PaymentRefundFailed{
+			Reason:   reason,
+			RefundId: e.GetRefundId(),
+			Amount:   e.GetAmount(),
+		}}
+	case *eventv1.PaymentCanceled:
+		reason, err := mapEnum(cancelReasons, e.GetReason())
+		if err != nil {
+			return nil, err
+		}
+		out.Event = &integrationeventv1.PaymentEvent_Canceled{Canceled: &integrationeventv1.PaymentCanceled{
+			Reason: reason,
+		}}
+	case *eventv1.PaymentFailed:
+		// Message is free-form provider text and stays internal.
+		reason, err := mapEnum(failureReasons, e.GetReason())
+		if err != nil {
+			return nil, err
+		}
+		out.Event = &integrationeventv1.PaymentEvent_Failed{Failed: &integrationeventv1.PaymentFailed{
+			Reason: reason,
+		}}
+	case *eventv1.PaymentDisputeOpened:
+		// The provider dispute ID stays internal.
+		reason, err := mapEnum(disputeReasons, e.GetReason())
+		if err != nil {
+			return nil, err
+		}
+		out.Event = &integrationeventv1.PaymentEvent_DisputeOpened{DisputeOpened: &integrationeventv1.PaymentDisputeOpened{
+			Amount: e.GetAmount(),
+			Reason: reason,
+		}}
+	case *eventv1.PaymentDisputeEvidenceSubmitted:
+		out.Event = &integrationeventv1.PaymentEvent_DisputeEvidenceSubmitted{
+			DisputeEvidenceSubmitted: &integrationeventv1.PaymentDisputeEvidenceSubmitted{},
+		}
+	case *eventv1.PaymentDisputeWon:
+		out.Event = &integrationeventv1.PaymentEvent_DisputeWon{DisputeWon: &integrationeventv1.PaymentDisputeWon{}}
+	case *eventv1.PaymentDisputeLost:
+		out.Event = &integrationeventv1.PaymentEvent_DisputeLost{DisputeLost: &integrationeventv1.PaymentDisputeLost{
+			ReversedAmount: e.GetReversedAmount(),
+			TotalReversed:  e.GetTotalReversed(),
+			Full:           e.GetFull(),
+		}}
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnmappedEvent, evt)
+	}
+
+	return out, nil
+}
+
+// EventType returns the fully-qualified name of the public event carried by evt.
+func EventType(evt *integrationeventv1.PaymentEvent) string {
+	fd := evt.ProtoReflect().WhichOneof(eventOneof)
+	if fd == nil {
+		return ""
+	}
+	return string(fd.Message().FullName())
+}
+
+var eventOneof = (&integrationeventv1.PaymentEvent{}).ProtoReflect().Descriptor().Oneofs().ByName("event")
+
+func toMeta(m *eventv1.EventMeta) *integrationeventv1.EventMeta {
+	return &integrationeventv1.EventMeta{
+		EventId:   m.GetEventId(),
+		PaymentId: m.GetPaymentId(),
+		InvoiceId: m.GetInvoiceId(),
+		Version:   m.GetVersion(),
+	}
+}
+
+func mapEnum[D, P ~int32](table map[D]P, v D) (P, error) {
+	out, ok := table[v]
+	if !ok {
+		var zero P
+		return zero, fmt.Errorf("%w: %T(%d)", ErrUnmappedEnum, v, v)
+	}
+	return out, nil
+}
@@ -0,0 +1,228 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	integrationeventv1 "github.com/shortlink-org/billing/payments/internal/domain/integration_event/v1"
+)
+
+// internalOnly lists domain events that are deliberately not published.
+var internalOnly = map[protoreflect.FullName]string{
+	"domain.event.v1.PaymentProviderAttached": "provider references must not leak to consumers",
+	"domain.event.v1.PaymentSCAEvaluated":     "SCA rules and exemptions are risk internals",
+	"domain.event.v1.PaymentSettled":          "fees and payouts are finance internals",
+}
+
+// notProduced lists public enum values the domain cannot express yet.
+// Adding a public value requires either a mapping or an entry here.
+var notProduced = map[protoreflect.FullName]string{
+	"domain.integration_event.v1.FAILURE_REASON_INSUFFICIENT_FUNDS": "providers report it as a plain decline",
+	"domain.integration_event.v1.FAILURE_REASON_CARD_EXPIRED":       "providers report it as a plain decline",
+	"domain.integration_event.v1.FAILURE_REASON_INVALID_CVV":        "providers report it as a plain decline",
+	"domain.integration_event.v1.FAILURE_REASON_FRAUD_SUSPECTED":    "no fraud signal in the domain",
+	"domain.integration_event.v1.FAILURE_REASON_PROVIDER_ERROR":     "provider errors surface as NETWORK_ERROR",
+}
+
+// TestToPaymentEvent_EveryDomainEvent fails when a domain event is added without a mapping
+// or when a public event type is never produced.
+func TestToPaymentEvent_EveryDomainEvent(t *testing.T) {
+	metaDesc := (&eventv1.EventMeta{}).ProtoReflect().Descriptor()
+	produced := map[protoreflect.Name]bool{}
+
+	msgs := eventv1.File_domain_event_v1_payment_events_proto.Messages()
+	for i := range msgs.Len() {
+		md := msgs.Get(i)
+		if md.FullName() == metaDesc.FullName() {
+			continue
+		}
+
+		t.Run(string(md.Name()), func(t *testing.T) {
+			evt := newDomainEvent(t, md)
+
+			out, err := ToPaymentEvent(evt)
+			if reason, ok := internalOnly[md.FullName()]; ok {
+				require.ErrorIs(t, err, ErrUnmappedEvent, reason)
+				require.ErrorIs(t, err, ErrInternalEvent, reason)
+				return
+			}
+			require.NoError(t, err, "add a mapping to ToPaymentEvent or list the event in internalOnly")
+
+			fd := out.ProtoReflect().WhichOneof(eventOneof)
+			require.NotNil(t, fd)
+			produced[fd.Name()] = true
+
+			require.EqualValues(t, 7, out.GetMeta().GetVersion())
+			require.Len(t, out.GetMeta().GetPaymentId(), 16)
+			require.Len(t, out.GetMeta().GetInvoiceId(), 16)
+		})
+	}
+
+	fields := eventOneof.Fields()
+	for i := range fields.Len() {
+		require.True(t, produced[fields.Get(i).Name()], "public event %q is never produced", fields.Get(i).Name())
+	}
+}
+
+func TestEnumTables_Exhaustive(t *testing.T) {
+	check := func(t *testing.T, domain, public protoreflect.EnumDescriptor, mapped map[protoreflect.EnumNumber]protoreflect.EnumNumber) {
+		t.Helper()
+
+		targets := map[protoreflect.EnumNumber]bool{}
+		for i := range domain.Values().Len() {
+			v := domain.Values().Get(i)
+			to, ok := mapped[v.Number()]
+			require.True(t, ok, "domain value %s is not mapped", v.FullName())
+			require.NotNil(t, public.Values().ByNumber(to), "%s maps to unknown public number %d", v.FullName(), to)
+			targets[to] = true
+		}
+
+		for i := range public.Values().Len() {
+			v := public.Values().Get(i)
+			if _, ok := notProduced[v.FullName()]; ok {
+				require.False(t, targets[v.Number()], "%s is produced, drop it from notProduced", v.FullName())
+				continue
+			}
+			require.True(t, targets[v.Number()], "public value %s is never produced", v.FullName())
+		}
+	}
+
+	t.Run("PaymentKind", func(t *testing.T) {
+		check(t, eventv1.PaymentKind(0).Descriptor(), integrationeventv1.PaymentKind(0).Descriptor(), numbers(paymentKinds))
+	})
+	t.Run("CaptureMode", func(t *testing.T) {
+		check(t, eventv1.CaptureMode(0).Descriptor(), integrationeventv1.CaptureMode(0).Descriptor(), numbers(captureModes))
+	})
+	t.Run("CancelReason", func(t *testing.T) {
+		check(t, eventv1.CancelReason(0).Descriptor(), integrationeventv1.CancelReason(0).Descriptor(), numbers(cancelReasons))
+	})
+	t.Run("FailureReason", func(t *testing.T) {
+		check(t, eventv1.FailureReason(0).Descriptor(), integrationeventv1.FailureReason(0).Descriptor(), numbers(failureReasons))
+	})
+	t.Run("DisputeReason", func(t *testing.T) {
+		check(t, eventv1.DisputeReason(0).Descriptor(), integrationeventv1.DisputeReason(0).Descriptor(), numbers(disputeReasons))
+	})
+	t.Run("RefundReason", func(t *testing.T) {
+		check(t, eventv1.RefundReason(0).Descriptor(), integrationeventv1.RefundReason(0).Descriptor(), numbers(refundReasons))
+	})
+}
+
+func TestToPaymentEvent_Mapping(t *testing.T) {
+	pid, inv := uuid.New(), uuid.New()
+	meta := &eventv1.EventMeta{EventId: pid[:], PaymentId: pid[:], InvoiceId: inv[:], Version: 3}
+	amount := &money.Money{CurrencyCode: "EUR", Units: 12, Nanos: 500_000_000}
+
+	tests := []struct {
+		name string
+		in   proto.Message
+		want *integrationeventv1.PaymentEvent
+	}{
+		{
+			name: "recurring is published as subscription",
+			in: &eventv1.PaymentCreated{
+				Meta: meta, InvoiceId: inv[:], Amount: amount,
+				Kind: eventv1.PaymentKind_PAYMENT_KIND_RECURRING, CaptureMode: eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+			},
+			want: &integrationeventv1.PaymentEvent{Event: &integrationeventv1.PaymentEvent_Created{Created: &integrationeventv1.PaymentCreated{
+				Amount: amount, Kind: integrationeventv1.PaymentKind_PAYMENT_KIND_SUBSCRIPTION, CaptureMode: integrationeventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+			}}},
+		},
+		{
+			name: "reversal is not mistaken for insufficient funds",
+			in:   &eventv1.PaymentFailed{Meta: meta, Reason: eventv1.FailureReason_FAILURE_REASON_REVERSED, Message: "raw provider text"},
+			want: &integrationeventv1.PaymentEvent{Event: &integrationeventv1.PaymentEvent_Failed{Failed: &integrationeventv1.PaymentFailed{
+				Reason: integrationeventv1.FailureReason_FAILURE_REASON_DECLINED,
+			}}},
+		},
+		{
+			name: "refund totals",
+			in:   &eventv1.PaymentRefunded{Meta: meta, RefundAmount: amount, TotalRefunded: amount, Full: true},
+			want: &integrationeventv1.PaymentEvent{Event: &integrationeventv1.PaymentEvent_Refunded{Refunded: &integrationeventv1.PaymentRefunded{
+				RefundAmount: amount, TotalRefunded: amount, Full: true,
+			}}},
+		},
+		{
+			name: "provider refund ID stays internal",
+			in: &eventv1.PaymentRefundRequested{
+				Meta: meta, RefundId: inv[:], ProviderRefundId: "re_1", Amount: amount,
+				Reason: eventv1.RefundReason_REFUND_REASON_DUPLICATE, TotalPending: amount,
+			},
+			want: &integrationeventv1.PaymentEvent{Event: &integrationeventv1.PaymentEvent_RefundPending{RefundPending: &integrationeventv1.PaymentRefundPending{
+				RefundId: inv[:], Amount: amount, Reason: integrationeventv1.RefundReason_REFUND_REASON_DUPLICATE,
+			}}},
+		},
+		{
+			name: "dispute ID stays internal",
+			in: &eventv1.PaymentDisputeOpened{
+				Meta: meta, Amount: amount, Reason: eventv1.DisputeReason_DISPUTE_REASON_FRAUDULENT, DisputeId: "dp_1",
+			},
+			want: &integrationeventv1.PaymentEvent{Event: &integrationeventv1.PaymentEvent_DisputeOpened{DisputeOpened: &integrationeventv1.PaymentDisputeOpened{
+				Amount: amount, Reason: integrationeventv1.DisputeReason_DISPUTE_REASON_FRAUDULENT,
+			}}},
+		},
+		{
+			name: "chargeback totals",
+			in:   &eventv1.PaymentDisputeLost{Meta: meta, ReversedAmount: amount, TotalReversed: amount, Full: true},
+			want: &integrationeventv1.PaymentEvent{Event: &integrationeventv1.PaymentEvent_DisputeLost{DisputeLost: &integrationeventv1.PaymentDisputeLost{
+				ReversedAmount: amount, TotalReversed: amount, Full: true,
+			}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want.Meta = &integrationeventv1.EventMeta{EventId: pid[:], PaymentId: pid[:], InvoiceId: inv[:], Version: 3}
+
+			got, err := ToPaymentEvent(tt.in)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tt.want, got), "got %v", got)
+		})
+	}
+}
+
+func TestToPaymentEvent_Errors(t *testing.T) {
+	meta := &eventv1.EventMeta{Version: 1}
+
+	_, err := ToPaymentEvent(&eventv1.PaymentCanceled{Meta: meta, Reason: eventv1.CancelReason(42)})
+	require.ErrorIs(t, err, ErrUnmappedEnum)
+
+	_, err = ToPaymentEvent(&eventv1.PaymentPaid{})
+	require.ErrorIs(t, err, ErrMissingMeta)
+
+	_, err = ToPaymentEvent(&eventv1.EventMeta{})
+	require.ErrorIs(t, err, ErrUnmappedEvent)
+}
+
+// newDomainEvent instantiates md with a populated meta and zero-valued payload.
+func newDomainEvent(t *testing.T, md protoreflect.MessageDescriptor) proto.Message {
+	t.Helper()
+
+	pid, inv := uuid.New(), uuid.New()
+	meta := &eventv1.EventMeta{PaymentId: pid[:], InvoiceId: inv[:], Version: 7}
+
+	fd := md.Fields().ByName("meta")
+	require.NotNil(t, fd, "%s has no meta field", md.FullName())
+
+	// Use the generated type so that the translator's type switch sees it.
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	require.NoError(t, err)
+
+	msg := mt.New()
+	msg.Set(fd, protoreflect.ValueOfMessage(meta.ProtoReflect()))
+	return msg.Interface()
+}
+
+func numbers[D, P ~int32](table map[D]P) map[protoreflect.EnumNumber]protoreflect.EnumNumber {
+	out := make(map[protoreflect.EnumNumber]protoreflect.EnumNumber, len(table))
+	for k, v := range table {
+		out[protoreflect.EnumNumber(k)] = protoreflect.EnumNumber(v)
+	}
+	return out
+}
@@ -0,0 +1,49 @@
+// Package outbox relays events committed to the payments outbox to the message broker.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Topic is the recommended single topic for public payment events.
+const Topic = "payments.payment.events.v1"
+
+var (
+	// ErrPoisonMessage marks a record that can never be published (undecodable or untranslatable).
+	ErrPoisonMessage = errors.New("outbox: poison message")
+	// ErrRecordNotFound is returned by a Store when the record id is unknown.
+	ErrRecordNotFound = errors.New("outbox: record not found")
+)
+
+// Record is a single outbox row: one domain event waiting to be published.
+type Record struct {
+	ID        int64
+	PaymentID uuid.UUID
+	Version   uint64
+	EventType string // fully-qualified proto message name
+	Payload   []byte // proto wire format of the domain event
+
+	EventID  uuid.UUID // uuid.Nil until the first publish attempt
+	Attempts int
+}
+
+// Store is the relay's view of the outbox. Repositories that write outbox rows
+// in their Save transaction implement it.
+type Store interface {
+	// Pending returns up to limit records due at now, ordered by ID.
+	// A record is never returned while an earlier undelivered, non-dead record of
+	// the same payment is waiting for a later retry: this keeps per-aggregate order.
+	Pending(ctx context.Context, now time.Time, limit int) ([]Record, error)
+	// AssignEventID persists the event ID so that retries reuse it.
+	AssignEventID(ctx context.Context, id int64, eventID uuid.UUID) error
+	// MarkSent records a successful delivery.
+	MarkSent(ctx context.Context, id int64, at time.Time) error
+	// MarkRetry increments the attempt counter and schedules the next attempt.
+	MarkRetry(ctx context.Context, id int64, cause string, next time.Time) error
+	// MarkDead parks a poison record; it is never returned by Pending again.
+	MarkDead(ctx context.Context, id int64, cause string, at time.Time) error
+}
@@ -0,0 +1,267 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shortlink-org/go-sdk/logger"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/integration"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+
+	// Register domain event types for decode.
+	_ "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+)
+
+// errInternalEvent marks a record that is deliberately not published.
+var errInternalEvent = errors.New("outbox: internal-only event")
+
+const (
+	defaultBatchSize  = 100
+	defaultInterval   = time.Second
+	defaultStuckAfter = 10
+)
+
+// Relay tails the outbox and publishes PaymentEvent integration events keyed by payment_id.
+//
+// Delivery is at-least-once: a crash between Publish and MarkSent re-publishes the
+// record with the same event_id, so consumers de-duplicate on EventMeta.event_id.
+// A failed publish is retried until the broker takes it, holding back the later events
+// of the payment; only poison messages are parked as dead.
+// Run a single relay per outbox; per-aggregate ordering relies on it.
+type Relay struct {
+	log       logger.Logger
+	store     Store
+	publisher ports.EventPublisher
+
+	topic      string
+	batchSize  int
+	interval   time.Duration
+	stuckAfter int
+	backoff    func(attempt int) time.Duration
+	now        func() time.Time
+	newID      func() (uuid.UUID, error)
+}
+
+type RelayOption func(*Relay)
+
+// WithTopic overrides the destination topic.
+func WithTopic(topic string) RelayOption {
+	return func(r *Relay) { r.topic = topic }
+}
+
+// WithBatchSize limits how many records a single Flush reads.
+func WithBatchSize(n int) RelayOption {
+	return func(r *Relay) { r.batchSize = n }
+}
+
+// WithInterval sets the polling interval of Run.
+func WithInterval(d time.Duration) RelayOption {
+	return func(r *Relay) { r.interval = d }
+}
+
+// WithStuckAfter sets after how many failed publishes a record is reported as stuck.
+// The record is still retried; the report is an error log on every further failure.
+func WithStuckAfter(n int) RelayOption {
+	return func(r *Relay) { r.stuckAfter = n }
+}
+
+// WithBackoff sets the delay before retry number attempt (1-based).
+func WithBackoff(f func(attempt int) time.Duration) RelayOption {
+	return func(r *Relay) { r.backoff = f }
+}
+
+// WithClock replaces time.Now, mainly for tests.
+func WithClock(now func() time.Time) RelayOption {
+	return func(r *Relay) { r.now = now }
+}
+
+// NewRelay wires a relay over store and publisher.
+func NewRelay(log logger.Logger, store Store, publisher ports.EventPublisher, opts ...RelayOption) *Relay {
+	r := &Relay{
+		log:        log,
+		store:      store,
+		publisher:  publisher,
+		topic:      Topic,
+		batchSize:  defaultBatchSize,
+		interval:   defaultInterval,
+		stuckAfter: defaultStuckAfter,
+		backoff:    exponentialBackoff,
+		now:        time.Now,
+		newID:      uuid.NewV7,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Run flushes the outbox every interval until ctx is canceled.
+func (r *Relay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Flush(ctx); err != nil && ctx.Err() == nil {
+			// Store is unavailable; try again on the next tick.
+			r.log.ErrorWithContext(ctx, "outbox relay: flush failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush publishes one batch of due records and returns how many were delivered.
+// A failed record blocks the rest of its payment's records in this batch.
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+	records, err := r.store.Pending(ctx, r.now(), r.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("outbox: read pending: %w", err)
+	}
+
+	sent := 0
+	blocked := make(map[uuid.UUID]struct{})
+
+	for _, rec := range records {
+		if _, ok := blocked[rec.PaymentID]; ok {
+			continue
+		}
+
+		err := r.deliver(ctx, rec)
+		switch {
+		case err == nil:
+			if err := r.store.MarkSent(ctx, rec.ID, r.now()); err != nil {
+				return sent, fmt.Errorf("outbox: mark sent %d: %w", rec.ID, err)
+			}
+			sent++
+		case errors.Is(err, errInternalEvent):
+			// Not part of the public contract: consumers see the version skip over it.
+			if err := r.store.MarkSent(ctx, rec.ID, r.now()); err != nil {
+				return sent, fmt.Errorf("outbox: mark skipped %d: %w", rec.ID, err)
+			}
+		case errors.Is(err, ErrPoisonMessage):
+			// Parked for manual inspection; later events of the payment still flow,
+			// consumers observe the gap through EventMeta.version.
+			if err := r.bury(ctx, rec, err); err != nil {
+				return sent, err
+			}
+		case ctx.Err() != nil:
+			return sent, ctx.Err()
+		default:
+			// The broker is unavailable or refused the record: it may take it later, so the
+			// payment waits for it rather than losing an event.
+			blocked[rec.PaymentID] = struct{}{}
+			if err := r.retry(ctx, rec, err); err != nil {
+				return sent, err
+			}
+		}
+	}
+
+	return sent, nil
+}
+
+func (r *Relay) deliver(ctx context.Context, rec Record) error {
+	evt, err := decode(rec)
+	if err != nil {
+		return err
+	}
+
+	out, err := integration.ToPaymentEvent(evt)
+	switch {
+	case errors.Is(err, integration.ErrInternalEvent):
+		return errInternalEvent
+	case err != nil:
+		return fmt.Errorf("%w: %w", ErrPoisonMessage, err)
+	}
+
+	if rec.EventID == uuid.Nil {
+		id, err := r.newID()
+		if err != nil {
+			return fmt.Errorf("outbox: new event id: %w", err)
+		}
+		if err := r.store.AssignEventID(ctx, rec.ID, id); err != nil {
+			return fmt.Errorf("outbox: assign event id %d: %w", rec.ID, err)
+		}
+		rec.EventID = id
+	}
+	out.Meta.EventId = rec.EventID[:]
+
+	value, err := proto.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("%w: marshal %s: %w", ErrPoisonMessage, rec.EventType, err)
+	}
+
+	return r.publisher.Publish(ctx, ports.Message{
+		Topic: r.topic,
+		Key:   rec.PaymentID[:],
+		Value: value,
+		Headers: map[string]string{
+			"event_id":   rec.EventID.String(),
+			"event_type": integration.EventType(out),
+		},
+	})
+}
+
+func (r *Relay) retry(ctx context.Context, rec Record, cause error) error {
+	attempt := rec.Attempts + 1
+	if attempt >= r.stuckAfter {
+		r.log.ErrorWithContext(ctx, "outbox relay: record stuck, payment events held back",
+			"outbox_id", rec.ID,
+			"payment_id", rec.PaymentID.String(),
+			"version", rec.Version,
+			"attempts", attempt,
+			"error", cause.Error(),
+		)
+	}
+
+	if err := r.store.MarkRetry(ctx, rec.ID, cause.Error(), r.now().Add(r.backoff(attempt))); err != nil {
+		return fmt.Errorf("outbox: mark retry %d: %w", rec.ID, err)
+	}
+	return nil
+}
+
+func (r *Relay) bury(ctx context.Context, rec Record, cause error) error {
+	r.log.WarnWithContext(ctx, "outbox relay: record parked as dead",
+		"outbox_id", rec.ID,
+		"payment_id", rec.PaymentID.String(),
+		"version", rec.Version,
+		"error", cause.Error(),
+	)
+
+	if err := r.store.MarkDead(ctx, rec.ID, cause.Error(), r.now()); err != nil {
+		return fmt.Errorf("outbox: mark dead %d: %w", rec.ID, err)
+	}
+	return nil
+}
+
+func decode(rec Record) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(rec.EventType))
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown event type %q", ErrPoisonMessage, rec.EventType)
+	}
+
+	msg := mt.New().Interface()
+	if err := proto.Unmarshal(rec.Payload, msg); err != nil {
+		return nil, fmt.Errorf("%w: unmarshal %s: %w", ErrPoisonMessage, rec.EventType, err)
+	}
+
+	return msg, nil
+}
+
+// exponentialBackoff doubles from 1s and caps at 5m.
+func exponentialBackoff(attempt int) time.Duration {
+	const maxDelay = 5 * time.Minute
+
+	d := time.Second << min(attempt-1, 20)
+	return min(d, maxDelay)
+}
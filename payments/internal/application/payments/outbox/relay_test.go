@@ -0,0 +1,260 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shortlink-org/go-sdk/logger"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/outbox"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	integrationeventv1 "github.com/shortlink-org/billing/payments/internal/domain/integration_event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+// broker is an in-process stand-in for Kafka: one append-only log per key.
+type broker struct {
+	mu    sync.Mutex
+	logs  map[string][]ports.Message
+	fails int // fail the next N publishes
+}
+
+func newBroker() *broker { return &broker{logs: make(map[string][]ports.Message)} }
+
+func (b *broker) Publish(_ context.Context, msg ports.Message) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.fails > 0 {
+		b.fails--
+		return errors.New("broker: leader not available")
+	}
+	b.logs[string(msg.Key)] = append(b.logs[string(msg.Key)], msg)
+	return nil
+}
+
+func (b *broker) partition(t *testing.T, id uuid.UUID) []*integrationeventv1.PaymentEvent {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := make([]*integrationeventv1.PaymentEvent, 0, len(b.logs[string(id[:])]))
+	for _, msg := range b.logs[string(id[:])] {
+		evt := &integrationeventv1.PaymentEvent{}
+		require.NoError(t, proto.Unmarshal(msg.Value, evt))
+		require.Equal(t, uuid.UUID(evt.GetMeta().GetEventId()).String(), msg.Headers["event_id"])
+		out = append(out, evt)
+	}
+	return out
+}
+
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+func newLogger(t *testing.T) logger.Logger {
+	t.Helper()
+	log, err := logger.New(logger.Configuration{Writer: io.Discard})
+	require.NoError(t, err)
+	return log
+}
+
+func paidPayment(t *testing.T, repo *memory.InMemory) *payment.Payment {
+	t.Helper()
+	ctx := context.Background()
+	amount := &money.Money{CurrencyCode: "USD", Units: 10}
+
+	p, err := payment.New(uuid.New(), uuid.New(), amount,
+		eventv1.PaymentKind_PAYMENT_KIND_RECURRING, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+	require.NoError(t, err)
+	require.NoError(t, p.Authorize(ctx, amount))
+	require.NoError(t, p.Capture(ctx, amount))
+	require.NoError(t, repo.Save(ctx, p, 0))
+	return p
+}
+
+func TestRelay_PublishesInOrderWithEventIDs(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	b := newBroker()
+	relay := outbox.NewRelay(newLogger(t), repo, b)
+
+	p1 := paidPayment(t, repo)
+	p2 := paidPayment(t, repo)
+
+	sent, err := relay.Flush(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 6, sent)
+
+	for _, p := range []*payment.Payment{p1, p2} {
+		events := b.partition(t, p.ID())
+		require.Len(t, events, 3)
+
+		require.NotNil(t, events[0].GetCreated())
+		require.Equal(t, integrationeventv1.PaymentKind_PAYMENT_KIND_SUBSCRIPTION, events[0].GetCreated().GetKind())
+		require.NotNil(t, events[1].GetAuthorized())
+		require.NotNil(t, events[2].GetPaid())
+
+		for i, e := range events {
+			require.EqualValues(t, i+1, e.GetMeta().GetVersion())
+			require.Equal(t, p.InvoiceID(), uuid.UUID(e.GetMeta().GetInvoiceId()))
+			require.EqualValues(t, 7, uuid.UUID(e.GetMeta().GetEventId()).Version())
+		}
+	}
+
+	// Nothing left to send.
+	sent, err = relay.Flush(ctx)
+	require.NoError(t, err)
+	require.Zero(t, sent)
+}
+
+func TestRelay_RetryKeepsOrderAndEventID(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	b := newBroker()
+	c := &clock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	relay := outbox.NewRelay(newLogger(t), repo, b,
+		outbox.WithClock(c.Now),
+		outbox.WithBackoff(func(int) time.Duration { return time.Minute }),
+	)
+
+	p := paidPayment(t, repo)
+
+	// First publish fails: the whole stream waits for its head.
+	b.fails = 1
+	sent, err := relay.Flush(ctx)
+	require.NoError(t, err)
+	require.Zero(t, sent)
+
+	pending, err := repo.Pending(ctx, c.now, 10)
+	require.NoError(t, err)
+	require.Empty(t, pending, "later events must not overtake the failed head")
+
+	c.now = c.now.Add(time.Minute)
+	pending, err = repo.Pending(ctx, c.now, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+	firstID := pending[0].EventID
+	require.NotEqual(t, uuid.Nil, firstID)
+
+	sent, err = relay.Flush(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, sent)
+
+	events := b.partition(t, p.ID())
+	require.Len(t, events, 3)
+	require.Equal(t, firstID, uuid.UUID(events[0].GetMeta().GetEventId()), "retry must reuse the event_id")
+	for i, e := range events {
+		require.EqualValues(t, i+1, e.GetMeta().GetVersion())
+	}
+}
+
+func TestRelay_PoisonMessages(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("broker outage holds the payment back until delivered", func(t *testing.T) {
+		repo := memory.New()
+		b := newBroker()
+		c := &clock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+		relay := outbox.NewRelay(newLogger(t), repo, b,
+			outbox.WithClock(c.Now),
+			outbox.WithStuckAfter(2),
+			outbox.WithBackoff(func(int) time.Duration { return time.Second }),
+		)
+		p := paidPayment(t, repo)
+
+		// Far beyond the stuck threshold: the head event is never given up.
+		b.fails = 20
+		for range 20 {
+			sent, err := relay.Flush(ctx)
+			require.NoError(t, err)
+			require.Zero(t, sent)
+			require.Empty(t, b.partition(t, p.ID()), "later events wait for the head")
+			c.now = c.now.Add(time.Second)
+		}
+
+		sent, err := relay.Flush(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, sent)
+
+		events := b.partition(t, p.ID())
+		require.Len(t, events, 3)
+		for i, e := range events {
+			require.EqualValues(t, i+1, e.GetMeta().GetVersion())
+		}
+	})
+
+	t.Run("undecodable payload is parked immediately", func(t *testing.T) {
+		b := newBroker()
+		store := &stubStore{records: []outbox.Record{
+			{ID: 1, PaymentID: uuid.New(), Version: 1, EventType: "domain.event.v1.Unknown"},
+			{ID: 2, PaymentID: uuid.New(), Version: 1, EventType: "domain.event.v1.PaymentCreated", Payload: []byte{0xff}},
+		}}
+		relay := outbox.NewRelay(newLogger(t), store, b)
+
+		sent, err := relay.Flush(ctx)
+		require.NoError(t, err)
+		require.Zero(t, sent)
+		require.ElementsMatch(t, []int64{1, 2}, store.dead)
+	})
+}
+
+// stubStore serves fixed records, e.g. corrupted rows a repository cannot produce.
+type stubStore struct {
+	records []outbox.Record
+	dead    []int64
+}
+
+func (s *stubStore) Pending(context.Context, time.Time, int) ([]outbox.Record, error) {
+	return s.records, nil
+}
+
+func (s *stubStore) AssignEventID(context.Context, int64, uuid.UUID) error { return nil }
+
+func (s *stubStore) MarkSent(context.Context, int64, time.Time) error { return nil }
+
+func (s *stubStore) MarkRetry(context.Context, int64, string, time.Time) error { return nil }
+
+func (s *stubStore) MarkDead(_ context.Context, id int64, _ string, _ time.Time) error {
+	s.dead = append(s.dead, id)
+	return nil
+}
+
+func TestRelay_SkipsInternalEvents(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	b := newBroker()
+	relay := outbox.NewRelay(newLogger(t), repo, b)
+
+	amount := &money.Money{CurrencyCode: "USD", Units: 10}
+	p, err := payment.New(uuid.New(), uuid.New(), amount,
+		eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+	require.NoError(t, err)
+	require.NoError(t, p.AttachProvider(ctx, "stripe", "pi_secret"))
+	require.NoError(t, p.Authorize(ctx, amount))
+	require.NoError(t, repo.Save(ctx, p, 0))
+
+	sent, err := relay.Flush(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, sent)
+
+	events := b.partition(t, p.ID())
+	require.Len(t, events, 2)
+	require.NotNil(t, events[0].GetCreated())
+	require.NotNil(t, events[1].GetAuthorized())
+	require.EqualValues(t, 3, events[1].GetMeta().GetVersion())
+
+	pending, err := repo.Pending(ctx, time.Now().Add(time.Hour), 100)
+	require.NoError(t, err)
+	require.Empty(t, pending, "internal events must not be parked or retried")
+}
@@ -0,0 +1,55 @@
+package ports
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+)
+
+// ErrUnsupportedOperation is returned by use cases when the provider cannot carry out a command.
+// Nothing has been sent to the provider or recorded for the payment.
+var ErrUnsupportedOperation = errors.New("ports: operation not supported by provider")
+
+// Capabilities describe what a provider supports. Use cases check commands against them
+// before calling the provider.
+type Capabilities struct {
+	Currencies   []string              // ISO-4217; empty → any currency
+	CaptureModes []eventv1.CaptureMode // e.g. IMMEDIATE only for providers without holds
+
+	PartialCapture           bool // several captures of one hold; without it a capture is final and releases the rest
+	Refund                   bool
+	PartialRefund            bool // refund less than was captured, possibly in several refunds
+	IncrementalAuthorization bool // raise a hold; the provider implements AuthorizationIncrementer
+	MaxRefunds               int  // refunds per payment that did not fail; 0 → unlimited
+}
+
+// SupportsCurrency reports whether payments in code can be made.
+func (c Capabilities) SupportsCurrency(code string) bool {
+	return len(c.Currencies) == 0 || slices.ContainsFunc(c.Currencies, func(s string) bool {
+		return strings.EqualFold(s, code)
+	})
+}
+
+// SupportsCaptureMode reports whether payments can be captured in mode.
+func (c Capabilities) SupportsCaptureMode(mode eventv1.CaptureMode) bool {
+	return slices.Contains(c.CaptureModes, mode)
+}
+
+// CapabilitiesRouter is implemented by providers dispatching payments to several providers,
+// whose capabilities differ per payment.
+type CapabilitiesRouter interface {
+	// CapabilitiesOf returns the capabilities of the named provider; false if it is not routed to.
+	CapabilitiesOf(provider Provider) (Capabilities, bool)
+}
+
+// CapabilitiesFor returns the capabilities p offers for a payment held by provider.
+func CapabilitiesFor(p PaymentProvider, provider Provider) Capabilities {
+	if r, ok := p.(CapabilitiesRouter); ok {
+		if caps, ok := r.CapabilitiesOf(provider); ok {
+			return caps
+		}
+	}
+	return p.Capabilities()
+}
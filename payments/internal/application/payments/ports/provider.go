@@ -2,9 +2,13 @@ package ports
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/genproto/googleapis/type/money"
+
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
 )
 
 // Provider identifier (per ADR naming).
@@ -13,6 +17,7 @@ type Provider string
 const (
 	ProviderStripe  Provider = "stripe"
 	ProviderTinkoff Provider = "tinkoff"
+	ProviderFake    Provider = "fake" // in-memory sandbox for local development and tests
 )
 
 // Normalized provider status after CreatePayment.
@@ -28,6 +33,27 @@ const (
 	ProviderStatusFailed
 )
 
+// SCAOutcome explains a failed attempt in SCA/3DS terms.
+type SCAOutcome int
+
+const (
+	SCAOutcomeNone         SCAOutcome = iota // failure unrelated to SCA
+	SCAOutcomeNotCompleted                   // challenge required but not performed/abandoned
+	SCAOutcomeFailed                         // challenge performed and rejected
+)
+
+// FailureReason is the reason a payment that failed with this outcome is failed with.
+func (o SCAOutcome) FailureReason() eventv1.FailureReason {
+	switch o {
+	case SCAOutcomeFailed:
+		return eventv1.FailureReason_FAILURE_REASON_SCA_FAILED
+	case SCAOutcomeNotCompleted:
+		return eventv1.FailureReason_FAILURE_REASON_SCA_NOT_COMPLETED
+	default:
+		return eventv1.FailureReason_FAILURE_REASON_DECLINED
+	}
+}
+
 type CreatePaymentIn struct {
 	PaymentID     uuid.UUID
 	InvoiceID     uuid.UUID
@@ -37,6 +63,17 @@ type CreatePaymentIn struct {
 	Description   string
 	Metadata      map[string]string
 	ReturnURL     string
+
+	// SCA policy decision: RequireSCA asks the provider for a 3DS challenge; otherwise the
+	// provider decides and SCAExemption tells which exemption the policy claimed.
+	RequireSCA   bool
+	SCAExemption eventv1.SCAExemption
+
+	// Inputs of payment routing.
+	CustomerRegion string // ISO 3166-1 alpha-2, e.g. "DE"; empty if unknown
+	MerchantID     string // empty if the payment is not made on behalf of a merchant
+
+	IdempotencyKey string
 }
 
 type CreatePaymentOut struct {
@@ -51,21 +88,173 @@ type CreatePaymentOut struct {
 
 type RefundPaymentIn struct {
 	PaymentID  uuid.UUID
-	ProviderID string // e.g., Stripe PaymentIntent ID
+	Provider   Provider // provider holding the payment, as attached at creation
+	ProviderID string   // e.g., Stripe PaymentIntent ID
 	Amount     *money.Money
 	Currency   string // ISO-4217 (dup for convenience)
-	Reason     string
+	Reason     eventv1.RefundReason
 	Metadata   map[string]string
+
+	IdempotencyKey string // unique per refund attempt, so a second partial refund is not deduplicated
 }
 
 type RefundPaymentOut struct {
 	Provider Provider
-	RefundID string // e.g., Stripe Refund ID
+	RefundID string         // e.g., Stripe Refund ID
+	Status   ProviderStatus // Succeeded, Pending, or Failed/Canceled when rejected
+	Amount   *money.Money   // actual refunded amount from provider
+}
+
+type CapturePaymentIn struct {
+	PaymentID      uuid.UUID
+	Provider       Provider // provider holding the payment, as attached at creation
+	ProviderID     string   // e.g., Stripe PaymentIntent ID
+	Amount         *money.Money
+	Currency       string // ISO-4217 (dup for convenience)
+	Final          bool   // true → release the uncaptured remainder of the authorization
+	IdempotencyKey string
+	Metadata       map[string]string
+}
+
+type CapturePaymentOut struct {
+	Provider Provider
 	Status   ProviderStatus
-	Amount   *money.Money // actual refunded amount from provider
+	Captured *money.Money // amount captured by this call
+}
+
+type GetPaymentIn struct {
+	PaymentID  uuid.UUID
+	Provider   Provider // provider holding the payment, as attached at creation
+	ProviderID string   // e.g., Stripe PaymentIntent ID
+}
+
+type GetPaymentOut struct {
+	Provider Provider
+	Status   ProviderStatus
+
+	Authorized *money.Money // set if provider holds funds (requires_capture)
+	Captured   *money.Money // set if provider captured (succeeded)
+
+	SCA SCAOutcome // set with ProviderStatusFailed
+}
+
+type CancelPaymentIn struct {
+	PaymentID      uuid.UUID
+	Provider       Provider // provider holding the payment, as attached at creation
+	ProviderID     string   // e.g., Stripe PaymentIntent ID
+	Reason         string   // "user", "system", "auth_void", "duplicate"
+	IdempotencyKey string
+}
+
+type CancelPaymentOut struct {
+	Provider Provider
+	Status   ProviderStatus // ProviderStatusCanceled once the hold is released
 }
 
+type IncrementAuthorizationIn struct {
+	PaymentID      uuid.UUID
+	Provider       Provider     // provider holding the payment, as attached at creation
+	ProviderID     string       // e.g., Stripe PaymentIntent ID
+	Amount         *money.Money // increment on top of the current hold
+	Total          *money.Money // hold after the increment (Stripe takes the new total)
+	Currency       string       // ISO-4217 (dup for convenience)
+	IdempotencyKey string
+	Metadata       map[string]string
+}
+
+type IncrementAuthorizationOut struct {
+	Provider   Provider
+	Status     ProviderStatus // RequiresCapture once the hold is raised
+	Authorized *money.Money   // total hold reported by the provider
+}
+
+type ListRefundsIn struct {
+	PaymentID  uuid.UUID
+	Provider   Provider // provider holding the payment, as attached at creation
+	ProviderID string   // e.g., Stripe PaymentIntent ID
+}
+
+type ListRefundsOut struct {
+	Provider Provider
+	Refunds  []ProviderRefund // newest first
+}
+
+type ResolvePaymentIn struct {
+	Provider   Provider // provider that sent the notification
+	ProviderID string   // e.g., Stripe PaymentIntent ID
+}
+
+type ResolvePaymentOut struct {
+	PaymentID uuid.UUID // uuid.Nil if the provider payment is not one of ours
+}
+
+// ProviderRefund is a refund of a payment as the provider reports it.
+type ProviderRefund struct {
+	ID       uuid.UUID      // our refund ID from the provider metadata, uuid.Nil for foreign refunds
+	RefundID string         // e.g., Stripe Refund ID
+	Status   ProviderStatus // Succeeded, Pending, or Failed/Canceled
+	Amount   *money.Money
+}
+
+type ListSettlementIn struct {
+	Provider Provider // provider that paid out
+	PayoutID string   // e.g., Stripe po_..., T-Bank payment order number
+}
+
+type ListSettlementOut struct {
+	Provider Provider
+	PayoutID string
+	Entries  []SettlementEntry
+	Skipped  int // payout lines unrelated to a payment, e.g. the payout itself or fees billed separately
+}
+
+// SettlementEntry is a movement of a payment's funds in a payout, as the provider reports it.
+// Amount is in the payment currency; Gross, Fee and Net are in the settlement currency.
+type SettlementEntry struct {
+	TransactionID string    // unique per entry, e.g. Stripe txn_...
+	PaymentID     uuid.UUID // our payment ID, uuid.Nil for payments made outside this service
+	ProviderID    string    // e.g., Stripe PaymentIntent ID
+	Type          eventv1.SettlementType
+	Amount        *money.Money // negative when funds go back, e.g. a refund
+	Gross         *money.Money
+	Fee           *money.Money // negative when refunded
+	Net           *money.Money // Gross - Fee
+	SettledAt     time.Time    // funds available for the payout
+}
+
+// ErrProviderUnavailable is returned by providers refusing calls while they are known to be down
+// (e.g. an open circuit breaker). Nothing has been sent to the provider.
+var ErrProviderUnavailable = errors.New("ports: provider unavailable")
+
 type PaymentProvider interface {
+	Capabilities() Capabilities
 	CreatePayment(ctx context.Context, in CreatePaymentIn) (CreatePaymentOut, error)
+	GetPayment(ctx context.Context, in GetPaymentIn) (GetPaymentOut, error)
+	CapturePayment(ctx context.Context, in CapturePaymentIn) (CapturePaymentOut, error)
 	RefundPayment(ctx context.Context, in RefundPaymentIn) (RefundPaymentOut, error)
+	CancelPayment(ctx context.Context, in CancelPaymentIn) (CancelPaymentOut, error)
+}
+
+// AuthorizationIncrementer is implemented by providers that can raise an existing hold
+// (e.g. Stripe increment_authorization). Providers without it cannot increase a hold.
+type AuthorizationIncrementer interface {
+	IncrementAuthorization(ctx context.Context, in IncrementAuthorizationIn) (IncrementAuthorizationOut, error)
+}
+
+// RefundLister is implemented by providers that can list the refunds of a payment (e.g. Stripe),
+// so refunds settled without a webhook can be reconciled.
+type RefundLister interface {
+	ListRefunds(ctx context.Context, in ListRefundsIn) (ListRefundsOut, error)
+}
+
+// PaymentResolver is implemented by providers whose notifications may name a payment by its provider
+// ID only (e.g. Stripe charges and disputes), so the payment can be looked up at the provider.
+type PaymentResolver interface {
+	ResolvePayment(ctx context.Context, in ResolvePaymentIn) (ResolvePaymentOut, error)
+}
+
+// SettlementLister is implemented by providers that report the payments settled in a payout
+// (e.g. Stripe balance transactions, T-Bank settlement reports).
+type SettlementLister interface {
+	ListSettlement(ctx context.Context, in ListSettlementIn) (ListSettlementOut, error)
 }
@@ -0,0 +1,17 @@
+package ports
+
+import "context"
+
+// Message is a broker-agnostic record produced by the outbox relay.
+type Message struct {
+	Topic   string
+	Key     []byte // partitioning key; payment_id keeps per-aggregate ordering
+	Value   []byte
+	Headers map[string]string
+}
+
+// EventPublisher delivers integration events to the message broker.
+// Publish must return only after the broker acknowledged the message.
+type EventPublisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
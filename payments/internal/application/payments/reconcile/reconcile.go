@@ -0,0 +1,80 @@
+// Package reconcile catches payments up with their provider when a webhook was missed.
+//
+// A payment is unsettled while the provider may still move it without us: in CREATED,
+// WAITING_FOR_CONFIRMATION or AUTHORIZED, or PAID with a refund pending. Repositories track
+// unsettled payments on every Save, in the same transaction as the events. reconciler.Reconciler
+// polls the provider for those that have not been saved for a threshold and applies what the
+// stream is missing through the webhook use case:
+//
+//	requires_action  -> RequireSCA
+//	requires_capture -> Authorize / Confirm
+//	succeeded        -> Capture
+//	failed           -> Fail
+//	canceled         -> Cancel
+//	refund settled   -> SettleRefund / FailRefund   (providers implementing ports.RefundLister)
+//
+// Every check is persisted with the payment, so a payment the provider keeps pending is
+// checked less and less often and a batch always starts with the payments waiting longest.
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+// ErrCheckNotFound is returned by a Store when the payment is not tracked (anymore).
+var ErrCheckNotFound = errors.New("reconcile: not found")
+
+// Check is the reconciliation state of an unsettled payment.
+type Check struct {
+	PaymentID uuid.UUID
+	State     flowv1.PaymentFlow // state of the last save
+	SavedAt   time.Time          // last save of the payment
+
+	CheckedAt time.Time            // last check, zero if never checked
+	Status    ports.ProviderStatus // status reported by the last check
+	Checks    int                  // checks since the last save
+	NextAt    time.Time            // earliest next check, zero if due
+}
+
+// Store persists the reconciliation state. At most one Check exists per payment.
+// Repositories that own the payments schema implement it and keep it in sync on Save:
+// a save of an unsettled payment resets its checks, a save of a settled one drops them.
+type Store interface {
+	// Stale returns unsettled payments saved before savedBefore whose next check is due at now,
+	// those never checked first, then the earliest due.
+	Stale(ctx context.Context, savedBefore, now time.Time, limit int) ([]Check, error)
+	// Record stores the outcome of a check: CheckedAt, Status, Checks and NextAt of c.
+	// It returns ErrCheckNotFound when the payment is no longer tracked or was saved after c.SavedAt.
+	Record(ctx context.Context, c Check) error
+}
+
+// Unsettled reports whether the provider may still move p without us.
+func Unsettled(p *payment.Payment) bool {
+	switch p.State() {
+	case flowv1.PaymentFlow_PAYMENT_FLOW_CREATED,
+		flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION,
+		flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED:
+		return true
+	case flowv1.PaymentFlow_PAYMENT_FLOW_PAID:
+		return hasPendingRefund(p)
+	default:
+		return false
+	}
+}
+
+func hasPendingRefund(p *payment.Payment) bool {
+	for _, r := range p.Refunds() {
+		if r.Status == payment.RefundStatusPending {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,375 @@
+// Package reconciler polls the provider for stale unsettled payments, see package reconcile.
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shortlink-org/go-sdk/logger"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/time/rate"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/reconcile"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/webhook"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+)
+
+const (
+	defaultBatchSize  = 100
+	defaultInterval   = time.Minute
+	defaultThreshold  = 15 * time.Minute
+	defaultBackoff    = 5 * time.Minute
+	defaultMaxBackoff = 6 * time.Hour
+	defaultRate       = 10 // provider calls per second
+
+	meterName = "github.com/shortlink-org/billing/payments/internal/application/payments/reconcile/reconciler"
+)
+
+// errInterrupted ends a batch when ctx ends before the rate limit allows the next provider call.
+var errInterrupted = errors.New("reconciler: interrupted")
+
+// Webhooks applies provider events to payments; *webhook.Handler implements it.
+type Webhooks interface {
+	Handle(ctx context.Context, evt webhook.Event) (*webhook.Result, error)
+}
+
+// Reconciler polls the provider for stale unsettled payments and applies what their streams miss.
+//
+// The provider state is applied as a webhook event with an ID derived from the payment version,
+// so a check repeated before the payment moves is a duplicate in the inbox, and a real webhook
+// arriving meanwhile is reconciled with it like any late event. Several reconcilers may run;
+// the loser of a race gets a version conflict and the payment is checked again later.
+type Reconciler struct {
+	log      logger.Logger
+	store    reconcile.Store
+	repo     repository.PaymentRepository
+	provider ports.PaymentProvider
+	webhooks Webhooks
+
+	batchSize  int
+	interval   time.Duration
+	threshold  time.Duration
+	backoff    time.Duration
+	maxBackoff time.Duration
+	limiter    *rate.Limiter
+	meter      metric.MeterProvider
+	now        func() time.Time
+
+	drift metric.Int64Counter
+}
+
+type Option func(*Reconciler)
+
+// WithBatchSize limits how many payments a single Reconcile checks.
+func WithBatchSize(n int) Option {
+	return func(r *Reconciler) { r.batchSize = n }
+}
+
+// WithInterval sets the polling interval of Run.
+func WithInterval(d time.Duration) Option {
+	return func(r *Reconciler) { r.interval = d }
+}
+
+// WithThreshold sets how long a payment stays unsaved before it is checked.
+func WithThreshold(d time.Duration) Option {
+	return func(r *Reconciler) { r.threshold = d }
+}
+
+// WithBackoff sets the delay after the first check of a payment, doubled by every further
+// check up to maxDelay.
+func WithBackoff(delay, maxDelay time.Duration) Option {
+	return func(r *Reconciler) { r.backoff, r.maxBackoff = delay, maxDelay }
+}
+
+// WithRateLimit caps the provider calls per second, leaving the provider's rate limit to the
+// payment flows; burst calls may be made at once.
+func WithRateLimit(perSecond float64, burst int) Option {
+	return func(r *Reconciler) { r.limiter = rate.NewLimiter(rate.Limit(perSecond), burst) }
+}
+
+// WithMeterProvider sets the meter provider of the drift metric; it defaults to the global one.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(r *Reconciler) { r.meter = mp }
+}
+
+// WithClock replaces time.Now, mainly for tests.
+func WithClock(now func() time.Time) Option {
+	return func(r *Reconciler) { r.now = now }
+}
+
+// New wires a reconciler over the reconciliation store, the payment repository and
+// the provider. Missed provider state is applied through webhooks.
+func New(
+	log logger.Logger,
+	store reconcile.Store,
+	repo repository.PaymentRepository,
+	provider ports.PaymentProvider,
+	webhooks Webhooks,
+	opts ...Option,
+) (*Reconciler, error) {
+	r := &Reconciler{
+		log:        log,
+		store:      store,
+		repo:       repo,
+		provider:   provider,
+		webhooks:   webhooks,
+		batchSize:  defaultBatchSize,
+		interval:   defaultInterval,
+		threshold:  defaultThreshold,
+		backoff:    defaultBackoff,
+		maxBackoff: defaultMaxBackoff,
+		limiter:    rate.NewLimiter(defaultRate, 1),
+		meter:      otel.GetMeterProvider(),
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	drift, err := r.meter.Meter(meterName).Int64Counter("payments.reconcile.drift",
+		metric.WithDescription("Provider state changes missing from payment streams, applied by the reconciler"),
+		metric.WithUnit("{event}"))
+	if err != nil {
+		return nil, fmt.Errorf("reconciler: drift counter: %w", err)
+	}
+	r.drift = drift
+
+	return r, nil
+}
+
+// Run reconciles a batch every interval until ctx is canceled.
+func (r *Reconciler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Reconcile(ctx); err != nil && ctx.Err() == nil {
+			// Store is unavailable; try again on the next tick.
+			r.log.ErrorWithContext(ctx, "reconciler: reconcile failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reconcile checks one batch of stale payments and returns how many had drifted from the provider.
+// A payment whose check fails is checked again after its backoff; the others are still checked.
+func (r *Reconciler) Reconcile(ctx context.Context) (int, error) {
+	now := r.now()
+	stale, err := r.store.Stale(ctx, now.Add(-r.threshold), now, r.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("reconciler: read stale: %w", err)
+	}
+
+	drifted := 0
+	for _, c := range stale {
+		status, drift, err := r.check(ctx, c.PaymentID)
+		if ctx.Err() != nil || errors.Is(err, errInterrupted) {
+			// Unchecked payments stay due for the next batch.
+			return drifted, err
+		}
+		if err != nil {
+			r.log.WarnWithContext(ctx, "reconciler: check failed",
+				"payment_id", c.PaymentID.String(), "state", c.State.String(), "error", err)
+		}
+		if drift {
+			drifted++
+		}
+
+		c.CheckedAt = r.now()
+		c.Status = status
+		c.Checks++
+		c.NextAt = c.CheckedAt.Add(r.delay(c.Checks))
+		// Not found: the payment was saved meanwhile, e.g. by this check, and starts over.
+		if err := r.store.Record(ctx, c); err != nil && !errors.Is(err, reconcile.ErrCheckNotFound) {
+			return drifted, fmt.Errorf("reconciler: record check: %w", err)
+		}
+	}
+
+	return drifted, nil
+}
+
+// delay is the backoff after the n-th check of a payment.
+func (r *Reconciler) delay(n int) time.Duration {
+	d := r.backoff
+	for i := 1; i < n && d < r.maxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, r.maxBackoff)
+}
+
+// check asks the provider about a payment and applies what its stream misses.
+// It returns the provider status of the payment, Unknown if it was not asked.
+func (r *Reconciler) check(ctx context.Context, paymentID uuid.UUID) (ports.ProviderStatus, bool, error) {
+	agg, err := r.repo.Load(ctx, paymentID)
+	if err != nil {
+		return ports.ProviderStatusUnknown, false, fmt.Errorf("load payment: %w", err)
+	}
+
+	// Settled meanwhile, or the provider never saw the payment.
+	if !reconcile.Unsettled(agg) || agg.ProviderID() == "" {
+		return ports.ProviderStatusUnknown, false, nil
+	}
+
+	if agg.State() == flowv1.PaymentFlow_PAYMENT_FLOW_PAID {
+		drift, err := r.checkRefunds(ctx, agg)
+		return ports.ProviderStatusUnknown, drift, err
+	}
+
+	if err := r.wait(ctx); err != nil {
+		return ports.ProviderStatusUnknown, false, err
+	}
+	out, err := r.provider.GetPayment(ctx, ports.GetPaymentIn{
+		PaymentID:  agg.ID(),
+		Provider:   ports.Provider(agg.Provider()),
+		ProviderID: agg.ProviderID(),
+	})
+	if err != nil {
+		return ports.ProviderStatusUnknown, false, fmt.Errorf("provider get: %w", err)
+	}
+
+	evt, ok := paymentEvent(agg, out)
+	if !ok {
+		return out.Status, false, nil
+	}
+	drift, err := r.apply(ctx, agg, evt, evt.Type)
+	return out.Status, drift, err
+}
+
+// checkRefunds settles the pending refunds of a paid payment the provider has settled or failed.
+// Providers that cannot list refunds are left to their webhooks.
+func (r *Reconciler) checkRefunds(ctx context.Context, agg *payment.Payment) (bool, error) {
+	lister, ok := r.provider.(ports.RefundLister)
+	if !ok {
+		return false, nil
+	}
+
+	if err := r.wait(ctx); err != nil {
+		return false, err
+	}
+	out, err := lister.ListRefunds(ctx, ports.ListRefundsIn{
+		PaymentID:  agg.ID(),
+		Provider:   ports.Provider(agg.Provider()),
+		ProviderID: agg.ProviderID(),
+	})
+	if errors.Is(err, ports.ErrUnsupportedOperation) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("provider list refunds: %w", err)
+	}
+
+	drifted := false
+	for _, pr := range out.Refunds {
+		ours, ok := agg.FindProviderRefund(pr.RefundID)
+		if !ok {
+			ours, ok = agg.FindRefund(pr.ID)
+		}
+		if !ok || ours.Status != payment.RefundStatusPending {
+			continue
+		}
+
+		evt := webhook.Event{
+			Provider:         ports.Provider(agg.Provider()),
+			PaymentID:        agg.ID(),
+			ProviderID:       agg.ProviderID(),
+			RefundID:         ours.ID,
+			ProviderRefundID: pr.RefundID,
+		}
+		switch pr.Status {
+		case ports.ProviderStatusSucceeded:
+			evt.Type, evt.Kind = "reconcile.refund_succeeded", webhook.KindRefundSucceeded
+		case ports.ProviderStatusFailed, ports.ProviderStatusCanceled:
+			evt.Type, evt.Kind = "reconcile.refund_failed", webhook.KindRefundFailed
+		default:
+			continue
+		}
+
+		drift, err := r.apply(ctx, agg, evt, evt.Type+":"+ours.ID.String())
+		if err != nil {
+			return drifted, err
+		}
+		drifted = drifted || drift
+	}
+
+	return drifted, nil
+}
+
+// wait blocks until the rate limit allows the next provider call.
+func (r *Reconciler) wait(ctx context.Context) error {
+	if err := r.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("%w: %w", errInterrupted, err)
+	}
+	return nil
+}
+
+// apply hands evt to the webhook use case under an ID unique to the payment version and key,
+// and reports whether it recorded anything.
+func (r *Reconciler) apply(ctx context.Context, agg *payment.Payment, evt webhook.Event, key string) (bool, error) {
+	evt.ID = fmt.Sprintf("reconcile:%s:%d:%s", agg.ID(), agg.Version(), key)
+
+	res, err := r.webhooks.Handle(ctx, evt)
+	if err != nil {
+		return false, fmt.Errorf("apply %s: %w", evt.Type, err)
+	}
+	if res.Duplicate || res.Recorded == 0 {
+		return false, nil
+	}
+
+	r.drift.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("provider", agg.Provider()),
+		attribute.String("state", agg.State().String()),
+		attribute.String("type", evt.Type),
+	))
+	r.log.InfoWithContext(ctx, "reconciler: applied missed provider state",
+		"payment_id", agg.ID().String(), "state", agg.State().String(), "type", evt.Type,
+		"new_state", res.State.String(), "recorded", res.Recorded)
+
+	return true, nil
+}
+
+// paymentEvent translates the provider state of an unsettled payment into a webhook event.
+// Pending and unknown states carry nothing new.
+func paymentEvent(agg *payment.Payment, out ports.GetPaymentOut) (webhook.Event, bool) {
+	evt := webhook.Event{
+		Provider:   ports.Provider(agg.Provider()),
+		PaymentID:  agg.ID(),
+		ProviderID: agg.ProviderID(),
+	}
+
+	switch out.Status {
+	case ports.ProviderStatusRequiresAction:
+		evt.Type, evt.Kind = "reconcile.requires_action", webhook.KindRequiresAction
+	case ports.ProviderStatusRequiresCapture:
+		evt.Type, evt.Kind = "reconcile.authorized", webhook.KindAuthorized
+		evt.Authorized = lo.Ternary(out.Authorized != nil, out.Authorized, ledger.Clone(agg.Ledger.Amount))
+	case ports.ProviderStatusSucceeded:
+		evt.Type, evt.Kind = "reconcile.succeeded", webhook.KindSucceeded
+		evt.Captured = lo.Ternary(out.Captured != nil, out.Captured, ledger.Clone(agg.Ledger.Amount))
+	case ports.ProviderStatusFailed:
+		evt.Type, evt.Kind = "reconcile.failed", webhook.KindFailed
+		evt.SCA = out.SCA
+	case ports.ProviderStatusCanceled:
+		evt.Type, evt.Kind = "reconcile.canceled", webhook.KindCanceled
+		evt.CancelReason = eventv1.CancelReason_CANCEL_REASON_SYSTEM
+	default:
+		return webhook.Event{}, false
+	}
+
+	return evt, true
+}
@@ -0,0 +1,321 @@
+package reconciler_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shortlink-org/go-sdk/logger"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/reconcile"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/reconcile/reconciler"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/webhook"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+)
+
+var amount = &money.Money{CurrencyCode: "EUR", Units: 40}
+
+// clock is a fake time source shared by the repository and the reconciler.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// lister is a provider that can list refunds, like Stripe.
+type lister struct {
+	*mocks.MockPaymentProvider
+	*mocks.MockRefundLister
+}
+
+type env struct {
+	r      *reconciler.Reconciler
+	repo   *memory.InMemory
+	clk    *clock
+	reader *sdkmetric.ManualReader
+}
+
+func setup(t *testing.T, provider ports.PaymentProvider, opts ...reconciler.Option) env {
+	t.Helper()
+
+	log, err := logger.New(logger.Configuration{Writer: io.Discard})
+	require.NoError(t, err)
+
+	clk := &clock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	repo := memory.New(memory.WithClock(clk.Now))
+	reader := sdkmetric.NewManualReader()
+
+	opts = append([]reconciler.Option{
+		reconciler.WithClock(clk.Now),
+		reconciler.WithThreshold(15 * time.Minute),
+		reconciler.WithBackoff(5*time.Minute, 20*time.Minute),
+		reconciler.WithRateLimit(1000, 1),
+		reconciler.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	}, opts...)
+	r, err := reconciler.New(log, repo, repo, provider, &webhook.Handler{Repo: repo, Inbox: repo}, opts...)
+	require.NoError(t, err)
+
+	return env{r: r, repo: repo, clk: clk, reader: reader}
+}
+
+func stored(t *testing.T, repo *memory.InMemory, mode eventv1.CaptureMode, steps ...func(*payment.Payment) error) *payment.Payment {
+	t.Helper()
+	ctx := context.Background()
+
+	p, err := payment.New(uuid.New(), uuid.New(), amount, eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, mode)
+	require.NoError(t, err)
+	require.NoError(t, p.AttachProvider(ctx, "stripe", "pi_"+p.ID().String()))
+	for _, step := range steps {
+		require.NoError(t, step(p))
+	}
+	require.NoError(t, repo.Save(ctx, p, 0))
+	return p
+}
+
+func (e env) reconcile(t *testing.T) int {
+	t.Helper()
+
+	n, err := e.r.Reconcile(context.Background())
+	require.NoError(t, err)
+	return n
+}
+
+func (e env) state(t *testing.T, id uuid.UUID) flowv1.PaymentFlow {
+	t.Helper()
+
+	p, err := e.repo.Load(context.Background(), id)
+	require.NoError(t, err)
+	return p.State()
+}
+
+// check returns the persisted reconciliation state of a payment.
+func (e env) check(t *testing.T, id uuid.UUID) (reconcile.Check, bool) {
+	t.Helper()
+
+	far := e.clk.Now().Add(365 * 24 * time.Hour)
+	all, err := e.repo.Stale(context.Background(), far, far, 1000)
+	require.NoError(t, err)
+	for _, c := range all {
+		if c.PaymentID == id {
+			return c, true
+		}
+	}
+	return reconcile.Check{}, false
+}
+
+// drift sums the drift counter by event type.
+func (e env) drift(t *testing.T) map[string]int64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, e.reader.Collect(context.Background(), &rm))
+
+	out := make(map[string]int64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "payments.reconcile.drift" {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				typ, _ := dp.Attributes.Value(attribute.Key("type"))
+				out[typ.AsString()] += dp.Value
+			}
+		}
+	}
+	return out
+}
+
+func byPayment(p *payment.Payment) any {
+	return mock.MatchedBy(func(in ports.GetPaymentIn) bool {
+		return in.PaymentID == p.ID() && in.ProviderID == p.ProviderID() && in.Provider == ports.ProviderStripe
+	})
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	immediate := eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE
+	manual := eventv1.CaptureMode_CAPTURE_MODE_MANUAL
+
+	t.Run("missed capture is applied after the threshold", func(t *testing.T) {
+		provider := mocks.NewMockPaymentProvider(t)
+		e := setup(t, provider)
+		p := stored(t, e.repo, immediate)
+
+		e.clk.Advance(10 * time.Minute)
+		require.Zero(t, e.reconcile(t), "saved too recently")
+
+		provider.EXPECT().GetPayment(mock.Anything, byPayment(p)).
+			Return(ports.GetPaymentOut{Status: ports.ProviderStatusSucceeded, Captured: amount}, nil).Once()
+
+		e.clk.Advance(6 * time.Minute)
+		require.Equal(t, 1, e.reconcile(t))
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, e.state(t, p.ID()))
+		require.Equal(t, map[string]int64{"reconcile.succeeded": 1}, e.drift(t))
+
+		_, tracked := e.check(t, p.ID())
+		require.False(t, tracked, "a paid payment without pending refunds is settled")
+	})
+
+	t.Run("missed failure and cancel are applied", func(t *testing.T) {
+		provider := mocks.NewMockPaymentProvider(t)
+		e := setup(t, provider)
+		failed := stored(t, e.repo, immediate, func(p *payment.Payment) error { return p.RequireSCA(ctx) })
+		canceled := stored(t, e.repo, manual, func(p *payment.Payment) error { return p.Authorize(ctx, amount) })
+
+		provider.EXPECT().GetPayment(mock.Anything, byPayment(failed)).
+			Return(ports.GetPaymentOut{Status: ports.ProviderStatusFailed, SCA: ports.SCAOutcomeFailed}, nil).Once()
+		provider.EXPECT().GetPayment(mock.Anything, byPayment(canceled)).
+			Return(ports.GetPaymentOut{Status: ports.ProviderStatusCanceled}, nil).Once()
+
+		e.clk.Advance(time.Hour)
+		require.Equal(t, 2, e.reconcile(t))
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_FAILED, e.state(t, failed.ID()))
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_CANCELED, e.state(t, canceled.ID()))
+		require.Equal(t, map[string]int64{"reconcile.failed": 1, "reconcile.canceled": 1}, e.drift(t))
+	})
+
+	t.Run("payment in sync backs off", func(t *testing.T) {
+		provider := mocks.NewMockPaymentProvider(t)
+		e := setup(t, provider)
+		p := stored(t, e.repo, manual, func(p *payment.Payment) error { return p.Authorize(ctx, amount) })
+
+		provider.EXPECT().GetPayment(mock.Anything, byPayment(p)).
+			Return(ports.GetPaymentOut{Status: ports.ProviderStatusRequiresCapture, Authorized: amount}, nil).Times(3)
+
+		e.clk.Advance(time.Hour)
+		require.Zero(t, e.reconcile(t))
+		c, _ := e.check(t, p.ID())
+		require.Equal(t, 1, c.Checks)
+		require.Equal(t, ports.ProviderStatusRequiresCapture, c.Status)
+		require.Equal(t, e.clk.Now().Add(5*time.Minute), c.NextAt)
+
+		// Backoff doubles: 5m, 10m, then capped at 20m.
+		require.Zero(t, e.reconcile(t))
+		e.clk.Advance(5 * time.Minute)
+		require.Zero(t, e.reconcile(t))
+		e.clk.Advance(9 * time.Minute)
+		require.Zero(t, e.reconcile(t))
+		e.clk.Advance(time.Minute)
+		require.Zero(t, e.reconcile(t))
+
+		c, _ = e.check(t, p.ID())
+		require.Equal(t, 3, c.Checks)
+		require.Equal(t, e.clk.Now().Add(20*time.Minute), c.NextAt)
+		require.Empty(t, e.drift(t))
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED, e.state(t, p.ID()))
+	})
+
+	t.Run("failed check is retried after the backoff", func(t *testing.T) {
+		provider := mocks.NewMockPaymentProvider(t)
+		e := setup(t, provider)
+		broken := stored(t, e.repo, immediate)
+		pending := stored(t, e.repo, immediate)
+
+		provider.EXPECT().GetPayment(mock.Anything, byPayment(broken)).
+			Return(ports.GetPaymentOut{}, errors.New("stripe: api_connection_error")).Once()
+		provider.EXPECT().GetPayment(mock.Anything, byPayment(pending)).
+			Return(ports.GetPaymentOut{Status: ports.ProviderStatusPending}, nil).Once()
+
+		e.clk.Advance(time.Hour)
+		require.Zero(t, e.reconcile(t))
+
+		c, _ := e.check(t, broken.ID())
+		require.Equal(t, 1, c.Checks)
+		require.Equal(t, ports.ProviderStatusUnknown, c.Status)
+
+		provider.EXPECT().GetPayment(mock.Anything, byPayment(broken)).
+			Return(ports.GetPaymentOut{Status: ports.ProviderStatusSucceeded}, nil).Once()
+		provider.EXPECT().GetPayment(mock.Anything, byPayment(pending)).
+			Return(ports.GetPaymentOut{Status: ports.ProviderStatusPending}, nil).Once()
+
+		e.clk.Advance(5 * time.Minute)
+		require.Equal(t, 1, e.reconcile(t))
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, e.state(t, broken.ID()))
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_CREATED, e.state(t, pending.ID()))
+	})
+
+	t.Run("missed refund settlement is applied", func(t *testing.T) {
+		provider := lister{mocks.NewMockPaymentProvider(t), mocks.NewMockRefundLister(t)}
+		e := setup(t, provider)
+		refundID := uuid.New()
+		p := stored(t, e.repo, immediate,
+			func(p *payment.Payment) error { return p.Capture(ctx, amount) },
+			func(p *payment.Payment) error {
+				_, err := p.RequestRefund(ctx, payment.Refund{
+					ID: refundID, ProviderRefundID: "re_1", Amount: amount, Status: payment.RefundStatusPending,
+				})
+				return err
+			})
+
+		provider.MockRefundLister.EXPECT().ListRefunds(mock.Anything, mock.MatchedBy(func(in ports.ListRefundsIn) bool {
+			return in.PaymentID == p.ID() && in.ProviderID == p.ProviderID()
+		})).Return(ports.ListRefundsOut{Refunds: []ports.ProviderRefund{
+			{ID: uuid.New(), RefundID: "re_foreign", Status: ports.ProviderStatusSucceeded, Amount: amount},
+			{ID: refundID, RefundID: "re_1", Status: ports.ProviderStatusSucceeded, Amount: amount},
+		}}, nil).Once()
+
+		e.clk.Advance(time.Hour)
+		require.Equal(t, 1, e.reconcile(t))
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_REFUNDED, e.state(t, p.ID()))
+		require.Equal(t, map[string]int64{"reconcile.refund_succeeded": 1}, e.drift(t))
+
+		_, tracked := e.check(t, p.ID())
+		require.False(t, tracked)
+	})
+
+	t.Run("pending refund is left to webhooks without a refund lister", func(t *testing.T) {
+		e := setup(t, mocks.NewMockPaymentProvider(t))
+		p := stored(t, e.repo, immediate,
+			func(p *payment.Payment) error { return p.Capture(ctx, amount) },
+			func(p *payment.Payment) error {
+				_, err := p.RequestRefund(ctx, payment.Refund{ID: uuid.New(), Amount: amount, Status: payment.RefundStatusPending})
+				return err
+			})
+
+		e.clk.Advance(time.Hour)
+		require.Zero(t, e.reconcile(t))
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, e.state(t, p.ID()))
+	})
+
+	t.Run("provider calls are rate limited", func(t *testing.T) {
+		provider := mocks.NewMockPaymentProvider(t)
+		e := setup(t, provider, reconciler.WithRateLimit(1.0/3600, 1))
+		stored(t, e.repo, immediate)
+		stored(t, e.repo, immediate)
+
+		provider.EXPECT().GetPayment(mock.Anything, mock.Anything).
+			Return(ports.GetPaymentOut{Status: ports.ProviderStatusPending}, nil).Once()
+
+		e.clk.Advance(time.Hour)
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err := e.r.Reconcile(ctx)
+		require.Error(t, err, "the second call waits for the limiter")
+	})
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/deadline"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+// planDeadline keeps the deadline of p in sync with its state. Callers hold r.mu.
+func (r *InMemory) planDeadline(p *payment.Payment) {
+	if r.planner == nil {
+		return
+	}
+
+	d, ok := r.planner.Plan(p, r.now())
+	if !ok {
+		delete(r.deadlines, p.ID())
+		return
+	}
+	// Staying in the same state keeps the original due time.
+	if cur, exists := r.deadlines[p.ID()]; exists && cur.State == d.State {
+		return
+	}
+	r.deadlines[p.ID()] = d
+}
+
+// Due implements deadline.Store.
+func (r *InMemory) Due(_ context.Context, now time.Time, limit int) ([]deadline.Deadline, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]deadline.Deadline, 0)
+	for _, d := range r.deadlines {
+		if !d.DueAt.After(now) {
+			out = append(out, d)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].DueAt.Before(out[j].DueAt) })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// Postpone implements deadline.Store.
+func (r *InMemory) Postpone(_ context.Context, paymentID uuid.UUID, next time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, ok := r.deadlines[paymentID]
+	if !ok {
+		return deadline.ErrDeadlineNotFound
+	}
+	d.DueAt = next
+	r.deadlines[paymentID] = d
+	return nil
+}
+
+// Remove implements deadline.Store.
+func (r *InMemory) Remove(_ context.Context, paymentID uuid.UUID, state flowv1.PaymentFlow) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, ok := r.deadlines[paymentID]
+	if !ok || d.State != state {
+		return deadline.ErrDeadlineNotFound
+	}
+	delete(r.deadlines, paymentID)
+	return nil
+}
@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
+)
+
+// Reserve implements idempotency.Store.
+func (r *InMemory) Reserve(_ context.Context, key idempotency.Key, fingerprint []byte, at time.Time) (*idempotency.Record, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if rec, ok := r.idempotency[key]; ok {
+		cp := *rec
+		return &cp, nil
+	}
+	r.idempotency[key] = &idempotency.Record{
+		Key:         key,
+		Fingerprint: append([]byte(nil), fingerprint...),
+		CreatedAt:   at,
+	}
+	return nil, nil
+}
+
+// Complete implements idempotency.Store.
+func (r *InMemory) Complete(_ context.Context, key idempotency.Key, response []byte, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, ok := r.idempotency[key]
+	if !ok {
+		return idempotency.ErrRecordNotFound
+	}
+	rec.Response = append([]byte(nil), response...)
+	rec.CompletedAt = at
+	return nil
+}
+
+// Release implements idempotency.Store.
+func (r *InMemory) Release(_ context.Context, key idempotency.Key) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if rec, ok := r.idempotency[key]; ok && !rec.Completed() {
+		delete(r.idempotency, key)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/inbox"
+)
+
+type inboxKey struct{ provider, eventID string }
+
+type inboxRow struct {
+	inbox.Message
+	receivedAt  time.Time
+	processedAt time.Time
+}
+
+// Receive implements inbox.Store.
+func (r *InMemory) Receive(_ context.Context, msg inbox.Message, at time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := inboxKey{msg.Provider, msg.EventID}
+	if row, ok := r.inbox[key]; ok {
+		return !row.processedAt.IsZero(), nil
+	}
+	r.inbox[key] = &inboxRow{Message: msg, receivedAt: at}
+	return false, nil
+}
+
+// MarkProcessed implements inbox.Store.
+func (r *InMemory) MarkProcessed(_ context.Context, provider, eventID string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.inbox[inboxKey{provider, eventID}]
+	if !ok {
+		return inbox.ErrMessageNotFound
+	}
+	row.processedAt = at
+	return nil
+}
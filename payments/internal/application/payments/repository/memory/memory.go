@@ -2,32 +2,94 @@ package memory
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/shortlink-org/billing/payments/internal/application/payments/deadline"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/inbox"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/outbox"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/reconcile"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/settlement"
 	"github.com/shortlink-org/billing/payments/internal/domain/payment"
 )
 
 // InMemory implements repository.PaymentRepository using an in-proc event store.
+// It also keeps an outbox, an inbox, idempotency records, deadlines, reconciliation checks and
+// settlements and implements outbox.Store, inbox.Store, idempotency.Store, deadline.Store,
+// reconcile.Store and settlement.Store.
 // Concurrency-safe; suitable for tests/dev.
 type InMemory struct {
 	mu       sync.RWMutex
 	streams  map[uuid.UUID][]proto.Message // append-only event stream per aggregate
 	versions map[uuid.UUID]uint64          // last persisted version per aggregate
+	order    []uuid.UUID                   // stream IDs in creation order
+	outbox   []*outboxRow                  // ordered by ID, ID == index+1
+	inbox    map[inboxKey]*inboxRow
+
+	idempotency map[idempotency.Key]*idempotency.Record
+
+	planner     deadline.Planner // nil → no deadlines
+	deadlines   map[uuid.UUID]deadline.Deadline
+	checks      map[uuid.UUID]reconcile.Check
+	settlements map[string][]settlement.Entry // by payout ID
+	now         func() time.Time
+}
+
+// Option configures an InMemory repository.
+type Option func(*InMemory)
+
+// WithDeadlines plans a deadline on every Save, see deadline.Store.
+func WithDeadlines(planner deadline.Planner) Option {
+	return func(r *InMemory) { r.planner = planner }
+}
+
+// WithClock replaces time.Now for planned deadlines and reconciliation checks, mainly for tests.
+func WithClock(now func() time.Time) Option {
+	return func(r *InMemory) { r.now = now }
+}
+
+type outboxRow struct {
+	outbox.Record
+	nextAttemptAt time.Time
+	lastError     string
+	sent          bool
+	dead          bool
 }
 
 // New returns a fresh in-memory repository.
-func New() *InMemory {
-	return &InMemory{
+func New(opts ...Option) *InMemory {
+	r := &InMemory{
 		streams:  make(map[uuid.UUID][]proto.Message),
 		versions: make(map[uuid.UUID]uint64),
+		inbox:    make(map[inboxKey]*inboxRow),
+
+		idempotency: make(map[idempotency.Key]*idempotency.Record),
+		deadlines:   make(map[uuid.UUID]deadline.Deadline),
+		checks:      make(map[uuid.UUID]reconcile.Check),
+		settlements: make(map[string][]settlement.Entry),
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
 	}
+	return r
 }
 
-var _ repository.PaymentRepository = (*InMemory)(nil)
+var (
+	_ repository.PaymentRepository = (*InMemory)(nil)
+	_ outbox.Store                 = (*InMemory)(nil)
+	_ inbox.Store                  = (*InMemory)(nil)
+	_ idempotency.Store            = (*InMemory)(nil)
+	_ deadline.Store               = (*InMemory)(nil)
+	_ reconcile.Store              = (*InMemory)(nil)
+	_ settlement.Store             = (*InMemory)(nil)
+)
 
 func (r *InMemory) Save(_ context.Context, p *payment.Payment, expectedVersion uint64) error {
 	r.mu.Lock()
@@ -46,13 +108,36 @@ func (r *InMemory) Save(_ context.Context, p *payment.Payment, expectedVersion u
 		return nil
 	}
 
+	// Encode outbox rows first so that a bad event leaves the store untouched
+	rows := make([]*outboxRow, 0, len(evts))
+	for i, e := range evts {
+		payload, err := proto.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("marshal event: %w", err)
+		}
+		rows = append(rows, &outboxRow{Record: outbox.Record{
+			ID:        int64(len(r.outbox) + i + 1),
+			PaymentID: id,
+			Version:   cur + uint64(i) + 1,
+			EventType: string(e.ProtoReflect().Descriptor().FullName()),
+			Payload:   payload,
+		}})
+	}
+
 	// Append deep copies (defensive)
 	dst := r.streams[id]
 	for _, e := range evts {
 		dst = append(dst, proto.Clone(e))
 	}
+	if cur == 0 {
+		r.order = append(r.order, id)
+	}
 	r.streams[id] = dst
+	r.outbox = append(r.outbox, rows...)
 	r.versions[id] = cur + uint64(len(evts))
+	r.planDeadline(p)
+	r.trackCheck(p)
+	r.indexSettlements(p)
 
 	// Clear aggregate buffer after successful commit
 	p.ClearUncommitted()
@@ -70,3 +155,85 @@ func (r *InMemory) Load(_ context.Context, id uuid.UUID) (*payment.Payment, erro
 	// Rebuild aggregate.
 	return payment.Rehydrate(events), nil
 }
+
+func (r *InMemory) ListByInvoice(_ context.Context, invoiceID uuid.UUID) ([]*payment.Payment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]*payment.Payment, 0)
+	for _, id := range r.order {
+		p := payment.Rehydrate(r.streams[id])
+		if p.InvoiceID() == invoiceID {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+// Pending implements outbox.Store.
+func (r *InMemory) Pending(_ context.Context, now time.Time, limit int) ([]outbox.Record, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var (
+		out     []outbox.Record
+		waiting = make(map[uuid.UUID]struct{})
+	)
+	for _, row := range r.outbox {
+		if len(out) == limit {
+			break
+		}
+		if row.sent || row.dead {
+			continue
+		}
+		if _, ok := waiting[row.PaymentID]; ok {
+			continue
+		}
+		if row.nextAttemptAt.After(now) {
+			// Later events of this payment must wait for this one.
+			waiting[row.PaymentID] = struct{}{}
+			continue
+		}
+		out = append(out, row.Record)
+	}
+
+	return out, nil
+}
+
+// AssignEventID implements outbox.Store.
+func (r *InMemory) AssignEventID(_ context.Context, id int64, eventID uuid.UUID) error {
+	return r.updateRow(id, func(row *outboxRow) { row.EventID = eventID })
+}
+
+// MarkSent implements outbox.Store.
+func (r *InMemory) MarkSent(_ context.Context, id int64, _ time.Time) error {
+	return r.updateRow(id, func(row *outboxRow) { row.sent = true })
+}
+
+// MarkRetry implements outbox.Store.
+func (r *InMemory) MarkRetry(_ context.Context, id int64, cause string, next time.Time) error {
+	return r.updateRow(id, func(row *outboxRow) {
+		row.Attempts++
+		row.lastError = cause
+		row.nextAttemptAt = next
+	})
+}
+
+// MarkDead implements outbox.Store.
+func (r *InMemory) MarkDead(_ context.Context, id int64, cause string, _ time.Time) error {
+	return r.updateRow(id, func(row *outboxRow) {
+		row.lastError = cause
+		row.dead = true
+	})
+}
+
+func (r *InMemory) updateRow(id int64, fn func(row *outboxRow)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if id < 1 || id > int64(len(r.outbox)) {
+		return outbox.ErrRecordNotFound
+	}
+	fn(r.outbox[id-1])
+	return nil
+}
@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/reconcile"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+// trackCheck keeps the reconciliation state of p in sync with its state. Callers hold r.mu.
+func (r *InMemory) trackCheck(p *payment.Payment) {
+	if !reconcile.Unsettled(p) {
+		delete(r.checks, p.ID())
+		return
+	}
+	// A saved payment is not stuck: its checks start over.
+	r.checks[p.ID()] = reconcile.Check{PaymentID: p.ID(), State: p.State(), SavedAt: r.now()}
+}
+
+// Stale implements reconcile.Store.
+func (r *InMemory) Stale(_ context.Context, savedBefore, now time.Time, limit int) ([]reconcile.Check, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]reconcile.Check, 0)
+	for _, c := range r.checks {
+		if c.SavedAt.Before(savedBefore) && !c.NextAt.After(now) {
+			out = append(out, c)
+		}
+	}
+	// The zero NextAt of payments never checked sorts first.
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].NextAt.Equal(out[j].NextAt) {
+			return out[i].NextAt.Before(out[j].NextAt)
+		}
+		return out[i].SavedAt.Before(out[j].SavedAt)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// Record implements reconcile.Store.
+func (r *InMemory) Record(_ context.Context, c reconcile.Check) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cur, ok := r.checks[c.PaymentID]
+	if !ok || !cur.SavedAt.Equal(c.SavedAt) {
+		return reconcile.ErrCheckNotFound
+	}
+	cur.CheckedAt, cur.Status, cur.Checks, cur.NextAt = c.CheckedAt, c.Status, c.Checks, c.NextAt
+	r.checks[c.PaymentID] = cur
+	return nil
+}
@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/settlement"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+// indexSettlements files the settlements recorded by p under their payout. Callers hold r.mu.
+func (r *InMemory) indexSettlements(p *payment.Payment) {
+	for _, e := range settlement.Recorded(p) {
+		r.settlements[e.PayoutID] = append(r.settlements[e.PayoutID], e)
+	}
+}
+
+// Batch implements settlement.Store.
+func (r *InMemory) Batch(_ context.Context, payoutID string) (settlement.Batch, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := r.settlements[payoutID]
+	if len(entries) == 0 {
+		return settlement.Batch{}, settlement.ErrBatchNotFound
+	}
+	out := settlement.Batch{PayoutID: payoutID, Entries: append([]settlement.Entry(nil), entries...)}
+	sort.SliceStable(out.Entries, func(i, j int) bool {
+		if !out.Entries[i].SettledAt.Equal(out.Entries[j].SettledAt) {
+			return out.Entries[i].SettledAt.Before(out.Entries[j].SettledAt)
+		}
+		return out.Entries[i].ID < out.Entries[j].ID
+	})
+	return out, nil
+}
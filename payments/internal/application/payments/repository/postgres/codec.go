@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	// Register domain event types for decodeEvent.
+	_ "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+)
+
+// ErrUnknownEventType is returned when a stored event type is not a registered proto message.
+var ErrUnknownEventType = errors.New("payment repository: unknown event type")
+
+// encodeEvent returns the fully-qualified message name and wire-format payload of a domain event.
+func encodeEvent(e proto.Message) (string, []byte, error) {
+	payload, err := proto.Marshal(e)
+	if err != nil {
+		return "", nil, fmt.Errorf("marshal event: %w", err)
+	}
+
+	return string(e.ProtoReflect().Descriptor().FullName()), payload, nil
+}
+
+// decodeEvent resolves eventType in the global proto registry and unmarshals payload into it.
+func decodeEvent(eventType string, payload []byte) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(eventType))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, eventType)
+	}
+
+	msg := mt.New().Interface()
+	if err := proto.Unmarshal(payload, msg); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", eventType, err)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/deadline"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+var _ deadline.Store = (*Store)(nil)
+
+// planDeadline keeps the deadline of p in sync with its state inside the Save transaction.
+func (s *Store) planDeadline(ctx context.Context, tx pgx.Tx, p *payment.Payment) error {
+	if s.planner == nil {
+		return nil
+	}
+
+	d, ok := s.planner.Plan(p, time.Now())
+	if !ok {
+		if _, err := tx.Exec(ctx, `DELETE FROM payments.deadlines WHERE payment_id = $1`, p.ID()); err != nil {
+			return fmt.Errorf("drop deadline: %w", err)
+		}
+		return nil
+	}
+
+	// Staying in the same state keeps the original due time.
+	_, err := tx.Exec(ctx,
+		`INSERT INTO payments.deadlines (payment_id, state, due_at) VALUES ($1, $2, $3)
+		 ON CONFLICT (payment_id) DO UPDATE SET state = EXCLUDED.state, due_at = EXCLUDED.due_at
+		 WHERE payments.deadlines.state <> EXCLUDED.state`,
+		d.PaymentID, d.State.String(), d.DueAt)
+	if err != nil {
+		return fmt.Errorf("plan deadline: %w", err)
+	}
+	return nil
+}
+
+// Due implements deadline.Store.
+func (s *Store) Due(ctx context.Context, now time.Time, limit int) ([]deadline.Deadline, error) {
+	rows, err := s.client.Query(ctx,
+		`SELECT payment_id, state, due_at FROM payments.deadlines
+		 WHERE due_at <= $1 ORDER BY due_at LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query deadlines: %w", err)
+	}
+
+	out, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (deadline.Deadline, error) {
+		var (
+			d     deadline.Deadline
+			state string
+		)
+		if err := row.Scan(&d.PaymentID, &state, &d.DueAt); err != nil {
+			return deadline.Deadline{}, err
+		}
+		d.State = flowv1.PaymentFlow(flowv1.PaymentFlow_value[state])
+		return d, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read deadlines: %w", err)
+	}
+
+	return out, nil
+}
+
+// Postpone implements deadline.Store.
+func (s *Store) Postpone(ctx context.Context, paymentID uuid.UUID, next time.Time) error {
+	tag, err := s.client.Exec(ctx,
+		`UPDATE payments.deadlines SET due_at = $2 WHERE payment_id = $1`, paymentID, next)
+	if err != nil {
+		return fmt.Errorf("postpone deadline: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return deadline.ErrDeadlineNotFound
+	}
+
+	return nil
+}
+
+// Remove implements deadline.Store.
+func (s *Store) Remove(ctx context.Context, paymentID uuid.UUID, state flowv1.PaymentFlow) error {
+	tag, err := s.client.Exec(ctx,
+		`DELETE FROM payments.deadlines WHERE payment_id = $1 AND state = $2`, paymentID, state.String())
+	if err != nil {
+		return fmt.Errorf("remove deadline: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return deadline.ErrDeadlineNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
+)
+
+var _ idempotency.Store = (*Store)(nil)
+
+// Reserve implements idempotency.Store.
+func (s *Store) Reserve(ctx context.Context, key idempotency.Key, fingerprint []byte, at time.Time) (*idempotency.Record, error) {
+	tag, err := s.client.Exec(ctx,
+		`INSERT INTO payments.idempotency_keys(caller, key, fingerprint, created_at)
+		 VALUES ($1, $2, $3, $4)
+		 ON CONFLICT (caller, key) DO NOTHING`,
+		key.Caller, key.Key, fingerprint, at)
+	if err != nil {
+		return nil, fmt.Errorf("insert idempotency key: %w", err)
+	}
+	if tag.RowsAffected() == 1 {
+		return nil, nil
+	}
+
+	rec := &idempotency.Record{Key: key}
+	var completedAt *time.Time
+	err = s.client.QueryRow(ctx,
+		`SELECT fingerprint, response, created_at, completed_at
+		 FROM payments.idempotency_keys WHERE caller = $1 AND key = $2`,
+		key.Caller, key.Key).Scan(&rec.Fingerprint, &rec.Response, &rec.CreatedAt, &completedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Released between the insert and the select: the key is free again.
+		return s.Reserve(ctx, key, fingerprint, at)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select idempotency key: %w", err)
+	}
+	if completedAt != nil {
+		rec.CompletedAt = *completedAt
+	}
+
+	return rec, nil
+}
+
+// Complete implements idempotency.Store.
+func (s *Store) Complete(ctx context.Context, key idempotency.Key, response []byte, at time.Time) error {
+	tag, err := s.client.Exec(ctx,
+		`UPDATE payments.idempotency_keys SET response = $3, completed_at = $4
+		 WHERE caller = $1 AND key = $2`,
+		key.Caller, key.Key, response, at)
+	if err != nil {
+		return fmt.Errorf("update idempotency key: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return idempotency.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Release implements idempotency.Store.
+func (s *Store) Release(ctx context.Context, key idempotency.Key) error {
+	_, err := s.client.Exec(ctx,
+		`DELETE FROM payments.idempotency_keys
+		 WHERE caller = $1 AND key = $2 AND completed_at IS NULL`,
+		key.Caller, key.Key)
+	if err != nil {
+		return fmt.Errorf("delete idempotency key: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/inbox"
+)
+
+var _ inbox.Store = (*Store)(nil)
+
+// Receive implements inbox.Store.
+func (s *Store) Receive(ctx context.Context, msg inbox.Message, at time.Time) (bool, error) {
+	var paymentID *uuid.UUID
+	if msg.PaymentID != uuid.Nil {
+		paymentID = &msg.PaymentID
+	}
+
+	// Both branches run on the same snapshot: the SELECT sees the row only if it existed before.
+	var processed bool
+	err := s.client.QueryRow(ctx,
+		`WITH ins AS (
+		   INSERT INTO payments.inbox(provider, event_id, event_type, payment_id, received_at)
+		   VALUES ($1, $2, $3, $4, $5)
+		   ON CONFLICT (provider, event_id) DO NOTHING
+		   RETURNING false AS processed
+		 )
+		 SELECT processed FROM ins
+		 UNION ALL
+		 SELECT processed_at IS NOT NULL FROM payments.inbox WHERE provider = $1 AND event_id = $2
+		 LIMIT 1`,
+		msg.Provider, msg.EventID, msg.EventType, paymentID, at).Scan(&processed)
+	if err != nil {
+		return false, fmt.Errorf("receive inbox message: %w", err)
+	}
+
+	return processed, nil
+}
+
+// MarkProcessed implements inbox.Store.
+func (s *Store) MarkProcessed(ctx context.Context, provider, eventID string, at time.Time) error {
+	tag, err := s.client.Exec(ctx,
+		`UPDATE payments.inbox SET processed_at = $3 WHERE provider = $1 AND event_id = $2`,
+		provider, eventID, at)
+	if err != nil {
+		return fmt.Errorf("update inbox: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return inbox.ErrMessageNotFound
+	}
+
+	return nil
+}
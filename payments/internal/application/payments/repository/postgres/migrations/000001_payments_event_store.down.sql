@@ -0,0 +1,7 @@
+-- DROP TABLE ==========================================================================================================
+DROP TABLE IF EXISTS payments.outbox;
+DROP TABLE IF EXISTS payments.events;
+DROP TABLE IF EXISTS payments.streams;
+
+-- DROP SCHEMA =========================================================================================================
+DROP SCHEMA IF EXISTS payments;
@@ -0,0 +1,52 @@
+-- PAYMENTS SCHEMA =====================================================================================================
+
+CREATE SCHEMA IF NOT EXISTS payments;
+
+-- STREAMS TABLE =======================================================================================================
+-- One row per payment aggregate: current version for optimistic concurrency and a few
+-- denormalized columns for lookups that must not replay the stream.
+CREATE TABLE payments.streams(
+    "payment_id" UUID NOT NULL,
+    "invoice_id" UUID NOT NULL,
+    "version" BIGINT NOT NULL,
+    "state" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE
+    payments.streams ADD PRIMARY KEY("payment_id");
+
+CREATE INDEX streams_invoice_id_idx ON payments.streams("invoice_id");
+
+-- EVENTS TABLE ========================================================================================================
+-- Append-only event store. The unique (payment_id, version) pair is the last line of
+-- defence against concurrent writers appending to the same stream.
+CREATE TABLE payments.events(
+    "payment_id" UUID NOT NULL REFERENCES payments.streams("payment_id"),
+    "version" BIGINT NOT NULL,
+    "event_type" TEXT NOT NULL,
+    "payload" BYTEA NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT events_payment_id_version_key UNIQUE ("payment_id", "version")
+);
+
+COMMENT ON COLUMN
+    payments.events."event_type" IS 'Fully-qualified protobuf message name, e.g. domain.event.v1.PaymentCreated';
+
+-- OUTBOX TABLE ========================================================================================================
+-- Written in the same transaction as events; drained by the outbox relay.
+CREATE TABLE payments.outbox(
+    "id" BIGSERIAL NOT NULL,
+    "payment_id" UUID NOT NULL,
+    "version" BIGINT NOT NULL,
+    "event_type" TEXT NOT NULL,
+    "payload" BYTEA NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    "sent_at" TIMESTAMPTZ
+);
+
+ALTER TABLE
+    payments.outbox ADD PRIMARY KEY("id");
+
+CREATE INDEX outbox_unsent_idx ON payments.outbox("id") WHERE "sent_at" IS NULL;
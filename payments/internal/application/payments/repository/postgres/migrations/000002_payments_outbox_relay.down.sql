@@ -0,0 +1,11 @@
+-- OUTBOX RELAY ========================================================================================================
+DROP INDEX IF EXISTS payments.outbox_pending_idx;
+
+CREATE INDEX outbox_unsent_idx ON payments.outbox("id") WHERE "sent_at" IS NULL;
+
+ALTER TABLE payments.outbox
+    DROP COLUMN IF EXISTS "dead_at",
+    DROP COLUMN IF EXISTS "next_attempt_at",
+    DROP COLUMN IF EXISTS "last_error",
+    DROP COLUMN IF EXISTS "attempts",
+    DROP COLUMN IF EXISTS "event_id";
@@ -0,0 +1,17 @@
+-- OUTBOX RELAY ========================================================================================================
+-- Delivery bookkeeping for the outbox relay. event_id is assigned once (UUIDv7) before the
+-- first publish attempt so that retries carry the same ID and consumers can de-duplicate.
+ALTER TABLE payments.outbox
+    ADD COLUMN "event_id" UUID,
+    ADD COLUMN "attempts" INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN "last_error" TEXT,
+    ADD COLUMN "next_attempt_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN "dead_at" TIMESTAMPTZ;
+
+COMMENT ON COLUMN
+    payments.outbox."dead_at" IS 'Set when the row is parked as a poison message; the relay never retries it';
+
+DROP INDEX IF EXISTS payments.outbox_unsent_idx;
+
+CREATE INDEX outbox_pending_idx ON payments.outbox("payment_id", "id")
+    WHERE "sent_at" IS NULL AND "dead_at" IS NULL;
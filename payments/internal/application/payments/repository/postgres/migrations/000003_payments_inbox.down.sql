@@ -0,0 +1,2 @@
+-- INBOX TABLE =========================================================================================================
+DROP TABLE IF EXISTS payments.inbox;
@@ -0,0 +1,19 @@
+-- INBOX TABLE =========================================================================================================
+-- Inbound provider events (webhooks) keyed by the provider's event ID. Providers deliver
+-- at least once and out of order; a row with processed_at set is a duplicate.
+CREATE TABLE payments.inbox(
+    "provider" TEXT NOT NULL,
+    "event_id" TEXT NOT NULL,
+    "event_type" TEXT NOT NULL,
+    "payment_id" UUID,
+    "received_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    "processed_at" TIMESTAMPTZ
+);
+
+ALTER TABLE
+    payments.inbox ADD PRIMARY KEY("provider", "event_id");
+
+COMMENT ON COLUMN
+    payments.inbox."payment_id" IS 'NULL when the event does not belong to a payment of this service';
+
+CREATE INDEX inbox_payment_id_idx ON payments.inbox("payment_id");
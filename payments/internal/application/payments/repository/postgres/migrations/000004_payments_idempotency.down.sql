@@ -0,0 +1,2 @@
+-- IDEMPOTENCY TABLE ===================================================================================================
+DROP TABLE IF EXISTS payments.idempotency_keys;
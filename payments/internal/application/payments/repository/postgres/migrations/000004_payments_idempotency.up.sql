@@ -0,0 +1,17 @@
+-- IDEMPOTENCY TABLE ===================================================================================================
+-- Client idempotency keys for commands (create, refund), scoped by caller. A row without
+-- completed_at is a reservation of a request that is still running or was abandoned.
+CREATE TABLE payments.idempotency_keys(
+    "caller" TEXT NOT NULL,
+    "key" TEXT NOT NULL,
+    "fingerprint" BYTEA NOT NULL,
+    "response" BYTEA,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    "completed_at" TIMESTAMPTZ
+);
+
+ALTER TABLE
+    payments.idempotency_keys ADD PRIMARY KEY("caller", "key");
+
+COMMENT ON COLUMN
+    payments.idempotency_keys."fingerprint" IS 'SHA-256 of the request; a replay with another payload is rejected';
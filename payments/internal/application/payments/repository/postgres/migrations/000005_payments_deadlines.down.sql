@@ -0,0 +1,2 @@
+-- DEADLINES TABLE =====================================================================================================
+DROP TABLE IF EXISTS payments.deadlines;
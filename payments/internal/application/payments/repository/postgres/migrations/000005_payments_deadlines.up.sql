@@ -0,0 +1,27 @@
+-- DEADLINES TABLE =====================================================================================================
+-- At most one deadline per payment, written in the same transaction as its events: entering
+-- CREATED, WAITING_FOR_CONFIRMATION or AUTHORIZED plans one, leaving the state drops it.
+CREATE TABLE payments.deadlines(
+    "payment_id" UUID NOT NULL REFERENCES payments.streams("payment_id"),
+    "state" TEXT NOT NULL,
+    "due_at" TIMESTAMPTZ NOT NULL
+);
+
+ALTER TABLE
+    payments.deadlines ADD PRIMARY KEY("payment_id");
+
+COMMENT ON COLUMN
+    payments.deadlines."state" IS 'State the deadline guards, e.g. PAYMENT_FLOW_AUTHORIZED';
+
+CREATE INDEX deadlines_due_at_idx ON payments.deadlines("due_at");
+
+-- Payments saved before this migration get the deadline of the default windows (deadline.DefaultPolicy),
+-- counted from their last save; provider and capture mode overrides apply from their next save.
+INSERT INTO payments.deadlines ("payment_id", "state", "due_at")
+SELECT "payment_id", "state", "updated_at" + CASE "state"
+        WHEN 'PAYMENT_FLOW_CREATED' THEN INTERVAL '24 hours'
+        WHEN 'PAYMENT_FLOW_WAITING_FOR_CONFIRMATION' THEN INTERVAL '1 hour'
+        ELSE INTERVAL '7 days'
+    END
+FROM payments.streams
+WHERE "state" IN ('PAYMENT_FLOW_CREATED', 'PAYMENT_FLOW_WAITING_FOR_CONFIRMATION', 'PAYMENT_FLOW_AUTHORIZED');
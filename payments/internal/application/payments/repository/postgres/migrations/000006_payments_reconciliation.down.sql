@@ -0,0 +1,2 @@
+-- RECONCILIATION TABLE ================================================================================================
+DROP TABLE IF EXISTS payments.reconciliation;
@@ -0,0 +1,29 @@
+-- RECONCILIATION TABLE ================================================================================================
+-- One row per unsettled payment, written in the same transaction as its events: a save of a
+-- payment the provider may still move without us resets its checks, any other save drops it.
+CREATE TABLE payments.reconciliation(
+    "payment_id" UUID NOT NULL REFERENCES payments.streams("payment_id"),
+    "state" TEXT NOT NULL,
+    "saved_at" TIMESTAMPTZ NOT NULL,
+    "checked_at" TIMESTAMPTZ,
+    "status" SMALLINT NOT NULL DEFAULT 0,
+    "checks" INTEGER NOT NULL DEFAULT 0,
+    "next_at" TIMESTAMPTZ
+);
+
+ALTER TABLE
+    payments.reconciliation ADD PRIMARY KEY("payment_id");
+
+COMMENT ON COLUMN
+    payments.reconciliation."status" IS 'ports.ProviderStatus reported by the last check, 0 if unknown';
+COMMENT ON COLUMN
+    payments.reconciliation."next_at" IS 'Earliest next check, NULL if due';
+
+CREATE INDEX reconciliation_next_at_idx ON payments.reconciliation("next_at" NULLS FIRST, "saved_at");
+
+-- Payments saved before this migration are tracked from the state of their stream. Paid payments
+-- with a pending refund cannot be told apart here; they are tracked on their next save.
+INSERT INTO payments.reconciliation ("payment_id", "state", "saved_at")
+SELECT "payment_id", "state", "updated_at"
+FROM payments.streams
+WHERE "state" IN ('PAYMENT_FLOW_CREATED', 'PAYMENT_FLOW_WAITING_FOR_CONFIRMATION', 'PAYMENT_FLOW_AUTHORIZED');
@@ -0,0 +1,2 @@
+-- SETTLEMENTS TABLE ===================================================================================================
+DROP TABLE IF EXISTS payments.settlements;
@@ -0,0 +1,23 @@
+-- SETTLEMENTS TABLE ===================================================================================================
+-- Index of the PaymentSettled events by payout, written in the same transaction as the events.
+-- The amounts stay in the event payload; a batch is read by joining payments.events.
+CREATE TABLE payments.settlements(
+    "payment_id" UUID NOT NULL,
+    "version" BIGINT NOT NULL,
+    "settlement_id" TEXT NOT NULL,
+    "payout_id" TEXT NOT NULL,
+    "provider" TEXT NOT NULL,
+    "settled_at" TIMESTAMPTZ NOT NULL,
+    CONSTRAINT settlements_event_fkey FOREIGN KEY ("payment_id", "version")
+        REFERENCES payments.events("payment_id", "version")
+);
+
+ALTER TABLE
+    payments.settlements ADD PRIMARY KEY("payment_id", "settlement_id");
+
+COMMENT ON COLUMN
+    payments.settlements."settlement_id" IS 'Provider transaction, e.g. Stripe txn_...';
+COMMENT ON COLUMN
+    payments.settlements."payout_id" IS 'Provider payout, e.g. Stripe po_... or the T-Bank payment order number';
+
+CREATE INDEX settlements_payout_id_idx ON payments.settlements("payout_id", "settled_at");
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/outbox"
+)
+
+var _ outbox.Store = (*Store)(nil)
+
+// Pending implements outbox.Store.
+func (s *Store) Pending(ctx context.Context, now time.Time, limit int) ([]outbox.Record, error) {
+	rows, err := s.client.Query(ctx,
+		`SELECT o.id, o.payment_id, o.version, o.event_type, o.payload, o.event_id, o.attempts
+		 FROM payments.outbox o
+		 WHERE o.sent_at IS NULL AND o.dead_at IS NULL AND o.next_attempt_at <= $1
+		   AND NOT EXISTS (
+		     SELECT 1 FROM payments.outbox p
+		     WHERE p.payment_id = o.payment_id AND p.id < o.id
+		       AND p.sent_at IS NULL AND p.dead_at IS NULL AND p.next_attempt_at > $1
+		   )
+		 ORDER BY o.id
+		 LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query outbox: %w", err)
+	}
+
+	records, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (outbox.Record, error) {
+		var (
+			rec     outbox.Record
+			eventID *uuid.UUID
+		)
+		if err := row.Scan(&rec.ID, &rec.PaymentID, &rec.Version, &rec.EventType, &rec.Payload, &eventID, &rec.Attempts); err != nil {
+			return rec, err
+		}
+		if eventID != nil {
+			rec.EventID = *eventID
+		}
+		return rec, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read outbox: %w", err)
+	}
+
+	return records, nil
+}
+
+// AssignEventID implements outbox.Store.
+func (s *Store) AssignEventID(ctx context.Context, id int64, eventID uuid.UUID) error {
+	return s.updateOutbox(ctx, `UPDATE payments.outbox SET event_id = $2 WHERE id = $1`, id, eventID)
+}
+
+// MarkSent implements outbox.Store.
+func (s *Store) MarkSent(ctx context.Context, id int64, at time.Time) error {
+	return s.updateOutbox(ctx, `UPDATE payments.outbox SET sent_at = $2 WHERE id = $1`, id, at)
+}
+
+// MarkRetry implements outbox.Store.
+func (s *Store) MarkRetry(ctx context.Context, id int64, cause string, next time.Time) error {
+	return s.updateOutbox(ctx,
+		`UPDATE payments.outbox SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3 WHERE id = $1`,
+		id, cause, next)
+}
+
+// MarkDead implements outbox.Store.
+func (s *Store) MarkDead(ctx context.Context, id int64, cause string, at time.Time) error {
+	return s.updateOutbox(ctx, `UPDATE payments.outbox SET last_error = $2, dead_at = $3 WHERE id = $1`, id, cause, at)
+}
+
+func (s *Store) updateOutbox(ctx context.Context, sql string, id int64, args ...any) error {
+	tag, err := s.client.Exec(ctx, sql, append([]any{id}, args...)...)
+	if err != nil {
+		return fmt.Errorf("update outbox: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return outbox.ErrRecordNotFound
+	}
+
+	return nil
+}
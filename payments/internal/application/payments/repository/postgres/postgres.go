@@ -0,0 +1,252 @@
+package postgres
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shortlink-org/shortlink/pkg/db"
+	"github.com/shortlink-org/shortlink/pkg/db/drivers/postgres/migrate"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/deadline"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+// uniqueViolation is the SQLSTATE raised by the (payment_id, version) constraint.
+const uniqueViolation = "23505"
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Store implements repository.PaymentRepository on top of a PostgreSQL event store.
+// Every Save appends the uncommitted events to payments.events and writes one
+// payments.outbox row per event in the same transaction (transactional outbox).
+// The reconciliation state of unsettled payments and the settlements by payout are kept in the
+// same transaction.
+type Store struct {
+	client  *pgxpool.Pool
+	planner deadline.Planner // nil → no deadlines
+}
+
+// Option configures a Store.
+type Option func(*Store)
+
+// WithDeadlines plans a deadline on every Save, in the same transaction as the events.
+func WithDeadlines(planner deadline.Planner) Option {
+	return func(s *Store) { s.planner = planner }
+}
+
+var _ repository.PaymentRepository = (*Store)(nil)
+
+// New runs embedded migrations and returns a Postgres-backed payment repository.
+func New(ctx context.Context, store db.DB, opts ...Option) (*Store, error) {
+	client, ok := store.GetConn().(*pgxpool.Pool)
+	if !ok {
+		return nil, db.ErrGetConnection
+	}
+
+	// Migration ---------------------------------------------------------------------------------------------------
+	err := migrate.Migration(ctx, store, migrations, "repository_payments")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Store{
+		client: client,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// Save appends the aggregate's uncommitted events if the persisted stream version
+// equals expectedVersion. Events and outbox rows are committed atomically.
+func (s *Store) Save(ctx context.Context, p *payment.Payment, expectedVersion uint64) error {
+	if err := p.Invariants(); err != nil {
+		if errors.Is(err, payment.ErrInvariantViolation) {
+			return err
+		}
+		return fmt.Errorf("%w: %w", payment.ErrInvariantViolation, err)
+	}
+
+	evts := p.UncommittedEvents()
+	if len(evts) == 0 {
+		return nil
+	}
+
+	tx, err := s.client.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := s.advanceStream(ctx, tx, p, expectedVersion, uint64(len(evts))); err != nil {
+		return err
+	}
+
+	for i, e := range evts {
+		eventType, payload, err := encodeEvent(e)
+		if err != nil {
+			return err
+		}
+		version := expectedVersion + uint64(i) + 1
+
+		_, err = tx.Exec(ctx,
+			`INSERT INTO payments.events (payment_id, version, event_type, payload) VALUES ($1, $2, $3, $4)`,
+			p.ID(), version, eventType, payload)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+				return payment.ErrVersionConflict
+			}
+			return fmt.Errorf("append event: %w", err)
+		}
+
+		_, err = tx.Exec(ctx,
+			`INSERT INTO payments.outbox (payment_id, version, event_type, payload) VALUES ($1, $2, $3, $4)`,
+			p.ID(), version, eventType, payload)
+		if err != nil {
+			return fmt.Errorf("write outbox: %w", err)
+		}
+
+		if err := s.indexSettlement(ctx, tx, p, version, e); err != nil {
+			return err
+		}
+	}
+
+	if err := s.planDeadline(ctx, tx, p); err != nil {
+		return err
+	}
+	if err := s.trackCheck(ctx, tx, p); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	// Clear aggregate buffer after successful commit
+	p.ClearUncommitted()
+	return nil
+}
+
+// advanceStream creates the stream row for a new aggregate or moves the version of an
+// existing one forward. Zero affected rows means someone else won the race.
+func (s *Store) advanceStream(ctx context.Context, tx pgx.Tx, p *payment.Payment, expectedVersion, n uint64) error {
+	var (
+		tag pgconn.CommandTag
+		err error
+	)
+
+	if expectedVersion == 0 {
+		tag, err = tx.Exec(ctx,
+			`INSERT INTO payments.streams (payment_id, invoice_id, version, state)
+			 VALUES ($1, $2, $3, $4)
+			 ON CONFLICT (payment_id) DO NOTHING`,
+			p.ID(), p.InvoiceID(), expectedVersion+n, p.State().String())
+	} else {
+		tag, err = tx.Exec(ctx,
+			`UPDATE payments.streams
+			 SET version = $3, state = $4, updated_at = now()
+			 WHERE payment_id = $1 AND version = $2`,
+			p.ID(), expectedVersion, expectedVersion+n, p.State().String())
+	}
+	if err != nil {
+		return fmt.Errorf("advance stream: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return payment.ErrVersionConflict
+	}
+
+	return nil
+}
+
+// Load reconstructs a payment aggregate from its event stream or returns ErrNotFound.
+func (s *Store) Load(ctx context.Context, id uuid.UUID) (*payment.Payment, error) {
+	rows, err := s.client.Query(ctx,
+		`SELECT event_type, payload FROM payments.events WHERE payment_id = $1 ORDER BY version`, id)
+	if err != nil {
+		return nil, fmt.Errorf("query events: %w", err)
+	}
+
+	events, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (proto.Message, error) {
+		var (
+			eventType string
+			payload   []byte
+		)
+		if err := row.Scan(&eventType, &payload); err != nil {
+			return nil, err
+		}
+		return decodeEvent(eventType, payload)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read events: %w", err)
+	}
+
+	if len(events) == 0 {
+		return nil, repository.ErrNotFound
+	}
+	// Rebuild aggregate.
+	return payment.Rehydrate(events), nil
+}
+
+// ListByInvoice rebuilds every payment of an invoice, oldest first.
+func (s *Store) ListByInvoice(ctx context.Context, invoiceID uuid.UUID) ([]*payment.Payment, error) {
+	rows, err := s.client.Query(ctx,
+		`SELECT e.payment_id, e.event_type, e.payload
+		 FROM payments.streams s JOIN payments.events e USING (payment_id)
+		 WHERE s.invoice_id = $1
+		 ORDER BY s.created_at, s.payment_id, e.version`, invoiceID)
+	if err != nil {
+		return nil, fmt.Errorf("query invoice events: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		out    = make([]*payment.Payment, 0)
+		cur    uuid.UUID
+		events []proto.Message
+	)
+	flush := func() {
+		if len(events) > 0 {
+			out = append(out, payment.Rehydrate(events))
+		}
+		events = nil
+	}
+
+	for rows.Next() {
+		var (
+			id        uuid.UUID
+			eventType string
+			payload   []byte
+		)
+		if err := rows.Scan(&id, &eventType, &payload); err != nil {
+			return nil, fmt.Errorf("read invoice events: %w", err)
+		}
+		if id != cur {
+			flush()
+			cur = id
+		}
+
+		evt, err := decodeEvent(eventType, payload)
+		if err != nil {
+			return nil, fmt.Errorf("read invoice events: %w", err)
+		}
+		events = append(events, evt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read invoice events: %w", err)
+	}
+	flush()
+
+	return out, nil
+}
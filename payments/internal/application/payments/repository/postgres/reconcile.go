@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/reconcile"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+var _ reconcile.Store = (*Store)(nil)
+
+// trackCheck keeps the reconciliation state of p in sync with its state inside the Save transaction.
+func (s *Store) trackCheck(ctx context.Context, tx pgx.Tx, p *payment.Payment) error {
+	if !reconcile.Unsettled(p) {
+		if _, err := tx.Exec(ctx, `DELETE FROM payments.reconciliation WHERE payment_id = $1`, p.ID()); err != nil {
+			return fmt.Errorf("drop reconciliation: %w", err)
+		}
+		return nil
+	}
+
+	// A saved payment is not stuck: its checks start over.
+	_, err := tx.Exec(ctx,
+		`INSERT INTO payments.reconciliation (payment_id, state, saved_at) VALUES ($1, $2, $3)
+		 ON CONFLICT (payment_id) DO UPDATE
+		 SET state = EXCLUDED.state, saved_at = EXCLUDED.saved_at, checks = 0, next_at = NULL`,
+		p.ID(), p.State().String(), time.Now())
+	if err != nil {
+		return fmt.Errorf("track reconciliation: %w", err)
+	}
+	return nil
+}
+
+// Stale implements reconcile.Store.
+func (s *Store) Stale(ctx context.Context, savedBefore, now time.Time, limit int) ([]reconcile.Check, error) {
+	rows, err := s.client.Query(ctx,
+		`SELECT payment_id, state, saved_at, checked_at, status, checks, next_at FROM payments.reconciliation
+		 WHERE saved_at < $1 AND (next_at IS NULL OR next_at <= $2)
+		 ORDER BY next_at NULLS FIRST, saved_at LIMIT $3`, savedBefore, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query reconciliation: %w", err)
+	}
+
+	out, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (reconcile.Check, error) {
+		var (
+			c         reconcile.Check
+			state     string
+			status    int16
+			checkedAt *time.Time
+			nextAt    *time.Time
+		)
+		if err := row.Scan(&c.PaymentID, &state, &c.SavedAt, &checkedAt, &status, &c.Checks, &nextAt); err != nil {
+			return reconcile.Check{}, err
+		}
+		c.State = flowv1.PaymentFlow(flowv1.PaymentFlow_value[state])
+		c.Status = ports.ProviderStatus(status)
+		if checkedAt != nil {
+			c.CheckedAt = *checkedAt
+		}
+		if nextAt != nil {
+			c.NextAt = *nextAt
+		}
+		return c, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read reconciliation: %w", err)
+	}
+
+	return out, nil
+}
+
+// Record implements reconcile.Store.
+func (s *Store) Record(ctx context.Context, c reconcile.Check) error {
+	tag, err := s.client.Exec(ctx,
+		`UPDATE payments.reconciliation SET checked_at = $3, status = $4, checks = $5, next_at = $6
+		 WHERE payment_id = $1 AND saved_at = $2`,
+		c.PaymentID, c.SavedAt, c.CheckedAt, int16(c.Status), c.Checks, c.NextAt)
+	if err != nil {
+		return fmt.Errorf("record reconciliation: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return reconcile.ErrCheckNotFound
+	}
+
+	return nil
+}
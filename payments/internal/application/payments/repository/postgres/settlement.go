@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/settlement"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+var _ settlement.Store = (*Store)(nil)
+
+// indexSettlement files a PaymentSettled event appended as version under its payout inside the
+// Save transaction. Other events are ignored.
+func (s *Store) indexSettlement(ctx context.Context, tx pgx.Tx, p *payment.Payment, version uint64, e proto.Message) error {
+	ev, ok := e.(*eventv1.PaymentSettled)
+	if !ok {
+		return nil
+	}
+
+	_, err := tx.Exec(ctx,
+		`INSERT INTO payments.settlements (payment_id, version, settlement_id, payout_id, provider, settled_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		p.ID(), version, ev.GetSettlementId(), ev.GetPayoutId(), p.Provider(), ev.GetSettledAt().AsTime())
+	if err != nil {
+		return fmt.Errorf("index settlement: %w", err)
+	}
+	return nil
+}
+
+// Batch implements settlement.Store.
+func (s *Store) Batch(ctx context.Context, payoutID string) (settlement.Batch, error) {
+	rows, err := s.client.Query(ctx,
+		`SELECT s.payment_id, s.provider, e.payload
+		 FROM payments.settlements s JOIN payments.events e USING (payment_id, version)
+		 WHERE s.payout_id = $1
+		 ORDER BY s.settled_at, s.settlement_id`, payoutID)
+	if err != nil {
+		return settlement.Batch{}, fmt.Errorf("query settlements: %w", err)
+	}
+
+	entries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (settlement.Entry, error) {
+		var (
+			e       settlement.Entry
+			payload []byte
+		)
+		if err := row.Scan(&e.PaymentID, &e.Provider, &payload); err != nil {
+			return settlement.Entry{}, err
+		}
+		ev := &eventv1.PaymentSettled{}
+		if err := proto.Unmarshal(payload, ev); err != nil {
+			return settlement.Entry{}, fmt.Errorf("unmarshal settlement: %w", err)
+		}
+		e.Settlement = payment.SettlementOf(ev)
+		return e, nil
+	})
+	if err != nil {
+		return settlement.Batch{}, fmt.Errorf("read settlements: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return settlement.Batch{}, settlement.ErrBatchNotFound
+	}
+	return settlement.Batch{PayoutID: payoutID, Entries: entries}, nil
+}
@@ -18,4 +18,8 @@ type PaymentRepository interface {
 
 	// Load reconstructs a payment aggregate by its ID or returns ErrNotFound.
 	Load(ctx context.Context, id uuid.UUID) (*payment.Payment, error)
+
+	// ListByInvoice returns the payments of an invoice, oldest first.
+	// An invoice without payments yields an empty slice, not ErrNotFound.
+	ListByInvoice(ctx context.Context, invoiceID uuid.UUID) ([]*payment.Payment, error)
 }
@@ -0,0 +1,295 @@
+// Package sca decides whether a payment is created with an SCA/3DS challenge.
+//
+// The Engine evaluates rules loaded from YAML top to bottom; the first rule whose
+// conditions all match decides. A rule either requires SCA or claims a PSD2 exemption:
+//
+//	low_value                  RTS Art. 16, remote transactions up to EUR 30
+//	transaction_risk_analysis  RTS Art. 18, within the acquirer's fraud-rate band
+//	recurring                  RTS Art. 14, same amount to the same payee
+//	merchant_initiated         out of scope, the customer is not in session
+//	out_of_scope               out of scope, e.g. a card issued outside the EEA
+//
+// The issuer may still challenge an exempted payment; a claimed exemption only lets the
+// provider skip requesting one.
+package sca
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// ErrInvalidPolicy is returned when a policy file cannot be used.
+var ErrInvalidPolicy = errors.New("sca: invalid policy")
+
+// DefaultRule names the decision taken when no rule matches.
+const DefaultRule = "default"
+
+// Config is the YAML form of a policy.
+type Config struct {
+	// Regions names groups of countries usable in conditions, e.g. EEA: [AT, BE, ...].
+	Regions map[string][]string `yaml:"regions"`
+	// Default is the outcome when no rule matches; SCA is required if it is empty.
+	Default Outcome `yaml:"default"`
+	Rules   []Rule  `yaml:"rules"`
+}
+
+// Outcome is what a rule decides: either RequireSCA or an Exemption.
+type Outcome struct {
+	RequireSCA bool   `yaml:"require_sca"`
+	Exemption  string `yaml:"exemption"` // e.g. "low_value"
+}
+
+// Rule applies its Outcome to payments matching all of When.
+type Rule struct {
+	Name    string     `yaml:"name"`
+	When    Conditions `yaml:"when"`
+	Outcome `yaml:",inline"`
+}
+
+// Conditions narrow a rule. Empty conditions match every payment.
+type Conditions struct {
+	Currencies []string `yaml:"currencies"` // ISO 4217
+	// MinAmount and MaxAmount are inclusive bounds per currency, e.g. {EUR: "30.00"}.
+	// A payment in a currency without a bound does not match.
+	MinAmount         map[string]string `yaml:"min_amount"`
+	MaxAmount         map[string]string `yaml:"max_amount"`
+	Kinds             []string          `yaml:"kinds"`       // e.g. "one_time", "recurring"
+	Regions           []string          `yaml:"regions"`     // countries or region groups
+	NotRegions        []string          `yaml:"not_regions"` // countries or region groups
+	MerchantInitiated *bool             `yaml:"merchant_initiated"`
+}
+
+// Engine is a payment.Policy whose SCA decision follows configured rules.
+// Capture and currency rules are delegated to a base policy.
+type Engine struct {
+	payment.Policy
+
+	rules    []rule
+	fallback payment.SCADecision
+}
+
+// Option configures an Engine.
+type Option func(*Engine)
+
+// WithBase sets the policy capture and currency rules are delegated to.
+// It defaults to a zero payment.StaticPolicy.
+func WithBase(base payment.Policy) Option {
+	return func(e *Engine) { e.Policy = base }
+}
+
+var _ payment.Policy = (*Engine)(nil)
+
+// LoadFile reads a YAML policy from path.
+func LoadFile(path string, opts ...Option) (*Engine, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("sca: open policy: %w", err)
+	}
+	defer f.Close()
+
+	return Load(f, opts...)
+}
+
+// Load reads a YAML policy. Unknown keys are rejected, so a typo does not silently drop a condition.
+func Load(r io.Reader, opts ...Option) (*Engine, error) {
+	var cfg Config
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPolicy, err)
+	}
+
+	return New(cfg, opts...)
+}
+
+// New compiles cfg into an Engine.
+func New(cfg Config, opts ...Option) (*Engine, error) {
+	e := &Engine{
+		Policy:   &payment.StaticPolicy{},
+		fallback: payment.SCADecision{Required: true, Rule: DefaultRule},
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	if cfg.Default != (Outcome{}) {
+		d, err := cfg.Default.decision(DefaultRule)
+		if err != nil {
+			return nil, err
+		}
+		e.fallback = d
+	}
+
+	seen := make(map[string]bool, len(cfg.Rules))
+	for _, r := range cfg.Rules {
+		if r.Name == "" || r.Name == DefaultRule || seen[r.Name] {
+			return nil, fmt.Errorf("%w: rule name %q is empty, reserved or repeated", ErrInvalidPolicy, r.Name)
+		}
+		seen[r.Name] = true
+
+		compiled, err := compile(r, cfg.Regions)
+		if err != nil {
+			return nil, err
+		}
+		e.rules = append(e.rules, compiled)
+	}
+
+	return e, nil
+}
+
+// ShouldRequireSCA implements payment.Policy.
+func (e *Engine) ShouldRequireSCA(in payment.SCAContext) payment.SCADecision {
+	for _, r := range e.rules {
+		if r.matches(in) {
+			return r.decision
+		}
+	}
+	return e.fallback
+}
+
+// rule is a Rule with parsed values.
+type rule struct {
+	decision payment.SCADecision
+
+	currencies        []string
+	minAmount         map[string]decimal.Decimal
+	maxAmount         map[string]decimal.Decimal
+	kinds             []eventv1.PaymentKind
+	regions           []string
+	notRegions        []string
+	merchantInitiated *bool
+}
+
+func compile(r Rule, groups map[string][]string) (rule, error) {
+	d, err := r.decision(r.Name)
+	if err != nil {
+		return rule{}, err
+	}
+
+	out := rule{
+		decision:          d,
+		currencies:        upper(r.When.Currencies),
+		merchantInitiated: r.When.MerchantInitiated,
+		regions:           expand(r.When.Regions, groups),
+		notRegions:        expand(r.When.NotRegions, groups),
+	}
+
+	if out.minAmount, err = amounts(r.When.MinAmount); err != nil {
+		return rule{}, fmt.Errorf("%w: rule %q: min_amount: %w", ErrInvalidPolicy, r.Name, err)
+	}
+	if out.maxAmount, err = amounts(r.When.MaxAmount); err != nil {
+		return rule{}, fmt.Errorf("%w: rule %q: max_amount: %w", ErrInvalidPolicy, r.Name, err)
+	}
+
+	for _, k := range r.When.Kinds {
+		v, ok := eventv1.PaymentKind_value["PAYMENT_KIND_"+strings.ToUpper(k)]
+		if !ok || v == 0 {
+			return rule{}, fmt.Errorf("%w: rule %q: unknown kind %q", ErrInvalidPolicy, r.Name, k)
+		}
+		out.kinds = append(out.kinds, eventv1.PaymentKind(v))
+	}
+
+	return out, nil
+}
+
+func (r rule) matches(in payment.SCAContext) bool {
+	currency := strings.ToUpper(in.Amount.GetCurrencyCode())
+	region := strings.ToUpper(in.Region)
+
+	if len(r.currencies) > 0 && !slices.Contains(r.currencies, currency) {
+		return false
+	}
+	if !within(r.minAmount, in.Amount, func(amt, bound decimal.Decimal) bool { return amt.GreaterThanOrEqual(bound) }) ||
+		!within(r.maxAmount, in.Amount, func(amt, bound decimal.Decimal) bool { return amt.LessThanOrEqual(bound) }) {
+		return false
+	}
+	if len(r.kinds) > 0 && !slices.Contains(r.kinds, in.Kind) {
+		return false
+	}
+	// An unknown region matches neither regions nor not_regions.
+	if len(r.regions) > 0 && (region == "" || !slices.Contains(r.regions, region)) {
+		return false
+	}
+	if len(r.notRegions) > 0 && (region == "" || slices.Contains(r.notRegions, region)) {
+		return false
+	}
+	if r.merchantInitiated != nil && *r.merchantInitiated != in.MerchantInitiated {
+		return false
+	}
+	return true
+}
+
+func (o Outcome) decision(name string) (payment.SCADecision, error) {
+	switch {
+	case o.RequireSCA && o.Exemption != "":
+		return payment.SCADecision{}, fmt.Errorf("%w: rule %q both requires SCA and claims an exemption", ErrInvalidPolicy, name)
+	case o.RequireSCA:
+		return payment.SCADecision{Required: true, Rule: name}, nil
+	}
+
+	v, ok := eventv1.SCAExemption_value["SCA_EXEMPTION_"+strings.ToUpper(o.Exemption)]
+	if !ok || v == 0 {
+		return payment.SCADecision{}, fmt.Errorf("%w: rule %q: unknown exemption %q", ErrInvalidPolicy, name, o.Exemption)
+	}
+	return payment.SCADecision{Exemption: eventv1.SCAExemption(v), Rule: name}, nil
+}
+
+// within reports whether amt satisfies the bound of its currency; no bounds always do.
+func within(bounds map[string]decimal.Decimal, amt *money.Money, ok func(amt, bound decimal.Decimal) bool) bool {
+	if len(bounds) == 0 {
+		return true
+	}
+	bound, found := bounds[strings.ToUpper(amt.GetCurrencyCode())]
+	if !found {
+		return false
+	}
+	return ok(decimal.New(amt.GetUnits(), 0).Add(decimal.New(int64(amt.GetNanos()), -9)), bound)
+}
+
+func amounts(in map[string]string) (map[string]decimal.Decimal, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]decimal.Decimal, len(in))
+	for currency, s := range in {
+		d, err := decimal.NewFromString(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", currency, err)
+		}
+		out[strings.ToUpper(currency)] = d
+	}
+	return out, nil
+}
+
+// expand replaces region group names by their countries.
+func expand(regions []string, groups map[string][]string) []string {
+	var out []string
+	for _, r := range regions {
+		if countries, ok := groups[r]; ok {
+			out = append(out, upper(countries)...)
+			continue
+		}
+		out = append(out, strings.ToUpper(r))
+	}
+	return out
+}
+
+func upper(in []string) []string {
+	out := make([]string, len(in))
+	for i, s := range in {
+		out[i] = strings.ToUpper(s)
+	}
+	return out
+}
@@ -0,0 +1,124 @@
+package sca_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/sca"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+func eur(units int64, nanos int32) *money.Money {
+	return &money.Money{CurrencyCode: "EUR", Units: units, Nanos: nanos}
+}
+
+func TestEngine_ShouldRequireSCA(t *testing.T) {
+	engine, err := sca.LoadFile(filepath.Join("testdata", "policy.yaml"))
+	require.NoError(t, err)
+
+	oneTime := eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME
+	recurring := eventv1.PaymentKind_PAYMENT_KIND_RECURRING
+
+	tests := []struct {
+		name string
+		in   payment.SCAContext
+		want payment.SCADecision
+	}{
+		{
+			name: "low value in the EEA",
+			in:   payment.SCAContext{Amount: eur(30, 0), Kind: oneTime, Region: "de"},
+			want: payment.SCADecision{Exemption: eventv1.SCAExemption_SCA_EXEMPTION_LOW_VALUE, Rule: "low-value"},
+		},
+		{
+			name: "above low value falls through to TRA",
+			in:   payment.SCAContext{Amount: eur(30, 10_000_000), Kind: oneTime, Region: "FR"},
+			want: payment.SCADecision{Exemption: eventv1.SCAExemption_SCA_EXEMPTION_TRANSACTION_RISK_ANALYSIS, Rule: "transaction-risk-analysis"},
+		},
+		{
+			name: "high value always challenged",
+			in:   payment.SCAContext{Amount: eur(500, 0), Kind: oneTime, Region: "DE"},
+			want: payment.SCADecision{Required: true, Rule: "high-value"},
+		},
+		{
+			name: "no matching rule requires SCA",
+			in:   payment.SCAContext{Amount: eur(300, 0), Kind: oneTime, Region: "DE"},
+			want: payment.SCADecision{Required: true, Rule: sca.DefaultRule},
+		},
+		{
+			name: "currency without a bound does not match",
+			in:   payment.SCAContext{Amount: &money.Money{CurrencyCode: "SEK", Units: 100}, Kind: oneTime, Region: "SE"},
+			want: payment.SCADecision{Required: true, Rule: sca.DefaultRule},
+		},
+		{
+			name: "customer outside the EEA",
+			in:   payment.SCAContext{Amount: eur(1000, 0), Kind: oneTime, Region: "US"},
+			want: payment.SCADecision{Exemption: eventv1.SCAExemption_SCA_EXEMPTION_OUT_OF_SCOPE, Rule: "outside-eea"},
+		},
+		{
+			name: "unknown region is not out of scope",
+			in:   payment.SCAContext{Amount: eur(10, 0), Kind: oneTime},
+			want: payment.SCADecision{Required: true, Rule: sca.DefaultRule},
+		},
+		{
+			name: "recurring charge of a saved card",
+			in:   payment.SCAContext{Amount: eur(1000, 0), Kind: recurring, Region: "DE", MerchantInitiated: true},
+			want: payment.SCADecision{Exemption: eventv1.SCAExemption_SCA_EXEMPTION_RECURRING, Rule: "recurring"},
+		},
+		{
+			name: "first recurring payment is customer-initiated",
+			in:   payment.SCAContext{Amount: eur(1000, 0), Kind: recurring, Region: "DE"},
+			want: payment.SCADecision{Required: true, Rule: "high-value"},
+		},
+		{
+			name: "merchant-initiated one-time charge",
+			in:   payment.SCAContext{Amount: eur(1000, 0), Kind: oneTime, Region: "DE", MerchantInitiated: true},
+			want: payment.SCADecision{Exemption: eventv1.SCAExemption_SCA_EXEMPTION_MERCHANT_INITIATED, Rule: "merchant-initiated"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, engine.ShouldRequireSCA(tt.in))
+		})
+	}
+}
+
+func TestEngine_Default(t *testing.T) {
+	engine, err := sca.Load(strings.NewReader("default: {exemption: out_of_scope}"))
+	require.NoError(t, err)
+	require.Equal(t,
+		payment.SCADecision{Exemption: eventv1.SCAExemption_SCA_EXEMPTION_OUT_OF_SCOPE, Rule: sca.DefaultRule},
+		engine.ShouldRequireSCA(payment.SCAContext{Amount: eur(10, 0)}))
+
+	// An empty policy challenges everything, like the issuer would.
+	engine, err = sca.Load(strings.NewReader(""))
+	require.NoError(t, err)
+	require.True(t, engine.ShouldRequireSCA(payment.SCAContext{Amount: eur(10, 0)}).Required)
+	require.True(t, engine.IsCurrencySupported("EUR"), "delegated to the base policy")
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	for name, policy := range map[string]string{
+		"unknown key":       "rules: [{name: a, when: {region: [DE]}, require_sca: true}]",
+		"no outcome":        "rules: [{name: a}]",
+		"both outcomes":     "rules: [{name: a, require_sca: true, exemption: low_value}]",
+		"unknown exemption": "rules: [{name: a, exemption: trusted_friend}]",
+		"unknown kind":      "rules: [{name: a, when: {kinds: [monthly]}, require_sca: true}]",
+		"bad amount":        "rules: [{name: a, when: {max_amount: {EUR: thirty}}, exemption: low_value}]",
+		"repeated name":     "rules: [{name: a, require_sca: true}, {name: a, require_sca: true}]",
+		"reserved name":     "rules: [{name: default, require_sca: true}]",
+		"invalid default":   "default: {exemption: unknown}",
+		"not a mapping":     "- rules",
+		"missing rule name": "rules: [{require_sca: true}]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := sca.Load(strings.NewReader(policy))
+			require.ErrorIs(t, err, sca.ErrInvalidPolicy)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Package settlement keeps what providers actually paid out for our payments.
+//
+// Providers settle captured funds in payouts: Stripe lists the balance transactions of a payout,
+// T-Bank sends a registry with every payment order (ports.SettlementLister). The settle use case
+// records each entry on its payment as a PaymentSettled event, which books the provider fee, the
+// FX adjustment and the net amount on the ledger. Repositories index those events by payout on
+// every Save, in the same transaction as the events, so that finance can query a batch by payout ID.
+package settlement
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+)
+
+// ErrBatchNotFound is returned by a Store when nothing of the payout was imported.
+var ErrBatchNotFound = errors.New("settlement: batch not found")
+
+// Entry is a settlement of a payment in a payout.
+type Entry struct {
+	PaymentID uuid.UUID
+	Provider  string
+	payment.Settlement
+}
+
+// Batch is everything imported from a payout, ordered by settlement time.
+type Batch struct {
+	PayoutID string
+	Entries  []Entry
+}
+
+// Store queries settlements by payout. Repositories that own the payments schema implement it
+// and index every PaymentSettled event on Save.
+type Store interface {
+	// Batch returns the settlements of a payout or ErrBatchNotFound.
+	Batch(ctx context.Context, payoutID string) (Batch, error)
+}
+
+// Totals sums gross, fees and net of the batch. A payout is made in one currency; entries in
+// another one fail with ledger.ErrCurrencyMismatch.
+func (b Batch) Totals() (gross, fees, net *money.Money, err error) {
+	if len(b.Entries) == 0 {
+		return nil, nil, nil, ErrBatchNotFound
+	}
+	currency := ledger.Currency(b.Entries[0].Net)
+	gross, fees, net = ledger.Zero(currency), ledger.Zero(currency), ledger.Zero(currency)
+	for _, e := range b.Entries {
+		if gross, err = ledger.Add(gross, e.Gross); err != nil {
+			return nil, nil, nil, err
+		}
+		if fees, err = ledger.Add(fees, e.Fee); err != nil {
+			return nil, nil, nil, err
+		}
+		if net, err = ledger.Add(net, e.Net); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return gross, fees, net, nil
+}
+
+// Recorded returns the entries of the uncommitted PaymentSettled events of p, i.e. what a Save
+// of p has to index.
+func Recorded(p *payment.Payment) []Entry {
+	var out []Entry
+	for _, e := range p.UncommittedEvents() {
+		if ev, ok := e.(*eventv1.PaymentSettled); ok {
+			out = append(out, Entry{PaymentID: p.ID(), Provider: p.Provider(), Settlement: payment.SettlementOf(ev)})
+		}
+	}
+	return out
+}
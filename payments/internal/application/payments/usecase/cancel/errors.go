@@ -0,0 +1,12 @@
+package cancel
+
+import "errors"
+
+var (
+	// ErrPaymentNotFound is returned when the payment to cancel is not found.
+	ErrPaymentNotFound = errors.New("cancel: payment not found")
+	// ErrPaymentNotCancelable is returned when the payment is not in a cancelable state.
+	ErrPaymentNotCancelable = errors.New("cancel: payment is not cancelable")
+	// ErrCancelRejected is returned when the provider did not void the payment.
+	ErrCancelRejected = errors.New("cancel: rejected by provider")
+)
@@ -0,0 +1,122 @@
+package cancel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+// Command contains input data for canceling a payment.
+type Command struct {
+	PaymentID uuid.UUID
+	Reason    eventv1.CancelReason // UNSPECIFIED → AUTH_VOID for a held payment, USER otherwise
+}
+
+// Result is returned after a successful cancellation.
+type Result struct {
+	PaymentID uuid.UUID
+	Reason    eventv1.CancelReason
+	State     flowv1.PaymentFlow
+	Version   uint64
+}
+
+// Handler orchestrates payment cancellation and authorization voids.
+type Handler struct {
+	Repo     repository.PaymentRepository
+	Provider ports.PaymentProvider
+}
+
+func (h *Handler) Handle(ctx context.Context, cmd Command) (*Result, error) {
+	if cmd.PaymentID == uuid.Nil {
+		return nil, fmt.Errorf("%w: payment ID is required", ErrPaymentNotFound)
+	}
+
+	agg, err := h.Repo.Load(ctx, cmd.PaymentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrPaymentNotFound, cmd.PaymentID)
+		}
+		return nil, fmt.Errorf("load payment: %w", err)
+	}
+	expectedVersion := agg.Version()
+
+	switch agg.State() {
+	case flowv1.PaymentFlow_PAYMENT_FLOW_CREATED,
+		flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION,
+		flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED:
+	default:
+		// Captured money goes back through a refund, not a void.
+		return nil, fmt.Errorf("%w: payment state is %v", ErrPaymentNotCancelable, agg.State())
+	}
+	if agg.ProviderID() == "" && agg.State() == flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED {
+		return nil, fmt.Errorf("%w: %w", ErrPaymentNotCancelable, payment.ErrProviderNotAttached)
+	}
+
+	reason := cmd.Reason
+	if reason == eventv1.CancelReason_CANCEL_REASON_UNSPECIFIED {
+		reason = eventv1.CancelReason_CANCEL_REASON_USER
+		if agg.State() == flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED {
+			reason = eventv1.CancelReason_CANCEL_REASON_AUTH_VOID
+		}
+	}
+
+	// Void at the provider first: recording CANCELED while the hold is still on the
+	// card is exactly what we must not do.
+	if err := h.void(ctx, agg, reason, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	if err := agg.Cancel(ctx, reason); err != nil {
+		return nil, fmt.Errorf("apply cancel to aggregate: %w", err)
+	}
+
+	if err := agg.Invariants(); err != nil {
+		return nil, fmt.Errorf("domain invariants violated: %w", err)
+	}
+
+	if err := h.Repo.Save(ctx, agg, expectedVersion); err != nil {
+		return nil, fmt.Errorf("save canceled payment: %w", err)
+	}
+
+	return &Result{
+		PaymentID: cmd.PaymentID,
+		Reason:    reason,
+		State:     agg.State(),
+		Version:   agg.Version(),
+	}, nil
+}
+
+// void cancels the payment at the provider. A payment the provider has not seen yet
+// has nothing to void.
+func (h *Handler) void(ctx context.Context, agg *payment.Payment, reason eventv1.CancelReason, expectedVersion uint64) error {
+	if agg.ProviderID() == "" {
+		return nil
+	}
+
+	out, err := h.Provider.CancelPayment(ctx, ports.CancelPaymentIn{
+		PaymentID:      agg.ID(),
+		Provider:       ports.Provider(agg.Provider()),
+		ProviderID:     agg.ProviderID(),
+		Reason:         strings.ToLower(strings.TrimPrefix(reason.String(), "CANCEL_REASON_")),
+		IdempotencyKey: fmt.Sprintf("%s:cancel:%d", agg.ID(), expectedVersion),
+	})
+	if err != nil {
+		// Nothing was recorded: the stream stays as loaded and the cancel can be retried.
+		return fmt.Errorf("provider cancel: %w", err)
+	}
+
+	if out.Status != ports.ProviderStatusCanceled {
+		// e.g. the provider captured concurrently: recording CANCELED would lie about the money.
+		return fmt.Errorf("%w: provider status %d", ErrCancelRejected, out.Status)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package cancel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+)
+
+var amount = &money.Money{CurrencyCode: "USD", Units: 15}
+
+func storedPayment(t *testing.T, repo *memory.InMemory, steps ...func(context.Context, *payment.Payment) error) *payment.Payment {
+	t.Helper()
+	ctx := context.Background()
+
+	p, err := payment.New(uuid.New(), uuid.New(), amount,
+		eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+	require.NoError(t, err)
+	require.NoError(t, p.AttachProvider(ctx, "stripe", "pi_1"))
+	for _, step := range steps {
+		require.NoError(t, step(ctx, p))
+	}
+	require.NoError(t, repo.Save(ctx, p, 0))
+	return p
+}
+
+func authorize(ctx context.Context, p *payment.Payment) error { return p.Authorize(ctx, amount) }
+
+func capture(ctx context.Context, p *payment.Payment) error { return p.Capture(ctx, amount) }
+
+func requireSCA(ctx context.Context, p *payment.Payment) error { return p.RequireSCA(ctx) }
+
+func TestHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		steps      []func(context.Context, *payment.Payment) error
+		reason     eventv1.CancelReason
+		wantReason eventv1.CancelReason
+		wantWire   string
+	}{
+		{
+			name:       "authorized hold is voided",
+			steps:      []func(context.Context, *payment.Payment) error{authorize},
+			wantReason: eventv1.CancelReason_CANCEL_REASON_AUTH_VOID,
+			wantWire:   "auth_void",
+		},
+		{
+			name:       "waiting for SCA",
+			steps:      []func(context.Context, *payment.Payment) error{requireSCA},
+			wantReason: eventv1.CancelReason_CANCEL_REASON_USER,
+			wantWire:   "user",
+		},
+		{
+			name:       "explicit reason",
+			reason:     eventv1.CancelReason_CANCEL_REASON_DUPLICATE,
+			wantReason: eventv1.CancelReason_CANCEL_REASON_DUPLICATE,
+			wantWire:   "duplicate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := memory.New()
+			provider := mocks.NewMockPaymentProvider(t)
+			h := &Handler{Repo: repo, Provider: provider}
+			p := storedPayment(t, repo, tt.steps...)
+
+			provider.EXPECT().CancelPayment(mock.Anything, mock.MatchedBy(func(in ports.CancelPaymentIn) bool {
+				return in.ProviderID == "pi_1" && in.Reason == tt.wantWire && in.IdempotencyKey != ""
+			})).Return(ports.CancelPaymentOut{Provider: ports.ProviderStripe, Status: ports.ProviderStatusCanceled}, nil).Once()
+
+			res, err := h.Handle(ctx, Command{PaymentID: p.ID(), Reason: tt.reason})
+			require.NoError(t, err)
+			require.Equal(t, tt.wantReason, res.Reason)
+			require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_CANCELED, res.State)
+
+			got, err := repo.Load(ctx, p.ID())
+			require.NoError(t, err)
+			require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_CANCELED, got.State())
+			require.Equal(t, res.Version, got.Version())
+		})
+	}
+}
+
+func TestHandler_Handle_NoProvider(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	h := &Handler{Repo: repo, Provider: mocks.NewMockPaymentProvider(t)}
+
+	// Created but never sent to the provider: there is nothing to void.
+	p, err := payment.New(uuid.New(), uuid.New(), amount,
+		eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, p, 0))
+
+	res, err := h.Handle(ctx, Command{PaymentID: p.ID()})
+	require.NoError(t, err)
+	require.Equal(t, eventv1.CancelReason_CANCEL_REASON_USER, res.Reason)
+	require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_CANCELED, res.State)
+
+	// A hold without a provider ID cannot be voided.
+	held, err := payment.New(uuid.New(), uuid.New(), amount,
+		eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+	require.NoError(t, err)
+	require.NoError(t, authorize(ctx, held))
+	require.NoError(t, repo.Save(ctx, held, 0))
+
+	_, err = h.Handle(ctx, Command{PaymentID: held.ID()})
+	require.ErrorIs(t, err, payment.ErrProviderNotAttached)
+}
+
+func TestHandler_Handle_StreamUntouched(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("provider error", func(t *testing.T) {
+		repo := memory.New()
+		provider := mocks.NewMockPaymentProvider(t)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := storedPayment(t, repo, authorize)
+
+		provider.EXPECT().CancelPayment(mock.Anything, mock.Anything).
+			Return(ports.CancelPaymentOut{}, errors.New("stripe: api_connection_error")).Once()
+		_, err := h.Handle(ctx, Command{PaymentID: p.ID()})
+		require.Error(t, err)
+
+		provider.EXPECT().CancelPayment(mock.Anything, mock.Anything).
+			Return(ports.CancelPaymentOut{Status: ports.ProviderStatusSucceeded}, nil).Once()
+		_, err = h.Handle(ctx, Command{PaymentID: p.ID()})
+		require.ErrorIs(t, err, ErrCancelRejected)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED, got.State())
+		require.Equal(t, p.Version(), got.Version())
+	})
+
+	t.Run("captured payments are refunded, not canceled", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Provider: mocks.NewMockPaymentProvider(t)}
+		p := storedPayment(t, repo, authorize, capture)
+
+		_, err := h.Handle(ctx, Command{PaymentID: p.ID()})
+		require.ErrorIs(t, err, ErrPaymentNotCancelable)
+
+		_, err = h.Handle(ctx, Command{PaymentID: uuid.New()})
+		require.ErrorIs(t, err, ErrPaymentNotFound)
+	})
+}
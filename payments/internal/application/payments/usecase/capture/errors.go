@@ -0,0 +1,14 @@
+package capture
+
+import "errors"
+
+var (
+	// ErrPaymentNotFound is returned when the payment to capture is not found.
+	ErrPaymentNotFound = errors.New("capture: payment not found")
+	// ErrInvalidCaptureAmount is returned when the capture amount is invalid.
+	ErrInvalidCaptureAmount = errors.New("capture: invalid capture amount")
+	// ErrPaymentNotCapturable is returned when the payment is not in a capturable state.
+	ErrPaymentNotCapturable = errors.New("capture: payment is not capturable")
+	// ErrCaptureRejected is returned when the provider did not capture the funds.
+	ErrCaptureRejected = errors.New("capture: rejected by provider")
+)
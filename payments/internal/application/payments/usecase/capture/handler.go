@@ -0,0 +1,152 @@
+package capture
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// Command contains input data for capturing a manually captured payment.
+type Command struct {
+	PaymentID uuid.UUID
+	Amount    *money.Money // nil → capture everything still held
+	// Final releases whatever stays uncaptured after this capture; no further captures are accepted.
+	Final    bool
+	Metadata map[string]string
+}
+
+// Result is returned after a successful capture.
+type Result struct {
+	PaymentID          uuid.UUID
+	CapturedAmount     *money.Money // captured by this call
+	TotalCaptured      *money.Money
+	RemainingToCapture *money.Money
+	ReleasedAmount     *money.Money // released by a final capture, nil when nothing was left
+	State              flowv1.PaymentFlow
+	Version            uint64
+}
+
+// Handler orchestrates payment captures.
+type Handler struct {
+	Repo     repository.PaymentRepository
+	Provider ports.PaymentProvider
+	Policy   payment.Policy // optional; nil skips the capture specifications
+}
+
+func (h *Handler) Handle(ctx context.Context, cmd Command) (*Result, error) {
+	if cmd.PaymentID == uuid.Nil {
+		return nil, fmt.Errorf("%w: payment ID is required", ErrPaymentNotFound)
+	}
+
+	agg, err := h.Repo.Load(ctx, cmd.PaymentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrPaymentNotFound, cmd.PaymentID)
+		}
+		return nil, fmt.Errorf("load payment: %w", err)
+	}
+	expectedVersion := agg.Version()
+
+	// AUTHORIZED → first capture; PAID → further partial captures of the same authorization.
+	if agg.State() != flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED && agg.State() != flowv1.PaymentFlow_PAYMENT_FLOW_PAID {
+		return nil, fmt.Errorf("%w: payment state is %v", ErrPaymentNotCapturable, agg.State())
+	}
+	if agg.ProviderID() == "" {
+		return nil, fmt.Errorf("%w: %w", ErrPaymentNotCapturable, payment.ErrProviderNotAttached)
+	}
+
+	remaining := agg.Ledger.RemainingToCapture()
+	if remaining == nil || ledger.Compare(remaining, ledger.Zero(remaining.GetCurrencyCode())) <= 0 {
+		return nil, fmt.Errorf("%w: nothing left to capture", ErrPaymentNotCapturable)
+	}
+
+	amount := lo.Ternary(cmd.Amount != nil, cmd.Amount, remaining)
+	switch {
+	case amount.GetCurrencyCode() != remaining.GetCurrencyCode():
+		return nil, fmt.Errorf("%w: currency %s, payment is in %s", ErrInvalidCaptureAmount, amount.GetCurrencyCode(), remaining.GetCurrencyCode())
+	case ledger.Compare(amount, ledger.Zero(amount.GetCurrencyCode())) <= 0:
+		return nil, fmt.Errorf("%w: amount must be positive", ErrInvalidCaptureAmount)
+	case ledger.Compare(amount, remaining) > 0:
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCaptureAmount, ledger.ErrCaptureExceedsLimit)
+	}
+	caps := ports.CapabilitiesFor(h.Provider, ports.Provider(agg.Provider()))
+	// Without partial captures the provider takes one capture and releases the rest of the hold,
+	// so a capture of less than the hold must be final.
+	partial := ledger.Compare(amount, remaining) < 0
+	if partial && !cmd.Final && !caps.PartialCapture {
+		return nil, fmt.Errorf("%w: partial capture", ports.ErrUnsupportedOperation)
+	}
+	final := cmd.Final || !partial
+	if h.Policy != nil {
+		if err := agg.CheckCapture(h.Policy.Specifications().Capture, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	out, err := h.Provider.CapturePayment(ctx, ports.CapturePaymentIn{
+		PaymentID:  cmd.PaymentID,
+		Provider:   ports.Provider(agg.Provider()),
+		ProviderID: agg.ProviderID(),
+		Amount:     amount,
+		Currency:   amount.GetCurrencyCode(),
+		Final:      final,
+		// One key per aggregate version: a retried request dedupes at the provider,
+		// the next capture (after a successful save) gets a fresh key.
+		IdempotencyKey: fmt.Sprintf("%s:capture:%d", cmd.PaymentID, expectedVersion),
+		Metadata: lo.Assign(cmd.Metadata, map[string]string{
+			"payment_id": cmd.PaymentID.String(),
+		}),
+	})
+	if err != nil {
+		// Nothing was recorded: the stream stays as loaded and the capture can be retried.
+		return nil, fmt.Errorf("provider capture: %w", err)
+	}
+
+	switch out.Status {
+	case ports.ProviderStatusSucceeded, ports.ProviderStatusRequiresCapture:
+	default:
+		return nil, fmt.Errorf("%w: provider status %d", ErrCaptureRejected, out.Status)
+	}
+
+	captured := lo.Ternary(out.Captured != nil, out.Captured, amount)
+	if err := agg.Capture(ctx, captured); err != nil {
+		return nil, fmt.Errorf("apply capture to aggregate: %w", err)
+	}
+
+	var released *money.Money
+	if final {
+		// The provider has already given the remainder back to the customer.
+		if released, err = agg.ReleaseAuthorization(ctx); err != nil {
+			return nil, fmt.Errorf("apply release to aggregate: %w", err)
+		}
+	}
+
+	if err := agg.Invariants(); err != nil {
+		return nil, fmt.Errorf("domain invariants violated: %w", err)
+	}
+
+	if err := h.Repo.Save(ctx, agg, expectedVersion); err != nil {
+		return nil, fmt.Errorf("save captured payment: %w", err)
+	}
+
+	return &Result{
+		PaymentID:          cmd.PaymentID,
+		CapturedAmount:     captured,
+		TotalCaptured:      agg.Ledger.Captured,
+		RemainingToCapture: agg.Ledger.RemainingToCapture(),
+		ReleasedAmount:     released,
+		State:              agg.State(),
+		Version:            agg.Version(),
+	}, nil
+}
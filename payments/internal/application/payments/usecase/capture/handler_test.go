@@ -0,0 +1,191 @@
+package capture
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/fixtures"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+)
+
+var partialCaptures = ports.Capabilities{PartialCapture: true}
+
+func capturedOK(_ context.Context, in ports.CapturePaymentIn) (ports.CapturePaymentOut, error) {
+	return ports.CapturePaymentOut{
+		Provider: ports.ProviderStripe,
+		Status:   ports.ProviderStatusSucceeded,
+		Captured: in.Amount,
+	}, nil
+}
+
+func TestHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("partial then remaining", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, partialCaptures)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.AuthorizedPayment(t, repo, fixtures.USD(100))
+
+		provider.EXPECT().CapturePayment(mock.Anything, mock.MatchedBy(func(in ports.CapturePaymentIn) bool {
+			return in.ProviderID == "pi_1" && !in.Final && proto.Equal(in.Amount, fixtures.USD(30))
+		})).RunAndReturn(capturedOK).Once()
+
+		res, err := h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(30)})
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, res.State)
+		require.True(t, proto.Equal(fixtures.USD(70), res.RemainingToCapture))
+		require.Equal(t, p.Version()+1, res.Version)
+
+		provider.EXPECT().CapturePayment(mock.Anything, mock.MatchedBy(func(in ports.CapturePaymentIn) bool {
+			return in.Final && proto.Equal(in.Amount, fixtures.USD(70))
+		})).RunAndReturn(capturedOK).Once()
+
+		res, err = h.Handle(ctx, Command{PaymentID: p.ID()})
+		require.NoError(t, err)
+		require.True(t, proto.Equal(fixtures.USD(100), res.TotalCaptured))
+		require.True(t, proto.Equal(fixtures.USD(0), res.RemainingToCapture))
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, p.Version()+2, got.Version())
+
+		_, err = h.Handle(ctx, Command{PaymentID: p.ID()})
+		require.ErrorIs(t, err, ErrPaymentNotCapturable)
+	})
+
+	t.Run("final partial capture releases the rest", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, partialCaptures)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.AuthorizedPayment(t, repo, fixtures.USD(100))
+
+		provider.EXPECT().CapturePayment(mock.Anything, mock.MatchedBy(func(in ports.CapturePaymentIn) bool {
+			return in.Final && proto.Equal(in.Amount, fixtures.USD(60))
+		})).RunAndReturn(capturedOK).Once()
+
+		res, err := h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(60), Final: true})
+		require.NoError(t, err)
+		require.True(t, proto.Equal(fixtures.USD(40), res.ReleasedAmount))
+		require.True(t, proto.Equal(fixtures.USD(0), res.RemainingToCapture))
+		require.Equal(t, p.Version()+2, res.Version)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.True(t, proto.Equal(fixtures.USD(40), got.Ledger.Released))
+
+		_, err = h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(10)})
+		require.ErrorIs(t, err, ErrPaymentNotCapturable)
+	})
+
+	t.Run("rejects invalid amounts before calling the provider", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Provider: fixtures.Provider(t, partialCaptures)}
+		p := fixtures.AuthorizedPayment(t, repo, fixtures.USD(100))
+
+		for _, amt := range []*money.Money{fixtures.USD(101), fixtures.USD(0), {CurrencyCode: "EUR", Units: 10}} {
+			_, err := h.Handle(ctx, Command{PaymentID: p.ID(), Amount: amt})
+			require.ErrorIs(t, err, ErrInvalidCaptureAmount)
+		}
+	})
+
+	t.Run("capture limit is checked before calling the provider", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, partialCaptures)
+		policy := &payment.StaticPolicy{Limits: payment.Limits{MaxCaptures: 1}}
+		h := &Handler{Repo: repo, Provider: provider, Policy: policy}
+		p := fixtures.AuthorizedPayment(t, repo, fixtures.USD(100))
+
+		provider.EXPECT().CapturePayment(mock.Anything, mock.Anything).RunAndReturn(capturedOK).Once()
+
+		_, err := h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(30)})
+		require.NoError(t, err)
+
+		_, err = h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(30)})
+		require.ErrorIs(t, err, payment.ErrPolicyViolation)
+		require.Equal(t, payment.RuleMaxCaptures, payment.Violations(err)[0].Rule)
+	})
+
+	t.Run("partial capture the provider cannot make", func(t *testing.T) {
+		repo := memory.New()
+		provider := mocks.NewMockPaymentProvider(t)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.AuthorizedPayment(t, repo, fixtures.USD(100))
+
+		provider.EXPECT().Capabilities().Return(ports.Capabilities{})
+		_, err := h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(30)})
+		require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+
+		provider.EXPECT().CapturePayment(mock.Anything, mock.Anything).RunAndReturn(capturedOK).Once()
+		res, err := h.Handle(ctx, Command{PaymentID: p.ID()})
+		require.NoError(t, err)
+		require.True(t, proto.Equal(fixtures.USD(100), res.TotalCaptured))
+	})
+
+	t.Run("final partial capture the provider can make once", func(t *testing.T) {
+		repo := memory.New()
+		provider := mocks.NewMockPaymentProvider(t)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.AuthorizedPayment(t, repo, fixtures.USD(100))
+
+		provider.EXPECT().Capabilities().Return(ports.Capabilities{})
+		provider.EXPECT().CapturePayment(mock.Anything, mock.MatchedBy(func(in ports.CapturePaymentIn) bool {
+			return in.Final && proto.Equal(in.Amount, fixtures.USD(30))
+		})).RunAndReturn(capturedOK).Once()
+
+		res, err := h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(30), Final: true})
+		require.NoError(t, err)
+		require.True(t, proto.Equal(fixtures.USD(70), res.ReleasedAmount))
+		require.Zero(t, res.RemainingToCapture.GetUnits())
+	})
+
+	t.Run("provider error leaves the stream untouched", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, partialCaptures)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.AuthorizedPayment(t, repo, fixtures.USD(100))
+
+		provider.EXPECT().CapturePayment(mock.Anything, mock.Anything).
+			Return(ports.CapturePaymentOut{}, errors.New("stripe: api_connection_error")).Once()
+		_, err := h.Handle(ctx, Command{PaymentID: p.ID()})
+		require.Error(t, err)
+
+		provider.EXPECT().CapturePayment(mock.Anything, mock.Anything).
+			Return(ports.CapturePaymentOut{Status: ports.ProviderStatusCanceled}, nil).Once()
+		_, err = h.Handle(ctx, Command{PaymentID: p.ID()})
+		require.ErrorIs(t, err, ErrCaptureRejected)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED, got.State())
+		require.Equal(t, p.Version(), got.Version())
+	})
+
+	t.Run("not capturable", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Provider: fixtures.Provider(t, partialCaptures)}
+
+		_, err := h.Handle(ctx, Command{PaymentID: uuid.New()})
+		require.ErrorIs(t, err, ErrPaymentNotFound)
+
+		p, err := payment.New(uuid.New(), uuid.New(), fixtures.USD(10),
+			eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, p, 0))
+
+		_, err = h.Handle(ctx, Command{PaymentID: p.ID()})
+		require.ErrorIs(t, err, ErrPaymentNotCapturable)
+	})
+}
@@ -0,0 +1,12 @@
+package confirm
+
+import "errors"
+
+var (
+	// ErrPaymentNotFound is returned when the payment to confirm is not found.
+	ErrPaymentNotFound = errors.New("confirm: payment not found")
+	// ErrNotAwaitingConfirmation is returned when the payment is not waiting for SCA/3DS.
+	ErrNotAwaitingConfirmation = errors.New("confirm: payment is not waiting for confirmation")
+	// ErrUnexpectedProviderStatus is returned when the provider reports a status the flow cannot handle.
+	ErrUnexpectedProviderStatus = errors.New("confirm: unexpected provider status")
+)
@@ -0,0 +1,118 @@
+package confirm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+)
+
+// Command contains input data for confirming a payment after SCA/3DS.
+type Command struct {
+	PaymentID uuid.UUID
+}
+
+// Result is returned after the provider status has been applied.
+type Result struct {
+	PaymentID      uuid.UUID
+	State          flowv1.PaymentFlow
+	Version        uint64
+	ProviderStatus ports.ProviderStatus // Pending/RequiresAction → still WAITING_FOR_CONFIRMATION, poll again
+}
+
+// Handler applies the provider outcome of an SCA/3DS challenge.
+type Handler struct {
+	Repo     repository.PaymentRepository
+	Provider ports.PaymentProvider
+}
+
+func (h *Handler) Handle(ctx context.Context, cmd Command) (*Result, error) {
+	if cmd.PaymentID == uuid.Nil {
+		return nil, fmt.Errorf("%w: payment ID is required", ErrPaymentNotFound)
+	}
+
+	agg, err := h.Repo.Load(ctx, cmd.PaymentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrPaymentNotFound, cmd.PaymentID)
+		}
+		return nil, fmt.Errorf("load payment: %w", err)
+	}
+	expectedVersion := agg.Version()
+
+	if agg.State() != flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION {
+		return nil, fmt.Errorf("%w: payment state is %v", ErrNotAwaitingConfirmation, agg.State())
+	}
+	if agg.ProviderID() == "" {
+		return nil, fmt.Errorf("%w: %w", ErrNotAwaitingConfirmation, payment.ErrProviderNotAttached)
+	}
+
+	// The client only tells us the challenge is over; the provider tells us how it ended.
+	out, err := h.Provider.GetPayment(ctx, ports.GetPaymentIn{
+		PaymentID:  cmd.PaymentID,
+		Provider:   ports.Provider(agg.Provider()),
+		ProviderID: agg.ProviderID(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("provider get: %w", err)
+	}
+
+	switch out.Status {
+	case ports.ProviderStatusRequiresCapture:
+		amt := lo.Ternary(out.Authorized != nil, out.Authorized, ledger.Clone(agg.Ledger.Amount))
+		if err := agg.Confirm(ctx, amt); err != nil {
+			return nil, err
+		}
+	case ports.ProviderStatusSucceeded:
+		// Automatic capture: the provider authorized and captured in one go.
+		amt := lo.Ternary(out.Captured != nil, out.Captured, ledger.Clone(agg.Ledger.Amount))
+		if err := agg.Confirm(ctx, amt); err != nil {
+			return nil, err
+		}
+		if err := agg.Capture(ctx, amt); err != nil {
+			return nil, err
+		}
+	case ports.ProviderStatusFailed:
+		if err := agg.Fail(ctx, out.SCA.FailureReason()); err != nil {
+			return nil, err
+		}
+	case ports.ProviderStatusCanceled:
+		if err := agg.Cancel(ctx, eventv1.CancelReason_CANCEL_REASON_SYSTEM); err != nil {
+			return nil, err
+		}
+	case ports.ProviderStatusRequiresAction, ports.ProviderStatusPending:
+		// Challenge not finished yet or still processing: nothing to record.
+		return &Result{
+			PaymentID:      agg.ID(),
+			State:          agg.State(),
+			Version:        agg.Version(),
+			ProviderStatus: out.Status,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnexpectedProviderStatus, out.Status)
+	}
+
+	if err := agg.Invariants(); err != nil {
+		return nil, fmt.Errorf("domain invariants violated: %w", err)
+	}
+
+	if err := h.Repo.Save(ctx, agg, expectedVersion); err != nil {
+		return nil, fmt.Errorf("save confirmed payment: %w", err)
+	}
+
+	return &Result{
+		PaymentID:      agg.ID(),
+		State:          agg.State(),
+		Version:        agg.Version(),
+		ProviderStatus: out.Status,
+	}, nil
+}
@@ -0,0 +1,147 @@
+package confirm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+)
+
+// waitingPayment stores a payment that is waiting for the SCA/3DS challenge.
+func waitingPayment(t *testing.T, repo *memory.InMemory, mode eventv1.CaptureMode) *payment.Payment {
+	t.Helper()
+	ctx := context.Background()
+
+	p, err := payment.New(uuid.New(), uuid.New(), &money.Money{CurrencyCode: "EUR", Units: 40}, eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, mode)
+	require.NoError(t, err)
+	require.NoError(t, p.AttachProvider(ctx, "stripe", "pi_1"))
+	require.NoError(t, p.RequireSCA(ctx))
+	require.NoError(t, repo.Save(ctx, p, 0))
+	return p
+}
+
+func TestHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		mode      eventv1.CaptureMode
+		out       ports.GetPaymentOut
+		wantState flowv1.PaymentFlow
+		wantEvent proto.Message
+	}{
+		{
+			name:      "authorized for manual capture",
+			mode:      eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+			out:       ports.GetPaymentOut{Status: ports.ProviderStatusRequiresCapture},
+			wantState: flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED,
+			wantEvent: &eventv1.PaymentAuthorized{},
+		},
+		{
+			name:      "captured immediately",
+			mode:      eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE,
+			out:       ports.GetPaymentOut{Status: ports.ProviderStatusSucceeded, Captured: &money.Money{CurrencyCode: "EUR", Units: 40}},
+			wantState: flowv1.PaymentFlow_PAYMENT_FLOW_PAID,
+			wantEvent: &eventv1.PaymentPaid{},
+		},
+		{
+			name:      "challenge rejected",
+			mode:      eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+			out:       ports.GetPaymentOut{Status: ports.ProviderStatusFailed, SCA: ports.SCAOutcomeFailed},
+			wantState: flowv1.PaymentFlow_PAYMENT_FLOW_FAILED,
+			wantEvent: &eventv1.PaymentFailed{Reason: eventv1.FailureReason_FAILURE_REASON_SCA_FAILED},
+		},
+		{
+			name:      "challenge not completed",
+			mode:      eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+			out:       ports.GetPaymentOut{Status: ports.ProviderStatusFailed, SCA: ports.SCAOutcomeNotCompleted},
+			wantState: flowv1.PaymentFlow_PAYMENT_FLOW_FAILED,
+			wantEvent: &eventv1.PaymentFailed{Reason: eventv1.FailureReason_FAILURE_REASON_SCA_NOT_COMPLETED},
+		},
+		{
+			name:      "declined after authentication",
+			mode:      eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+			out:       ports.GetPaymentOut{Status: ports.ProviderStatusFailed},
+			wantState: flowv1.PaymentFlow_PAYMENT_FLOW_FAILED,
+			wantEvent: &eventv1.PaymentFailed{Reason: eventv1.FailureReason_FAILURE_REASON_DECLINED},
+		},
+		{
+			name:      "canceled at the provider",
+			mode:      eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+			out:       ports.GetPaymentOut{Status: ports.ProviderStatusCanceled},
+			wantState: flowv1.PaymentFlow_PAYMENT_FLOW_CANCELED,
+			wantEvent: &eventv1.PaymentCanceled{},
+		},
+		{
+			name:      "challenge still open",
+			mode:      eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+			out:       ports.GetPaymentOut{Status: ports.ProviderStatusRequiresAction},
+			wantState: flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := memory.New()
+			provider := mocks.NewMockPaymentProvider(t)
+			h := &Handler{Repo: repo, Provider: provider}
+			p := waitingPayment(t, repo, tt.mode)
+
+			provider.EXPECT().GetPayment(mock.Anything, ports.GetPaymentIn{PaymentID: p.ID(), Provider: ports.ProviderStripe, ProviderID: "pi_1"}).
+				Return(tt.out, nil).Once()
+
+			res, err := h.Handle(ctx, Command{PaymentID: p.ID()})
+			require.NoError(t, err)
+			require.Equal(t, tt.wantState, res.State)
+			require.Equal(t, tt.out.Status, res.ProviderStatus)
+
+			got, err := repo.Load(ctx, p.ID())
+			require.NoError(t, err)
+			require.Equal(t, tt.wantState, got.State())
+			require.Equal(t, res.Version, got.Version())
+
+			pending, err := repo.Pending(ctx, time.Now(), 100)
+			require.NoError(t, err)
+			last := pending[len(pending)-1]
+			if tt.wantEvent == nil {
+				require.Equal(t, "domain.event.v1.PaymentWaitingForConfirmation", last.EventType)
+				return
+			}
+			require.Equal(t, string(tt.wantEvent.ProtoReflect().Descriptor().FullName()), last.EventType)
+
+			if f, ok := tt.wantEvent.(*eventv1.PaymentFailed); ok {
+				evt := &eventv1.PaymentFailed{}
+				require.NoError(t, proto.Unmarshal(last.Payload, evt))
+				require.Equal(t, f.GetReason(), evt.GetReason())
+			}
+		})
+	}
+
+	t.Run("not waiting", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Provider: mocks.NewMockPaymentProvider(t)}
+
+		p, err := payment.New(uuid.New(), uuid.New(), &money.Money{CurrencyCode: "EUR", Units: 40},
+			eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, p, 0))
+
+		_, err = h.Handle(ctx, Command{PaymentID: p.ID()})
+		require.ErrorIs(t, err, ErrNotAwaitingConfirmation)
+
+		_, err = h.Handle(ctx, Command{PaymentID: uuid.New()})
+		require.ErrorIs(t, err, ErrPaymentNotFound)
+	})
+}
@@ -2,11 +2,13 @@ package create
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
 	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
@@ -19,7 +21,7 @@ import (
 
 // Command contains input data for creating a payment.
 type Command struct {
-	PaymentID   uuid.UUID // uuid.Nil → generate v7 in domain
+	PaymentID   uuid.UUID // uuid.Nil → derived from the idempotency key, or v7 without one
 	InvoiceID   uuid.UUID
 	Amount      *money.Money
 	Kind        eventv1.PaymentKind
@@ -27,6 +29,15 @@ type Command struct {
 	Description string
 	Metadata    map[string]string
 	ReturnURL   string
+
+	// Inputs of the SCA policy and of payment routing.
+	CustomerRegion    string // ISO 3166-1 alpha-2, e.g. "DE"; empty if unknown
+	MerchantInitiated bool   // charged without the customer in session, e.g. a subscription renewal
+	MerchantID        string // routing only; empty if the payment is not made on behalf of a merchant
+
+	// Idempotency de-duplicates client retries: a repeated key with the same command
+	// returns the first Result, with another command it fails with idempotency.ErrKeyReused.
+	Idempotency idempotency.Key
 }
 
 // Result is returned after successful payment creation.
@@ -37,26 +48,77 @@ type Result struct {
 	Provider     ports.Provider
 	ProviderID   string
 	ClientSecret string
+	SCA          payment.SCADecision
 }
 
 // Handler orchestrates payment creation.
 type Handler struct {
-	Repo     repository.PaymentRepository
-	Provider ports.PaymentProvider
+	Repo        repository.PaymentRepository
+	Provider    ports.PaymentProvider
+	Policy      payment.Policy    // optional; nil uses the aggregate's static policy
+	Idempotency idempotency.Store // optional; nil disables Command.Idempotency
 }
 
 func (h *Handler) Handle(ctx context.Context, cmd Command) (*Result, error) {
-	agg, err := payment.New(cmd.PaymentID, cmd.InvoiceID, cmd.Amount, cmd.Kind, cmd.Mode)
+	if h.Idempotency == nil || cmd.Idempotency.IsZero() {
+		return h.handle(ctx, cmd)
+	}
+
+	return idempotency.Do(ctx, h.Idempotency, cmd.Idempotency, cmd, func(ctx context.Context) (*Result, error) {
+		// Derived, not generated: a retry after a failed attempt released the key creates the
+		// same payment under the same provider key instead of charging the customer twice.
+		if cmd.PaymentID == uuid.Nil {
+			id, err := idempotency.DeriveID(cmd.Idempotency, cmd)
+			if err != nil {
+				return nil, fmt.Errorf("derive payment ID: %w", err)
+			}
+			cmd.PaymentID = id
+		}
+		return h.handle(ctx, cmd)
+	})
+}
+
+func (h *Handler) handle(ctx context.Context, cmd Command) (*Result, error) {
+	// Generated here, after the idempotency fingerprint, so that a retry without an ID still matches.
+	if cmd.PaymentID == uuid.Nil {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("generate payment ID: %w", err)
+		}
+		cmd.PaymentID = id
+	}
+
+	var opts []payment.Option
+	if h.Policy != nil {
+		opts = append(opts, payment.WithPolicy(h.Policy))
+	}
+
+	agg, err := payment.New(cmd.PaymentID, cmd.InvoiceID, cmd.Amount, cmd.Kind, cmd.Mode, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("create aggregate: %w", err)
 	}
 
+	caps := h.Provider.Capabilities()
+	if !caps.SupportsCurrency(cmd.Amount.GetCurrencyCode()) {
+		return nil, fmt.Errorf("%w: currency %s", ports.ErrUnsupportedOperation, cmd.Amount.GetCurrencyCode())
+	}
+	if !caps.SupportsCaptureMode(cmd.Mode) {
+		return nil, fmt.Errorf("%w: capture mode %v", ports.ErrUnsupportedOperation, cmd.Mode)
+	}
+
+	// Recorded in the stream, so every payment shows why it was or was not challenged.
+	sca, err := agg.EvaluateSCA(ctx, cmd.CustomerRegion, cmd.MerchantInitiated)
+	if err != nil {
+		return nil, fmt.Errorf("evaluate SCA: %w", err)
+	}
+
 	// default metadata always overrides user metadata
 	defaultMeta := map[string]string{
 		"payment_id": agg.ID().String(),
 		"invoice_id": agg.InvoiceID().String(),
 		"kind":       cmd.Kind.String(),
 		"mode":       cmd.Mode.String(),
+		"sca_rule":   sca.Rule,
 	}
 	meta := lo.Assign(cmd.Metadata, defaultMeta)
 
@@ -69,11 +131,29 @@ func (h *Handler) Handle(ctx context.Context, cmd Command) (*Result, error) {
 		Description:   cmd.Description,
 		Metadata:      meta,
 		ReturnURL:     cmd.ReturnURL,
+		RequireSCA:    sca.Required,
+		SCAExemption:  sca.Exemption,
+
+		CustomerRegion: cmd.CustomerRegion,
+		MerchantID:     cmd.MerchantID,
+
+		IdempotencyKey: fmt.Sprintf("%s:create", agg.ID()),
 	})
-	if err != nil {
+	switch {
+	case errors.Is(err, ports.ErrProviderUnavailable):
+		// Nothing was sent, so the payment fails like one the provider could not process.
+		out = ports.CreatePaymentOut{Status: ports.ProviderStatusFailed}
+	case err != nil:
 		return nil, fmt.Errorf("provider create: %w", err)
 	}
 
+	// Later captures, refunds and cancels address the provider object through this reference.
+	if out.ProviderID != "" {
+		if err := agg.AttachProvider(ctx, string(out.Provider), out.ProviderID); err != nil {
+			return nil, fmt.Errorf("attach provider: %w", err)
+		}
+	}
+
 	switch out.Status {
 	case ports.ProviderStatusRequiresAction:
 		if err := agg.RequireSCA(ctx); err != nil {
@@ -119,5 +199,6 @@ func (h *Handler) Handle(ctx context.Context, cmd Command) (*Result, error) {
 		Provider:     out.Provider,
 		ProviderID:   out.ProviderID,
 		ClientSecret: out.ClientSecret,
+		SCA:          sca,
 	}, nil
 }
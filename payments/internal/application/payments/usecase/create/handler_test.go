@@ -0,0 +1,230 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/sca"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+)
+
+func usd(units int64) *money.Money { return &money.Money{CurrencyCode: "USD", Units: units} }
+
+// manualCapture is a provider taking any currency with holds.
+var manualCapture = ports.Capabilities{CaptureModes: []eventv1.CaptureMode{eventv1.CaptureMode_CAPTURE_MODE_MANUAL}}
+
+func TestHandler_Handle_Idempotency(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	provider := mocks.NewMockPaymentProvider(t)
+	h := &Handler{Repo: repo, Provider: provider, Idempotency: repo}
+
+	provider.EXPECT().Capabilities().Return(manualCapture)
+	provider.EXPECT().CreatePayment(mock.Anything, mock.MatchedBy(func(in ports.CreatePaymentIn) bool {
+		return in.IdempotencyKey == in.PaymentID.String()+":create"
+	})).Return(ports.CreatePaymentOut{
+		Provider:   ports.ProviderStripe,
+		ProviderID: "pi_1",
+		Status:     ports.ProviderStatusRequiresCapture,
+	}, nil).Once()
+
+	cmd := Command{
+		PaymentID:   uuid.New(),
+		InvoiceID:   uuid.New(),
+		Amount:      usd(100),
+		Kind:        eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+		Mode:        eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+		Idempotency: idempotency.Key{Caller: "billing", Key: "order-42"},
+	}
+
+	first, err := h.Handle(ctx, cmd)
+	require.NoError(t, err)
+	require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED, first.State)
+
+	// The retry after a timeout gets the same payment back, not a second one.
+	second, err := h.Handle(ctx, cmd)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+
+	_, err = repo.Load(ctx, first.ID)
+	require.NoError(t, err)
+
+	cmd.Amount = usd(200)
+	_, err = h.Handle(ctx, cmd)
+	require.ErrorIs(t, err, idempotency.ErrKeyReused)
+}
+
+func TestHandler_Handle_RetryAfterFailure(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	provider := mocks.NewMockPaymentProvider(t)
+	h := &Handler{Repo: repo, Provider: provider, Idempotency: repo}
+
+	var keys []string
+	provider.EXPECT().Capabilities().Return(manualCapture)
+	provider.EXPECT().CreatePayment(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, in ports.CreatePaymentIn) { keys = append(keys, in.IdempotencyKey) }).
+		Return(ports.CreatePaymentOut{}, fmt.Errorf("stripe: connection reset")).Once()
+	provider.EXPECT().CreatePayment(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, in ports.CreatePaymentIn) { keys = append(keys, in.IdempotencyKey) }).
+		Return(ports.CreatePaymentOut{
+			Provider:   ports.ProviderStripe,
+			ProviderID: "pi_1",
+			Status:     ports.ProviderStatusRequiresCapture,
+		}, nil).Once()
+
+	cmd := Command{
+		InvoiceID:   uuid.New(),
+		Amount:      usd(100),
+		Kind:        eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+		Mode:        eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+		Idempotency: idempotency.Key{Caller: "billing", Key: "order-42"},
+	}
+
+	_, err := h.Handle(ctx, cmd)
+	require.Error(t, err)
+
+	// The provider may have created the payment before the connection dropped:
+	// the retry must reach it as the same payment.
+	res, err := h.Handle(ctx, cmd)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Equal(t, keys[0], keys[1])
+	require.Equal(t, res.ID.String()+":create", keys[1])
+}
+
+func TestHandler_Handle_SCAPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	policy, err := sca.Load(strings.NewReader(`
+rules:
+  - name: low-value
+    when: {regions: [DE], max_amount: {USD: "30"}}
+    exemption: low_value
+`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		amount    *money.Money
+		status    ports.ProviderStatus
+		want      payment.SCADecision
+		wantState flowv1.PaymentFlow
+	}{
+		{
+			name:      "exempted",
+			amount:    usd(30),
+			status:    ports.ProviderStatusRequiresCapture,
+			want:      payment.SCADecision{Exemption: eventv1.SCAExemption_SCA_EXEMPTION_LOW_VALUE, Rule: "low-value"},
+			wantState: flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED,
+		},
+		{
+			name:      "challenged",
+			amount:    usd(31),
+			status:    ports.ProviderStatusRequiresAction,
+			want:      payment.SCADecision{Required: true, Rule: sca.DefaultRule},
+			wantState: flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := memory.New()
+			provider := mocks.NewMockPaymentProvider(t)
+			h := &Handler{Repo: repo, Provider: provider, Policy: policy}
+
+			provider.EXPECT().Capabilities().Return(manualCapture)
+			provider.EXPECT().CreatePayment(mock.Anything, mock.MatchedBy(func(in ports.CreatePaymentIn) bool {
+				return in.RequireSCA == tt.want.Required && in.SCAExemption == tt.want.Exemption &&
+					in.Metadata["sca_rule"] == tt.want.Rule
+			})).Return(ports.CreatePaymentOut{Provider: ports.ProviderStripe, ProviderID: "pi_1", Status: tt.status}, nil).Once()
+
+			res, err := h.Handle(ctx, Command{
+				InvoiceID:      uuid.New(),
+				Amount:         tt.amount,
+				Kind:           eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+				Mode:           eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+				CustomerRegion: "DE",
+			})
+			require.NoError(t, err)
+			require.Equal(t, tt.wantState, res.State)
+			require.Equal(t, tt.want, res.SCA)
+
+			// The decision is part of the stream, not only of the response.
+			got, err := repo.Load(ctx, res.ID)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.SCA())
+		})
+	}
+}
+
+func TestHandler_Handle_Capabilities(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		amount *money.Money
+		mode   eventv1.CaptureMode
+	}{
+		{name: "currency", amount: &money.Money{CurrencyCode: "EUR", Units: 10}, mode: eventv1.CaptureMode_CAPTURE_MODE_MANUAL},
+		{name: "capture mode", amount: &money.Money{CurrencyCode: "RUB", Units: 10}, mode: eventv1.CaptureMode_CAPTURE_MODE_MANUAL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := mocks.NewMockPaymentProvider(t)
+			h := &Handler{Repo: memory.New(), Provider: provider}
+
+			provider.EXPECT().Capabilities().Return(ports.Capabilities{
+				Currencies:   []string{"RUB"},
+				CaptureModes: []eventv1.CaptureMode{eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE},
+			}).Once()
+
+			_, err := h.Handle(ctx, Command{
+				InvoiceID: uuid.New(),
+				Amount:    tt.amount,
+				Kind:      eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+				Mode:      tt.mode,
+			})
+			require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+		})
+	}
+}
+
+func TestHandler_Handle_ProviderUnavailable(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	provider := mocks.NewMockPaymentProvider(t)
+	h := &Handler{Repo: repo, Provider: provider}
+
+	provider.EXPECT().Capabilities().Return(manualCapture)
+	provider.EXPECT().CreatePayment(mock.Anything, mock.Anything).
+		Return(ports.CreatePaymentOut{}, fmt.Errorf("%w: stripe: circuit breaker open", ports.ErrProviderUnavailable)).Once()
+
+	res, err := h.Handle(ctx, Command{
+		InvoiceID: uuid.New(),
+		Amount:    usd(100),
+		Kind:      eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+		Mode:      eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+	})
+	require.NoError(t, err)
+	require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_FAILED, res.State)
+
+	got, err := repo.Load(ctx, res.ID)
+	require.NoError(t, err)
+	require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_FAILED, got.State())
+	require.Empty(t, got.ProviderID())
+}
@@ -0,0 +1,14 @@
+package increment
+
+import "errors"
+
+var (
+	// ErrPaymentNotFound is returned when the payment to increase is not found.
+	ErrPaymentNotFound = errors.New("increment: payment not found")
+	// ErrInvalidIncrementAmount is returned when the increment is not positive or in another currency.
+	ErrInvalidIncrementAmount = errors.New("increment: invalid increment amount")
+	// ErrPaymentNotIncreasable is returned when the payment holds no authorization to raise.
+	ErrPaymentNotIncreasable = errors.New("increment: payment is not increasable")
+	// ErrIncrementRejected is returned when the provider did not raise the hold.
+	ErrIncrementRejected = errors.New("increment: rejected by provider")
+)
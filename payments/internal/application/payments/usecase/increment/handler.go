@@ -0,0 +1,146 @@
+package increment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// Command contains input data for increasing the hold of a manually captured payment.
+type Command struct {
+	PaymentID uuid.UUID
+	Amount    *money.Money // added on top of the current hold
+	Metadata  map[string]string
+}
+
+// Result is returned after the hold was increased.
+type Result struct {
+	PaymentID       uuid.UUID
+	IncrementAmount *money.Money // added by this call
+	TotalAuthorized *money.Money
+	Amount          *money.Money // payment amount, raised with the hold when exceeded
+	State           flowv1.PaymentFlow
+	Version         uint64
+}
+
+// Handler orchestrates incremental authorizations (e.g. a hotel stay or a rental extended).
+type Handler struct {
+	Repo     repository.PaymentRepository
+	Provider ports.PaymentProvider // must implement ports.AuthorizationIncrementer and declare the capability
+	Policy   payment.Policy        // optional; nil skips the creation specifications for the raised amount
+}
+
+func (h *Handler) Handle(ctx context.Context, cmd Command) (*Result, error) {
+	if cmd.PaymentID == uuid.Nil {
+		return nil, fmt.Errorf("%w: payment ID is required", ErrPaymentNotFound)
+	}
+
+	incrementer, ok := h.Provider.(ports.AuthorizationIncrementer)
+	if !ok {
+		return nil, fmt.Errorf("%w: incremental authorization", ports.ErrUnsupportedOperation)
+	}
+
+	agg, err := h.Repo.Load(ctx, cmd.PaymentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrPaymentNotFound, cmd.PaymentID)
+		}
+		return nil, fmt.Errorf("load payment: %w", err)
+	}
+	expectedVersion := agg.Version()
+
+	// Only an uncaptured hold can grow; after the first capture the authorization is being settled.
+	if agg.State() != flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED {
+		return nil, fmt.Errorf("%w: payment state is %v", ErrPaymentNotIncreasable, agg.State())
+	}
+	if agg.ProviderID() == "" {
+		return nil, fmt.Errorf("%w: %w", ErrPaymentNotIncreasable, payment.ErrProviderNotAttached)
+	}
+	if !ports.CapabilitiesFor(h.Provider, ports.Provider(agg.Provider())).IncrementalAuthorization {
+		return nil, fmt.Errorf("%w: incremental authorization", ports.ErrUnsupportedOperation)
+	}
+
+	held := agg.Ledger.Authorized
+	switch {
+	case cmd.Amount == nil:
+		return nil, fmt.Errorf("%w: amount is required", ErrInvalidIncrementAmount)
+	case cmd.Amount.GetCurrencyCode() != held.GetCurrencyCode():
+		return nil, fmt.Errorf("%w: currency %s, payment is in %s", ErrInvalidIncrementAmount, cmd.Amount.GetCurrencyCode(), held.GetCurrencyCode())
+	case ledger.Compare(cmd.Amount, ledger.Zero(cmd.Amount.GetCurrencyCode())) <= 0:
+		return nil, fmt.Errorf("%w: amount must be positive", ErrInvalidIncrementAmount)
+	}
+	if h.Policy != nil {
+		if err := agg.CheckIncrease(h.Policy.Specifications().Create, cmd.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	total, err := ledger.Add(held, cmd.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIncrementAmount, err)
+	}
+
+	out, err := incrementer.IncrementAuthorization(ctx, ports.IncrementAuthorizationIn{
+		PaymentID:  cmd.PaymentID,
+		Provider:   ports.Provider(agg.Provider()),
+		ProviderID: agg.ProviderID(),
+		Amount:     cmd.Amount,
+		Total:      total,
+		Currency:   total.GetCurrencyCode(),
+		// One key per aggregate version, like captures.
+		IdempotencyKey: fmt.Sprintf("%s:increment:%d", cmd.PaymentID, expectedVersion),
+		Metadata: lo.Assign(cmd.Metadata, map[string]string{
+			"payment_id": cmd.PaymentID.String(),
+		}),
+	})
+	if err != nil {
+		// Nothing was recorded: the stream stays as loaded and the increment can be retried.
+		return nil, fmt.Errorf("provider increment authorization: %w", err)
+	}
+	if out.Status != ports.ProviderStatusRequiresCapture {
+		return nil, fmt.Errorf("%w: provider status %d", ErrIncrementRejected, out.Status)
+	}
+
+	// The provider reports the total hold; record what it actually added.
+	increment := cmd.Amount
+	if out.Authorized != nil {
+		if increment, err = ledger.Sub(out.Authorized, held); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrIncrementRejected, err)
+		}
+		if ledger.Compare(increment, ledger.Zero(increment.GetCurrencyCode())) <= 0 {
+			return nil, fmt.Errorf("%w: hold is still %v", ErrIncrementRejected, out.Authorized)
+		}
+	}
+
+	if err := agg.IncreaseAuthorization(ctx, increment); err != nil {
+		return nil, fmt.Errorf("apply increment to aggregate: %w", err)
+	}
+
+	if err := agg.Invariants(); err != nil {
+		return nil, fmt.Errorf("domain invariants violated: %w", err)
+	}
+
+	if err := h.Repo.Save(ctx, agg, expectedVersion); err != nil {
+		return nil, fmt.Errorf("save increased payment: %w", err)
+	}
+
+	return &Result{
+		PaymentID:       cmd.PaymentID,
+		IncrementAmount: increment,
+		TotalAuthorized: agg.Ledger.Authorized,
+		Amount:          agg.Ledger.Amount,
+		State:           agg.State(),
+		Version:         agg.Version(),
+	}, nil
+}
@@ -0,0 +1,143 @@
+package increment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/fixtures"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+)
+
+// incrementingProvider is a provider that can raise holds.
+type incrementingProvider struct {
+	*mocks.MockPaymentProvider
+	*mocks.MockAuthorizationIncrementer
+}
+
+func newProvider(t *testing.T) (*incrementingProvider, *mocks.MockAuthorizationIncrementer) {
+	provider := fixtures.Provider(t, ports.Capabilities{IncrementalAuthorization: true})
+	inc := mocks.NewMockAuthorizationIncrementer(t)
+	return &incrementingProvider{MockPaymentProvider: provider, MockAuthorizationIncrementer: inc}, inc
+}
+
+func incremented(_ context.Context, in ports.IncrementAuthorizationIn) (ports.IncrementAuthorizationOut, error) {
+	return ports.IncrementAuthorizationOut{
+		Provider:   ports.ProviderStripe,
+		Status:     ports.ProviderStatusRequiresCapture,
+		Authorized: in.Total,
+	}, nil
+}
+
+func TestHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("raises the hold and the amount", func(t *testing.T) {
+		repo := memory.New()
+		provider, inc := newProvider(t)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.AuthorizedPayment(t, repo, fixtures.USD(200))
+
+		inc.EXPECT().IncrementAuthorization(mock.Anything, mock.MatchedBy(func(in ports.IncrementAuthorizationIn) bool {
+			return in.ProviderID == "pi_1" && proto.Equal(in.Amount, fixtures.USD(50)) && proto.Equal(in.Total, fixtures.USD(250))
+		})).RunAndReturn(incremented).Once()
+
+		res, err := h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(50)})
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED, res.State)
+		require.True(t, proto.Equal(fixtures.USD(250), res.TotalAuthorized))
+		require.True(t, proto.Equal(fixtures.USD(250), res.Amount))
+		require.Equal(t, p.Version()+1, res.Version)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.True(t, proto.Equal(fixtures.USD(250), got.Ledger.Amount))
+	})
+
+	t.Run("provider without the capability", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Provider: mocks.NewMockPaymentProvider(t)}
+		p := fixtures.AuthorizedPayment(t, repo, fixtures.USD(200))
+
+		_, err := h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(50)})
+		require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+
+		// Implementing the method is not enough: the provider must also declare the capability.
+		provider := &incrementingProvider{
+			MockPaymentProvider:          mocks.NewMockPaymentProvider(t),
+			MockAuthorizationIncrementer: mocks.NewMockAuthorizationIncrementer(t),
+		}
+		provider.MockPaymentProvider.EXPECT().Capabilities().Return(ports.Capabilities{}).Once()
+		h.Provider = provider
+
+		_, err = h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(50)})
+		require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+	})
+
+	t.Run("raised amount is checked before calling the provider", func(t *testing.T) {
+		repo := memory.New()
+		provider, _ := newProvider(t)
+		policy := &payment.StaticPolicy{Limits: payment.Limits{MaxAmount: map[string]*money.Money{"USD": fixtures.USD(220)}}}
+		h := &Handler{Repo: repo, Provider: provider, Policy: policy}
+		p := fixtures.AuthorizedPayment(t, repo, fixtures.USD(200))
+
+		for _, amt := range []*money.Money{fixtures.USD(0), {CurrencyCode: "EUR", Units: 10}, nil} {
+			_, err := h.Handle(ctx, Command{PaymentID: p.ID(), Amount: amt})
+			require.ErrorIs(t, err, ErrInvalidIncrementAmount)
+		}
+
+		_, err := h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(50)})
+		require.ErrorIs(t, err, payment.ErrPolicyViolation)
+	})
+
+	t.Run("declined increment leaves the stream untouched", func(t *testing.T) {
+		repo := memory.New()
+		provider, inc := newProvider(t)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.AuthorizedPayment(t, repo, fixtures.USD(200))
+
+		inc.EXPECT().IncrementAuthorization(mock.Anything, mock.Anything).
+			Return(ports.IncrementAuthorizationOut{}, errors.New("stripe: card_declined")).Once()
+		_, err := h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(50)})
+		require.Error(t, err)
+
+		inc.EXPECT().IncrementAuthorization(mock.Anything, mock.Anything).
+			Return(ports.IncrementAuthorizationOut{Status: ports.ProviderStatusRequiresCapture, Authorized: fixtures.USD(200)}, nil).Once()
+		_, err = h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(50)})
+		require.ErrorIs(t, err, ErrIncrementRejected)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, p.Version(), got.Version())
+		require.True(t, proto.Equal(fixtures.USD(200), got.Ledger.Authorized))
+	})
+
+	t.Run("not increasable", func(t *testing.T) {
+		repo := memory.New()
+		provider, _ := newProvider(t)
+		h := &Handler{Repo: repo, Provider: provider}
+
+		_, err := h.Handle(ctx, Command{PaymentID: uuid.New(), Amount: fixtures.USD(1)})
+		require.ErrorIs(t, err, ErrPaymentNotFound)
+
+		p, err := payment.New(uuid.New(), uuid.New(), fixtures.USD(10),
+			eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE)
+		require.NoError(t, err)
+		require.NoError(t, p.Capture(ctx, fixtures.USD(10)))
+		require.NoError(t, repo.Save(ctx, p, 0))
+
+		_, err = h.Handle(ctx, Command{PaymentID: p.ID(), Amount: fixtures.USD(1)})
+		require.ErrorIs(t, err, ErrPaymentNotIncreasable)
+	})
+}
@@ -1,16 +1,25 @@
 package dto
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/google/uuid"
 	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
 )
 
 // RefundRequestDTO represents the input data for refunding a payment.
 type RefundRequestDTO struct {
 	PaymentID string            `json:"payment_id" validate:"required,uuid"`
-	Amount    *MoneyDTO         `json:"amount,omitempty"` // nil for full refund
-	Reason    string            `json:"reason" validate:"required"`
+	Amount    *MoneyDTO         `json:"amount,omitempty"`           // nil for full refund
+	Reason    string            `json:"reason" validate:"required"` // e.g. "requested_by_customer", "duplicate"
 	Metadata  map[string]string `json:"metadata,omitempty"`
+
+	IdempotencyKey string `json:"idempotency_key,omitempty"` // client retries reuse it
+	Caller         string `json:"-"`                         // set by the transport from the authenticated client
 }
 
 // ToCommand converts RefundRequestDTO to the domain Command.
@@ -25,11 +34,20 @@ func (dto RefundRequestDTO) ToCommand() (Command, error) {
 		amount = dto.Amount.ToMoney()
 	}
 
+	reason, ok := ParseRefundReason(dto.Reason)
+	if !ok {
+		return Command{}, fmt.Errorf("unknown refund reason %q", dto.Reason)
+	}
+
 	return Command{
 		PaymentID: paymentID,
 		Amount:    amount,
-		Reason:    dto.Reason,
+		Reason:    reason,
 		Metadata:  dto.Metadata,
+		Idempotency: idempotency.Key{
+			Caller: dto.Caller,
+			Key:    dto.IdempotencyKey,
+		},
 	}, nil
 }
 
@@ -38,6 +56,17 @@ func (dto RefundRequestDTO) ToCommand() (Command, error) {
 type Command struct {
 	PaymentID uuid.UUID
 	Amount    *money.Money
-	Reason    string
+	Reason    eventv1.RefundReason
 	Metadata  map[string]string
+
+	Idempotency idempotency.Key
+}
+
+// ParseRefundReason maps a lower-case reason such as "order_canceled" onto the enum.
+func ParseRefundReason(s string) (eventv1.RefundReason, bool) {
+	v, ok := eventv1.RefundReason_value["REFUND_REASON_"+strings.ToUpper(strings.TrimSpace(s))]
+	if !ok || v == int32(eventv1.RefundReason_REFUND_REASON_UNSPECIFIED) {
+		return eventv1.RefundReason_REFUND_REASON_UNSPECIFIED, false
+	}
+	return eventv1.RefundReason(v), true
 }
@@ -13,6 +13,7 @@ type RefundResponseDTO struct {
 	RefundAmount  *MoneyDTO `json:"refund_amount"`
 	TotalRefunded *MoneyDTO `json:"total_refunded"`
 	IsFullRefund  bool      `json:"is_full_refund"`
+	Pending       bool      `json:"pending"`
 	State         string    `json:"state"`
 	Version       uint64    `json:"version"`
 }
@@ -25,6 +26,7 @@ type Result struct {
 	RefundAmount  *money.Money
 	TotalRefunded *money.Money
 	IsFullRefund  bool
+	Pending       bool // accepted by the provider, settles via webhook
 	State         flowv1.PaymentFlow
 	Version       uint64
 }
@@ -37,6 +39,7 @@ func FromResult(result *Result) RefundResponseDTO {
 		RefundAmount:  FromMoney(result.RefundAmount),
 		TotalRefunded: FromMoney(result.TotalRefunded),
 		IsFullRefund:  result.IsFullRefund,
+		Pending:       result.Pending,
 		State:         mapFlowToString(result.State),
 		Version:       result.Version,
 	}
@@ -59,6 +62,10 @@ func mapFlowToString(flow flowv1.PaymentFlow) string {
 		return "canceled"
 	case flowv1.PaymentFlow_PAYMENT_FLOW_FAILED:
 		return "failed"
+	case flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED:
+		return "disputed"
+	case flowv1.PaymentFlow_PAYMENT_FLOW_CHARGED_BACK:
+		return "charged_back"
 	default:
 		return "unknown"
 	}
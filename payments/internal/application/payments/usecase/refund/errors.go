@@ -9,4 +9,8 @@ var (
 	ErrInvalidRefundAmount = errors.New("refund: invalid refund amount")
 	// ErrPaymentNotRefundable is returned when the payment is not in a refundable state.
 	ErrPaymentNotRefundable = errors.New("refund: payment is not refundable")
+	// ErrInvalidRefundReason is returned when the refund reason is unknown.
+	ErrInvalidRefundReason = errors.New("refund: invalid refund reason")
+	// ErrRefundRejected is returned when the provider declined the refund; the failure is recorded.
+	ErrRefundRejected = errors.New("refund: rejected by provider")
 )
\ No newline at end of file
@@ -8,11 +8,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund/dto"
 	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
 	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
 	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
 
 	"google.golang.org/genproto/googleapis/type/money"
@@ -43,11 +45,29 @@ type Result struct {
 
 // Handler orchestrates payment refunds.
 type Handler struct {
-	Repo     repository.PaymentRepository
-	Provider ports.PaymentProvider
+	Repo        repository.PaymentRepository
+	Provider    ports.PaymentProvider
+	Policy      payment.Policy    // optional; nil skips the refund specifications
+	Idempotency idempotency.Store // optional; nil disables Command.Idempotency
 }
 
 func (h *Handler) Handle(ctx context.Context, cmd dto.Command) (*dto.Result, error) {
+	if h.Idempotency == nil || cmd.Idempotency.IsZero() {
+		return h.handle(ctx, cmd, uuid.New())
+	}
+
+	// Every retry of the command refunds under the same ID and provider key, even after a
+	// failed attempt released the client key: a refund the provider made is never made twice.
+	refundID, err := idempotency.DeriveID(cmd.Idempotency, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return idempotency.Do(ctx, h.Idempotency, cmd.Idempotency, cmd, func(ctx context.Context) (*dto.Result, error) {
+		return h.handle(ctx, cmd, refundID)
+	})
+}
+
+func (h *Handler) handle(ctx context.Context, cmd dto.Command, refundID uuid.UUID) (*dto.Result, error) {
 	// Validate input
 	if cmd.PaymentID == uuid.Nil {
 		return nil, fmt.Errorf("%w: payment ID is required", ErrInvalidRefundAmount)
@@ -61,11 +81,23 @@ func (h *Handler) Handle(ctx context.Context, cmd dto.Command) (*dto.Result, err
 		}
 		return nil, fmt.Errorf("load payment: %w", err)
 	}
+	expectedVersion := agg.Version()
+
+	// A retry after the refund was recorded, e.g. by its webhook, only reports it.
+	if r, ok := agg.FindRefund(refundID); ok {
+		if r.Status == payment.RefundStatusFailed {
+			return nil, fmt.Errorf("%w: refund %s", ErrRefundRejected, r.ProviderRefundID)
+		}
+		return recorded(agg, r), nil
+	}
 
 	// Validate payment is refundable
 	if agg.State() != flowv1.PaymentFlow_PAYMENT_FLOW_PAID && agg.State() != flowv1.PaymentFlow_PAYMENT_FLOW_REFUNDED {
 		return nil, fmt.Errorf("%w: payment state is %v", ErrPaymentNotRefundable, agg.State())
 	}
+	if agg.ProviderID() == "" {
+		return nil, fmt.Errorf("%w: %w", ErrPaymentNotRefundable, payment.ErrProviderNotAttached)
+	}
 
 	// Determine refund amount
 	refundAmount := cmd.Amount
@@ -74,16 +106,8 @@ func (h *Handler) Handle(ctx context.Context, cmd dto.Command) (*dto.Result, err
 			return nil, fmt.Errorf("%w: no captured amount to refund", ErrPaymentNotRefundable)
 		}
 
-		totalRefunded := agg.Ledger.TotalRefunded
-		if totalRefunded == nil {
-			totalRefunded = ledger.Zero(agg.Ledger.Captured.GetCurrencyCode())
-		}
-
-		remaining, err := ledger.Sub(agg.Ledger.Captured, totalRefunded)
-		if err != nil {
-			return nil, fmt.Errorf("calculate refundable amount: %w", err)
-		}
-
+		// Captured minus refunds and funds reversed by lost chargebacks.
+		remaining := agg.Ledger.Refundable()
 		if isZero(remaining) {
 			return nil, fmt.Errorf("%w: payment already fully refunded", ErrInvalidRefundAmount)
 		}
@@ -95,20 +119,31 @@ func (h *Handler) Handle(ctx context.Context, cmd dto.Command) (*dto.Result, err
 	if refundAmount == nil || isZero(refundAmount) || isNegative(refundAmount) {
 		return nil, fmt.Errorf("%w: amount must be positive", ErrInvalidRefundAmount)
 	}
+	caps := ports.CapabilitiesFor(h.Provider, ports.Provider(agg.Provider()))
+	if err := checkCapabilities(caps, agg, refundAmount); err != nil {
+		return nil, err
+	}
+	if h.Policy != nil {
+		if err := agg.CheckRefund(h.Policy.Specifications().Refund, refundAmount); err != nil {
+			return nil, err
+		}
+	}
 
-	// TODO: extract providerID from aggregate/metadata when available
-	providerID := ""
-
+	// Our refund ID travels as metadata, so refund webhooks can be matched even before we store it.
 	providerIn := ports.RefundPaymentIn{
 		PaymentID:  cmd.PaymentID,
-		ProviderID: providerID,
+		Provider:   ports.Provider(agg.Provider()),
+		ProviderID: agg.ProviderID(),
 		Amount:     refundAmount,
 		Currency:   refundAmount.GetCurrencyCode(),
 		Reason:     cmd.Reason,
 		Metadata: lo.Assign(cmd.Metadata, map[string]string{
 			"payment_id":    cmd.PaymentID.String(),
-			"refund_reason": cmd.Reason,
+			"refund_id":     refundID.String(),
+			"refund_reason": cmd.Reason.String(),
 		}),
+		// One key per refund: a retry reuses it, the next partial refund does not.
+		IdempotencyKey: fmt.Sprintf("%s:refund:%s", cmd.PaymentID, refundID),
 	}
 
 	providerOut, err := h.Provider.RefundPayment(ctx, providerIn)
@@ -116,34 +151,93 @@ func (h *Handler) Handle(ctx context.Context, cmd dto.Command) (*dto.Result, err
 		// провайдер/интеграционная ошибка -> NETWORK_ERROR
 		agg.RefundFailed(ctx, eventv1.FailureReason_FAILURE_REASON_NETWORK_ERROR)
 
-		if saveErr := h.Repo.Save(ctx, agg, agg.Version()); saveErr != nil {
+		if saveErr := h.Repo.Save(ctx, agg, expectedVersion); saveErr != nil {
 			return nil, fmt.Errorf("save refund failure: %w (original error: %v)", saveErr, err)
 		}
 		return nil, fmt.Errorf("provider refund failed: %w", err)
 	}
 
-	// Apply refund to domain aggregate
+	// Apply refund to domain aggregate: a pending refund only reserves the amount,
+	// the webhook settles or fails it later.
 	actualRefundAmount := lo.Ternary(providerOut.Amount != nil, providerOut.Amount, refundAmount)
-	isFullRefund, err := agg.Refund(ctx, actualRefundAmount)
+	refund := payment.Refund{
+		ID:               refundID,
+		ProviderRefundID: providerOut.RefundID,
+		Amount:           actualRefundAmount,
+		Reason:           cmd.Reason,
+		Status:           payment.RefundStatusPending,
+	}
+	if providerOut.Status == ports.ProviderStatusSucceeded {
+		refund.Status = payment.RefundStatusSucceeded
+	}
+	isFullRefund, err := agg.RequestRefund(ctx, refund)
 	if err != nil {
 		return nil, fmt.Errorf("apply refund to aggregate: %w", err)
 	}
 
+	rejected := providerOut.Status == ports.ProviderStatusFailed || providerOut.Status == ports.ProviderStatusCanceled
+	if rejected {
+		if err := agg.FailRefund(ctx, refundID, eventv1.FailureReason_FAILURE_REASON_DECLINED); err != nil {
+			return nil, fmt.Errorf("apply refund failure to aggregate: %w", err)
+		}
+	}
+
 	if err := agg.Invariants(); err != nil {
 		return nil, fmt.Errorf("domain invariants violated: %w", err)
 	}
 
-	if err := h.Repo.Save(ctx, agg, agg.Version()-1); err != nil {
+	if err := h.Repo.Save(ctx, agg, expectedVersion); err != nil {
 		return nil, fmt.Errorf("save refunded payment: %w", err)
 	}
+	if rejected {
+		return nil, fmt.Errorf("%w: refund %s", ErrRefundRejected, providerOut.RefundID)
+	}
 
 	return &dto.Result{
 		PaymentID:     cmd.PaymentID,
-		RefundID:      providerOut.RefundID,
+		RefundID:      refundID.String(),
 		RefundAmount:  actualRefundAmount,
 		TotalRefunded: agg.Ledger.TotalRefunded,
 		IsFullRefund:  isFullRefund,
+		Pending:       refund.Status == payment.RefundStatusPending,
 		State:         agg.State(),
 		Version:       agg.Version(),
 	}, nil
 }
+
+// recorded reports a refund the payment already holds.
+func recorded(agg *payment.Payment, r payment.Refund) *dto.Result {
+	return &dto.Result{
+		PaymentID:     agg.ID(),
+		RefundID:      r.ID.String(),
+		RefundAmount:  r.Amount,
+		TotalRefunded: agg.Ledger.TotalRefunded,
+		IsFullRefund:  isZero(agg.Ledger.Refundable()),
+		Pending:       r.Status == payment.RefundStatusPending,
+		State:         agg.State(),
+		Version:       agg.Version(),
+	}
+}
+
+// checkCapabilities rejects refunds the provider cannot make.
+func checkCapabilities(caps ports.Capabilities, agg *payment.Payment, amount *money.Money) error {
+	if !caps.Refund {
+		return fmt.Errorf("%w: refund", ports.ErrUnsupportedOperation)
+	}
+	if !caps.PartialRefund && ledger.Compare(amount, agg.Ledger.Settled()) < 0 {
+		return fmt.Errorf("%w: partial refund", ports.ErrUnsupportedOperation)
+	}
+	if caps.MaxRefunds <= 0 {
+		return nil
+	}
+	previous := 0
+	for _, r := range agg.Refunds() {
+		if r.Status != payment.RefundStatusFailed {
+			previous++
+		}
+	}
+	if previous >= caps.MaxRefunds {
+		return fmt.Errorf("%w: more than %d refunds per payment", ports.ErrUnsupportedOperation, caps.MaxRefunds)
+	}
+	return nil
+}
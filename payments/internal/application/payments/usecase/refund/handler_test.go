@@ -6,428 +6,313 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
-	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
-	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund/dto"
 	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
 	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
 	"github.com/shortlink-org/billing/payments/internal/domain/payment"
-	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+	"github.com/shortlink-org/billing/payments/internal/fixtures"
 )
 
-// Mock implementations for testing
-type MockPaymentRepository struct {
-	mock.Mock
+var partialRefunds = ports.Capabilities{Refund: true, PartialRefund: true}
+
+func refundedOK(_ context.Context, in ports.RefundPaymentIn) (ports.RefundPaymentOut, error) {
+	return ports.RefundPaymentOut{
+		Provider: ports.ProviderStripe,
+		RefundID: "re_" + in.Metadata["refund_id"],
+		Status:   ports.ProviderStatusSucceeded,
+		Amount:   in.Amount,
+	}, nil
 }
 
-func (m *MockPaymentRepository) Save(ctx context.Context, p *payment.Payment, expectedVersion uint64) error {
-	args := m.Called(ctx, p, expectedVersion)
-	return args.Error(0)
+func refundPending(ctx context.Context, in ports.RefundPaymentIn) (ports.RefundPaymentOut, error) {
+	out, err := refundedOK(ctx, in)
+	out.Status = ports.ProviderStatusPending
+	return out, err
 }
 
-func (m *MockPaymentRepository) Load(ctx context.Context, id uuid.UUID) (*payment.Payment, error) {
-	args := m.Called(ctx, id)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
+func command(p *payment.Payment, amount *money.Money) dto.Command {
+	return dto.Command{
+		PaymentID: p.ID(),
+		Amount:    amount,
+		Reason:    eventv1.RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER,
 	}
-	return args.Get(0).(*payment.Payment), args.Error(1)
 }
 
-type MockPaymentProvider struct {
-	mock.Mock
-}
+func TestHandler_Handle(t *testing.T) {
+	ctx := context.Background()
 
-func (m *MockPaymentProvider) CreatePayment(ctx context.Context, in ports.CreatePaymentIn) (ports.CreatePaymentOut, error) {
-	args := m.Called(ctx, in)
-	return args.Get(0).(ports.CreatePaymentOut), args.Error(1)
-}
+	t.Run("partial then remaining", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, partialRefunds)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.PaidPayment(t, repo, fixtures.USD(100))
 
-func (m *MockPaymentProvider) RefundPayment(ctx context.Context, in ports.RefundPaymentIn) (ports.RefundPaymentOut, error) {
-	args := m.Called(ctx, in)
-	return args.Get(0).(ports.RefundPaymentOut), args.Error(1)
-}
+		provider.EXPECT().RefundPayment(mock.Anything, mock.MatchedBy(func(in ports.RefundPaymentIn) bool {
+			return in.ProviderID == "pi_1" && proto.Equal(in.Amount, fixtures.USD(30)) &&
+				in.IdempotencyKey == p.ID().String()+":refund:"+in.Metadata["refund_id"]
+		})).RunAndReturn(refundedOK).Once()
 
-// Test helper to create a money amount
-func money(currency string, units int64, nanos int32) *money.Money {
-	return &money.Money{
-		CurrencyCode: currency,
-		Units:        units,
-		Nanos:        nanos,
-	}
-}
+		res, err := h.Handle(ctx, command(p, fixtures.USD(30)))
+		require.NoError(t, err)
+		require.False(t, res.IsFullRefund)
+		require.False(t, res.Pending)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, res.State)
+		require.True(t, proto.Equal(fixtures.USD(30), res.TotalRefunded))
 
-// Test helper to create a paid payment aggregate
-func createPaidPayment(t *testing.T, paymentID, invoiceID uuid.UUID, amount *money.Money) *payment.Payment {
-	// Create a new payment
-	agg, err := payment.New(paymentID, invoiceID, amount, eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_AUTO)
-	require.NoError(t, err)
-	
-	// Capture the full amount to make it paid
-	err = agg.Capture(context.Background(), amount)
-	require.NoError(t, err)
-	
-	return agg
-}
+		provider.EXPECT().RefundPayment(mock.Anything, mock.MatchedBy(func(in ports.RefundPaymentIn) bool {
+			return proto.Equal(in.Amount, fixtures.USD(70))
+		})).RunAndReturn(refundedOK).Once()
 
-func TestHandler_Handle_SuccessfulPartialRefund(t *testing.T) {
-	// Arrange
-	ctx := context.Background()
-	paymentID := uuid.New()
-	invoiceID := uuid.New()
-	originalAmount := money("USD", 100, 0) // $100.00
-	refundAmount := money("USD", 30, 0)    // $30.00
-	
-	agg := createPaidPayment(t, paymentID, invoiceID, originalAmount)
-	
-	mockRepo := &MockPaymentRepository{}
-	mockProvider := &MockPaymentProvider{}
-	
-	mockRepo.On("Load", ctx, paymentID).Return(agg, nil)
-	mockProvider.On("RefundPayment", ctx, mock.MatchedBy(func(in ports.RefundPaymentIn) bool {
-		return in.PaymentID == paymentID && 
-			   in.Amount.Units == 30 && 
-			   in.Currency == "USD"
-	})).Return(ports.RefundPaymentOut{
-		Provider: ports.ProviderStripe,
-		RefundID: "re_test123",
-		Status:   ports.ProviderStatusSucceeded,
-		Amount:   refundAmount,
-	}, nil)
-	mockRepo.On("Save", ctx, mock.AnythingOfType("*payment.Payment"), mock.AnythingOfType("uint64")).Return(nil)
-	
-	handler := &Handler{
-		Repo:     mockRepo,
-		Provider: mockProvider,
-	}
-	
-	cmd := Command{
-		PaymentID: paymentID,
-		Amount:    refundAmount,
-		Reason:    "customer request",
-		Metadata:  map[string]string{"source": "test"},
-	}
-	
-	// Act
-	result, err := handler.Handle(ctx, cmd)
-	
-	// Assert
-	require.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, paymentID, result.PaymentID)
-	assert.Equal(t, "re_test123", result.RefundID)
-	assert.Equal(t, refundAmount.Units, result.RefundAmount.Units)
-	assert.Equal(t, refundAmount.Units, result.TotalRefunded.Units)
-	assert.False(t, result.IsFullRefund)
-	assert.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, result.State)
-	
-	mockRepo.AssertExpectations(t)
-	mockProvider.AssertExpectations(t)
-}
+		res, err = h.Handle(ctx, command(p, nil))
+		require.NoError(t, err)
+		require.True(t, res.IsFullRefund)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_REFUNDED, res.State)
+		require.True(t, proto.Equal(fixtures.USD(100), res.TotalRefunded))
 
-func TestHandler_Handle_SuccessfulFullRefund(t *testing.T) {
-	// Arrange
-	ctx := context.Background()
-	paymentID := uuid.New()
-	invoiceID := uuid.New()
-	originalAmount := money("USD", 100, 0) // $100.00
-	
-	agg := createPaidPayment(t, paymentID, invoiceID, originalAmount)
-	
-	mockRepo := &MockPaymentRepository{}
-	mockProvider := &MockPaymentProvider{}
-	
-	mockRepo.On("Load", ctx, paymentID).Return(agg, nil)
-	mockProvider.On("RefundPayment", ctx, mock.MatchedBy(func(in ports.RefundPaymentIn) bool {
-		return in.PaymentID == paymentID && 
-			   in.Amount.Units == 100 && 
-			   in.Currency == "USD"
-	})).Return(ports.RefundPaymentOut{
-		Provider: ports.ProviderStripe,
-		RefundID: "re_test123",
-		Status:   ports.ProviderStatusSucceeded,
-		Amount:   originalAmount,
-	}, nil)
-	mockRepo.On("Save", ctx, mock.AnythingOfType("*payment.Payment"), mock.AnythingOfType("uint64")).Return(nil)
-	
-	handler := &Handler{
-		Repo:     mockRepo,
-		Provider: mockProvider,
-	}
-	
-	// Command with nil amount means full refund
-	cmd := Command{
-		PaymentID: paymentID,
-		Amount:    nil, // Full refund
-		Reason:    "order cancelled",
-	}
-	
-	// Act
-	result, err := handler.Handle(ctx, cmd)
-	
-	// Assert
-	require.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, paymentID, result.PaymentID)
-	assert.Equal(t, "re_test123", result.RefundID)
-	assert.Equal(t, originalAmount.Units, result.RefundAmount.Units)
-	assert.Equal(t, originalAmount.Units, result.TotalRefunded.Units)
-	assert.True(t, result.IsFullRefund)
-	assert.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_REFUNDED, result.State)
-	
-	mockRepo.AssertExpectations(t)
-	mockProvider.AssertExpectations(t)
-}
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Len(t, got.Refunds(), 2)
+		require.NotEqual(t, got.Refunds()[0].ID, got.Refunds()[1].ID)
 
-func TestHandler_Handle_PaymentNotFound(t *testing.T) {
-	// Arrange
-	ctx := context.Background()
-	paymentID := uuid.New()
-	
-	mockRepo := &MockPaymentRepository{}
-	mockProvider := &MockPaymentProvider{}
-	
-	mockRepo.On("Load", ctx, paymentID).Return(nil, repository.ErrNotFound)
-	
-	handler := &Handler{
-		Repo:     mockRepo,
-		Provider: mockProvider,
-	}
-	
-	cmd := Command{
-		PaymentID: paymentID,
-		Amount:    money("USD", 50, 0),
-		Reason:    "test",
-	}
-	
-	// Act
-	result, err := handler.Handle(ctx, cmd)
-	
-	// Assert
-	assert.Nil(t, result)
-	assert.Error(t, err)
-	assert.ErrorIs(t, err, ErrPaymentNotFound)
-	
-	mockRepo.AssertExpectations(t)
-}
+		_, err = h.Handle(ctx, command(p, nil))
+		require.ErrorIs(t, err, ErrInvalidRefundAmount)
+	})
 
-func TestHandler_Handle_InvalidPaymentID(t *testing.T) {
-	// Arrange
-	ctx := context.Background()
-	
-	mockRepo := &MockPaymentRepository{}
-	mockProvider := &MockPaymentProvider{}
-	
-	handler := &Handler{
-		Repo:     mockRepo,
-		Provider: mockProvider,
-	}
-	
-	cmd := Command{
-		PaymentID: uuid.Nil, // Invalid payment ID
-		Amount:    money("USD", 50, 0),
-		Reason:    "test",
-	}
-	
-	// Act
-	result, err := handler.Handle(ctx, cmd)
-	
-	// Assert
-	assert.Nil(t, result)
-	assert.Error(t, err)
-	assert.ErrorIs(t, err, ErrInvalidRefundAmount)
-}
+	t.Run("pending refund reserves its amount", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, partialRefunds)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.PaidPayment(t, repo, fixtures.USD(100))
 
-func TestHandler_Handle_PaymentNotRefundable(t *testing.T) {
-	// Arrange
-	ctx := context.Background()
-	paymentID := uuid.New()
-	invoiceID := uuid.New()
-	originalAmount := money("USD", 100, 0)
-	
-	// Create a payment in CREATED state (not refundable)
-	agg, err := payment.New(paymentID, invoiceID, originalAmount, eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_AUTO)
-	require.NoError(t, err)
-	
-	mockRepo := &MockPaymentRepository{}
-	mockProvider := &MockPaymentProvider{}
-	
-	mockRepo.On("Load", ctx, paymentID).Return(agg, nil)
-	
-	handler := &Handler{
-		Repo:     mockRepo,
-		Provider: mockProvider,
-	}
-	
-	cmd := Command{
-		PaymentID: paymentID,
-		Amount:    money("USD", 50, 0),
-		Reason:    "test",
-	}
-	
-	// Act
-	result, err := handler.Handle(ctx, cmd)
-	
-	// Assert
-	assert.Nil(t, result)
-	assert.Error(t, err)
-	assert.ErrorIs(t, err, ErrPaymentNotRefundable)
-	
-	mockRepo.AssertExpectations(t)
-}
+		provider.EXPECT().RefundPayment(mock.Anything, mock.Anything).RunAndReturn(refundPending).Once()
 
-func TestHandler_Handle_InvalidRefundAmount(t *testing.T) {
-	// Arrange
-	ctx := context.Background()
-	paymentID := uuid.New()
-	invoiceID := uuid.New()
-	originalAmount := money("USD", 100, 0)
-	
-	agg := createPaidPayment(t, paymentID, invoiceID, originalAmount)
-	
-	mockRepo := &MockPaymentRepository{}
-	mockProvider := &MockPaymentProvider{}
-	
-	mockRepo.On("Load", ctx, paymentID).Return(agg, nil)
-	
-	handler := &Handler{
-		Repo:     mockRepo,
-		Provider: mockProvider,
-	}
-	
-	testCases := []struct {
-		name   string
-		amount *money.Money
-	}{
-		{"zero amount", money("USD", 0, 0)},
-		{"negative amount", money("USD", -10, 0)},
-	}
-	
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			cmd := Command{
-				PaymentID: paymentID,
-				Amount:    tc.amount,
-				Reason:    "test",
-			}
-			
-			// Act
-			result, err := handler.Handle(ctx, cmd)
-			
-			// Assert
-			assert.Nil(t, result)
-			assert.Error(t, err)
-			assert.ErrorIs(t, err, ErrInvalidRefundAmount)
-		})
-	}
-	
-	mockRepo.AssertExpectations(t)
+		res, err := h.Handle(ctx, command(p, fixtures.USD(30)))
+		require.NoError(t, err)
+		require.True(t, res.Pending)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, res.State)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, payment.RefundStatusPending, got.Refunds()[0].Status)
+		require.True(t, proto.Equal(fixtures.USD(70), got.Ledger.Refundable()))
+
+		// A full refund covers what the pending one does not reserve.
+		provider.EXPECT().RefundPayment(mock.Anything, mock.MatchedBy(func(in ports.RefundPaymentIn) bool {
+			return proto.Equal(in.Amount, fixtures.USD(70))
+		})).RunAndReturn(refundPending).Once()
+
+		_, err = h.Handle(ctx, command(p, nil))
+		require.NoError(t, err)
+
+		_, err = h.Handle(ctx, command(p, nil))
+		require.ErrorIs(t, err, ErrInvalidRefundAmount)
+	})
+
+	t.Run("rejected refund is recorded as failed", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, partialRefunds)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.PaidPayment(t, repo, fixtures.USD(100))
+
+		provider.EXPECT().RefundPayment(mock.Anything, mock.Anything).
+			Return(ports.RefundPaymentOut{Provider: ports.ProviderStripe, RefundID: "re_1", Status: ports.ProviderStatusFailed}, nil).Once()
+
+		_, err := h.Handle(ctx, command(p, fixtures.USD(30)))
+		require.ErrorIs(t, err, ErrRefundRejected)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, payment.RefundStatusFailed, got.Refunds()[0].Status)
+		require.True(t, proto.Equal(fixtures.USD(100), got.Ledger.Refundable()))
+	})
+
+	t.Run("rejects invalid amounts before calling the provider", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Provider: fixtures.Provider(t, partialRefunds)}
+		p := fixtures.PaidPayment(t, repo, fixtures.USD(100))
+
+		for _, amt := range []*money.Money{fixtures.USD(0), fixtures.USD(-10), {CurrencyCode: "USD", Nanos: -100}} {
+			_, err := h.Handle(ctx, command(p, amt))
+			require.ErrorIs(t, err, ErrInvalidRefundAmount)
+		}
+
+		_, err := h.Handle(ctx, dto.Command{Amount: fixtures.USD(10)})
+		require.ErrorIs(t, err, ErrInvalidRefundAmount)
+	})
+
+	t.Run("not refundable", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Provider: fixtures.Provider(t, partialRefunds)}
+
+		_, err := h.Handle(ctx, dto.Command{PaymentID: uuid.New()})
+		require.ErrorIs(t, err, ErrPaymentNotFound)
+
+		p, err := payment.New(uuid.New(), uuid.New(), fixtures.USD(10),
+			eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, p, 0))
+
+		_, err = h.Handle(ctx, command(p, nil))
+		require.ErrorIs(t, err, ErrPaymentNotRefundable)
+	})
 }
 
-func TestHandler_Handle_ProviderRefundFails(t *testing.T) {
-	// Arrange
+func TestHandler_Handle_Capabilities(t *testing.T) {
 	ctx := context.Background()
-	paymentID := uuid.New()
-	invoiceID := uuid.New()
-	originalAmount := money("USD", 100, 0)
-	refundAmount := money("USD", 50, 0)
-	
-	agg := createPaidPayment(t, paymentID, invoiceID, originalAmount)
-	
-	mockRepo := &MockPaymentRepository{}
-	mockProvider := &MockPaymentProvider{}
-	
-	mockRepo.On("Load", ctx, paymentID).Return(agg, nil)
-	mockProvider.On("RefundPayment", ctx, mock.AnythingOfType("ports.RefundPaymentIn")).Return(
-		ports.RefundPaymentOut{}, errors.New("provider error"))
-	mockRepo.On("Save", ctx, mock.AnythingOfType("*payment.Payment"), mock.AnythingOfType("uint64")).Return(nil)
-	
-	handler := &Handler{
-		Repo:     mockRepo,
-		Provider: mockProvider,
-	}
-	
-	cmd := Command{
-		PaymentID: paymentID,
-		Amount:    refundAmount,
-		Reason:    "test",
-	}
-	
-	// Act
-	result, err := handler.Handle(ctx, cmd)
-	
-	// Assert
-	assert.Nil(t, result)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "provider refund failed")
-	
-	mockRepo.AssertExpectations(t)
-	mockProvider.AssertExpectations(t)
+
+	t.Run("provider without refunds", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Provider: fixtures.Provider(t, ports.Capabilities{})}
+		p := fixtures.PaidPayment(t, repo, fixtures.USD(100))
+
+		_, err := h.Handle(ctx, command(p, nil))
+		require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+	})
+
+	t.Run("partial refund the provider cannot make", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, ports.Capabilities{Refund: true})
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.PaidPayment(t, repo, fixtures.USD(100))
+
+		_, err := h.Handle(ctx, command(p, fixtures.USD(30)))
+		require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+
+		provider.EXPECT().RefundPayment(mock.Anything, mock.Anything).RunAndReturn(refundedOK).Once()
+		res, err := h.Handle(ctx, command(p, nil))
+		require.NoError(t, err)
+		require.True(t, res.IsFullRefund)
+	})
+
+	t.Run("refund limit counts refunds that did not fail", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, ports.Capabilities{Refund: true, PartialRefund: true, MaxRefunds: 1})
+		h := &Handler{Repo: repo, Provider: provider}
+		p := fixtures.PaidPayment(t, repo, fixtures.USD(100))
+
+		provider.EXPECT().RefundPayment(mock.Anything, mock.Anything).
+			Return(ports.RefundPaymentOut{Provider: ports.ProviderStripe, RefundID: "re_1", Status: ports.ProviderStatusFailed}, nil).Once()
+		_, err := h.Handle(ctx, command(p, fixtures.USD(30)))
+		require.ErrorIs(t, err, ErrRefundRejected)
+
+		provider.EXPECT().RefundPayment(mock.Anything, mock.Anything).RunAndReturn(refundedOK).Once()
+		_, err = h.Handle(ctx, command(p, fixtures.USD(30)))
+		require.NoError(t, err)
+
+		_, err = h.Handle(ctx, command(p, fixtures.USD(30)))
+		require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+	})
 }
 
-func TestHandler_Handle_AlreadyFullyRefunded(t *testing.T) {
-	// Arrange
+func TestHandler_Handle_Idempotency(t *testing.T) {
 	ctx := context.Background()
-	paymentID := uuid.New()
-	invoiceID := uuid.New()
-	originalAmount := money("USD", 100, 0)
-	
-	agg := createPaidPayment(t, paymentID, invoiceID, originalAmount)
-	
-	// Refund the full amount first
-	_, err := agg.Refund(ctx, originalAmount)
-	require.NoError(t, err)
-	
-	mockRepo := &MockPaymentRepository{}
-	mockProvider := &MockPaymentProvider{}
-	
-	mockRepo.On("Load", ctx, paymentID).Return(agg, nil)
-	
-	handler := &Handler{
-		Repo:     mockRepo,
-		Provider: mockProvider,
-	}
-	
-	// Try to refund again (full refund with nil amount)
-	cmd := Command{
-		PaymentID: paymentID,
-		Amount:    nil, // Full refund
-		Reason:    "test",
-	}
-	
-	// Act
-	result, err := handler.Handle(ctx, cmd)
-	
-	// Assert
-	assert.Nil(t, result)
-	assert.Error(t, err)
-	assert.ErrorIs(t, err, ErrInvalidRefundAmount)
-	assert.Contains(t, err.Error(), "already fully refunded")
-	
-	mockRepo.AssertExpectations(t)
+
+	t.Run("retry reuses the provider key and replays the result", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, partialRefunds)
+		h := &Handler{Repo: repo, Provider: provider, Idempotency: repo}
+		p := fixtures.PaidPayment(t, repo, fixtures.USD(100))
+
+		var keys []string
+		record := func(_ context.Context, in ports.RefundPaymentIn) { keys = append(keys, in.IdempotencyKey) }
+		provider.EXPECT().RefundPayment(mock.Anything, mock.Anything).Run(record).
+			Return(ports.RefundPaymentOut{}, errors.New("stripe: api_connection_error")).Once()
+		provider.EXPECT().RefundPayment(mock.Anything, mock.Anything).Run(record).
+			RunAndReturn(refundedOK).Once()
+
+		cmd := command(p, fixtures.USD(30))
+		cmd.Idempotency = idempotency.Key{Caller: "billing", Key: "refund-42"}
+
+		// The provider may have refunded before the connection dropped:
+		// the retry must reach it as the same refund.
+		_, err := h.Handle(ctx, cmd)
+		require.Error(t, err)
+
+		first, err := h.Handle(ctx, cmd)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		require.Equal(t, keys[0], keys[1])
+		require.Equal(t, p.ID().String()+":refund:"+first.RefundID, keys[1])
+
+		// Replayed from the stored result: the provider is not called a third time.
+		second, err := h.Handle(ctx, cmd)
+		require.NoError(t, err)
+		require.Equal(t, first.RefundID, second.RefundID)
+		require.Equal(t, first.Version, second.Version)
+		require.True(t, proto.Equal(first.TotalRefunded, second.TotalRefunded))
+
+		cmd.Amount = fixtures.USD(40)
+		_, err = h.Handle(ctx, cmd)
+		require.ErrorIs(t, err, idempotency.ErrKeyReused)
+	})
+
+	t.Run("next partial refund gets its own provider key", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, partialRefunds)
+		h := &Handler{Repo: repo, Provider: provider, Idempotency: repo}
+		p := fixtures.PaidPayment(t, repo, fixtures.USD(100))
+
+		var keys []string
+		provider.EXPECT().RefundPayment(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, in ports.RefundPaymentIn) { keys = append(keys, in.IdempotencyKey) }).
+			RunAndReturn(refundedOK).Twice()
+
+		for _, key := range []string{"refund-1", "refund-2"} {
+			cmd := command(p, fixtures.USD(30))
+			cmd.Idempotency = idempotency.Key{Caller: "billing", Key: key}
+			_, err := h.Handle(ctx, cmd)
+			require.NoError(t, err)
+		}
+		require.Len(t, keys, 2)
+		require.NotEqual(t, keys[0], keys[1])
+	})
+
+	t.Run("recorded refund is reported without calling the provider", func(t *testing.T) {
+		repo := memory.New()
+		provider := fixtures.Provider(t, partialRefunds)
+		p := fixtures.PaidPayment(t, repo, fixtures.USD(100))
+
+		provider.EXPECT().RefundPayment(mock.Anything, mock.Anything).RunAndReturn(refundPending).Once()
+
+		cmd := command(p, fixtures.USD(30))
+		cmd.Idempotency = idempotency.Key{Caller: "billing", Key: "refund-42"}
+
+		first, err := (&Handler{Repo: repo, Provider: provider, Idempotency: repo}).Handle(ctx, cmd)
+		require.NoError(t, err)
+
+		// The stored result is gone, e.g. expired: the refund in the stream still answers the retry.
+		again, err := (&Handler{Repo: repo, Provider: provider, Idempotency: memory.New()}).Handle(ctx, cmd)
+		require.NoError(t, err)
+		require.Equal(t, first.RefundID, again.RefundID)
+		require.True(t, again.Pending)
+		require.True(t, proto.Equal(fixtures.USD(30), again.RefundAmount))
+	})
 }
 
-// Test helper functions
 func TestIsZero(t *testing.T) {
 	testCases := []struct {
 		name     string
-		money    *money.Money
+		amount   *money.Money
 		expected bool
 	}{
 		{"nil money", nil, true},
-		{"zero money", money("USD", 0, 0), true},
-		{"positive money", money("USD", 10, 0), false},
-		{"negative money", money("USD", -10, 0), false},
-		{"zero units with nanos", money("USD", 0, 100), false},
+		{"zero money", fixtures.USD(0), true},
+		{"positive money", fixtures.USD(10), false},
+		{"negative money", fixtures.USD(-10), false},
+		{"zero units with nanos", &money.Money{CurrencyCode: "USD", Nanos: 100}, false},
 	}
-	
+
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := isZero(tc.money)
-			assert.Equal(t, tc.expected, result)
+			require.Equal(t, tc.expected, isZero(tc.amount))
 		})
 	}
 }
@@ -435,21 +320,20 @@ func TestIsZero(t *testing.T) {
 func TestIsNegative(t *testing.T) {
 	testCases := []struct {
 		name     string
-		money    *money.Money
+		amount   *money.Money
 		expected bool
 	}{
 		{"nil money", nil, false},
-		{"positive money", money("USD", 10, 0), false},
-		{"zero money", money("USD", 0, 0), false},
-		{"negative units", money("USD", -10, 0), true},
-		{"zero units negative nanos", money("USD", 0, -100), true},
-		{"negative units positive nanos", money("USD", -1, 100), true},
+		{"positive money", fixtures.USD(10), false},
+		{"zero money", fixtures.USD(0), false},
+		{"negative units", fixtures.USD(-10), true},
+		{"zero units negative nanos", &money.Money{CurrencyCode: "USD", Nanos: -100}, true},
+		{"negative units positive nanos", &money.Money{CurrencyCode: "USD", Units: -1, Nanos: 100}, true},
 	}
-	
+
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			result := isNegative(tc.money)
-			assert.Equal(t, tc.expected, result)
+			require.Equal(t, tc.expected, isNegative(tc.amount))
 		})
 	}
-}
\ No newline at end of file
+}
@@ -3,6 +3,7 @@ package refund
 import (
 	"context"
 
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund/dto"
@@ -14,11 +15,15 @@ type Service struct {
 }
 
 // NewService constructs a new refund service with the required dependencies.
+// A repository that also implements idempotency.Store de-duplicates requests with an IdempotencyKey.
 func NewService(repo repository.PaymentRepository, provider ports.PaymentProvider) *Service {
+	store, _ := repo.(idempotency.Store)
+
 	return &Service{
 		handler: &Handler{
-			Repo:     repo,
-			Provider: provider,
+			Repo:        repo,
+			Provider:    provider,
+			Idempotency: store,
 		},
 	}
 }
@@ -49,8 +54,8 @@ func (s *Service) validateRefundRequest(req dto.RefundRequestDTO) error {
 	if req.PaymentID == "" {
 		return ErrInvalidRefundAmount
 	}
-	if req.Reason == "" {
-		return ErrInvalidRefundAmount
+	if _, ok := dto.ParseRefundReason(req.Reason); !ok {
+		return ErrInvalidRefundReason
 	}
 	if req.Amount != nil {
 		if req.Amount.CurrencyCode == "" {
@@ -0,0 +1,8 @@
+package settle
+
+import "errors"
+
+var (
+	// ErrInvalidPayout is returned when the payout to import is not named.
+	ErrInvalidPayout = errors.New("settle: payout ID is required")
+)
@@ -0,0 +1,149 @@
+package settle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+)
+
+// Command names the provider payout to import.
+type Command struct {
+	Provider ports.Provider // routes the listing; empty → the default provider
+	PayoutID string
+}
+
+// Result counts the entries of the payout by outcome.
+type Result struct {
+	PayoutID   string
+	Imported   int // recorded on their payment by this call
+	Duplicates int // recorded by an earlier import
+	Unmatched  int // of no payment of ours, e.g. charged by another integration
+	Skipped    int // not a payment's funds, e.g. the payout itself or a provider fee
+	Rejected   int // refused by their payment, e.g. a charge above what it captured
+}
+
+// Handler imports the settlement of a provider payout into the payment ledgers.
+type Handler struct {
+	Repo     repository.PaymentRepository
+	Provider ports.PaymentProvider // must implement ports.SettlementLister
+}
+
+// Handle lists the payout and records every entry on its payment, one save per payment.
+// Entries already recorded are counted as duplicates, so a failed import can be run again.
+// An entry its payment refuses is counted as rejected and does not hold back the rest of the payout.
+func (h *Handler) Handle(ctx context.Context, cmd Command) (*Result, error) {
+	if cmd.PayoutID == "" {
+		return nil, ErrInvalidPayout
+	}
+
+	lister, ok := h.Provider.(ports.SettlementLister)
+	if !ok {
+		return nil, fmt.Errorf("%w: settlement listing", ports.ErrUnsupportedOperation)
+	}
+	out, err := lister.ListSettlement(ctx, ports.ListSettlementIn{Provider: cmd.Provider, PayoutID: cmd.PayoutID})
+	if err != nil {
+		return nil, fmt.Errorf("list settlement: %w", err)
+	}
+
+	res := &Result{PayoutID: cmd.PayoutID, Skipped: out.Skipped}
+
+	// Group the entries by payment; each group is put in settlement order below.
+	var (
+		order   []uuid.UUID
+		entries = make(map[uuid.UUID][]ports.SettlementEntry)
+	)
+	for _, e := range out.Entries {
+		if e.PaymentID == uuid.Nil {
+			res.Unmatched++
+			continue
+		}
+		if _, ok := entries[e.PaymentID]; !ok {
+			order = append(order, e.PaymentID)
+		}
+		entries[e.PaymentID] = append(entries[e.PaymentID], e)
+	}
+
+	for _, id := range order {
+		inSettlementOrder(entries[id])
+		if err := h.settle(ctx, cmd.PayoutID, out.Provider, id, entries[id], res); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// settle records the entries of one payment and saves it once.
+func (h *Handler) settle(ctx context.Context, payoutID string, provider ports.Provider, id uuid.UUID, entries []ports.SettlementEntry, res *Result) error {
+	agg, err := h.Repo.Load(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		res.Unmatched += len(entries)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("load payment: %w", err)
+	}
+	if provider != "" && ports.Provider(agg.Provider()) != provider {
+		// Charged by another provider: the entry names a payment that is not this one.
+		res.Unmatched += len(entries)
+		return nil
+	}
+	expectedVersion := agg.Version()
+
+	imported := 0
+	for _, e := range entries {
+		err := agg.Settle(ctx, payment.Settlement{
+			ID:        e.TransactionID,
+			PayoutID:  payoutID,
+			Type:      e.Type,
+			Amount:    e.Amount,
+			Gross:     e.Gross,
+			Fee:       e.Fee,
+			Net:       e.Net,
+			SettledAt: e.SettledAt,
+		})
+		switch {
+		case errors.Is(err, payment.ErrSettlementExists):
+			res.Duplicates++
+		case err != nil:
+			res.Rejected++
+		default:
+			imported++
+		}
+	}
+	if imported == 0 {
+		return nil
+	}
+
+	if err := agg.Invariants(); err != nil {
+		return fmt.Errorf("domain invariants violated: %w", err)
+	}
+	if err := h.Repo.Save(ctx, agg, expectedVersion); err != nil {
+		return fmt.Errorf("save settled payment: %w", err)
+	}
+	res.Imported += imported
+
+	return nil
+}
+
+// inSettlementOrder sorts the entries of one payment: charges first, as the FX adjustment of every
+// other entry is measured against the first settled charge and refunds are checked against it,
+// then by settlement time. Providers may list a payout newest first, e.g. Stripe.
+func inSettlementOrder(entries []ports.SettlementEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		ci := entries[i].Type == eventv1.SettlementType_SETTLEMENT_TYPE_CHARGE
+		cj := entries[j].Type == eventv1.SettlementType_SETTLEMENT_TYPE_CHARGE
+		if ci != cj {
+			return ci
+		}
+		return entries[i].SettledAt.Before(entries[j].SettledAt)
+	})
+}
@@ -0,0 +1,193 @@
+package settle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+)
+
+func eur(units int64, nanos int32) *money.Money {
+	return &money.Money{CurrencyCode: "EUR", Units: units, Nanos: nanos}
+}
+
+// lister is a provider that lists payouts.
+type lister struct {
+	*mocks.MockPaymentProvider
+	*mocks.MockSettlementLister
+}
+
+func newLister(t *testing.T) lister {
+	return lister{mocks.NewMockPaymentProvider(t), mocks.NewMockSettlementLister(t)}
+}
+
+// capturedPayment stores a Stripe payment that captured amount.
+func capturedPayment(t *testing.T, repo *memory.InMemory, amount *money.Money) *payment.Payment {
+	t.Helper()
+	ctx := context.Background()
+
+	p, err := payment.New(uuid.New(), uuid.New(), amount,
+		eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE)
+	require.NoError(t, err)
+	require.NoError(t, p.AttachProvider(ctx, "stripe", "pi_"+p.ID().String()))
+	require.NoError(t, p.Authorize(ctx, amount))
+	require.NoError(t, p.Capture(ctx, amount))
+	require.NoError(t, repo.Save(ctx, p, 0))
+	return p
+}
+
+func entry(id string, paymentID uuid.UUID, typ eventv1.SettlementType, amount, fee, net *money.Money) ports.SettlementEntry {
+	return ports.SettlementEntry{
+		TransactionID: id,
+		PaymentID:     paymentID,
+		Type:          typ,
+		Amount:        amount,
+		Gross:         amount,
+		Fee:           fee,
+		Net:           net,
+		SettledAt:     time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	charge, refund := eventv1.SettlementType_SETTLEMENT_TYPE_CHARGE, eventv1.SettlementType_SETTLEMENT_TYPE_REFUND
+
+	t.Run("imports a payout once", func(t *testing.T) {
+		repo := memory.New()
+		provider := newLister(t)
+		h := &Handler{Repo: repo, Provider: provider}
+		first := capturedPayment(t, repo, eur(100, 0))
+		second := capturedPayment(t, repo, eur(20, 0))
+
+		provider.MockSettlementLister.EXPECT().ListSettlement(mock.Anything, ports.ListSettlementIn{
+			Provider: ports.ProviderStripe, PayoutID: "po_1",
+		}).Return(ports.ListSettlementOut{
+			Provider: ports.ProviderStripe,
+			PayoutID: "po_1",
+			Entries: []ports.SettlementEntry{
+				entry("txn_1", first.ID(), charge, eur(100, 0), eur(1, 650_000_000), eur(98, 350_000_000)),
+				entry("txn_2", second.ID(), charge, eur(20, 0), eur(0, 550_000_000), eur(19, 450_000_000)),
+				entry("txn_3", first.ID(), refund, eur(-10, 0), eur(0, 0), eur(-10, 0)),
+				entry("txn_4", uuid.Nil, charge, eur(5, 0), eur(0, 0), eur(5, 0)),
+				entry("txn_5", uuid.New(), charge, eur(5, 0), eur(0, 0), eur(5, 0)),
+			},
+			Skipped: 1,
+		}, nil).Twice()
+
+		cmd := Command{Provider: ports.ProviderStripe, PayoutID: "po_1"}
+		res, err := h.Handle(ctx, cmd)
+		require.NoError(t, err)
+		require.Equal(t, &Result{PayoutID: "po_1", Imported: 3, Unmatched: 2, Skipped: 1}, res)
+
+		got, err := repo.Load(ctx, first.ID())
+		require.NoError(t, err)
+		require.Equal(t, first.Version()+2, got.Version(), "one event per entry, one save per payment")
+		require.True(t, proto.Equal(eur(1, 650_000_000), got.Ledger.Fees))
+		require.True(t, proto.Equal(eur(88, 350_000_000), got.Ledger.Net))
+
+		batch, err := repo.Batch(ctx, "po_1")
+		require.NoError(t, err)
+		require.Len(t, batch.Entries, 3)
+		gross, fees, net, err := batch.Totals()
+		require.NoError(t, err)
+		require.True(t, proto.Equal(eur(110, 0), gross))
+		require.True(t, proto.Equal(eur(2, 200_000_000), fees))
+		require.True(t, proto.Equal(eur(107, 800_000_000), net))
+
+		res, err = h.Handle(ctx, cmd)
+		require.NoError(t, err)
+		require.Equal(t, &Result{PayoutID: "po_1", Duplicates: 3, Unmatched: 2, Skipped: 1}, res)
+		got, err = repo.Load(ctx, first.ID())
+		require.NoError(t, err)
+		require.Equal(t, first.Version()+2, got.Version())
+	})
+
+	t.Run("entry the ledger refuses", func(t *testing.T) {
+		repo := memory.New()
+		provider := newLister(t)
+		h := &Handler{Repo: repo, Provider: provider}
+		refused := capturedPayment(t, repo, eur(10, 0))
+		other := capturedPayment(t, repo, eur(20, 0))
+
+		provider.MockSettlementLister.EXPECT().ListSettlement(mock.Anything, mock.Anything).Return(ports.ListSettlementOut{
+			Provider: ports.ProviderStripe,
+			Entries: []ports.SettlementEntry{
+				entry("txn_1", refused.ID(), charge, eur(20, 0), eur(0, 0), eur(20, 0)),
+				entry("txn_2", other.ID(), charge, eur(20, 0), eur(0, 0), eur(20, 0)),
+			},
+		}, nil).Twice()
+
+		// The rest of the payout is imported; the refused entry stays rejected on every run.
+		res, err := h.Handle(ctx, Command{PayoutID: "po_1"})
+		require.NoError(t, err)
+		require.Equal(t, &Result{PayoutID: "po_1", Imported: 1, Rejected: 1}, res)
+
+		res, err = h.Handle(ctx, Command{PayoutID: "po_1"})
+		require.NoError(t, err)
+		require.Equal(t, &Result{PayoutID: "po_1", Duplicates: 1, Rejected: 1}, res)
+
+		got, err := repo.Load(ctx, refused.ID())
+		require.NoError(t, err)
+		require.Nil(t, got.Ledger.Net, "nothing is recorded")
+		got, err = repo.Load(ctx, other.ID())
+		require.NoError(t, err)
+		require.True(t, proto.Equal(eur(20, 0), got.Ledger.Net))
+	})
+
+	t.Run("payout listed newest first", func(t *testing.T) {
+		repo := memory.New()
+		provider := newLister(t)
+		h := &Handler{Repo: repo, Provider: provider}
+		p := capturedPayment(t, repo, eur(100, 0))
+
+		usd := func(units int64, nanos int32) *money.Money {
+			return &money.Money{CurrencyCode: "USD", Units: units, Nanos: nanos}
+		}
+		day := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+		provider.MockSettlementLister.EXPECT().ListSettlement(mock.Anything, mock.Anything).Return(ports.ListSettlementOut{
+			Provider: ports.ProviderStripe,
+			Entries: []ports.SettlementEntry{
+				{TransactionID: "txn_2", PaymentID: p.ID(), Type: refund, Amount: eur(-10, 0),
+					Gross: usd(-11, 0), Fee: usd(0, 0), Net: usd(-11, 0), SettledAt: day.AddDate(0, 0, 1)},
+				{TransactionID: "txn_1", PaymentID: p.ID(), Type: charge, Amount: eur(100, 0),
+					Gross: usd(108, 0), Fee: usd(3, 0), Net: usd(105, 0), SettledAt: day},
+			},
+		}, nil).Once()
+
+		res, err := h.Handle(ctx, Command{PayoutID: "po_1"})
+		require.NoError(t, err)
+		require.Equal(t, 2, res.Imported)
+
+		// The refund is measured against the rate of the charge settled before it.
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		settlements := got.Settlements()
+		require.Equal(t, "txn_1", settlements[0].ID)
+		require.True(t, proto.Equal(usd(0, -200_000_000), settlements[1].FXAdjustment))
+		require.Equal(t, day.AddDate(0, 0, 1), got.Ledger.SettledAt)
+	})
+
+	t.Run("provider without payouts", func(t *testing.T) {
+		h := &Handler{Repo: memory.New(), Provider: mocks.NewMockPaymentProvider(t)}
+		_, err := h.Handle(ctx, Command{PayoutID: "po_1"})
+		require.ErrorIs(t, err, ports.ErrUnsupportedOperation)
+	})
+
+	t.Run("payout is required", func(t *testing.T) {
+		h := &Handler{Repo: memory.New(), Provider: newLister(t)}
+		_, err := h.Handle(ctx, Command{})
+		require.ErrorIs(t, err, ErrInvalidPayout)
+	})
+}
@@ -0,0 +1,17 @@
+package webhook
+
+import "errors"
+
+var (
+	// ErrInvalidEvent is returned for an event without an ID or provider.
+	ErrInvalidEvent = errors.New("webhook: invalid event")
+	// ErrPaymentNotFound is returned when the event refers to a payment that is not stored (yet).
+	// The provider should redeliver: the event may have overtaken the create use case.
+	ErrPaymentNotFound = errors.New("webhook: payment not found")
+	// ErrRefundNotFound is returned when an event refers to one of our refunds that is not stored yet.
+	// The event stays unprocessed, so the provider's redelivery is applied.
+	ErrRefundNotFound = errors.New("webhook: refund not found")
+	// ErrNotCaptured is returned when a refund made outside this service overtakes the capture of its payment.
+	// The event stays unprocessed, so the provider's redelivery is applied.
+	ErrNotCaptured = errors.New("webhook: payment not captured yet")
+)
@@ -0,0 +1,360 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/inbox"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// Kind is the provider-agnostic meaning of a webhook event.
+type Kind int
+
+const (
+	KindIgnored         Kind = iota // not relevant for the payment stream
+	KindRequiresAction              // SCA/3DS challenge started
+	KindAuthorized                  // funds held: Authorized
+	KindSucceeded                   // funds captured: Captured (running total)
+	KindFailed                      // attempt failed: SCA
+	KindCanceled                    // intent canceled: CancelReason
+	KindRefunded                    // money returned: Captured (running total); the refunds have their own events
+	KindDisputeOpened               // chargeback opened: Disputed, DisputeReason, DisputeID
+	KindDisputeEvidence             // evidence submitted, dispute under review
+	KindDisputeWon                  // chargeback won
+	KindDisputeLost                 // chargeback lost: Disputed
+	KindRefundPending               // refund accepted, not settled yet: RefundID, ProviderRefundID, RefundAmount
+	KindRefundSucceeded             // refund settled: RefundID, ProviderRefundID, RefundAmount
+	KindRefundFailed                // refund failed or canceled: RefundID, ProviderRefundID
+)
+
+// Event is a verified provider webhook event, translated by the inbound adapter.
+//
+// Amounts are running totals as reported by the provider, not deltas: applying the same
+// totals twice, or an older event after a newer one, leaves the stream unchanged. Refunds are
+// the exception: each is tracked by its provider refund ID, so a repeated event finds it recorded.
+type Event struct {
+	ID         string // provider event ID, the inbox key
+	Provider   ports.Provider
+	Type       string // raw provider type, kept in the inbox for operators
+	Kind       Kind
+	PaymentID  uuid.UUID // uuid.Nil → not a payment of this service
+	ProviderID string    // e.g., Stripe PaymentIntent ID
+
+	Authorized   *money.Money
+	Captured     *money.Money
+	Disputed     *money.Money
+	SCA          ports.SCAOutcome
+	CancelReason eventv1.CancelReason
+
+	DisputeReason eventv1.DisputeReason
+	DisputeID     string // provider dispute ID
+
+	RefundID         uuid.UUID    // our refund ID from the provider metadata, uuid.Nil for foreign refunds
+	ProviderRefundID string       // e.g., Stripe Refund ID
+	RefundAmount     *money.Money // amount of this refund
+}
+
+// Result is returned after an event was handled.
+type Result struct {
+	Duplicate bool // already processed, nothing done
+	Ignored   bool // not relevant for any payment stream
+	State     flowv1.PaymentFlow
+	Version   uint64
+	Recorded  int // number of domain events appended
+}
+
+// Handler applies provider webhook events to payment aggregates exactly once per event ID.
+type Handler struct {
+	Repo  repository.PaymentRepository
+	Inbox inbox.Store
+}
+
+func (h *Handler) Handle(ctx context.Context, evt Event) (*Result, error) {
+	if evt.ID == "" || evt.Provider == "" {
+		return nil, fmt.Errorf("%w: missing ID or provider", ErrInvalidEvent)
+	}
+
+	processed, err := h.Inbox.Receive(ctx, inbox.Message{
+		Provider:  string(evt.Provider),
+		EventID:   evt.ID,
+		EventType: evt.Type,
+		PaymentID: evt.PaymentID,
+	}, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("inbox receive: %w", err)
+	}
+	if processed {
+		return &Result{Duplicate: true}, nil
+	}
+
+	if evt.Kind == KindIgnored || evt.PaymentID == uuid.Nil {
+		if err := h.Inbox.MarkProcessed(ctx, string(evt.Provider), evt.ID, time.Now()); err != nil {
+			return nil, fmt.Errorf("inbox mark processed: %w", err)
+		}
+		return &Result{Ignored: true}, nil
+	}
+
+	agg, err := h.Repo.Load(ctx, evt.PaymentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrPaymentNotFound, evt.PaymentID)
+		}
+		return nil, fmt.Errorf("load payment: %w", err)
+	}
+	expectedVersion := agg.Version()
+
+	if err := apply(ctx, agg, evt); err != nil {
+		return nil, fmt.Errorf("apply %s: %w", evt.Type, err)
+	}
+
+	recorded := len(agg.UncommittedEvents())
+	if recorded > 0 {
+		if err := agg.Invariants(); err != nil {
+			return nil, fmt.Errorf("domain invariants violated: %w", err)
+		}
+		if err := h.Repo.Save(ctx, agg, expectedVersion); err != nil {
+			return nil, fmt.Errorf("save payment: %w", err)
+		}
+	}
+
+	// A crash before this line redelivers the event; apply is convergent, so that is safe.
+	if err := h.Inbox.MarkProcessed(ctx, string(evt.Provider), evt.ID, time.Now()); err != nil {
+		return nil, fmt.Errorf("inbox mark processed: %w", err)
+	}
+
+	return &Result{
+		State:    agg.State(),
+		Version:  agg.Version(),
+		Recorded: recorded,
+	}, nil
+}
+
+// apply moves the aggregate towards the state reported by the provider.
+// Stale and repeated events are no-ops; a late event first replays the steps it implies
+// (e.g. charge.refunded before payment_intent.succeeded records the capture).
+func apply(ctx context.Context, agg *payment.Payment, evt Event) error {
+	if isTerminal(agg.State()) {
+		return nil
+	}
+
+	switch evt.Kind {
+	case KindRequiresAction:
+		if agg.State() == flowv1.PaymentFlow_PAYMENT_FLOW_CREATED {
+			return agg.RequireSCA(ctx)
+		}
+	case KindAuthorized:
+		return authorize(ctx, agg, evt.Authorized)
+	case KindSucceeded:
+		return captureUpTo(ctx, agg, evt.Captured)
+	case KindFailed:
+		if isOpen(agg.State()) {
+			return agg.Fail(ctx, evt.SCA.FailureReason())
+		}
+	case KindCanceled:
+		if isOpen(agg.State()) {
+			return agg.Cancel(ctx, evt.CancelReason)
+		}
+	case KindRefunded:
+		// The refund total cannot tell a refund of ours that is not saved yet from a foreign one:
+		// refunds are recorded from their own events only.
+		return captureUpTo(ctx, agg, evt.Captured)
+	case KindDisputeOpened:
+		return openDispute(ctx, agg, evt)
+	case KindDisputeEvidence:
+		if agg.State() == flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED {
+			return agg.SubmitDisputeEvidence(ctx)
+		}
+	case KindDisputeWon:
+		if agg.State() == flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED {
+			return agg.WinDispute(ctx)
+		}
+	case KindDisputeLost:
+		// The opening event may be lost or still in flight: open the dispute first.
+		if err := openDispute(ctx, agg, evt); err != nil {
+			return err
+		}
+		if agg.State() == flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED {
+			_, err := agg.LoseDispute(ctx)
+			return err
+		}
+	case KindRefundPending, KindRefundSucceeded, KindRefundFailed:
+		return refund(ctx, agg, evt)
+	case KindIgnored:
+	}
+
+	return nil
+}
+
+// authorize records a hold for a payment that has not seen one yet.
+func authorize(ctx context.Context, agg *payment.Payment, amt *money.Money) error {
+	if amt == nil || !positive(amt) {
+		return nil
+	}
+
+	switch agg.State() {
+	case flowv1.PaymentFlow_PAYMENT_FLOW_CREATED:
+		return agg.Authorize(ctx, amt)
+	case flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION:
+		return agg.Confirm(ctx, amt)
+	default:
+		return nil
+	}
+}
+
+// captureUpTo records captures until Ledger.Captured reaches total.
+func captureUpTo(ctx context.Context, agg *payment.Payment, total *money.Money) error {
+	if total == nil || !positive(total) {
+		return nil
+	}
+
+	// Immediate-capture payments go CREATED -> PAID, like the create use case records them.
+	if agg.State() == flowv1.PaymentFlow_PAYMENT_FLOW_CREATED {
+		err := agg.Capture(ctx, total)
+		if !errors.Is(err, payment.ErrPolicyCaptureMode) {
+			return err
+		}
+	}
+
+	// Otherwise captured funds imply a hold, even if its event was lost or is still in flight.
+	if err := authorize(ctx, agg, total); err != nil {
+		return err
+	}
+
+	delta, err := ledger.Sub(total, orZero(agg.Ledger.Captured, total))
+	if err != nil {
+		return err
+	}
+	if !positive(delta) {
+		return nil
+	}
+	return agg.Capture(ctx, delta)
+}
+
+// refund applies a refund event. Refunds of ours are recorded by the refund use case and only
+// settled or failed here; refunds made outside this service are recorded as reported.
+func refund(ctx context.Context, agg *payment.Payment, evt Event) error {
+	r, ok := agg.FindProviderRefund(evt.ProviderRefundID)
+	if !ok {
+		r, ok = agg.FindRefund(evt.RefundID)
+	}
+	switch {
+	case ok:
+		return settleRefund(ctx, agg, r, evt.Kind)
+	case evt.RefundID == uuid.Nil:
+		return foreignRefund(ctx, agg, evt)
+	case evt.Kind == KindRefundPending:
+		// Ours, and the refund use case records it as the provider accepted it.
+		return nil
+	default:
+		// Ours, but the refund use case has not saved it yet: let the provider redeliver.
+		return fmt.Errorf("%w: %s", ErrRefundNotFound, evt.RefundID)
+	}
+}
+
+// settleRefund settles or fails a pending refund.
+func settleRefund(ctx context.Context, agg *payment.Payment, r payment.Refund, kind Kind) error {
+	if r.Status != payment.RefundStatusPending {
+		return nil
+	}
+
+	switch kind {
+	case KindRefundFailed:
+		return agg.FailRefund(ctx, r.ID, eventv1.FailureReason_FAILURE_REASON_DECLINED)
+	case KindRefundSucceeded:
+		_, err := agg.SettleRefund(ctx, r.ID)
+		return err
+	default:
+		return nil
+	}
+}
+
+// foreignRefund records a refund made outside this service, e.g. in the provider dashboard,
+// under its provider refund ID, so its later events find it.
+func foreignRefund(ctx context.Context, agg *payment.Payment, evt Event) error {
+	if evt.Kind == KindRefundFailed || evt.ProviderRefundID == "" || evt.RefundAmount == nil || !positive(evt.RefundAmount) {
+		return nil
+	}
+	if isOpen(agg.State()) {
+		// The capture event is still in flight: let the provider redeliver.
+		return fmt.Errorf("%w: refund %s", ErrNotCaptured, evt.ProviderRefundID)
+	}
+	if agg.State() != flowv1.PaymentFlow_PAYMENT_FLOW_PAID {
+		return nil
+	}
+
+	status := payment.RefundStatusSucceeded
+	if evt.Kind == KindRefundPending {
+		status = payment.RefundStatusPending
+	}
+	_, err := agg.RequestRefund(ctx, payment.Refund{
+		ID:               uuid.New(),
+		ProviderRefundID: evt.ProviderRefundID,
+		Amount:           evt.RefundAmount,
+		Status:           status,
+	})
+	return err
+}
+
+// openDispute withholds the disputed amount of a paid payment.
+// The provider may dispute more than is left after our refunds; the rest is capped.
+func openDispute(ctx context.Context, agg *payment.Payment, evt Event) error {
+	amt := evt.Disputed
+	if amt == nil || !positive(amt) || agg.State() != flowv1.PaymentFlow_PAYMENT_FLOW_PAID {
+		return nil
+	}
+
+	refundable := agg.Ledger.Refundable()
+	if ledger.Compare(amt, refundable) > 0 {
+		amt = refundable
+	}
+	if !positive(amt) {
+		return nil
+	}
+	return agg.OpenDispute(ctx, amt, evt.DisputeReason, evt.DisputeID)
+}
+
+func isOpen(s flowv1.PaymentFlow) bool {
+	switch s {
+	case flowv1.PaymentFlow_PAYMENT_FLOW_CREATED,
+		flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION,
+		flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED:
+		return true
+	default:
+		return false
+	}
+}
+
+func isTerminal(s flowv1.PaymentFlow) bool {
+	switch s {
+	case flowv1.PaymentFlow_PAYMENT_FLOW_REFUNDED,
+		flowv1.PaymentFlow_PAYMENT_FLOW_CANCELED,
+		flowv1.PaymentFlow_PAYMENT_FLOW_FAILED,
+		flowv1.PaymentFlow_PAYMENT_FLOW_CHARGED_BACK:
+		return true
+	default:
+		return false
+	}
+}
+
+func positive(m *money.Money) bool {
+	return ledger.Compare(m, ledger.Zero(m.GetCurrencyCode())) > 0
+}
+
+func orZero(m, like *money.Money) *money.Money {
+	if m == nil {
+		return ledger.Zero(like.GetCurrencyCode())
+	}
+	return m
+}
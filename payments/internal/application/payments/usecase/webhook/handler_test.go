@@ -0,0 +1,383 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	refunduc "github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund/dto"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/fixtures"
+)
+
+func eur(units int64) *money.Money { return &money.Money{CurrencyCode: "EUR", Units: units} }
+
+// newPayment stores a freshly created 40 EUR payment.
+func newPayment(t *testing.T, repo *memory.InMemory, mode eventv1.CaptureMode) *payment.Payment {
+	t.Helper()
+
+	p, err := payment.New(uuid.New(), uuid.New(), eur(40), eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, mode)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(context.Background(), p, 0))
+	return p
+}
+
+func stripeEvent(id string, paymentID uuid.UUID, kind Kind) Event {
+	return Event{ID: id, Provider: ports.ProviderStripe, Type: "test", Kind: kind, PaymentID: paymentID, ProviderID: "pi_1"}
+}
+
+// dashboardRefund is a refund event without our refund_id, e.g. of a refund made in the dashboard.
+func dashboardRefund(id string, paymentID uuid.UUID, kind Kind, providerRefundID string, amount *money.Money) Event {
+	evt := stripeEvent(id, paymentID, kind)
+	evt.ProviderRefundID = providerRefundID
+	evt.RefundAmount = amount
+	return evt
+}
+
+func TestHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("in order", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Inbox: repo}
+		p := newPayment(t, repo, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+
+		authorized := stripeEvent("evt_1", p.ID(), KindAuthorized)
+		authorized.Authorized = eur(40)
+		res, err := h.Handle(ctx, authorized)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED, res.State)
+
+		succeeded := stripeEvent("evt_2", p.ID(), KindSucceeded)
+		succeeded.Captured = eur(40)
+		res, err = h.Handle(ctx, succeeded)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, res.State)
+		require.Equal(t, 1, res.Recorded)
+	})
+
+	t.Run("duplicate delivery", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Inbox: repo}
+		p := newPayment(t, repo, eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE)
+
+		succeeded := stripeEvent("evt_1", p.ID(), KindSucceeded)
+		succeeded.Captured = eur(40)
+		first, err := h.Handle(ctx, succeeded)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, first.State)
+
+		second, err := h.Handle(ctx, succeeded)
+		require.NoError(t, err)
+		require.True(t, second.Duplicate)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, first.Version, got.Version())
+	})
+
+	t.Run("refund before capture", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Inbox: repo}
+		p := newPayment(t, repo, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+
+		// A dashboard refund overtakes the capture: it waits for the redelivery.
+		created := dashboardRefund("evt_4", p.ID(), KindRefundSucceeded, "re_1", eur(15))
+		_, err := h.Handle(ctx, created)
+		require.ErrorIs(t, err, ErrNotCaptured)
+
+		refunded := stripeEvent("evt_3", p.ID(), KindRefunded)
+		refunded.Captured = eur(40)
+		res, err := h.Handle(ctx, refunded)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, res.State)
+		require.Equal(t, 2, res.Recorded) // authorized, paid
+
+		res, err = h.Handle(ctx, created)
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Recorded)
+
+		// The older events arrive late and find nothing left to do.
+		authorized := stripeEvent("evt_1", p.ID(), KindAuthorized)
+		authorized.Authorized = eur(40)
+		succeeded := stripeEvent("evt_2", p.ID(), KindSucceeded)
+		succeeded.Captured = eur(40)
+		for _, evt := range []Event{succeeded, authorized} {
+			late, err := h.Handle(ctx, evt)
+			require.NoError(t, err)
+			require.Zero(t, late.Recorded)
+			require.Equal(t, res.Version, late.Version)
+		}
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, int64(15), got.Ledger.TotalRefunded.GetUnits())
+		require.NoError(t, got.Invariants())
+	})
+
+	t.Run("failed challenge", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Inbox: repo}
+		p := newPayment(t, repo, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+
+		_, err := h.Handle(ctx, stripeEvent("evt_1", p.ID(), KindRequiresAction))
+		require.NoError(t, err)
+
+		failed := stripeEvent("evt_2", p.ID(), KindFailed)
+		failed.SCA = ports.SCAOutcomeFailed
+		res, err := h.Handle(ctx, failed)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_FAILED, res.State)
+
+		pending, err := repo.Pending(ctx, time.Now(), 100)
+		require.NoError(t, err)
+		evt := &eventv1.PaymentFailed{}
+		require.NoError(t, proto.Unmarshal(pending[len(pending)-1].Payload, evt))
+		require.Equal(t, eventv1.FailureReason_FAILURE_REASON_SCA_FAILED, evt.GetReason())
+
+		// A late success for a failed attempt does not resurrect the payment.
+		succeeded := stripeEvent("evt_3", p.ID(), KindSucceeded)
+		succeeded.Captured = eur(40)
+		res, err = h.Handle(ctx, succeeded)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_FAILED, res.State)
+	})
+
+	t.Run("lost dispute", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Inbox: repo}
+		p := newPayment(t, repo, eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE)
+
+		succeeded := stripeEvent("evt_1", p.ID(), KindSucceeded)
+		succeeded.Captured = eur(40)
+		_, err := h.Handle(ctx, succeeded)
+		require.NoError(t, err)
+
+		// The closing event arrives before the opening one.
+		lost := stripeEvent("evt_3", p.ID(), KindDisputeLost)
+		lost.Disputed = eur(40)
+		res, err := h.Handle(ctx, lost)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_CHARGED_BACK, res.State)
+		require.Equal(t, 2, res.Recorded) // opened, lost
+
+		opened := stripeEvent("evt_2", p.ID(), KindDisputeOpened)
+		opened.Disputed = eur(40)
+		late, err := h.Handle(ctx, opened)
+		require.NoError(t, err)
+		require.Zero(t, late.Recorded)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, int64(40), got.Ledger.Reversed.GetUnits())
+		require.NoError(t, got.Invariants())
+	})
+
+	t.Run("won dispute", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Inbox: repo}
+		p := newPayment(t, repo, eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE)
+
+		succeeded := stripeEvent("evt_1", p.ID(), KindSucceeded)
+		succeeded.Captured = eur(40)
+		_, err := h.Handle(ctx, succeeded)
+		require.NoError(t, err)
+
+		opened := stripeEvent("evt_2", p.ID(), KindDisputeOpened)
+		opened.Disputed = eur(25)
+		opened.DisputeReason = eventv1.DisputeReason_DISPUTE_REASON_PRODUCT_NOT_RECEIVED
+		res, err := h.Handle(ctx, opened)
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED, res.State)
+
+		_, err = h.Handle(ctx, stripeEvent("evt_3", p.ID(), KindDisputeEvidence))
+		require.NoError(t, err)
+
+		res, err = h.Handle(ctx, stripeEvent("evt_4", p.ID(), KindDisputeWon))
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID, res.State)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Nil(t, got.Ledger.Disputed)
+		require.Equal(t, int64(40), got.Ledger.Refundable().GetUnits())
+	})
+
+	t.Run("pending refund settles and fails", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Inbox: repo}
+		p := newPayment(t, repo, eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE)
+
+		expected := p.Version()
+		require.NoError(t, p.Capture(ctx, eur(40)))
+		settles, fails := uuid.New(), uuid.New()
+		for _, r := range []payment.Refund{
+			{ID: settles, ProviderRefundID: "re_1", Amount: eur(15), Status: payment.RefundStatusPending},
+			{ID: fails, ProviderRefundID: "re_2", Amount: eur(10), Status: payment.RefundStatusPending},
+		} {
+			_, err := p.RequestRefund(ctx, r)
+			require.NoError(t, err)
+		}
+		require.NoError(t, repo.Save(ctx, p, expected))
+
+		// The charge total covers the pending refunds; only refund events move them.
+		refunded := stripeEvent("evt_1", p.ID(), KindRefunded)
+		refunded.Captured = eur(40)
+		res, err := h.Handle(ctx, refunded)
+		require.NoError(t, err)
+		require.Zero(t, res.Recorded)
+
+		succeeded := stripeEvent("evt_2", p.ID(), KindRefundSucceeded)
+		succeeded.ProviderRefundID = "re_1"
+		res, err = h.Handle(ctx, succeeded)
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Recorded)
+
+		failed := stripeEvent("evt_3", p.ID(), KindRefundFailed)
+		failed.RefundID = fails
+		res, err = h.Handle(ctx, failed)
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Recorded)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Equal(t, int64(15), got.Ledger.TotalRefunded.GetUnits())
+		require.Nil(t, got.Ledger.PendingRefunded)
+		r, ok := got.FindRefund(fails)
+		require.True(t, ok)
+		require.Equal(t, payment.RefundStatusFailed, r.Status)
+
+		// One of ours that the refund use case has not stored yet is retried.
+		early := stripeEvent("evt_4", p.ID(), KindRefundSucceeded)
+		early.RefundID = uuid.New()
+		early.ProviderRefundID = "re_3"
+		_, err = h.Handle(ctx, early)
+		require.ErrorIs(t, err, ErrRefundNotFound)
+	})
+
+	t.Run("foreign refund settles by its provider ID", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Inbox: repo}
+		p := newPayment(t, repo, eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE)
+
+		succeeded := stripeEvent("evt_1", p.ID(), KindSucceeded)
+		succeeded.Captured = eur(40)
+		_, err := h.Handle(ctx, succeeded)
+		require.NoError(t, err)
+
+		res, err := h.Handle(ctx, dashboardRefund("evt_2", p.ID(), KindRefundPending, "re_1", eur(10)))
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Recorded)
+
+		res, err = h.Handle(ctx, dashboardRefund("evt_3", p.ID(), KindRefundSucceeded, "re_1", eur(10)))
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Recorded)
+
+		got, err := repo.Load(ctx, p.ID())
+		require.NoError(t, err)
+		require.Len(t, got.Refunds(), 1)
+		require.Equal(t, payment.RefundStatusSucceeded, got.Refunds()[0].Status)
+		require.Equal(t, int64(10), got.Ledger.TotalRefunded.GetUnits())
+	})
+
+	t.Run("payment not saved yet", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Inbox: repo}
+
+		id := uuid.New()
+		_, err := h.Handle(ctx, stripeEvent("evt_1", id, KindRequiresAction))
+		require.ErrorIs(t, err, ErrPaymentNotFound)
+
+		// The redelivery is not a duplicate: the first attempt was never processed.
+		p, err := payment.New(id, uuid.New(), eur(40), eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, eventv1.CaptureMode_CAPTURE_MODE_MANUAL)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, p, 0))
+
+		res, err := h.Handle(ctx, stripeEvent("evt_1", id, KindRequiresAction))
+		require.NoError(t, err)
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION, res.State)
+	})
+
+	t.Run("ignored", func(t *testing.T) {
+		repo := memory.New()
+		h := &Handler{Repo: repo, Inbox: repo}
+
+		res, err := h.Handle(ctx, stripeEvent("evt_1", uuid.Nil, KindSucceeded))
+		require.NoError(t, err)
+		require.True(t, res.Ignored)
+
+		res, err = h.Handle(ctx, stripeEvent("evt_1", uuid.Nil, KindSucceeded))
+		require.NoError(t, err)
+		require.True(t, res.Duplicate)
+
+		_, err = h.Handle(ctx, Event{Kind: KindSucceeded})
+		require.ErrorIs(t, err, ErrInvalidEvent)
+	})
+}
+
+// The provider reports a refund of ours while the refund use case still waits for its answer.
+func TestHandler_Handle_RefundBeforeSave(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.New()
+	h := &Handler{Repo: repo, Inbox: repo}
+
+	p := fixtures.PaidPayment(t, repo, fixtures.USD(100))
+	provider := fixtures.Provider(t, ports.Capabilities{Refund: true, PartialRefund: true})
+
+	var created Event
+	provider.EXPECT().RefundPayment(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, in ports.RefundPaymentIn) (ports.RefundPaymentOut, error) {
+			refundID := uuid.MustParse(in.Metadata["refund_id"])
+			created = stripeEvent("evt_1", p.ID(), KindRefundSucceeded)
+			created.RefundID, created.ProviderRefundID, created.RefundAmount = refundID, "re_1", in.Amount
+
+			// Neither event records the refund: the use case does, under the same provider refund ID.
+			_, err := h.Handle(ctx, created)
+			require.ErrorIs(t, err, ErrRefundNotFound)
+			refunded := stripeEvent("evt_2", p.ID(), KindRefunded)
+			refunded.Captured = fixtures.USD(100)
+			res, err := h.Handle(ctx, refunded)
+			require.NoError(t, err)
+			require.Zero(t, res.Recorded)
+
+			return ports.RefundPaymentOut{Provider: ports.ProviderStripe, RefundID: "re_1", Status: ports.ProviderStatusSucceeded, Amount: in.Amount}, nil
+		}).Once()
+
+	refunds := &refunduc.Handler{Repo: repo, Provider: provider, Idempotency: repo}
+	_, err := refunds.Handle(ctx, dto.Command{
+		PaymentID:   p.ID(),
+		Amount:      fixtures.USD(30),
+		Reason:      eventv1.RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER,
+		Idempotency: idempotency.Key{Caller: "billing", Key: "refund-1"},
+	})
+	require.NoError(t, err)
+
+	// The redelivery finds the refund settled.
+	res, err := h.Handle(ctx, created)
+	require.NoError(t, err)
+	require.Zero(t, res.Recorded)
+
+	got, err := repo.Load(ctx, p.ID())
+	require.NoError(t, err)
+	require.Len(t, got.Refunds(), 1)
+	require.True(t, proto.Equal(fixtures.USD(30), got.Ledger.TotalRefunded))
+
+	// A later failure of the refund finds it too and gives the amount back.
+	failed := stripeEvent("evt_3", p.ID(), KindRefundFailed)
+	failed.RefundID, failed.ProviderRefundID = created.RefundID, "re_1"
+	res, err = h.Handle(ctx, failed)
+	require.NoError(t, err)
+	require.Zero(t, res.Recorded, "a settled refund is final")
+}
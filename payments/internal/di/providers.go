@@ -1,58 +1,536 @@
 package di
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/shopspring/decimal"
+	"github.com/shortlink-org/go-sdk/logger"
+	"github.com/shortlink-org/shortlink/pkg/db"
+	"github.com/shortlink-org/shortlink/pkg/rpc"
+
+	fakeadp "github.com/shortlink-org/billing/payments/internal/adapter/fake"
+	grpcadp "github.com/shortlink-org/billing/payments/internal/adapter/grpc"
+	kafkaadp "github.com/shortlink-org/billing/payments/internal/adapter/kafka"
+	resilienceadp "github.com/shortlink-org/billing/payments/internal/adapter/resilience"
+	routingadp "github.com/shortlink-org/billing/payments/internal/adapter/routing"
 	stripeadp "github.com/shortlink-org/billing/payments/internal/adapter/stripe"
 	tinkoffadp "github.com/shortlink-org/billing/payments/internal/adapter/tinkoff"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/deadline"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/idempotency"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/inbox"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/outbox"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/reconcile"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/reconcile/reconciler"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/memory"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository/postgres"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/sca"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/settlement"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/cancel"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/capture"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/confirm"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/create"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/increment"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/settle"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/webhook"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
 	"github.com/spf13/viper"
+	"google.golang.org/genproto/googleapis/type/money"
 )
 
 // ProvidePaymentRepository provides the payment repository implementation.
-func ProvidePaymentRepository() repository.PaymentRepository {
-	return memory.New()
+// The repository is selected based on the STORE_TYPE environment variable.
+// Supported values: "postgres", anything else falls back to in-memory.
+// Both plan payment deadlines with policy on every save.
+func ProvidePaymentRepository(ctx context.Context, store db.DB, policy deadline.Policy) (repository.PaymentRepository, error) {
+	viper.AutomaticEnv()
+
+	switch viper.GetString("STORE_TYPE") {
+	case "postgres":
+		return postgres.New(ctx, store, postgres.WithDeadlines(policy))
+	default:
+		return memory.New(memory.WithDeadlines(policy)), nil
+	}
+}
+
+// ProvideDeadlinePolicy provides the expiry windows of open payments.
+// Defaults follow deadline.DefaultPolicy and are overridden by DEADLINE_CREATED, DEADLINE_SCA
+// and DEADLINE_AUTHORIZED. Narrower overrides add a provider and/or capture mode to the key,
+// e.g. DEADLINE_STRIPE_MANUAL_AUTHORIZED=168h, DEADLINE_TINKOFF_SCA=15m, DEADLINE_MANUAL_CREATED=2h.
+// Fields an override leaves unset are taken from the defaults; "0" disables a deadline.
+func ProvideDeadlinePolicy() deadline.Policy {
+	viper.AutomaticEnv()
+
+	policy := deadline.Policy{
+		Default:   deadlineWindows("DEADLINE", deadline.DefaultPolicy.Default),
+		Overrides: make(map[deadline.Scope]deadline.Windows),
+	}
+
+	modes := map[string]eventv1.CaptureMode{
+		"":          eventv1.CaptureMode_CAPTURE_MODE_UNSPECIFIED,
+		"IMMEDIATE": eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE,
+		"MANUAL":    eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+	}
+	for _, provider := range []ports.Provider{"", ports.ProviderStripe, ports.ProviderTinkoff, ports.ProviderFake} {
+		for modeName, mode := range modes {
+			if provider == "" && modeName == "" {
+				continue
+			}
+
+			prefix := "DEADLINE"
+			if provider != "" {
+				prefix += "_" + strings.ToUpper(string(provider))
+			}
+			if modeName != "" {
+				prefix += "_" + modeName
+			}
+			if !deadlineConfigured(prefix) {
+				continue
+			}
+
+			policy.Overrides[deadline.Scope{Provider: string(provider), Mode: mode}] = deadlineWindows(prefix, policy.Default)
+		}
+	}
+
+	return policy
+}
+
+func deadlineConfigured(prefix string) bool {
+	return viper.IsSet(prefix+"_CREATED") || viper.IsSet(prefix+"_SCA") || viper.IsSet(prefix+"_AUTHORIZED")
+}
+
+func deadlineWindows(prefix string, fallback deadline.Windows) deadline.Windows {
+	w := fallback
+	if viper.IsSet(prefix + "_CREATED") {
+		w.Created = viper.GetDuration(prefix + "_CREATED")
+	}
+	if viper.IsSet(prefix + "_SCA") {
+		w.SCA = viper.GetDuration(prefix + "_SCA")
+	}
+	if viper.IsSet(prefix + "_AUTHORIZED") {
+		w.Authorized = viper.GetDuration(prefix + "_AUTHORIZED")
+	}
+	return w
+}
+
+// ProvideDeadlineScheduler provides the scheduler expiring payments whose deadline passed.
+// Expired CREATED and WAITING_FOR_CONFIRMATION payments are voided at the provider first.
+// DEADLINE_INTERVAL sets the polling interval (default 10s).
+func ProvideDeadlineScheduler(
+	log logger.Logger,
+	repo repository.PaymentRepository,
+	provider ports.PaymentProvider,
+) (*deadline.Scheduler, error) {
+	viper.AutomaticEnv()
+	viper.SetDefault("DEADLINE_INTERVAL", "10s")
+
+	store, ok := repo.(deadline.Store)
+	if !ok {
+		return nil, fmt.Errorf("payment repository %T does not provide deadlines", repo)
+	}
+
+	return deadline.NewScheduler(log, store, repo,
+		deadline.WithProvider(provider),
+		deadline.WithInterval(viper.GetDuration("DEADLINE_INTERVAL")),
+	), nil
+}
+
+// ProvideReconciler provides the reconciler catching stale unsettled payments up with the provider.
+// RECONCILE_INTERVAL sets the polling interval (default 1m), RECONCILE_THRESHOLD how long a
+// payment stays unsaved before it is checked (default 15m) and RECONCILE_RATE the provider
+// calls per second left to reconciliation (default 10).
+func ProvideReconciler(
+	log logger.Logger,
+	repo repository.PaymentRepository,
+	provider ports.PaymentProvider,
+	webhooks *webhook.Handler,
+) (*reconciler.Reconciler, error) {
+	viper.AutomaticEnv()
+	viper.SetDefault("RECONCILE_INTERVAL", "1m")
+	viper.SetDefault("RECONCILE_THRESHOLD", "15m")
+	viper.SetDefault("RECONCILE_RATE", 10)
+
+	store, ok := repo.(reconcile.Store)
+	if !ok {
+		return nil, fmt.Errorf("payment repository %T does not provide reconciliation", repo)
+	}
+
+	return reconciler.New(log, store, repo, provider, webhooks,
+		reconciler.WithInterval(viper.GetDuration("RECONCILE_INTERVAL")),
+		reconciler.WithThreshold(viper.GetDuration("RECONCILE_THRESHOLD")),
+		reconciler.WithRateLimit(viper.GetFloat64("RECONCILE_RATE"), 1),
+	)
 }
 
 // ProvidePaymentProvider provides the payment provider implementation.
 // The provider is selected based on the PAYMENT_PROVIDER environment variable.
-// Supported values: "stripe" (default), "tinkoff"
-func ProvidePaymentProvider() (ports.PaymentProvider, error) {
+// Supported values: "stripe" (default), "tinkoff", "fake", "routing"; with "routing" each payment is
+// routed by the rules in the YAML file at PAYMENT_ROUTING_FILE. "fake" keeps payments in memory
+// and sends its webhooks straight to the webhook handler, after PAYMENT_FAKE_DELAY.
+func ProvidePaymentProvider(log logger.Logger, webhooks *webhook.Handler) (ports.PaymentProvider, error) {
 	viper.AutomaticEnv()
 	provider := viper.GetString("PAYMENT_PROVIDER")
 
-	switch provider {
+	if provider != "routing" {
+		return newPaymentProvider(provider, log, webhooks)
+	}
+
+	cfg, err := routingadp.LoadConfig(viper.GetString("PAYMENT_ROUTING_FILE"))
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make(map[ports.Provider]ports.PaymentProvider)
+	for _, name := range cfg.Providers() {
+		if providers[name], err = newPaymentProvider(string(name), log, webhooks); err != nil {
+			return nil, fmt.Errorf("routing provider %s: %w", name, err)
+		}
+	}
+
+	return routingadp.New(cfg, providers)
+}
+
+// newPaymentProvider builds one provider with per-call timeouts, retries and a circuit breaker,
+// configured by PAYMENT_PROVIDER_TIMEOUT, PAYMENT_PROVIDER_RETRIES, PAYMENT_PROVIDER_BREAKER_THRESHOLD
+// and PAYMENT_PROVIDER_BREAKER_COOLDOWN.
+func newPaymentProvider(name string, log logger.Logger, webhooks *webhook.Handler) (ports.PaymentProvider, error) {
+	viper.SetDefault("PAYMENT_PROVIDER_TIMEOUT", "10s")
+	viper.SetDefault("PAYMENT_PROVIDER_RETRIES", 2)
+	viper.SetDefault("PAYMENT_PROVIDER_BREAKER_THRESHOLD", 5)
+	viper.SetDefault("PAYMENT_PROVIDER_BREAKER_COOLDOWN", "30s")
+
+	var (
+		provider ports.PaymentProvider
+		err      error
+	)
+	switch name {
 	case "tinkoff":
-		return tinkoffadp.New()
+		provider, err = tinkoffadp.New()
+	case "fake":
+		viper.SetDefault("PAYMENT_FAKE_DELAY", "2s")
+		provider = fakeadp.New(log, fakeadp.WithWebhooks(webhooks), fakeadp.WithDelay(viper.GetDuration("PAYMENT_FAKE_DELAY")))
 	case "stripe", "":
-		return stripeadp.New()
+		name = "stripe"
+		provider, err = stripeadp.New()
 	default:
-		return nil, fmt.Errorf("unsupported payment provider: %s", provider)
+		return nil, fmt.Errorf("unsupported payment provider: %s", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resilienceadp.New(ports.Provider(name), provider,
+		resilienceadp.WithTimeout(viper.GetDuration("PAYMENT_PROVIDER_TIMEOUT")),
+		resilienceadp.WithRetries(viper.GetInt("PAYMENT_PROVIDER_RETRIES")),
+		resilienceadp.WithBreaker(viper.GetInt("PAYMENT_PROVIDER_BREAKER_THRESHOLD"), viper.GetDuration("PAYMENT_PROVIDER_BREAKER_COOLDOWN")),
+	), nil
+}
+
+// ProvideOutboxRelay provides the outbox relay publishing integration events to Kafka.
+// It returns nil when MQ_ENABLED is false; events then stay in the outbox.
+func ProvideOutboxRelay(log logger.Logger, repo repository.PaymentRepository) (*outbox.Relay, func(), error) {
+	viper.AutomaticEnv()
+	viper.SetDefault("MQ_ENABLED", false)
+
+	if !viper.GetBool("MQ_ENABLED") {
+		return nil, func() {}, nil
+	}
+
+	store, ok := repo.(outbox.Store)
+	if !ok {
+		return nil, nil, fmt.Errorf("payment repository %T does not provide an outbox", repo)
+	}
+
+	publisher, err := kafkaadp.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		_ = publisher.Close()
+	}
+
+	return outbox.NewRelay(log, store, publisher), cleanup, nil
+}
+
+// ProvidePaymentPolicy provides the business rules of payments.
+//
+// Limits are read from PAYMENT_MIN_AMOUNT and PAYMENT_MAX_AMOUNT (e.g. "EUR 0.50,USD 0.50"),
+// PAYMENT_MAX_CAPTURES and PAYMENT_MAX_REFUNDS. Allowed currencies are read from PAYMENT_CURRENCIES
+// (e.g. "EUR,USD"); narrower lists add a payment kind and/or capture mode to the key,
+// e.g. PAYMENT_RECURRING_CURRENCIES, PAYMENT_MANUAL_CURRENCIES, PAYMENT_ONE_TIME_MANUAL_CURRENCIES.
+// SCA rules are loaded from the YAML file at SCA_POLICY_FILE; without it SCA is left to the provider.
+func ProvidePaymentPolicy() (payment.Policy, error) {
+	viper.AutomaticEnv()
+
+	limits := payment.Limits{
+		MaxCaptures: viper.GetInt("PAYMENT_MAX_CAPTURES"),
+		MaxRefunds:  viper.GetInt("PAYMENT_MAX_REFUNDS"),
+		Currencies:  make(map[payment.CurrencyScope][]string),
+	}
+
+	var err error
+	if limits.MinAmount, err = amountLimits("PAYMENT_MIN_AMOUNT"); err != nil {
+		return nil, err
+	}
+	if limits.MaxAmount, err = amountLimits("PAYMENT_MAX_AMOUNT"); err != nil {
+		return nil, err
+	}
+
+	kinds := map[string]eventv1.PaymentKind{
+		"":          eventv1.PaymentKind_PAYMENT_KIND_UNSPECIFIED,
+		"ONE_TIME":  eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME,
+		"RECURRING": eventv1.PaymentKind_PAYMENT_KIND_RECURRING,
 	}
+	modes := map[string]eventv1.CaptureMode{
+		"":          eventv1.CaptureMode_CAPTURE_MODE_UNSPECIFIED,
+		"IMMEDIATE": eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE,
+		"MANUAL":    eventv1.CaptureMode_CAPTURE_MODE_MANUAL,
+	}
+	for kindName, kind := range kinds {
+		for modeName, mode := range modes {
+			key := "PAYMENT"
+			if kindName != "" {
+				key += "_" + kindName
+			}
+			if modeName != "" {
+				key += "_" + modeName
+			}
+			if codes := splitList(viper.GetString(key + "_CURRENCIES")); len(codes) > 0 {
+				limits.Currencies[payment.CurrencyScope{Kind: kind, Mode: mode}] = codes
+			}
+		}
+	}
+
+	base := &payment.StaticPolicy{Limits: limits}
+
+	path := viper.GetString("SCA_POLICY_FILE")
+	if path == "" {
+		return base, nil
+	}
+
+	return sca.LoadFile(path, sca.WithBase(base))
+}
+
+// amountLimits parses a list of per-currency amounts such as "EUR 0.50,USD 1".
+func amountLimits(key string) (map[string]*money.Money, error) {
+	out := make(map[string]*money.Money)
+	for _, item := range splitList(viper.GetString(key)) {
+		currency, amount, ok := strings.Cut(item, " ")
+		d, err := decimal.NewFromString(strings.TrimSpace(amount))
+		if !ok || err != nil {
+			return nil, fmt.Errorf("%s: invalid amount %q, want e.g. \"EUR 0.50\"", key, item)
+		}
+		units := d.IntPart()
+		out[strings.ToUpper(currency)] = &money.Money{
+			CurrencyCode: strings.ToUpper(currency),
+			Units:        units,
+			Nanos:        int32(d.Sub(decimal.NewFromInt(units)).Shift(9).IntPart()),
+		}
+	}
+	return out, nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
 }
 
 // ProvideCreateHandler provides the create payment usecase handler.
+// Idempotency keys are honored when the repository also stores idempotency records.
 func ProvideCreateHandler(
 	repo repository.PaymentRepository,
 	provider ports.PaymentProvider,
+	policy payment.Policy,
 ) *create.Handler {
+	store, _ := repo.(idempotency.Store)
+
 	return &create.Handler{
+		Repo:        repo,
+		Provider:    provider,
+		Policy:      policy,
+		Idempotency: store,
+	}
+}
+
+// ProvideConfirmHandler provides the SCA/3DS confirmation usecase handler.
+func ProvideConfirmHandler(
+	repo repository.PaymentRepository,
+	provider ports.PaymentProvider,
+) *confirm.Handler {
+	return &confirm.Handler{
 		Repo:     repo,
 		Provider: provider,
 	}
 }
 
+// ProvideCaptureHandler provides the capture payment usecase handler.
+func ProvideCaptureHandler(
+	repo repository.PaymentRepository,
+	provider ports.PaymentProvider,
+	policy payment.Policy,
+) *capture.Handler {
+	return &capture.Handler{
+		Repo:     repo,
+		Provider: provider,
+		Policy:   policy,
+	}
+}
+
+// ProvideIncrementHandler provides the increase authorization usecase handler.
+func ProvideIncrementHandler(
+	repo repository.PaymentRepository,
+	provider ports.PaymentProvider,
+	policy payment.Policy,
+) *increment.Handler {
+	return &increment.Handler{
+		Repo:     repo,
+		Provider: provider,
+		Policy:   policy,
+	}
+}
+
 // ProvideRefundHandler provides the refund payment usecase handler.
+// Idempotency keys are honored when the repository also stores idempotency records.
 func ProvideRefundHandler(
 	repo repository.PaymentRepository,
 	provider ports.PaymentProvider,
+	policy payment.Policy,
 ) *refund.Handler {
+	store, _ := repo.(idempotency.Store)
+
 	return &refund.Handler{
+		Repo:        repo,
+		Provider:    provider,
+		Policy:      policy,
+		Idempotency: store,
+	}
+}
+
+// ProvideCancelHandler provides the cancel payment usecase handler.
+func ProvideCancelHandler(
+	repo repository.PaymentRepository,
+	provider ports.PaymentProvider,
+) *cancel.Handler {
+	return &cancel.Handler{
+		Repo:     repo,
+		Provider: provider,
+	}
+}
+
+// ProvideWebhookHandler provides the provider webhook usecase handler.
+// The repository must also provide the inbox used for deduplication.
+func ProvideWebhookHandler(repo repository.PaymentRepository) (*webhook.Handler, error) {
+	store, ok := repo.(inbox.Store)
+	if !ok {
+		return nil, fmt.Errorf("payment repository %T does not provide an inbox", repo)
+	}
+
+	return &webhook.Handler{
+		Repo:  repo,
+		Inbox: store,
+	}, nil
+}
+
+// ProvideWebhookServer provides the HTTP server receiving Stripe webhooks on POST /webhooks/stripe.
+// It returns nil when STRIPE_WEBHOOK_SECRET is not set.
+// Example: export STRIPE_WEBHOOK_SECRET=whsec_... WEBHOOK_ADDR=:8081
+func ProvideWebhookServer(log logger.Logger, handler *webhook.Handler, provider ports.PaymentProvider) (*http.Server, func(), error) {
+	viper.AutomaticEnv()
+	viper.SetDefault("WEBHOOK_ADDR", ":8081")
+
+	secret := viper.GetString("STRIPE_WEBHOOK_SECRET")
+	if secret == "" {
+		return nil, func() {}, nil
+	}
+
+	// Charges and disputes carry no payment_id: their PaymentIntent is looked up through the
+	// wired provider, with its retries and circuit breaker.
+	resolver, ok := provider.(ports.PaymentResolver)
+	if !ok {
+		return nil, nil, fmt.Errorf("payment provider %T cannot resolve Stripe payments", provider)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("POST /webhooks/stripe", stripeadp.NewWebhookHandler(log, secret, resolver, handler))
+
+	srv := &http.Server{
+		Addr:              viper.GetString("WEBHOOK_ADDR"),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	cleanup := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(ctx)
+	}
+
+	return srv, cleanup, nil
+}
+
+// ProvideSettleHandler provides the usecase importing provider payouts into the payment ledgers.
+func ProvideSettleHandler(
+	repo repository.PaymentRepository,
+	provider ports.PaymentProvider,
+) *settle.Handler {
+	return &settle.Handler{
 		Repo:     repo,
 		Provider: provider,
 	}
 }
+
+// ProvideSettlementStore provides the settlements by payout, kept by the payment repository.
+func ProvideSettlementStore(repo repository.PaymentRepository) (settlement.Store, error) {
+	store, ok := repo.(settlement.Store)
+	if !ok {
+		return nil, fmt.Errorf("payment repository %T does not provide settlements", repo)
+	}
+	return store, nil
+}
+
+// ProvidePaymentRPCServer registers payments.v1.PaymentService on the shared gRPC server.
+// The server itself (address, TLS, interceptors) is configured by the shortlink rpc helpers;
+// when it is disabled, the service is still built but not exposed.
+func ProvidePaymentRPCServer(
+	runRPCServer *rpc.Server,
+	repo repository.PaymentRepository,
+	createUC *create.Handler,
+	confirmUC *confirm.Handler,
+	captureUC *capture.Handler,
+	incrementUC *increment.Handler,
+	refundUC *refund.Handler,
+	cancelUC *cancel.Handler,
+	settleUC *settle.Handler,
+	settlements settlement.Store,
+) *grpcadp.Server {
+	srv := &grpcadp.Server{
+		Repo:           repo,
+		CreatePayment:  createUC,
+		ConfirmPayment: confirmUC,
+		CapturePayment: captureUC,
+		IncreaseHold:   incrementUC,
+		RefundPayment:  refundUC,
+		CancelPayment:  cancelUC,
+		SettlePayout:   settleUC,
+		Settlements:    settlements,
+	}
+
+	if runRPCServer != nil {
+		srv.Register(runRPCServer.Server)
+	}
+
+	return srv
+}
@@ -6,6 +6,7 @@ package di
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/google/wire"
 	"go.opentelemetry.io/otel/trace"
@@ -15,10 +16,22 @@ import (
 	"github.com/shortlink-org/shortlink/pkg/di"
 	"github.com/shortlink-org/shortlink/pkg/di/pkg/autoMaxPro"
 	"github.com/shortlink-org/shortlink/pkg/di/pkg/profiling"
+	"github.com/shortlink-org/shortlink/pkg/di/pkg/store"
 	"github.com/shortlink-org/shortlink/pkg/observability/metrics"
+	"github.com/shortlink-org/shortlink/pkg/rpc"
 
+	grpcadp "github.com/shortlink-org/billing/payments/internal/adapter/grpc"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/deadline"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/outbox"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/reconcile/reconciler"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/cancel"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/capture"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/confirm"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/create"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/increment"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/webhook"
 )
 
 type PaymentService struct {
@@ -31,18 +44,46 @@ type PaymentService struct {
 	Metrics       *metrics.Monitoring
 	PprofEndpoint profiling.PprofEndpoint
 
-	CreatePayment *create.Handler
-	RefundPayment *refund.Handler
+	CreatePayment  *create.Handler
+	ConfirmPayment *confirm.Handler
+	CapturePayment *capture.Handler
+	IncreaseHold   *increment.Handler
+	RefundPayment  *refund.Handler
+	CancelPayment  *cancel.Handler
+	HandleWebhook  *webhook.Handler
+
+	OutboxRelay       *outbox.Relay
+	DeadlineScheduler *deadline.Scheduler
+	Reconciler        *reconciler.Reconciler
+	WebhookServer     *http.Server
+	RPCServer         *rpc.Server
+	PaymentRPC        *grpcadp.Server
 }
 
 var InfrastructureSet = wire.NewSet(
+	store.New,
+	ProvideDeadlinePolicy,
 	ProvidePaymentRepository,
 	ProvidePaymentProvider,
+	ProvidePaymentPolicy,
+	ProvideOutboxRelay,
+	ProvideDeadlineScheduler,
+	ProvideReconciler,
+	ProvideSettlementStore,
+	ProvideWebhookServer,
+	rpc.InitServer,
+	ProvidePaymentRPCServer,
 )
 
 var UsecaseSet = wire.NewSet(
 	ProvideCreateHandler,
+	ProvideConfirmHandler,
+	ProvideCaptureHandler,
+	ProvideIncrementHandler,
 	ProvideRefundHandler,
+	ProvideCancelHandler,
+	ProvideSettleHandler,
+	ProvideWebhookHandler,
 )
 
 var PaymentSet = wire.NewSet(
@@ -61,18 +102,40 @@ func NewPaymentService(
 	mon *metrics.Monitoring,
 	pprof profiling.PprofEndpoint,
 	createUC *create.Handler,
+	confirmUC *confirm.Handler,
+	captureUC *capture.Handler,
+	incrementUC *increment.Handler,
 	refundUC *refund.Handler,
+	cancelUC *cancel.Handler,
+	webhookUC *webhook.Handler,
+	relay *outbox.Relay,
+	scheduler *deadline.Scheduler,
+	rec *reconciler.Reconciler,
+	webhookSrv *http.Server,
+	rpcSrv *rpc.Server,
+	paymentRPC *grpcadp.Server,
 ) (*PaymentService, error) {
 	return &PaymentService{
-		Context:       ctx,
-		Log:           log,
-		Config:        cfg,
-		AutoMaxPro:    auto,
-		Tracer:        tr,
-		Metrics:       mon,
-		PprofEndpoint: pprof,
-		CreatePayment: createUC,
-		RefundPayment: refundUC,
+		Context:           ctx,
+		Log:               log,
+		Config:            cfg,
+		AutoMaxPro:        auto,
+		Tracer:            tr,
+		Metrics:           mon,
+		PprofEndpoint:     pprof,
+		CreatePayment:     createUC,
+		ConfirmPayment:    confirmUC,
+		CapturePayment:    captureUC,
+		IncreaseHold:      incrementUC,
+		RefundPayment:     refundUC,
+		CancelPayment:     cancelUC,
+		HandleWebhook:     webhookUC,
+		OutboxRelay:       relay,
+		DeadlineScheduler: scheduler,
+		Reconciler:        rec,
+		WebhookServer:     webhookSrv,
+		RPCServer:         rpcSrv,
+		PaymentRPC:        paymentRPC,
 	}, nil
 }
 
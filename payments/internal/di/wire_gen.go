@@ -9,8 +9,17 @@ package di
 import (
 	"context"
 	"github.com/google/wire"
+	"github.com/shortlink-org/billing/payments/internal/adapter/grpc"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/deadline"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/outbox"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/reconcile/reconciler"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/cancel"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/capture"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/confirm"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/create"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/increment"
 	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/refund"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/usecase/webhook"
 	"github.com/shortlink-org/go-sdk/config"
 	"github.com/shortlink-org/go-sdk/logger"
 	"github.com/shortlink-org/shortlink/pkg/di"
@@ -18,9 +27,12 @@ import (
 	"github.com/shortlink-org/shortlink/pkg/di/pkg/context"
 	"github.com/shortlink-org/shortlink/pkg/di/pkg/logger"
 	"github.com/shortlink-org/shortlink/pkg/di/pkg/profiling"
+	"github.com/shortlink-org/shortlink/pkg/di/pkg/store"
 	"github.com/shortlink-org/shortlink/pkg/di/pkg/traicing"
 	"github.com/shortlink-org/shortlink/pkg/observability/metrics"
+	"github.com/shortlink-org/shortlink/pkg/rpc"
 	"go.opentelemetry.io/otel/trace"
+	"net/http"
 )
 
 // Injectors from wire.go:
@@ -71,8 +83,7 @@ func InitializePaymentService() (*PaymentService, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
-	paymentRepository := ProvidePaymentRepository()
-	paymentProvider, err := ProvidePaymentProvider()
+	dbDB, cleanup6, err := store.New(context, logger, tracerProvider, monitoring)
 	if err != nil {
 		cleanup5()
 		cleanup4()
@@ -81,10 +92,129 @@ func InitializePaymentService() (*PaymentService, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
-	handler := ProvideCreateHandler(paymentRepository, paymentProvider)
-	refundHandler := ProvideRefundHandler(paymentRepository, paymentProvider)
-	paymentService, err := NewPaymentService(context, logger, configConfig, autoMaxProAutoMaxPro, tracerProvider, monitoring, pprofEndpoint, handler, refundHandler)
+	deadlinePolicy := ProvideDeadlinePolicy()
+	paymentRepository, err := ProvidePaymentRepository(context, dbDB, deadlinePolicy)
 	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	webhookHandler, err := ProvideWebhookHandler(paymentRepository)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	paymentProvider, err := ProvidePaymentProvider(logger, webhookHandler)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	policy, err := ProvidePaymentPolicy()
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	handler := ProvideCreateHandler(paymentRepository, paymentProvider, policy)
+	confirmHandler := ProvideConfirmHandler(paymentRepository, paymentProvider)
+	captureHandler := ProvideCaptureHandler(paymentRepository, paymentProvider, policy)
+	incrementHandler := ProvideIncrementHandler(paymentRepository, paymentProvider, policy)
+	refundHandler := ProvideRefundHandler(paymentRepository, paymentProvider, policy)
+	cancelHandler := ProvideCancelHandler(paymentRepository, paymentProvider)
+	settleHandler := ProvideSettleHandler(paymentRepository, paymentProvider)
+	relay, cleanup7, err := ProvideOutboxRelay(logger, paymentRepository)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	scheduler, err := ProvideDeadlineScheduler(logger, paymentRepository, paymentProvider)
+	if err != nil {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	reconcilerReconciler, err := ProvideReconciler(logger, paymentRepository, paymentProvider, webhookHandler)
+	if err != nil {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	server, cleanup8, err := ProvideWebhookServer(logger, webhookHandler, paymentProvider)
+	if err != nil {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	rpcServer, cleanup9, err := rpc.InitServer(context, logger, tracerProvider, monitoring)
+	if err != nil {
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	settlementStore, err := ProvideSettlementStore(paymentRepository)
+	if err != nil {
+		cleanup9()
+		cleanup8()
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	grpcadpServer := ProvidePaymentRPCServer(rpcServer, paymentRepository, handler, confirmHandler, captureHandler, incrementHandler, refundHandler, cancelHandler, settleHandler, settlementStore)
+	paymentService, err := NewPaymentService(context, logger, configConfig, autoMaxProAutoMaxPro, tracerProvider, monitoring, pprofEndpoint, handler, confirmHandler, captureHandler, incrementHandler, refundHandler, cancelHandler, webhookHandler, relay, scheduler, reconcilerReconciler, server, rpcServer, grpcadpServer)
+	if err != nil {
+		cleanup9()
+		cleanup8()
+		cleanup7()
+		cleanup6()
 		cleanup5()
 		cleanup4()
 		cleanup3()
@@ -93,6 +223,10 @@ func InitializePaymentService() (*PaymentService, func(), error) {
 		return nil, nil, err
 	}
 	return paymentService, func() {
+		cleanup9()
+		cleanup8()
+		cleanup7()
+		cleanup6()
 		cleanup5()
 		cleanup4()
 		cleanup3()
@@ -113,18 +247,46 @@ type PaymentService struct {
 	Metrics       *metrics.Monitoring
 	PprofEndpoint profiling.PprofEndpoint
 
-	CreatePayment *create.Handler
-	RefundPayment *refund.Handler
+	CreatePayment  *create.Handler
+	ConfirmPayment *confirm.Handler
+	CapturePayment *capture.Handler
+	IncreaseHold   *increment.Handler
+	RefundPayment  *refund.Handler
+	CancelPayment  *cancel.Handler
+	HandleWebhook  *webhook.Handler
+
+	OutboxRelay       *outbox.Relay
+	DeadlineScheduler *deadline.Scheduler
+	Reconciler        *reconciler.Reconciler
+	WebhookServer     *http.Server
+	RPCServer         *rpc.Server
+	PaymentRPC        *grpcadp.Server
 }
 
 var InfrastructureSet = wire.NewSet(
+	store.New,
+	ProvideDeadlinePolicy,
 	ProvidePaymentRepository,
 	ProvidePaymentProvider,
+	ProvidePaymentPolicy,
+	ProvideOutboxRelay,
+	ProvideDeadlineScheduler,
+	ProvideReconciler,
+	ProvideSettlementStore,
+	ProvideWebhookServer,
+	rpc.InitServer,
+	ProvidePaymentRPCServer,
 )
 
 var UsecaseSet = wire.NewSet(
 	ProvideCreateHandler,
+	ProvideConfirmHandler,
+	ProvideCaptureHandler,
+	ProvideIncrementHandler,
 	ProvideRefundHandler,
+	ProvideCancelHandler,
+	ProvideSettleHandler,
+	ProvideWebhookHandler,
 )
 
 var PaymentSet = wire.NewSet(di.DefaultSet, InfrastructureSet,
@@ -141,17 +303,39 @@ func NewPaymentService(ctx2 context.Context,
 	mon *metrics.Monitoring,
 	pprof profiling.PprofEndpoint,
 	createUC *create.Handler,
+	confirmUC *confirm.Handler,
+	captureUC *capture.Handler,
+	incrementUC *increment.Handler,
 	refundUC *refund.Handler,
+	cancelUC *cancel.Handler,
+	webhookUC *webhook.Handler,
+	relay *outbox.Relay,
+	scheduler *deadline.Scheduler,
+	rec *reconciler.Reconciler,
+	webhookSrv *http.Server,
+	rpcSrv *rpc.Server,
+	paymentRPC *grpcadp.Server,
 ) (*PaymentService, error) {
 	return &PaymentService{
-		Context:       ctx2,
-		Log:           log,
-		Config:        cfg,
-		AutoMaxPro:    auto,
-		Tracer:        tr,
-		Metrics:       mon,
-		PprofEndpoint: pprof,
-		CreatePayment: createUC,
-		RefundPayment: refundUC,
+		Context:           ctx2,
+		Log:               log,
+		Config:            cfg,
+		AutoMaxPro:        auto,
+		Tracer:            tr,
+		Metrics:           mon,
+		PprofEndpoint:     pprof,
+		CreatePayment:     createUC,
+		ConfirmPayment:    confirmUC,
+		CapturePayment:    captureUC,
+		IncreaseHold:      incrementUC,
+		RefundPayment:     refundUC,
+		CancelPayment:     cancelUC,
+		HandleWebhook:     webhookUC,
+		OutboxRelay:       relay,
+		DeadlineScheduler: scheduler,
+		Reconciler:        rec,
+		WebhookServer:     webhookSrv,
+		RPCServer:         rpcSrv,
+		PaymentRPC:        paymentRPC,
 	}, nil
 }
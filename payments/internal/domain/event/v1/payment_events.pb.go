@@ -13,6 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -185,11 +186,13 @@ func (CancelReason) EnumDescriptor() ([]byte, []int) {
 type FailureReason int32
 
 const (
-	FailureReason_FAILURE_REASON_UNSPECIFIED   FailureReason = 0
-	FailureReason_FAILURE_REASON_DECLINED      FailureReason = 1 // declined by issuer/PSP
-	FailureReason_FAILURE_REASON_REVERSED      FailureReason = 2 // reversed/disputed
-	FailureReason_FAILURE_REASON_AUTH_EXPIRED  FailureReason = 3 // authorization expired
-	FailureReason_FAILURE_REASON_NETWORK_ERROR FailureReason = 4 // network/integration error
+	FailureReason_FAILURE_REASON_UNSPECIFIED       FailureReason = 0
+	FailureReason_FAILURE_REASON_DECLINED          FailureReason = 1 // declined by issuer/PSP
+	FailureReason_FAILURE_REASON_REVERSED          FailureReason = 2 // reversed/disputed
+	FailureReason_FAILURE_REASON_AUTH_EXPIRED      FailureReason = 3 // authorization expired
+	FailureReason_FAILURE_REASON_NETWORK_ERROR     FailureReason = 4 // network/integration error
+	FailureReason_FAILURE_REASON_SCA_NOT_COMPLETED FailureReason = 5 // customer did not finish the SCA/3DS challenge
+	FailureReason_FAILURE_REASON_SCA_FAILED        FailureReason = 6 // SCA/3DS authentication rejected
 )
 
 // Enum value maps for FailureReason.
@@ -200,13 +203,17 @@ var (
 		2: "FAILURE_REASON_REVERSED",
 		3: "FAILURE_REASON_AUTH_EXPIRED",
 		4: "FAILURE_REASON_NETWORK_ERROR",
+		5: "FAILURE_REASON_SCA_NOT_COMPLETED",
+		6: "FAILURE_REASON_SCA_FAILED",
 	}
 	FailureReason_value = map[string]int32{
-		"FAILURE_REASON_UNSPECIFIED":   0,
-		"FAILURE_REASON_DECLINED":      1,
-		"FAILURE_REASON_REVERSED":      2,
-		"FAILURE_REASON_AUTH_EXPIRED":  3,
-		"FAILURE_REASON_NETWORK_ERROR": 4,
+		"FAILURE_REASON_UNSPECIFIED":       0,
+		"FAILURE_REASON_DECLINED":          1,
+		"FAILURE_REASON_REVERSED":          2,
+		"FAILURE_REASON_AUTH_EXPIRED":      3,
+		"FAILURE_REASON_NETWORK_ERROR":     4,
+		"FAILURE_REASON_SCA_NOT_COMPLETED": 5,
+		"FAILURE_REASON_SCA_FAILED":        6,
 	}
 )
 
@@ -237,6 +244,242 @@ func (FailureReason) EnumDescriptor() ([]byte, []int) {
 	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{3}
 }
 
+// Reason the cardholder gave for a dispute (provider-agnostic buckets).
+type DisputeReason int32
+
+const (
+	DisputeReason_DISPUTE_REASON_UNSPECIFIED           DisputeReason = 0
+	DisputeReason_DISPUTE_REASON_FRAUDULENT            DisputeReason = 1 // cardholder did not authorize the payment
+	DisputeReason_DISPUTE_REASON_PRODUCT_NOT_RECEIVED  DisputeReason = 2
+	DisputeReason_DISPUTE_REASON_PRODUCT_UNACCEPTABLE  DisputeReason = 3 // defective or not as described
+	DisputeReason_DISPUTE_REASON_DUPLICATE             DisputeReason = 4 // charged twice
+	DisputeReason_DISPUTE_REASON_SUBSCRIPTION_CANCELED DisputeReason = 5 // charged after canceling
+	DisputeReason_DISPUTE_REASON_CREDIT_NOT_PROCESSED  DisputeReason = 6 // promised refund never arrived
+	DisputeReason_DISPUTE_REASON_GENERAL               DisputeReason = 7 // anything else
+)
+
+// Enum value maps for DisputeReason.
+var (
+	DisputeReason_name = map[int32]string{
+		0: "DISPUTE_REASON_UNSPECIFIED",
+		1: "DISPUTE_REASON_FRAUDULENT",
+		2: "DISPUTE_REASON_PRODUCT_NOT_RECEIVED",
+		3: "DISPUTE_REASON_PRODUCT_UNACCEPTABLE",
+		4: "DISPUTE_REASON_DUPLICATE",
+		5: "DISPUTE_REASON_SUBSCRIPTION_CANCELED",
+		6: "DISPUTE_REASON_CREDIT_NOT_PROCESSED",
+		7: "DISPUTE_REASON_GENERAL",
+	}
+	DisputeReason_value = map[string]int32{
+		"DISPUTE_REASON_UNSPECIFIED":           0,
+		"DISPUTE_REASON_FRAUDULENT":            1,
+		"DISPUTE_REASON_PRODUCT_NOT_RECEIVED":  2,
+		"DISPUTE_REASON_PRODUCT_UNACCEPTABLE":  3,
+		"DISPUTE_REASON_DUPLICATE":             4,
+		"DISPUTE_REASON_SUBSCRIPTION_CANCELED": 5,
+		"DISPUTE_REASON_CREDIT_NOT_PROCESSED":  6,
+		"DISPUTE_REASON_GENERAL":               7,
+	}
+)
+
+func (x DisputeReason) Enum() *DisputeReason {
+	p := new(DisputeReason)
+	*p = x
+	return p
+}
+
+func (x DisputeReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisputeReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_event_v1_payment_events_proto_enumTypes[4].Descriptor()
+}
+
+func (DisputeReason) Type() protoreflect.EnumType {
+	return &file_domain_event_v1_payment_events_proto_enumTypes[4]
+}
+
+func (x DisputeReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisputeReason.Descriptor instead.
+func (DisputeReason) EnumDescriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{4}
+}
+
+// Reason the merchant gave for a refund.
+type RefundReason int32
+
+const (
+	RefundReason_REFUND_REASON_UNSPECIFIED           RefundReason = 0
+	RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER RefundReason = 1
+	RefundReason_REFUND_REASON_DUPLICATE             RefundReason = 2 // charged twice
+	RefundReason_REFUND_REASON_FRAUDULENT            RefundReason = 3 // payment was not made by the cardholder
+	RefundReason_REFUND_REASON_ORDER_CANCELED        RefundReason = 4 // goods or service will not be delivered
+)
+
+// Enum value maps for RefundReason.
+var (
+	RefundReason_name = map[int32]string{
+		0: "REFUND_REASON_UNSPECIFIED",
+		1: "REFUND_REASON_REQUESTED_BY_CUSTOMER",
+		2: "REFUND_REASON_DUPLICATE",
+		3: "REFUND_REASON_FRAUDULENT",
+		4: "REFUND_REASON_ORDER_CANCELED",
+	}
+	RefundReason_value = map[string]int32{
+		"REFUND_REASON_UNSPECIFIED":           0,
+		"REFUND_REASON_REQUESTED_BY_CUSTOMER": 1,
+		"REFUND_REASON_DUPLICATE":             2,
+		"REFUND_REASON_FRAUDULENT":            3,
+		"REFUND_REASON_ORDER_CANCELED":        4,
+	}
+)
+
+func (x RefundReason) Enum() *RefundReason {
+	p := new(RefundReason)
+	*p = x
+	return p
+}
+
+func (x RefundReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_event_v1_payment_events_proto_enumTypes[5].Descriptor()
+}
+
+func (RefundReason) Type() protoreflect.EnumType {
+	return &file_domain_event_v1_payment_events_proto_enumTypes[5]
+}
+
+func (x RefundReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundReason.Descriptor instead.
+func (RefundReason) EnumDescriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{5}
+}
+
+// Why SCA was not required (PSD2 RTS exemptions and out-of-scope transactions).
+type SCAExemption int32
+
+const (
+	SCAExemption_SCA_EXEMPTION_UNSPECIFIED               SCAExemption = 0 // SCA required, or no exemption applied
+	SCAExemption_SCA_EXEMPTION_LOW_VALUE                 SCAExemption = 1 // RTS Art. 16: low-value remote transaction
+	SCAExemption_SCA_EXEMPTION_TRANSACTION_RISK_ANALYSIS SCAExemption = 2 // RTS Art. 18: low fraud rate of the acquirer
+	SCAExemption_SCA_EXEMPTION_RECURRING                 SCAExemption = 3 // RTS Art. 14: same amount to the same payee
+	SCAExemption_SCA_EXEMPTION_MERCHANT_INITIATED        SCAExemption = 4 // out of scope: cardholder not in session
+	SCAExemption_SCA_EXEMPTION_OUT_OF_SCOPE              SCAExemption = 5 // out of scope: issuer or acquirer outside the EEA
+)
+
+// Enum value maps for SCAExemption.
+var (
+	SCAExemption_name = map[int32]string{
+		0: "SCA_EXEMPTION_UNSPECIFIED",
+		1: "SCA_EXEMPTION_LOW_VALUE",
+		2: "SCA_EXEMPTION_TRANSACTION_RISK_ANALYSIS",
+		3: "SCA_EXEMPTION_RECURRING",
+		4: "SCA_EXEMPTION_MERCHANT_INITIATED",
+		5: "SCA_EXEMPTION_OUT_OF_SCOPE",
+	}
+	SCAExemption_value = map[string]int32{
+		"SCA_EXEMPTION_UNSPECIFIED":               0,
+		"SCA_EXEMPTION_LOW_VALUE":                 1,
+		"SCA_EXEMPTION_TRANSACTION_RISK_ANALYSIS": 2,
+		"SCA_EXEMPTION_RECURRING":                 3,
+		"SCA_EXEMPTION_MERCHANT_INITIATED":        4,
+		"SCA_EXEMPTION_OUT_OF_SCOPE":              5,
+	}
+)
+
+func (x SCAExemption) Enum() *SCAExemption {
+	p := new(SCAExemption)
+	*p = x
+	return p
+}
+
+func (x SCAExemption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SCAExemption) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_event_v1_payment_events_proto_enumTypes[6].Descriptor()
+}
+
+func (SCAExemption) Type() protoreflect.EnumType {
+	return &file_domain_event_v1_payment_events_proto_enumTypes[6]
+}
+
+func (x SCAExemption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SCAExemption.Descriptor instead.
+func (SCAExemption) EnumDescriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{6}
+}
+
+// Movement of a payment's funds in a provider payout.
+type SettlementType int32
+
+const (
+	SettlementType_SETTLEMENT_TYPE_UNSPECIFIED SettlementType = 0
+	SettlementType_SETTLEMENT_TYPE_CHARGE      SettlementType = 1 // captured funds paid out
+	SettlementType_SETTLEMENT_TYPE_REFUND      SettlementType = 2 // refund withdrawn, or given back when it failed
+	SettlementType_SETTLEMENT_TYPE_DISPUTE     SettlementType = 3 // chargeback withdrawn or reinstated, with its dispute fee
+	SettlementType_SETTLEMENT_TYPE_ADJUSTMENT  SettlementType = 4 // any other correction the provider made to the payment
+)
+
+// Enum value maps for SettlementType.
+var (
+	SettlementType_name = map[int32]string{
+		0: "SETTLEMENT_TYPE_UNSPECIFIED",
+		1: "SETTLEMENT_TYPE_CHARGE",
+		2: "SETTLEMENT_TYPE_REFUND",
+		3: "SETTLEMENT_TYPE_DISPUTE",
+		4: "SETTLEMENT_TYPE_ADJUSTMENT",
+	}
+	SettlementType_value = map[string]int32{
+		"SETTLEMENT_TYPE_UNSPECIFIED": 0,
+		"SETTLEMENT_TYPE_CHARGE":      1,
+		"SETTLEMENT_TYPE_REFUND":      2,
+		"SETTLEMENT_TYPE_DISPUTE":     3,
+		"SETTLEMENT_TYPE_ADJUSTMENT":  4,
+	}
+)
+
+func (x SettlementType) Enum() *SettlementType {
+	p := new(SettlementType)
+	*p = x
+	return p
+}
+
+func (x SettlementType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SettlementType) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_event_v1_payment_events_proto_enumTypes[7].Descriptor()
+}
+
+func (SettlementType) Type() protoreflect.EnumType {
+	return &file_domain_event_v1_payment_events_proto_enumTypes[7]
+}
+
+func (x SettlementType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SettlementType.Descriptor instead.
+func (SettlementType) EnumDescriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{7}
+}
+
 // Minimal event metadata for idempotency and ordering.
 type EventMeta struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
@@ -401,6 +644,170 @@ func (x *PaymentCreated) GetFieldMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+// Links the payment to the provider-side object it is processed with.
+// Internal only: never published as an integration event. State unchanged.
+type PaymentProviderAttached struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`                       // e.g. "stripe", "tinkoff"
+	ProviderId    string                 `protobuf:"bytes,3,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"` // e.g. Stripe PaymentIntent ID
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentProviderAttached) Reset() {
+	*x = PaymentProviderAttached{}
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentProviderAttached) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentProviderAttached) ProtoMessage() {}
+
+func (x *PaymentProviderAttached) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentProviderAttached.ProtoReflect.Descriptor instead.
+func (*PaymentProviderAttached) Descriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PaymentProviderAttached) GetMeta() *EventMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PaymentProviderAttached) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PaymentProviderAttached) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *PaymentProviderAttached) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// Records the SCA decision taken at creation and the policy rule that matched.
+// Internal only: never published as an integration event. State unchanged.
+type PaymentSCAEvaluated struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Meta              *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Required          bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`                                     // 3DS is requested from the provider
+	Exemption         SCAExemption           `protobuf:"varint,3,opt,name=exemption,proto3,enum=domain.event.v1.SCAExemption" json:"exemption,omitempty"` // set when not required
+	Rule              string                 `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`                                              // name of the matched policy rule
+	Region            string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`                                          // customer region the decision was taken for, e.g. "DE"
+	MerchantInitiated bool                   `protobuf:"varint,6,opt,name=merchant_initiated,json=merchantInitiated,proto3" json:"merchant_initiated,omitempty"`
+	FieldMask         *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PaymentSCAEvaluated) Reset() {
+	*x = PaymentSCAEvaluated{}
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentSCAEvaluated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentSCAEvaluated) ProtoMessage() {}
+
+func (x *PaymentSCAEvaluated) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentSCAEvaluated.ProtoReflect.Descriptor instead.
+func (*PaymentSCAEvaluated) Descriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PaymentSCAEvaluated) GetMeta() *EventMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PaymentSCAEvaluated) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *PaymentSCAEvaluated) GetExemption() SCAExemption {
+	if x != nil {
+		return x.Exemption
+	}
+	return SCAExemption_SCA_EXEMPTION_UNSPECIFIED
+}
+
+func (x *PaymentSCAEvaluated) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PaymentSCAEvaluated) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *PaymentSCAEvaluated) GetMerchantInitiated() bool {
+	if x != nil {
+		return x.MerchantInitiated
+	}
+	return false
+}
+
+func (x *PaymentSCAEvaluated) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 // Optional step when SCA/3DS is required by provider/rules.
 // Final state: WAITING_FOR_CONFIRMATION.
 type PaymentWaitingForConfirmation struct {
@@ -413,7 +820,7 @@ type PaymentWaitingForConfirmation struct {
 
 func (x *PaymentWaitingForConfirmation) Reset() {
 	*x = PaymentWaitingForConfirmation{}
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[2]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -425,7 +832,7 @@ func (x *PaymentWaitingForConfirmation) String() string {
 func (*PaymentWaitingForConfirmation) ProtoMessage() {}
 
 func (x *PaymentWaitingForConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[2]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +845,7 @@ func (x *PaymentWaitingForConfirmation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentWaitingForConfirmation.ProtoReflect.Descriptor instead.
 func (*PaymentWaitingForConfirmation) Descriptor() ([]byte, []int) {
-	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{2}
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PaymentWaitingForConfirmation) GetMeta() *EventMeta {
@@ -461,6 +868,7 @@ type PaymentAuthorized struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Meta             *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	AuthorizedAmount *money.Money           `protobuf:"bytes,2,opt,name=authorized_amount,json=authorizedAmount,proto3" json:"authorized_amount,omitempty"` // incremental authorized amount
+	Amount           *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                                             // payment amount raised by an incremental authorization, unset otherwise
 	FieldMask        *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
@@ -468,7 +876,7 @@ type PaymentAuthorized struct {
 
 func (x *PaymentAuthorized) Reset() {
 	*x = PaymentAuthorized{}
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[3]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -480,7 +888,7 @@ func (x *PaymentAuthorized) String() string {
 func (*PaymentAuthorized) ProtoMessage() {}
 
 func (x *PaymentAuthorized) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[3]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +901,7 @@ func (x *PaymentAuthorized) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentAuthorized.ProtoReflect.Descriptor instead.
 func (*PaymentAuthorized) Descriptor() ([]byte, []int) {
-	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{3}
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PaymentAuthorized) GetMeta() *EventMeta {
@@ -510,6 +918,13 @@ func (x *PaymentAuthorized) GetAuthorizedAmount() *money.Money {
 	return nil
 }
 
+func (x *PaymentAuthorized) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
 func (x *PaymentAuthorized) GetFieldMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.FieldMask
@@ -530,7 +945,7 @@ type PaymentPaid struct {
 
 func (x *PaymentPaid) Reset() {
 	*x = PaymentPaid{}
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[4]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -542,7 +957,7 @@ func (x *PaymentPaid) String() string {
 func (*PaymentPaid) ProtoMessage() {}
 
 func (x *PaymentPaid) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[4]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,7 +970,7 @@ func (x *PaymentPaid) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentPaid.ProtoReflect.Descriptor instead.
 func (*PaymentPaid) Descriptor() ([]byte, []int) {
-	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{4}
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PaymentPaid) GetMeta() *EventMeta {
@@ -579,22 +994,189 @@ func (x *PaymentPaid) GetFieldMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
-// Refund succeeded (partial or full).
-// If `full` is true, final state becomes REFUNDED; else remains PAID.
-type PaymentRefunded struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	RefundAmount  *money.Money           `protobuf:"bytes,2,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`    // amount for this refund op
-	TotalRefunded *money.Money           `protobuf:"bytes,3,opt,name=total_refunded,json=totalRefunded,proto3" json:"total_refunded,omitempty"` // cumulative total refunded after this op
-	Full          bool                   `protobuf:"varint,4,opt,name=full,proto3" json:"full,omitempty"`                                       // total_refunded == captured total
-	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Uncaptured remainder of the authorization released at the provider after a final capture.
+// No further captures are accepted. State unchanged (PAID).
+type PaymentAuthorizationReleased struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Meta           *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ReleasedAmount *money.Money           `protobuf:"bytes,2,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount,omitempty"` // authorized - captured at the time of release
+	TotalReleased  *money.Money           `protobuf:"bytes,3,opt,name=total_released,json=totalReleased,proto3" json:"total_released,omitempty"`    // cumulative total released after this op
+	FieldMask      *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *PaymentRefunded) Reset() {
-	*x = PaymentRefunded{}
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[5]
+func (x *PaymentAuthorizationReleased) Reset() {
+	*x = PaymentAuthorizationReleased{}
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentAuthorizationReleased) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentAuthorizationReleased) ProtoMessage() {}
+
+func (x *PaymentAuthorizationReleased) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentAuthorizationReleased.ProtoReflect.Descriptor instead.
+func (*PaymentAuthorizationReleased) Descriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PaymentAuthorizationReleased) GetMeta() *EventMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PaymentAuthorizationReleased) GetReleasedAmount() *money.Money {
+	if x != nil {
+		return x.ReleasedAmount
+	}
+	return nil
+}
+
+func (x *PaymentAuthorizationReleased) GetTotalReleased() *money.Money {
+	if x != nil {
+		return x.TotalReleased
+	}
+	return nil
+}
+
+func (x *PaymentAuthorizationReleased) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// Refund accepted by the provider but not settled yet; the amount is no longer refundable.
+// State unchanged.
+type PaymentRefundRequested struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	RefundId         []byte                 `protobuf:"bytes,2,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`                           // 16-byte UUID
+	ProviderRefundId string                 `protobuf:"bytes,3,opt,name=provider_refund_id,json=providerRefundId,proto3" json:"provider_refund_id,omitempty"` // e.g. Stripe re_...
+	Amount           *money.Money           `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason           RefundReason           `protobuf:"varint,5,opt,name=reason,proto3,enum=domain.event.v1.RefundReason" json:"reason,omitempty"`
+	TotalPending     *money.Money           `protobuf:"bytes,6,opt,name=total_pending,json=totalPending,proto3" json:"total_pending,omitempty"` // cumulative pending refunds after this op
+	FieldMask        *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PaymentRefundRequested) Reset() {
+	*x = PaymentRefundRequested{}
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRefundRequested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRefundRequested) ProtoMessage() {}
+
+func (x *PaymentRefundRequested) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRefundRequested.ProtoReflect.Descriptor instead.
+func (*PaymentRefundRequested) Descriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PaymentRefundRequested) GetMeta() *EventMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PaymentRefundRequested) GetRefundId() []byte {
+	if x != nil {
+		return x.RefundId
+	}
+	return nil
+}
+
+func (x *PaymentRefundRequested) GetProviderRefundId() string {
+	if x != nil {
+		return x.ProviderRefundId
+	}
+	return ""
+}
+
+func (x *PaymentRefundRequested) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PaymentRefundRequested) GetReason() RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
+func (x *PaymentRefundRequested) GetTotalPending() *money.Money {
+	if x != nil {
+		return x.TotalPending
+	}
+	return nil
+}
+
+func (x *PaymentRefundRequested) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// Refund succeeded (partial or full): either settles a pending refund or records one that succeeded at once.
+// If `full` is true, final state becomes REFUNDED; else remains PAID.
+type PaymentRefunded struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	RefundAmount     *money.Money           `protobuf:"bytes,2,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`    // amount for this refund op
+	TotalRefunded    *money.Money           `protobuf:"bytes,3,opt,name=total_refunded,json=totalRefunded,proto3" json:"total_refunded,omitempty"` // cumulative total refunded after this op
+	Full             bool                   `protobuf:"varint,4,opt,name=full,proto3" json:"full,omitempty"`                                       // total_refunded == captured total
+	RefundId         []byte                 `protobuf:"bytes,5,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`                // 16-byte UUID
+	ProviderRefundId string                 `protobuf:"bytes,6,opt,name=provider_refund_id,json=providerRefundId,proto3" json:"provider_refund_id,omitempty"`
+	Reason           RefundReason           `protobuf:"varint,7,opt,name=reason,proto3,enum=domain.event.v1.RefundReason" json:"reason,omitempty"`
+	FieldMask        *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PaymentRefunded) Reset() {
+	*x = PaymentRefunded{}
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -606,7 +1188,7 @@ func (x *PaymentRefunded) String() string {
 func (*PaymentRefunded) ProtoMessage() {}
 
 func (x *PaymentRefunded) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[5]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +1201,7 @@ func (x *PaymentRefunded) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentRefunded.ProtoReflect.Descriptor instead.
 func (*PaymentRefunded) Descriptor() ([]byte, []int) {
-	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{5}
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PaymentRefunded) GetMeta() *EventMeta {
@@ -650,6 +1232,27 @@ func (x *PaymentRefunded) GetFull() bool {
 	return false
 }
 
+func (x *PaymentRefunded) GetRefundId() []byte {
+	if x != nil {
+		return x.RefundId
+	}
+	return nil
+}
+
+func (x *PaymentRefunded) GetProviderRefundId() string {
+	if x != nil {
+		return x.ProviderRefundId
+	}
+	return ""
+}
+
+func (x *PaymentRefunded) GetReason() RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
 func (x *PaymentRefunded) GetFieldMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.FieldMask
@@ -658,10 +1261,13 @@ func (x *PaymentRefunded) GetFieldMask() *fieldmaskpb.FieldMask {
 }
 
 // Refund attempt failed (no state change, remains PAID).
+// With refund_id set, the pending refund is released; otherwise the provider never accepted it.
 type PaymentRefundFailed struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	Reason        FailureReason          `protobuf:"varint,2,opt,name=reason,proto3,enum=domain.event.v1.FailureReason" json:"reason,omitempty"`
+	RefundId      []byte                 `protobuf:"bytes,3,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"` // 16-byte UUID, unset when no refund was created
+	Amount        *money.Money           `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                     // released pending amount
 	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -669,7 +1275,7 @@ type PaymentRefundFailed struct {
 
 func (x *PaymentRefundFailed) Reset() {
 	*x = PaymentRefundFailed{}
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[6]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -681,7 +1287,7 @@ func (x *PaymentRefundFailed) String() string {
 func (*PaymentRefundFailed) ProtoMessage() {}
 
 func (x *PaymentRefundFailed) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[6]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,7 +1300,7 @@ func (x *PaymentRefundFailed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentRefundFailed.ProtoReflect.Descriptor instead.
 func (*PaymentRefundFailed) Descriptor() ([]byte, []int) {
-	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{6}
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PaymentRefundFailed) GetMeta() *EventMeta {
@@ -711,6 +1317,20 @@ func (x *PaymentRefundFailed) GetReason() FailureReason {
 	return FailureReason_FAILURE_REASON_UNSPECIFIED
 }
 
+func (x *PaymentRefundFailed) GetRefundId() []byte {
+	if x != nil {
+		return x.RefundId
+	}
+	return nil
+}
+
+func (x *PaymentRefundFailed) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
 func (x *PaymentRefundFailed) GetFieldMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.FieldMask
@@ -731,7 +1351,7 @@ type PaymentCanceled struct {
 
 func (x *PaymentCanceled) Reset() {
 	*x = PaymentCanceled{}
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[7]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -743,7 +1363,7 @@ func (x *PaymentCanceled) String() string {
 func (*PaymentCanceled) ProtoMessage() {}
 
 func (x *PaymentCanceled) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[7]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +1376,7 @@ func (x *PaymentCanceled) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentCanceled.ProtoReflect.Descriptor instead.
 func (*PaymentCanceled) Descriptor() ([]byte, []int) {
-	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{7}
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PaymentCanceled) GetMeta() *EventMeta {
@@ -794,7 +1414,7 @@ type PaymentFailed struct {
 
 func (x *PaymentFailed) Reset() {
 	*x = PaymentFailed{}
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[8]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -806,7 +1426,7 @@ func (x *PaymentFailed) String() string {
 func (*PaymentFailed) ProtoMessage() {}
 
 func (x *PaymentFailed) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_event_v1_payment_events_proto_msgTypes[8]
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,7 +1439,7 @@ func (x *PaymentFailed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentFailed.ProtoReflect.Descriptor instead.
 func (*PaymentFailed) Descriptor() ([]byte, []int) {
-	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{8}
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PaymentFailed) GetMeta() *EventMeta {
@@ -850,11 +1470,417 @@ func (x *PaymentFailed) GetFieldMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+// Chargeback opened by the issuer; the disputed amount is withheld.
+// Final state: DISPUTED.
+type PaymentDisputeOpened struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // disputed amount, <= captured - refunded - reversed
+	Reason        DisputeReason          `protobuf:"varint,3,opt,name=reason,proto3,enum=domain.event.v1.DisputeReason" json:"reason,omitempty"`
+	DisputeId     string                 `protobuf:"bytes,4,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"` // provider dispute ID, e.g. Stripe dp_...
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentDisputeOpened) Reset() {
+	*x = PaymentDisputeOpened{}
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentDisputeOpened) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDisputeOpened) ProtoMessage() {}
+
+func (x *PaymentDisputeOpened) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentDisputeOpened.ProtoReflect.Descriptor instead.
+func (*PaymentDisputeOpened) Descriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PaymentDisputeOpened) GetMeta() *EventMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PaymentDisputeOpened) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PaymentDisputeOpened) GetReason() DisputeReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DisputeReason_DISPUTE_REASON_UNSPECIFIED
+}
+
+func (x *PaymentDisputeOpened) GetDisputeId() string {
+	if x != nil {
+		return x.DisputeId
+	}
+	return ""
+}
+
+func (x *PaymentDisputeOpened) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// Evidence was sent to the issuer. State unchanged (DISPUTED).
+type PaymentDisputeEvidenceSubmitted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentDisputeEvidenceSubmitted) Reset() {
+	*x = PaymentDisputeEvidenceSubmitted{}
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentDisputeEvidenceSubmitted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDisputeEvidenceSubmitted) ProtoMessage() {}
+
+func (x *PaymentDisputeEvidenceSubmitted) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentDisputeEvidenceSubmitted.ProtoReflect.Descriptor instead.
+func (*PaymentDisputeEvidenceSubmitted) Descriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PaymentDisputeEvidenceSubmitted) GetMeta() *EventMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PaymentDisputeEvidenceSubmitted) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// Dispute resolved in the merchant's favor; withheld funds are released.
+// Final state: PAID.
+type PaymentDisputeWon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentDisputeWon) Reset() {
+	*x = PaymentDisputeWon{}
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentDisputeWon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDisputeWon) ProtoMessage() {}
+
+func (x *PaymentDisputeWon) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentDisputeWon.ProtoReflect.Descriptor instead.
+func (*PaymentDisputeWon) Descriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PaymentDisputeWon) GetMeta() *EventMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PaymentDisputeWon) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// Dispute resolved in the cardholder's favor; the disputed amount is reversed.
+// If `full` is true, final state becomes CHARGED_BACK; else PAID.
+type PaymentDisputeLost struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Meta           *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ReversedAmount *money.Money           `protobuf:"bytes,2,opt,name=reversed_amount,json=reversedAmount,proto3" json:"reversed_amount,omitempty"` // amount reversed by this dispute
+	TotalReversed  *money.Money           `protobuf:"bytes,3,opt,name=total_reversed,json=totalReversed,proto3" json:"total_reversed,omitempty"`    // cumulative total reversed after this dispute
+	Full           bool                   `protobuf:"varint,4,opt,name=full,proto3" json:"full,omitempty"`                                          // total_refunded + total_reversed == captured total
+	FieldMask      *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PaymentDisputeLost) Reset() {
+	*x = PaymentDisputeLost{}
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentDisputeLost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDisputeLost) ProtoMessage() {}
+
+func (x *PaymentDisputeLost) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentDisputeLost.ProtoReflect.Descriptor instead.
+func (*PaymentDisputeLost) Descriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PaymentDisputeLost) GetMeta() *EventMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PaymentDisputeLost) GetReversedAmount() *money.Money {
+	if x != nil {
+		return x.ReversedAmount
+	}
+	return nil
+}
+
+func (x *PaymentDisputeLost) GetTotalReversed() *money.Money {
+	if x != nil {
+		return x.TotalReversed
+	}
+	return nil
+}
+
+func (x *PaymentDisputeLost) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *PaymentDisputeLost) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// Funds of the payment settled by the provider in a payout, with its fees.
+// amount is in the payment currency, every other amount in the settlement currency.
+// Internal only: never published as an integration event. State unchanged.
+type PaymentSettled struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *EventMeta             `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	SettlementId  string                 `protobuf:"bytes,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"` // provider transaction, e.g. Stripe txn_...; unique per payment
+	PayoutId      string                 `protobuf:"bytes,3,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`             // e.g. Stripe po_..., T-Bank payment order number
+	Type          SettlementType         `protobuf:"varint,4,opt,name=type,proto3,enum=domain.event.v1.SettlementType" json:"type,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                                 // negative when funds go back, e.g. a refund
+	Gross         *money.Money           `protobuf:"bytes,6,opt,name=gross,proto3" json:"gross,omitempty"`                                   // amount converted by the provider
+	Fee           *money.Money           `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`                                       // provider fees, negative when refunded
+	FxAdjustment  *money.Money           `protobuf:"bytes,8,opt,name=fx_adjustment,json=fxAdjustment,proto3" json:"fx_adjustment,omitempty"` // gross - amount at the rate of the first settled charge
+	Net           *money.Money           `protobuf:"bytes,9,opt,name=net,proto3" json:"net,omitempty"`                                       // gross - fee, paid out
+	SettledAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`         // funds available for the payout
+	TotalFees     *money.Money           `protobuf:"bytes,11,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`         // cumulative fees after this op
+	TotalNet      *money.Money           `protobuf:"bytes,12,opt,name=total_net,json=totalNet,proto3" json:"total_net,omitempty"`            // cumulative net after this op
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentSettled) Reset() {
+	*x = PaymentSettled{}
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentSettled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentSettled) ProtoMessage() {}
+
+func (x *PaymentSettled) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_event_v1_payment_events_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentSettled.ProtoReflect.Descriptor instead.
+func (*PaymentSettled) Descriptor() ([]byte, []int) {
+	return file_domain_event_v1_payment_events_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PaymentSettled) GetMeta() *EventMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *PaymentSettled) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *PaymentSettled) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+func (x *PaymentSettled) GetType() SettlementType {
+	if x != nil {
+		return x.Type
+	}
+	return SettlementType_SETTLEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *PaymentSettled) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PaymentSettled) GetGross() *money.Money {
+	if x != nil {
+		return x.Gross
+	}
+	return nil
+}
+
+func (x *PaymentSettled) GetFee() *money.Money {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *PaymentSettled) GetFxAdjustment() *money.Money {
+	if x != nil {
+		return x.FxAdjustment
+	}
+	return nil
+}
+
+func (x *PaymentSettled) GetNet() *money.Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *PaymentSettled) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+func (x *PaymentSettled) GetTotalFees() *money.Money {
+	if x != nil {
+		return x.TotalFees
+	}
+	return nil
+}
+
+func (x *PaymentSettled) GetTotalNet() *money.Money {
+	if x != nil {
+		return x.TotalNet
+	}
+	return nil
+}
+
+func (x *PaymentSettled) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 var File_domain_event_v1_payment_events_proto protoreflect.FileDescriptor
 
 const file_domain_event_v1_payment_events_proto_rawDesc = "" +
 	"\n" +
-	"$domain/event/v1/payment_events.proto\x12\x0fdomain.event.v1\x1a\x17google/type/money.proto\x1a google/protobuf/field_mask.proto\"\xb9\x01\n" +
+	"$domain/event/v1/payment_events.proto\x12\x0fdomain.event.v1\x1a\x17google/type/money.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb9\x01\n" +
 	"\tEventMeta\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\fR\aeventId\x12\x1d\n" +
 	"\n" +
@@ -872,31 +1898,68 @@ const file_domain_event_v1_payment_events_proto_rawDesc = "" +
 	"\x04kind\x18\x04 \x01(\x0e2\x1c.domain.event.v1.PaymentKindR\x04kind\x12?\n" +
 	"\fcapture_mode\x18\x05 \x01(\x0e2\x1c.domain.event.v1.CaptureModeR\vcaptureMode\x129\n" +
 	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xc1\x01\n" +
+	"\x17PaymentProviderAttached\x12.\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vprovider_id\x18\x03 \x01(\tR\n" +
+	"providerId\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xb4\x02\n" +
+	"\x13PaymentSCAEvaluated\x12.\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\bR\brequired\x12;\n" +
+	"\texemption\x18\x03 \x01(\x0e2\x1d.domain.event.v1.SCAExemptionR\texemption\x12\x12\n" +
+	"\x04rule\x18\x04 \x01(\tR\x04rule\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12-\n" +
+	"\x12merchant_initiated\x18\x06 \x01(\bR\x11merchantInitiated\x129\n" +
+	"\n" +
 	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x8a\x01\n" +
 	"\x1dPaymentWaitingForConfirmation\x12.\n" +
 	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x129\n" +
 	"\n" +
-	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xbf\x01\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xeb\x01\n" +
 	"\x11PaymentAuthorized\x12.\n" +
 	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x12?\n" +
-	"\x11authorized_amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x10authorizedAmount\x129\n" +
+	"\x11authorized_amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x10authorizedAmount\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x129\n" +
 	"\n" +
 	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xb5\x01\n" +
 	"\vPaymentPaid\x12.\n" +
 	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x12;\n" +
 	"\x0fcaptured_amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x0ecapturedAmount\x129\n" +
 	"\n" +
-	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x84\x02\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x81\x02\n" +
+	"\x1cPaymentAuthorizationReleased\x12.\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x12;\n" +
+	"\x0freleased_amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x0ereleasedAmount\x129\n" +
+	"\x0etotal_released\x18\x03 \x01(\v2\x12.google.type.MoneyR\rtotalReleased\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xea\x02\n" +
+	"\x16PaymentRefundRequested\x12.\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x12\x1b\n" +
+	"\trefund_id\x18\x02 \x01(\fR\brefundId\x12,\n" +
+	"\x12provider_refund_id\x18\x03 \x01(\tR\x10providerRefundId\x12*\n" +
+	"\x06amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\x06amount\x125\n" +
+	"\x06reason\x18\x05 \x01(\x0e2\x1d.domain.event.v1.RefundReasonR\x06reason\x127\n" +
+	"\rtotal_pending\x18\x06 \x01(\v2\x12.google.type.MoneyR\ftotalPending\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x86\x03\n" +
 	"\x0fPaymentRefunded\x12.\n" +
 	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x127\n" +
 	"\rrefund_amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\frefundAmount\x129\n" +
 	"\x0etotal_refunded\x18\x03 \x01(\v2\x12.google.type.MoneyR\rtotalRefunded\x12\x12\n" +
-	"\x04full\x18\x04 \x01(\bR\x04full\x129\n" +
+	"\x04full\x18\x04 \x01(\bR\x04full\x12\x1b\n" +
+	"\trefund_id\x18\x05 \x01(\fR\brefundId\x12,\n" +
+	"\x12provider_refund_id\x18\x06 \x01(\tR\x10providerRefundId\x125\n" +
+	"\x06reason\x18\a \x01(\x0e2\x1d.domain.event.v1.RefundReasonR\x06reason\x129\n" +
 	"\n" +
-	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xb8\x01\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x81\x02\n" +
 	"\x13PaymentRefundFailed\x12.\n" +
 	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x126\n" +
-	"\x06reason\x18\x02 \x01(\x0e2\x1e.domain.event.v1.FailureReasonR\x06reason\x129\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x1e.domain.event.v1.FailureReasonR\x06reason\x12\x1b\n" +
+	"\trefund_id\x18\x03 \x01(\fR\brefundId\x12*\n" +
+	"\x06amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\x06amount\x129\n" +
 	"\n" +
 	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xb3\x01\n" +
 	"\x0fPaymentCanceled\x12.\n" +
@@ -909,6 +1972,47 @@ const file_domain_event_v1_payment_events_proto_rawDesc = "" +
 	"\x06reason\x18\x02 \x01(\x0e2\x1e.domain.event.v1.FailureReasonR\x06reason\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x129\n" +
 	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x84\x02\n" +
+	"\x14PaymentDisputeOpened\x12.\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x126\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x1e.domain.event.v1.DisputeReasonR\x06reason\x12\x1d\n" +
+	"\n" +
+	"dispute_id\x18\x04 \x01(\tR\tdisputeId\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x8c\x01\n" +
+	"\x1fPaymentDisputeEvidenceSubmitted\x12.\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"~\n" +
+	"\x11PaymentDisputeWon\x12.\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x8b\x02\n" +
+	"\x12PaymentDisputeLost\x12.\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x12;\n" +
+	"\x0freversed_amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x0ereversedAmount\x129\n" +
+	"\x0etotal_reversed\x18\x03 \x01(\v2\x12.google.type.MoneyR\rtotalReversed\x12\x12\n" +
+	"\x04full\x18\x04 \x01(\bR\x04full\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xec\x04\n" +
+	"\x0ePaymentSettled\x12.\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1a.domain.event.v1.EventMetaR\x04meta\x12#\n" +
+	"\rsettlement_id\x18\x02 \x01(\tR\fsettlementId\x12\x1b\n" +
+	"\tpayout_id\x18\x03 \x01(\tR\bpayoutId\x123\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1f.domain.event.v1.SettlementTypeR\x04type\x12*\n" +
+	"\x06amount\x18\x05 \x01(\v2\x12.google.type.MoneyR\x06amount\x12(\n" +
+	"\x05gross\x18\x06 \x01(\v2\x12.google.type.MoneyR\x05gross\x12$\n" +
+	"\x03fee\x18\a \x01(\v2\x12.google.type.MoneyR\x03fee\x127\n" +
+	"\rfx_adjustment\x18\b \x01(\v2\x12.google.type.MoneyR\ffxAdjustment\x12$\n" +
+	"\x03net\x18\t \x01(\v2\x12.google.type.MoneyR\x03net\x129\n" +
+	"\n" +
+	"settled_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tsettledAt\x121\n" +
+	"\n" +
+	"total_fees\x18\v \x01(\v2\x12.google.type.MoneyR\ttotalFees\x12/\n" +
+	"\ttotal_net\x18\f \x01(\v2\x12.google.type.MoneyR\btotalNet\x129\n" +
+	"\n" +
 	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask*b\n" +
 	"\vPaymentKind\x12\x1c\n" +
 	"\x18PAYMENT_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
@@ -923,13 +2027,43 @@ const file_domain_event_v1_payment_events_proto_rawDesc = "" +
 	"\x12CANCEL_REASON_USER\x10\x01\x12\x18\n" +
 	"\x14CANCEL_REASON_SYSTEM\x10\x02\x12\x1b\n" +
 	"\x17CANCEL_REASON_AUTH_VOID\x10\x03\x12\x1b\n" +
-	"\x17CANCEL_REASON_DUPLICATE\x10\x04*\xac\x01\n" +
+	"\x17CANCEL_REASON_DUPLICATE\x10\x04*\xf1\x01\n" +
 	"\rFailureReason\x12\x1e\n" +
 	"\x1aFAILURE_REASON_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17FAILURE_REASON_DECLINED\x10\x01\x12\x1b\n" +
 	"\x17FAILURE_REASON_REVERSED\x10\x02\x12\x1f\n" +
 	"\x1bFAILURE_REASON_AUTH_EXPIRED\x10\x03\x12 \n" +
-	"\x1cFAILURE_REASON_NETWORK_ERROR\x10\x04B\xd3\x01\n" +
+	"\x1cFAILURE_REASON_NETWORK_ERROR\x10\x04\x12$\n" +
+	" FAILURE_REASON_SCA_NOT_COMPLETED\x10\x05\x12\x1d\n" +
+	"\x19FAILURE_REASON_SCA_FAILED\x10\x06*\xad\x02\n" +
+	"\rDisputeReason\x12\x1e\n" +
+	"\x1aDISPUTE_REASON_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19DISPUTE_REASON_FRAUDULENT\x10\x01\x12'\n" +
+	"#DISPUTE_REASON_PRODUCT_NOT_RECEIVED\x10\x02\x12'\n" +
+	"#DISPUTE_REASON_PRODUCT_UNACCEPTABLE\x10\x03\x12\x1c\n" +
+	"\x18DISPUTE_REASON_DUPLICATE\x10\x04\x12(\n" +
+	"$DISPUTE_REASON_SUBSCRIPTION_CANCELED\x10\x05\x12'\n" +
+	"#DISPUTE_REASON_CREDIT_NOT_PROCESSED\x10\x06\x12\x1a\n" +
+	"\x16DISPUTE_REASON_GENERAL\x10\a*\xb3\x01\n" +
+	"\fRefundReason\x12\x1d\n" +
+	"\x19REFUND_REASON_UNSPECIFIED\x10\x00\x12'\n" +
+	"#REFUND_REASON_REQUESTED_BY_CUSTOMER\x10\x01\x12\x1b\n" +
+	"\x17REFUND_REASON_DUPLICATE\x10\x02\x12\x1c\n" +
+	"\x18REFUND_REASON_FRAUDULENT\x10\x03\x12 \n" +
+	"\x1cREFUND_REASON_ORDER_CANCELED\x10\x04*\xda\x01\n" +
+	"\fSCAExemption\x12\x1d\n" +
+	"\x19SCA_EXEMPTION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SCA_EXEMPTION_LOW_VALUE\x10\x01\x12+\n" +
+	"'SCA_EXEMPTION_TRANSACTION_RISK_ANALYSIS\x10\x02\x12\x1b\n" +
+	"\x17SCA_EXEMPTION_RECURRING\x10\x03\x12$\n" +
+	" SCA_EXEMPTION_MERCHANT_INITIATED\x10\x04\x12\x1e\n" +
+	"\x1aSCA_EXEMPTION_OUT_OF_SCOPE\x10\x05*\xa6\x01\n" +
+	"\x0eSettlementType\x12\x1f\n" +
+	"\x1bSETTLEMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SETTLEMENT_TYPE_CHARGE\x10\x01\x12\x1a\n" +
+	"\x16SETTLEMENT_TYPE_REFUND\x10\x02\x12\x1b\n" +
+	"\x17SETTLEMENT_TYPE_DISPUTE\x10\x03\x12\x1e\n" +
+	"\x1aSETTLEMENT_TYPE_ADJUSTMENT\x10\x04B\xd3\x01\n" +
 	"\x13com.domain.event.v1B\x12PaymentEventsProtoP\x01ZJgithub.com/shortlink-org/billing/payments/internal/domain/event/v1;eventv1\xa2\x02\x03DEX\xaa\x02\x0fDomain.Event.V1\xca\x02\x0fDomain\\Event\\V1\xe2\x02\x1bDomain\\Event\\V1\\GPBMetadata\xea\x02\x11Domain::Event::V1b\x06proto3"
 
 var (
@@ -944,58 +2078,112 @@ func file_domain_event_v1_payment_events_proto_rawDescGZIP() []byte {
 	return file_domain_event_v1_payment_events_proto_rawDescData
 }
 
-var file_domain_event_v1_payment_events_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_domain_event_v1_payment_events_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_domain_event_v1_payment_events_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_domain_event_v1_payment_events_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_domain_event_v1_payment_events_proto_goTypes = []any{
-	(PaymentKind)(0),                      // 0: domain.event.v1.PaymentKind
-	(CaptureMode)(0),                      // 1: domain.event.v1.CaptureMode
-	(CancelReason)(0),                     // 2: domain.event.v1.CancelReason
-	(FailureReason)(0),                    // 3: domain.event.v1.FailureReason
-	(*EventMeta)(nil),                     // 4: domain.event.v1.EventMeta
-	(*PaymentCreated)(nil),                // 5: domain.event.v1.PaymentCreated
-	(*PaymentWaitingForConfirmation)(nil), // 6: domain.event.v1.PaymentWaitingForConfirmation
-	(*PaymentAuthorized)(nil),             // 7: domain.event.v1.PaymentAuthorized
-	(*PaymentPaid)(nil),                   // 8: domain.event.v1.PaymentPaid
-	(*PaymentRefunded)(nil),               // 9: domain.event.v1.PaymentRefunded
-	(*PaymentRefundFailed)(nil),           // 10: domain.event.v1.PaymentRefundFailed
-	(*PaymentCanceled)(nil),               // 11: domain.event.v1.PaymentCanceled
-	(*PaymentFailed)(nil),                 // 12: domain.event.v1.PaymentFailed
-	(*fieldmaskpb.FieldMask)(nil),         // 13: google.protobuf.FieldMask
-	(*money.Money)(nil),                   // 14: google.type.Money
+	(PaymentKind)(0),                        // 0: domain.event.v1.PaymentKind
+	(CaptureMode)(0),                        // 1: domain.event.v1.CaptureMode
+	(CancelReason)(0),                       // 2: domain.event.v1.CancelReason
+	(FailureReason)(0),                      // 3: domain.event.v1.FailureReason
+	(DisputeReason)(0),                      // 4: domain.event.v1.DisputeReason
+	(RefundReason)(0),                       // 5: domain.event.v1.RefundReason
+	(SCAExemption)(0),                       // 6: domain.event.v1.SCAExemption
+	(SettlementType)(0),                     // 7: domain.event.v1.SettlementType
+	(*EventMeta)(nil),                       // 8: domain.event.v1.EventMeta
+	(*PaymentCreated)(nil),                  // 9: domain.event.v1.PaymentCreated
+	(*PaymentProviderAttached)(nil),         // 10: domain.event.v1.PaymentProviderAttached
+	(*PaymentSCAEvaluated)(nil),             // 11: domain.event.v1.PaymentSCAEvaluated
+	(*PaymentWaitingForConfirmation)(nil),   // 12: domain.event.v1.PaymentWaitingForConfirmation
+	(*PaymentAuthorized)(nil),               // 13: domain.event.v1.PaymentAuthorized
+	(*PaymentPaid)(nil),                     // 14: domain.event.v1.PaymentPaid
+	(*PaymentAuthorizationReleased)(nil),    // 15: domain.event.v1.PaymentAuthorizationReleased
+	(*PaymentRefundRequested)(nil),          // 16: domain.event.v1.PaymentRefundRequested
+	(*PaymentRefunded)(nil),                 // 17: domain.event.v1.PaymentRefunded
+	(*PaymentRefundFailed)(nil),             // 18: domain.event.v1.PaymentRefundFailed
+	(*PaymentCanceled)(nil),                 // 19: domain.event.v1.PaymentCanceled
+	(*PaymentFailed)(nil),                   // 20: domain.event.v1.PaymentFailed
+	(*PaymentDisputeOpened)(nil),            // 21: domain.event.v1.PaymentDisputeOpened
+	(*PaymentDisputeEvidenceSubmitted)(nil), // 22: domain.event.v1.PaymentDisputeEvidenceSubmitted
+	(*PaymentDisputeWon)(nil),               // 23: domain.event.v1.PaymentDisputeWon
+	(*PaymentDisputeLost)(nil),              // 24: domain.event.v1.PaymentDisputeLost
+	(*PaymentSettled)(nil),                  // 25: domain.event.v1.PaymentSettled
+	(*fieldmaskpb.FieldMask)(nil),           // 26: google.protobuf.FieldMask
+	(*money.Money)(nil),                     // 27: google.type.Money
+	(*timestamppb.Timestamp)(nil),           // 28: google.protobuf.Timestamp
 }
 var file_domain_event_v1_payment_events_proto_depIdxs = []int32{
-	13, // 0: domain.event.v1.EventMeta.field_mask:type_name -> google.protobuf.FieldMask
-	4,  // 1: domain.event.v1.PaymentCreated.meta:type_name -> domain.event.v1.EventMeta
-	14, // 2: domain.event.v1.PaymentCreated.amount:type_name -> google.type.Money
+	26, // 0: domain.event.v1.EventMeta.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 1: domain.event.v1.PaymentCreated.meta:type_name -> domain.event.v1.EventMeta
+	27, // 2: domain.event.v1.PaymentCreated.amount:type_name -> google.type.Money
 	0,  // 3: domain.event.v1.PaymentCreated.kind:type_name -> domain.event.v1.PaymentKind
 	1,  // 4: domain.event.v1.PaymentCreated.capture_mode:type_name -> domain.event.v1.CaptureMode
-	13, // 5: domain.event.v1.PaymentCreated.field_mask:type_name -> google.protobuf.FieldMask
-	4,  // 6: domain.event.v1.PaymentWaitingForConfirmation.meta:type_name -> domain.event.v1.EventMeta
-	13, // 7: domain.event.v1.PaymentWaitingForConfirmation.field_mask:type_name -> google.protobuf.FieldMask
-	4,  // 8: domain.event.v1.PaymentAuthorized.meta:type_name -> domain.event.v1.EventMeta
-	14, // 9: domain.event.v1.PaymentAuthorized.authorized_amount:type_name -> google.type.Money
-	13, // 10: domain.event.v1.PaymentAuthorized.field_mask:type_name -> google.protobuf.FieldMask
-	4,  // 11: domain.event.v1.PaymentPaid.meta:type_name -> domain.event.v1.EventMeta
-	14, // 12: domain.event.v1.PaymentPaid.captured_amount:type_name -> google.type.Money
-	13, // 13: domain.event.v1.PaymentPaid.field_mask:type_name -> google.protobuf.FieldMask
-	4,  // 14: domain.event.v1.PaymentRefunded.meta:type_name -> domain.event.v1.EventMeta
-	14, // 15: domain.event.v1.PaymentRefunded.refund_amount:type_name -> google.type.Money
-	14, // 16: domain.event.v1.PaymentRefunded.total_refunded:type_name -> google.type.Money
-	13, // 17: domain.event.v1.PaymentRefunded.field_mask:type_name -> google.protobuf.FieldMask
-	4,  // 18: domain.event.v1.PaymentRefundFailed.meta:type_name -> domain.event.v1.EventMeta
-	3,  // 19: domain.event.v1.PaymentRefundFailed.reason:type_name -> domain.event.v1.FailureReason
-	13, // 20: domain.event.v1.PaymentRefundFailed.field_mask:type_name -> google.protobuf.FieldMask
-	4,  // 21: domain.event.v1.PaymentCanceled.meta:type_name -> domain.event.v1.EventMeta
-	2,  // 22: domain.event.v1.PaymentCanceled.reason:type_name -> domain.event.v1.CancelReason
-	13, // 23: domain.event.v1.PaymentCanceled.field_mask:type_name -> google.protobuf.FieldMask
-	4,  // 24: domain.event.v1.PaymentFailed.meta:type_name -> domain.event.v1.EventMeta
-	3,  // 25: domain.event.v1.PaymentFailed.reason:type_name -> domain.event.v1.FailureReason
-	13, // 26: domain.event.v1.PaymentFailed.field_mask:type_name -> google.protobuf.FieldMask
-	27, // [27:27] is the sub-list for method output_type
-	27, // [27:27] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	26, // 5: domain.event.v1.PaymentCreated.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 6: domain.event.v1.PaymentProviderAttached.meta:type_name -> domain.event.v1.EventMeta
+	26, // 7: domain.event.v1.PaymentProviderAttached.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 8: domain.event.v1.PaymentSCAEvaluated.meta:type_name -> domain.event.v1.EventMeta
+	6,  // 9: domain.event.v1.PaymentSCAEvaluated.exemption:type_name -> domain.event.v1.SCAExemption
+	26, // 10: domain.event.v1.PaymentSCAEvaluated.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 11: domain.event.v1.PaymentWaitingForConfirmation.meta:type_name -> domain.event.v1.EventMeta
+	26, // 12: domain.event.v1.PaymentWaitingForConfirmation.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 13: domain.event.v1.PaymentAuthorized.meta:type_name -> domain.event.v1.EventMeta
+	27, // 14: domain.event.v1.PaymentAuthorized.authorized_amount:type_name -> google.type.Money
+	27, // 15: domain.event.v1.PaymentAuthorized.amount:type_name -> google.type.Money
+	26, // 16: domain.event.v1.PaymentAuthorized.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 17: domain.event.v1.PaymentPaid.meta:type_name -> domain.event.v1.EventMeta
+	27, // 18: domain.event.v1.PaymentPaid.captured_amount:type_name -> google.type.Money
+	26, // 19: domain.event.v1.PaymentPaid.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 20: domain.event.v1.PaymentAuthorizationReleased.meta:type_name -> domain.event.v1.EventMeta
+	27, // 21: domain.event.v1.PaymentAuthorizationReleased.released_amount:type_name -> google.type.Money
+	27, // 22: domain.event.v1.PaymentAuthorizationReleased.total_released:type_name -> google.type.Money
+	26, // 23: domain.event.v1.PaymentAuthorizationReleased.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 24: domain.event.v1.PaymentRefundRequested.meta:type_name -> domain.event.v1.EventMeta
+	27, // 25: domain.event.v1.PaymentRefundRequested.amount:type_name -> google.type.Money
+	5,  // 26: domain.event.v1.PaymentRefundRequested.reason:type_name -> domain.event.v1.RefundReason
+	27, // 27: domain.event.v1.PaymentRefundRequested.total_pending:type_name -> google.type.Money
+	26, // 28: domain.event.v1.PaymentRefundRequested.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 29: domain.event.v1.PaymentRefunded.meta:type_name -> domain.event.v1.EventMeta
+	27, // 30: domain.event.v1.PaymentRefunded.refund_amount:type_name -> google.type.Money
+	27, // 31: domain.event.v1.PaymentRefunded.total_refunded:type_name -> google.type.Money
+	5,  // 32: domain.event.v1.PaymentRefunded.reason:type_name -> domain.event.v1.RefundReason
+	26, // 33: domain.event.v1.PaymentRefunded.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 34: domain.event.v1.PaymentRefundFailed.meta:type_name -> domain.event.v1.EventMeta
+	3,  // 35: domain.event.v1.PaymentRefundFailed.reason:type_name -> domain.event.v1.FailureReason
+	27, // 36: domain.event.v1.PaymentRefundFailed.amount:type_name -> google.type.Money
+	26, // 37: domain.event.v1.PaymentRefundFailed.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 38: domain.event.v1.PaymentCanceled.meta:type_name -> domain.event.v1.EventMeta
+	2,  // 39: domain.event.v1.PaymentCanceled.reason:type_name -> domain.event.v1.CancelReason
+	26, // 40: domain.event.v1.PaymentCanceled.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 41: domain.event.v1.PaymentFailed.meta:type_name -> domain.event.v1.EventMeta
+	3,  // 42: domain.event.v1.PaymentFailed.reason:type_name -> domain.event.v1.FailureReason
+	26, // 43: domain.event.v1.PaymentFailed.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 44: domain.event.v1.PaymentDisputeOpened.meta:type_name -> domain.event.v1.EventMeta
+	27, // 45: domain.event.v1.PaymentDisputeOpened.amount:type_name -> google.type.Money
+	4,  // 46: domain.event.v1.PaymentDisputeOpened.reason:type_name -> domain.event.v1.DisputeReason
+	26, // 47: domain.event.v1.PaymentDisputeOpened.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 48: domain.event.v1.PaymentDisputeEvidenceSubmitted.meta:type_name -> domain.event.v1.EventMeta
+	26, // 49: domain.event.v1.PaymentDisputeEvidenceSubmitted.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 50: domain.event.v1.PaymentDisputeWon.meta:type_name -> domain.event.v1.EventMeta
+	26, // 51: domain.event.v1.PaymentDisputeWon.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 52: domain.event.v1.PaymentDisputeLost.meta:type_name -> domain.event.v1.EventMeta
+	27, // 53: domain.event.v1.PaymentDisputeLost.reversed_amount:type_name -> google.type.Money
+	27, // 54: domain.event.v1.PaymentDisputeLost.total_reversed:type_name -> google.type.Money
+	26, // 55: domain.event.v1.PaymentDisputeLost.field_mask:type_name -> google.protobuf.FieldMask
+	8,  // 56: domain.event.v1.PaymentSettled.meta:type_name -> domain.event.v1.EventMeta
+	7,  // 57: domain.event.v1.PaymentSettled.type:type_name -> domain.event.v1.SettlementType
+	27, // 58: domain.event.v1.PaymentSettled.amount:type_name -> google.type.Money
+	27, // 59: domain.event.v1.PaymentSettled.gross:type_name -> google.type.Money
+	27, // 60: domain.event.v1.PaymentSettled.fee:type_name -> google.type.Money
+	27, // 61: domain.event.v1.PaymentSettled.fx_adjustment:type_name -> google.type.Money
+	27, // 62: domain.event.v1.PaymentSettled.net:type_name -> google.type.Money
+	28, // 63: domain.event.v1.PaymentSettled.settled_at:type_name -> google.protobuf.Timestamp
+	27, // 64: domain.event.v1.PaymentSettled.total_fees:type_name -> google.type.Money
+	27, // 65: domain.event.v1.PaymentSettled.total_net:type_name -> google.type.Money
+	26, // 66: domain.event.v1.PaymentSettled.field_mask:type_name -> google.protobuf.FieldMask
+	67, // [67:67] is the sub-list for method output_type
+	67, // [67:67] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_domain_event_v1_payment_events_proto_init() }
@@ -1008,8 +2196,8 @@ func file_domain_event_v1_payment_events_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_domain_event_v1_payment_events_proto_rawDesc), len(file_domain_event_v1_payment_events_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   9,
+			NumEnums:      8,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
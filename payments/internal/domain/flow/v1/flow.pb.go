@@ -40,6 +40,11 @@ const (
 	PaymentFlow_PAYMENT_FLOW_CANCELED PaymentFlow = 6
 	// Error/declined (including reverse/expire of authorization).
 	PaymentFlow_PAYMENT_FLOW_FAILED PaymentFlow = 7
+	// Chargeback opened on a paid payment; disputed funds are withheld until it is resolved.
+	// Won or partially lost disputes return to PAID.
+	PaymentFlow_PAYMENT_FLOW_DISPUTED PaymentFlow = 8
+	// Dispute lost and everything not refunded was reversed to the customer (terminal).
+	PaymentFlow_PAYMENT_FLOW_CHARGED_BACK PaymentFlow = 9
 )
 
 // Enum value maps for PaymentFlow.
@@ -53,6 +58,8 @@ var (
 		5: "PAYMENT_FLOW_REFUNDED",
 		6: "PAYMENT_FLOW_CANCELED",
 		7: "PAYMENT_FLOW_FAILED",
+		8: "PAYMENT_FLOW_DISPUTED",
+		9: "PAYMENT_FLOW_CHARGED_BACK",
 	}
 	PaymentFlow_value = map[string]int32{
 		"PAYMENT_FLOW_UNSPECIFIED":              0,
@@ -63,6 +70,8 @@ var (
 		"PAYMENT_FLOW_REFUNDED":                 5,
 		"PAYMENT_FLOW_CANCELED":                 6,
 		"PAYMENT_FLOW_FAILED":                   7,
+		"PAYMENT_FLOW_DISPUTED":                 8,
+		"PAYMENT_FLOW_CHARGED_BACK":             9,
 	}
 )
 
@@ -97,7 +106,7 @@ var File_domain_flow_v1_flow_proto protoreflect.FileDescriptor
 
 const file_domain_flow_v1_flow_proto_rawDesc = "" +
 	"\n" +
-	"\x19domain/flow/v1/flow.proto\x12\x0edomain.flow.v1*\xf3\x01\n" +
+	"\x19domain/flow/v1/flow.proto\x12\x0edomain.flow.v1*\xad\x02\n" +
 	"\vPaymentFlow\x12\x1c\n" +
 	"\x18PAYMENT_FLOW_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14PAYMENT_FLOW_CREATED\x10\x01\x12)\n" +
@@ -106,7 +115,9 @@ const file_domain_flow_v1_flow_proto_rawDesc = "" +
 	"\x11PAYMENT_FLOW_PAID\x10\x04\x12\x19\n" +
 	"\x15PAYMENT_FLOW_REFUNDED\x10\x05\x12\x19\n" +
 	"\x15PAYMENT_FLOW_CANCELED\x10\x06\x12\x17\n" +
-	"\x13PAYMENT_FLOW_FAILED\x10\aB\xc3\x01\n" +
+	"\x13PAYMENT_FLOW_FAILED\x10\a\x12\x19\n" +
+	"\x15PAYMENT_FLOW_DISPUTED\x10\b\x12\x1d\n" +
+	"\x19PAYMENT_FLOW_CHARGED_BACK\x10\tB\xc3\x01\n" +
 	"\x12com.domain.flow.v1B\tFlowProtoP\x01ZHgithub.com/shortlink-org/billing/payments/internal/domain/flow/v1;flowv1\xa2\x02\x03DFX\xaa\x02\x0eDomain.Flow.V1\xca\x02\x0eDomain\\Flow\\V1\xe2\x02\x1aDomain\\Flow\\V1\\GPBMetadata\xea\x02\x10Domain::Flow::V1b\x06proto3"
 
 var (
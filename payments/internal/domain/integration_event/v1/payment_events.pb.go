@@ -258,6 +258,127 @@ func (FailureReason) EnumDescriptor() ([]byte, []int) {
 	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{3}
 }
 
+// Canonical dispute categories (provider-agnostic).
+type DisputeReason int32
+
+const (
+	DisputeReason_DISPUTE_REASON_UNSPECIFIED           DisputeReason = 0
+	DisputeReason_DISPUTE_REASON_FRAUDULENT            DisputeReason = 1
+	DisputeReason_DISPUTE_REASON_PRODUCT_NOT_RECEIVED  DisputeReason = 2
+	DisputeReason_DISPUTE_REASON_PRODUCT_UNACCEPTABLE  DisputeReason = 3
+	DisputeReason_DISPUTE_REASON_DUPLICATE             DisputeReason = 4
+	DisputeReason_DISPUTE_REASON_SUBSCRIPTION_CANCELED DisputeReason = 5
+	DisputeReason_DISPUTE_REASON_CREDIT_NOT_PROCESSED  DisputeReason = 6
+	DisputeReason_DISPUTE_REASON_GENERAL               DisputeReason = 7
+)
+
+// Enum value maps for DisputeReason.
+var (
+	DisputeReason_name = map[int32]string{
+		0: "DISPUTE_REASON_UNSPECIFIED",
+		1: "DISPUTE_REASON_FRAUDULENT",
+		2: "DISPUTE_REASON_PRODUCT_NOT_RECEIVED",
+		3: "DISPUTE_REASON_PRODUCT_UNACCEPTABLE",
+		4: "DISPUTE_REASON_DUPLICATE",
+		5: "DISPUTE_REASON_SUBSCRIPTION_CANCELED",
+		6: "DISPUTE_REASON_CREDIT_NOT_PROCESSED",
+		7: "DISPUTE_REASON_GENERAL",
+	}
+	DisputeReason_value = map[string]int32{
+		"DISPUTE_REASON_UNSPECIFIED":           0,
+		"DISPUTE_REASON_FRAUDULENT":            1,
+		"DISPUTE_REASON_PRODUCT_NOT_RECEIVED":  2,
+		"DISPUTE_REASON_PRODUCT_UNACCEPTABLE":  3,
+		"DISPUTE_REASON_DUPLICATE":             4,
+		"DISPUTE_REASON_SUBSCRIPTION_CANCELED": 5,
+		"DISPUTE_REASON_CREDIT_NOT_PROCESSED":  6,
+		"DISPUTE_REASON_GENERAL":               7,
+	}
+)
+
+func (x DisputeReason) Enum() *DisputeReason {
+	p := new(DisputeReason)
+	*p = x
+	return p
+}
+
+func (x DisputeReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisputeReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_integration_event_v1_payment_events_proto_enumTypes[4].Descriptor()
+}
+
+func (DisputeReason) Type() protoreflect.EnumType {
+	return &file_domain_integration_event_v1_payment_events_proto_enumTypes[4]
+}
+
+func (x DisputeReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisputeReason.Descriptor instead.
+func (DisputeReason) EnumDescriptor() ([]byte, []int) {
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{4}
+}
+
+// Canonical refund categories (provider-agnostic).
+type RefundReason int32
+
+const (
+	RefundReason_REFUND_REASON_UNSPECIFIED           RefundReason = 0
+	RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER RefundReason = 1
+	RefundReason_REFUND_REASON_DUPLICATE             RefundReason = 2
+	RefundReason_REFUND_REASON_FRAUDULENT            RefundReason = 3
+	RefundReason_REFUND_REASON_ORDER_CANCELED        RefundReason = 4
+)
+
+// Enum value maps for RefundReason.
+var (
+	RefundReason_name = map[int32]string{
+		0: "REFUND_REASON_UNSPECIFIED",
+		1: "REFUND_REASON_REQUESTED_BY_CUSTOMER",
+		2: "REFUND_REASON_DUPLICATE",
+		3: "REFUND_REASON_FRAUDULENT",
+		4: "REFUND_REASON_ORDER_CANCELED",
+	}
+	RefundReason_value = map[string]int32{
+		"REFUND_REASON_UNSPECIFIED":           0,
+		"REFUND_REASON_REQUESTED_BY_CUSTOMER": 1,
+		"REFUND_REASON_DUPLICATE":             2,
+		"REFUND_REASON_FRAUDULENT":            3,
+		"REFUND_REASON_ORDER_CANCELED":        4,
+	}
+)
+
+func (x RefundReason) Enum() *RefundReason {
+	p := new(RefundReason)
+	*p = x
+	return p
+}
+
+func (x RefundReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_integration_event_v1_payment_events_proto_enumTypes[5].Descriptor()
+}
+
+func (RefundReason) Type() protoreflect.EnumType {
+	return &file_domain_integration_event_v1_payment_events_proto_enumTypes[5]
+}
+
+func (x RefundReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundReason.Descriptor instead.
+func (RefundReason) EnumDescriptor() ([]byte, []int) {
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{5}
+}
+
 // -----------------------------------------------------------------------------
 // Minimal metadata for idempotency and ordering across services.
 // -----------------------------------------------------------------------------
@@ -464,6 +585,7 @@ func (x *PaymentWaitingForConfirmation) GetFieldMask() *fieldmaskpb.FieldMask {
 type PaymentAuthorized struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	AuthorizedAmount *money.Money           `protobuf:"bytes,1,opt,name=authorized_amount,json=authorizedAmount,proto3" json:"authorized_amount,omitempty"` // hold amount
+	Amount           *money.Money           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                                             // new payment amount when the hold was increased, unset otherwise
 	FieldMask        *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
@@ -506,6 +628,13 @@ func (x *PaymentAuthorized) GetAuthorizedAmount() *money.Money {
 	return nil
 }
 
+func (x *PaymentAuthorized) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
 func (x *PaymentAuthorized) GetFieldMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.FieldMask
@@ -567,6 +696,130 @@ func (x *PaymentPaid) GetFieldMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+// (no state change, remains PAID)
+// Final capture done; the uncaptured remainder of the hold is back with the customer.
+type PaymentAuthorizationReleased struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReleasedAmount *money.Money           `protobuf:"bytes,1,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount,omitempty"` // released by this op
+	FieldMask      *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PaymentAuthorizationReleased) Reset() {
+	*x = PaymentAuthorizationReleased{}
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentAuthorizationReleased) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentAuthorizationReleased) ProtoMessage() {}
+
+func (x *PaymentAuthorizationReleased) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentAuthorizationReleased.ProtoReflect.Descriptor instead.
+func (*PaymentAuthorizationReleased) Descriptor() ([]byte, []int) {
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PaymentAuthorizationReleased) GetReleasedAmount() *money.Money {
+	if x != nil {
+		return x.ReleasedAmount
+	}
+	return nil
+}
+
+func (x *PaymentAuthorizationReleased) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// (no state change)
+// Refund accepted by the provider; money is not back with the customer yet.
+type PaymentRefundPending struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefundId      []byte                 `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"` // 16-byte UUID
+	Amount        *money.Money           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        RefundReason           `protobuf:"varint,3,opt,name=reason,proto3,enum=domain.integration_event.v1.RefundReason" json:"reason,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentRefundPending) Reset() {
+	*x = PaymentRefundPending{}
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRefundPending) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRefundPending) ProtoMessage() {}
+
+func (x *PaymentRefundPending) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRefundPending.ProtoReflect.Descriptor instead.
+func (*PaymentRefundPending) Descriptor() ([]byte, []int) {
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PaymentRefundPending) GetRefundId() []byte {
+	if x != nil {
+		return x.RefundId
+	}
+	return nil
+}
+
+func (x *PaymentRefundPending) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PaymentRefundPending) GetReason() RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
+func (x *PaymentRefundPending) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 // -> REFUNDED
 // At least one refund succeeded (partial or full). Entered on first success.
 type PaymentRefunded struct {
@@ -574,6 +827,8 @@ type PaymentRefunded struct {
 	RefundAmount  *money.Money           `protobuf:"bytes,1,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`    // this refund operation
 	TotalRefunded *money.Money           `protobuf:"bytes,2,opt,name=total_refunded,json=totalRefunded,proto3" json:"total_refunded,omitempty"` // cumulative after this op
 	Full          bool                   `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`                                       // total_refunded == captured
+	RefundId      []byte                 `protobuf:"bytes,4,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`                // 16-byte UUID
+	Reason        RefundReason           `protobuf:"varint,5,opt,name=reason,proto3,enum=domain.integration_event.v1.RefundReason" json:"reason,omitempty"`
 	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -581,7 +836,7 @@ type PaymentRefunded struct {
 
 func (x *PaymentRefunded) Reset() {
 	*x = PaymentRefunded{}
-	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[5]
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -593,7 +848,7 @@ func (x *PaymentRefunded) String() string {
 func (*PaymentRefunded) ProtoMessage() {}
 
 func (x *PaymentRefunded) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[5]
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +861,7 @@ func (x *PaymentRefunded) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentRefunded.ProtoReflect.Descriptor instead.
 func (*PaymentRefunded) Descriptor() ([]byte, []int) {
-	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{5}
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PaymentRefunded) GetRefundAmount() *money.Money {
@@ -630,6 +885,20 @@ func (x *PaymentRefunded) GetFull() bool {
 	return false
 }
 
+func (x *PaymentRefunded) GetRefundId() []byte {
+	if x != nil {
+		return x.RefundId
+	}
+	return nil
+}
+
+func (x *PaymentRefunded) GetReason() RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
 func (x *PaymentRefunded) GetFieldMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.FieldMask
@@ -642,6 +911,8 @@ func (x *PaymentRefunded) GetFieldMask() *fieldmaskpb.FieldMask {
 type PaymentRefundFailed struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reason        FailureReason          `protobuf:"varint,1,opt,name=reason,proto3,enum=domain.integration_event.v1.FailureReason" json:"reason,omitempty"`
+	RefundId      []byte                 `protobuf:"bytes,2,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"` // 16-byte UUID of the released pending refund, if any
+	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                     // released pending amount
 	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -649,7 +920,7 @@ type PaymentRefundFailed struct {
 
 func (x *PaymentRefundFailed) Reset() {
 	*x = PaymentRefundFailed{}
-	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[6]
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -661,7 +932,7 @@ func (x *PaymentRefundFailed) String() string {
 func (*PaymentRefundFailed) ProtoMessage() {}
 
 func (x *PaymentRefundFailed) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[6]
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +945,7 @@ func (x *PaymentRefundFailed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentRefundFailed.ProtoReflect.Descriptor instead.
 func (*PaymentRefundFailed) Descriptor() ([]byte, []int) {
-	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{6}
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PaymentRefundFailed) GetReason() FailureReason {
@@ -684,6 +955,20 @@ func (x *PaymentRefundFailed) GetReason() FailureReason {
 	return FailureReason_FAILURE_REASON_UNSPECIFIED
 }
 
+func (x *PaymentRefundFailed) GetRefundId() []byte {
+	if x != nil {
+		return x.RefundId
+	}
+	return nil
+}
+
+func (x *PaymentRefundFailed) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
 func (x *PaymentRefundFailed) GetFieldMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.FieldMask
@@ -703,7 +988,7 @@ type PaymentCanceled struct {
 
 func (x *PaymentCanceled) Reset() {
 	*x = PaymentCanceled{}
-	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[7]
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -715,7 +1000,7 @@ func (x *PaymentCanceled) String() string {
 func (*PaymentCanceled) ProtoMessage() {}
 
 func (x *PaymentCanceled) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[7]
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +1013,7 @@ func (x *PaymentCanceled) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentCanceled.ProtoReflect.Descriptor instead.
 func (*PaymentCanceled) Descriptor() ([]byte, []int) {
-	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{7}
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PaymentCanceled) GetReason() CancelReason {
@@ -757,7 +1042,7 @@ type PaymentFailed struct {
 
 func (x *PaymentFailed) Reset() {
 	*x = PaymentFailed{}
-	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[8]
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -769,7 +1054,7 @@ func (x *PaymentFailed) String() string {
 func (*PaymentFailed) ProtoMessage() {}
 
 func (x *PaymentFailed) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[8]
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +1067,7 @@ func (x *PaymentFailed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentFailed.ProtoReflect.Descriptor instead.
 func (*PaymentFailed) Descriptor() ([]byte, []int) {
-	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{8}
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PaymentFailed) GetReason() FailureReason {
@@ -799,6 +1084,230 @@ func (x *PaymentFailed) GetFieldMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+// -> DISPUTED
+// Chargeback opened on a paid payment; the disputed amount is withheld.
+type PaymentDisputeOpened struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        *money.Money           `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"` // disputed amount
+	Reason        DisputeReason          `protobuf:"varint,2,opt,name=reason,proto3,enum=domain.integration_event.v1.DisputeReason" json:"reason,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentDisputeOpened) Reset() {
+	*x = PaymentDisputeOpened{}
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentDisputeOpened) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDisputeOpened) ProtoMessage() {}
+
+func (x *PaymentDisputeOpened) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentDisputeOpened.ProtoReflect.Descriptor instead.
+func (*PaymentDisputeOpened) Descriptor() ([]byte, []int) {
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PaymentDisputeOpened) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PaymentDisputeOpened) GetReason() DisputeReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DisputeReason_DISPUTE_REASON_UNSPECIFIED
+}
+
+func (x *PaymentDisputeOpened) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// (no state change, remains DISPUTED)
+// Evidence was submitted to the issuer.
+type PaymentDisputeEvidenceSubmitted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentDisputeEvidenceSubmitted) Reset() {
+	*x = PaymentDisputeEvidenceSubmitted{}
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentDisputeEvidenceSubmitted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDisputeEvidenceSubmitted) ProtoMessage() {}
+
+func (x *PaymentDisputeEvidenceSubmitted) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentDisputeEvidenceSubmitted.ProtoReflect.Descriptor instead.
+func (*PaymentDisputeEvidenceSubmitted) Descriptor() ([]byte, []int) {
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PaymentDisputeEvidenceSubmitted) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// -> PAID
+// Dispute won; withheld funds are released.
+type PaymentDisputeWon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentDisputeWon) Reset() {
+	*x = PaymentDisputeWon{}
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentDisputeWon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDisputeWon) ProtoMessage() {}
+
+func (x *PaymentDisputeWon) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentDisputeWon.ProtoReflect.Descriptor instead.
+func (*PaymentDisputeWon) Descriptor() ([]byte, []int) {
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PaymentDisputeWon) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+// -> PAID (partial) or CHARGED_BACK (full)
+// Dispute lost; the disputed amount is reversed to the customer.
+type PaymentDisputeLost struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReversedAmount *money.Money           `protobuf:"bytes,1,opt,name=reversed_amount,json=reversedAmount,proto3" json:"reversed_amount,omitempty"` // this dispute
+	TotalReversed  *money.Money           `protobuf:"bytes,2,opt,name=total_reversed,json=totalReversed,proto3" json:"total_reversed,omitempty"`    // cumulative after this dispute
+	Full           bool                   `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`                                          // total_refunded + total_reversed == captured
+	FieldMask      *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PaymentDisputeLost) Reset() {
+	*x = PaymentDisputeLost{}
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentDisputeLost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDisputeLost) ProtoMessage() {}
+
+func (x *PaymentDisputeLost) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentDisputeLost.ProtoReflect.Descriptor instead.
+func (*PaymentDisputeLost) Descriptor() ([]byte, []int) {
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PaymentDisputeLost) GetReversedAmount() *money.Money {
+	if x != nil {
+		return x.ReversedAmount
+	}
+	return nil
+}
+
+func (x *PaymentDisputeLost) GetTotalReversed() *money.Money {
+	if x != nil {
+		return x.TotalReversed
+	}
+	return nil
+}
+
+func (x *PaymentDisputeLost) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *PaymentDisputeLost) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
 // -----------------------------------------------------------------------------
 // Single-topic wrapper: publish this message with key = payment_id.
 // Consumers switch on the `oneof` to handle specific event types.
@@ -816,6 +1325,12 @@ type PaymentEvent struct {
 	//	*PaymentEvent_RefundFailed
 	//	*PaymentEvent_Canceled
 	//	*PaymentEvent_Failed
+	//	*PaymentEvent_DisputeOpened
+	//	*PaymentEvent_DisputeEvidenceSubmitted
+	//	*PaymentEvent_DisputeWon
+	//	*PaymentEvent_DisputeLost
+	//	*PaymentEvent_RefundPending
+	//	*PaymentEvent_AuthorizationReleased
 	Event         isPaymentEvent_Event   `protobuf_oneof:"event"`
 	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -824,7 +1339,7 @@ type PaymentEvent struct {
 
 func (x *PaymentEvent) Reset() {
 	*x = PaymentEvent{}
-	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[9]
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -836,7 +1351,7 @@ func (x *PaymentEvent) String() string {
 func (*PaymentEvent) ProtoMessage() {}
 
 func (x *PaymentEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[9]
+	mi := &file_domain_integration_event_v1_payment_events_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,7 +1364,7 @@ func (x *PaymentEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentEvent.ProtoReflect.Descriptor instead.
 func (*PaymentEvent) Descriptor() ([]byte, []int) {
-	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{9}
+	return file_domain_integration_event_v1_payment_events_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PaymentEvent) GetMeta() *EventMeta {
@@ -938,6 +1453,60 @@ func (x *PaymentEvent) GetFailed() *PaymentFailed {
 	return nil
 }
 
+func (x *PaymentEvent) GetDisputeOpened() *PaymentDisputeOpened {
+	if x != nil {
+		if x, ok := x.Event.(*PaymentEvent_DisputeOpened); ok {
+			return x.DisputeOpened
+		}
+	}
+	return nil
+}
+
+func (x *PaymentEvent) GetDisputeEvidenceSubmitted() *PaymentDisputeEvidenceSubmitted {
+	if x != nil {
+		if x, ok := x.Event.(*PaymentEvent_DisputeEvidenceSubmitted); ok {
+			return x.DisputeEvidenceSubmitted
+		}
+	}
+	return nil
+}
+
+func (x *PaymentEvent) GetDisputeWon() *PaymentDisputeWon {
+	if x != nil {
+		if x, ok := x.Event.(*PaymentEvent_DisputeWon); ok {
+			return x.DisputeWon
+		}
+	}
+	return nil
+}
+
+func (x *PaymentEvent) GetDisputeLost() *PaymentDisputeLost {
+	if x != nil {
+		if x, ok := x.Event.(*PaymentEvent_DisputeLost); ok {
+			return x.DisputeLost
+		}
+	}
+	return nil
+}
+
+func (x *PaymentEvent) GetRefundPending() *PaymentRefundPending {
+	if x != nil {
+		if x, ok := x.Event.(*PaymentEvent_RefundPending); ok {
+			return x.RefundPending
+		}
+	}
+	return nil
+}
+
+func (x *PaymentEvent) GetAuthorizationReleased() *PaymentAuthorizationReleased {
+	if x != nil {
+		if x, ok := x.Event.(*PaymentEvent_AuthorizationReleased); ok {
+			return x.AuthorizationReleased
+		}
+	}
+	return nil
+}
+
 func (x *PaymentEvent) GetFieldMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.FieldMask
@@ -981,6 +1550,30 @@ type PaymentEvent_Failed struct {
 	Failed *PaymentFailed `protobuf:"bytes,17,opt,name=failed,proto3,oneof"` // -> FAILED
 }
 
+type PaymentEvent_DisputeOpened struct {
+	DisputeOpened *PaymentDisputeOpened `protobuf:"bytes,18,opt,name=dispute_opened,json=disputeOpened,proto3,oneof"` // -> DISPUTED
+}
+
+type PaymentEvent_DisputeEvidenceSubmitted struct {
+	DisputeEvidenceSubmitted *PaymentDisputeEvidenceSubmitted `protobuf:"bytes,19,opt,name=dispute_evidence_submitted,json=disputeEvidenceSubmitted,proto3,oneof"` // (no state change)
+}
+
+type PaymentEvent_DisputeWon struct {
+	DisputeWon *PaymentDisputeWon `protobuf:"bytes,20,opt,name=dispute_won,json=disputeWon,proto3,oneof"` // -> PAID
+}
+
+type PaymentEvent_DisputeLost struct {
+	DisputeLost *PaymentDisputeLost `protobuf:"bytes,21,opt,name=dispute_lost,json=disputeLost,proto3,oneof"` // -> PAID or CHARGED_BACK
+}
+
+type PaymentEvent_RefundPending struct {
+	RefundPending *PaymentRefundPending `protobuf:"bytes,22,opt,name=refund_pending,json=refundPending,proto3,oneof"` // (no state change)
+}
+
+type PaymentEvent_AuthorizationReleased struct {
+	AuthorizationReleased *PaymentAuthorizationReleased `protobuf:"bytes,23,opt,name=authorization_released,json=authorizationReleased,proto3,oneof"` // (no state change)
+}
+
 func (*PaymentEvent_Created) isPaymentEvent_Event() {}
 
 func (*PaymentEvent_WaitingForConfirmation) isPaymentEvent_Event() {}
@@ -997,6 +1590,18 @@ func (*PaymentEvent_Canceled) isPaymentEvent_Event() {}
 
 func (*PaymentEvent_Failed) isPaymentEvent_Event() {}
 
+func (*PaymentEvent_DisputeOpened) isPaymentEvent_Event() {}
+
+func (*PaymentEvent_DisputeEvidenceSubmitted) isPaymentEvent_Event() {}
+
+func (*PaymentEvent_DisputeWon) isPaymentEvent_Event() {}
+
+func (*PaymentEvent_DisputeLost) isPaymentEvent_Event() {}
+
+func (*PaymentEvent_RefundPending) isPaymentEvent_Event() {}
+
+func (*PaymentEvent_AuthorizationReleased) isPaymentEvent_Event() {}
+
 var File_domain_integration_event_v1_payment_events_proto protoreflect.FileDescriptor
 
 const file_domain_integration_event_v1_payment_events_proto_rawDesc = "" +
@@ -1019,23 +1624,38 @@ const file_domain_integration_event_v1_payment_events_proto_rawDesc = "" +
 	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"Z\n" +
 	"\x1dPaymentWaitingForConfirmation\x129\n" +
 	"\n" +
-	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x8f\x01\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xbb\x01\n" +
 	"\x11PaymentAuthorized\x12?\n" +
-	"\x11authorized_amount\x18\x01 \x01(\v2\x12.google.type.MoneyR\x10authorizedAmount\x129\n" +
+	"\x11authorized_amount\x18\x01 \x01(\v2\x12.google.type.MoneyR\x10authorizedAmount\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x129\n" +
 	"\n" +
 	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x85\x01\n" +
 	"\vPaymentPaid\x12;\n" +
 	"\x0fcaptured_amount\x18\x01 \x01(\v2\x12.google.type.MoneyR\x0ecapturedAmount\x129\n" +
 	"\n" +
-	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xd4\x01\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x96\x01\n" +
+	"\x1cPaymentAuthorizationReleased\x12;\n" +
+	"\x0freleased_amount\x18\x01 \x01(\v2\x12.google.type.MoneyR\x0ereleasedAmount\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xdd\x01\n" +
+	"\x14PaymentRefundPending\x12\x1b\n" +
+	"\trefund_id\x18\x01 \x01(\fR\brefundId\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x12A\n" +
+	"\x06reason\x18\x03 \x01(\x0e2).domain.integration_event.v1.RefundReasonR\x06reason\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xb4\x02\n" +
 	"\x0fPaymentRefunded\x127\n" +
 	"\rrefund_amount\x18\x01 \x01(\v2\x12.google.type.MoneyR\frefundAmount\x129\n" +
 	"\x0etotal_refunded\x18\x02 \x01(\v2\x12.google.type.MoneyR\rtotalRefunded\x12\x12\n" +
-	"\x04full\x18\x03 \x01(\bR\x04full\x129\n" +
+	"\x04full\x18\x03 \x01(\bR\x04full\x12\x1b\n" +
+	"\trefund_id\x18\x04 \x01(\fR\brefundId\x12A\n" +
+	"\x06reason\x18\x05 \x01(\x0e2).domain.integration_event.v1.RefundReasonR\x06reason\x129\n" +
 	"\n" +
-	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x94\x01\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xdd\x01\n" +
 	"\x13PaymentRefundFailed\x12B\n" +
-	"\x06reason\x18\x01 \x01(\x0e2*.domain.integration_event.v1.FailureReasonR\x06reason\x129\n" +
+	"\x06reason\x18\x01 \x01(\x0e2*.domain.integration_event.v1.FailureReasonR\x06reason\x12\x1b\n" +
+	"\trefund_id\x18\x02 \x01(\fR\brefundId\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x129\n" +
 	"\n" +
 	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x8f\x01\n" +
 	"\x0fPaymentCanceled\x12A\n" +
@@ -1045,7 +1665,25 @@ const file_domain_integration_event_v1_payment_events_proto_rawDesc = "" +
 	"\rPaymentFailed\x12B\n" +
 	"\x06reason\x18\x01 \x01(\x0e2*.domain.integration_event.v1.FailureReasonR\x06reason\x129\n" +
 	"\n" +
-	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\x98\x06\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xc1\x01\n" +
+	"\x14PaymentDisputeOpened\x12*\n" +
+	"\x06amount\x18\x01 \x01(\v2\x12.google.type.MoneyR\x06amount\x12B\n" +
+	"\x06reason\x18\x02 \x01(\x0e2*.domain.integration_event.v1.DisputeReasonR\x06reason\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\\\n" +
+	"\x1fPaymentDisputeEvidenceSubmitted\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"N\n" +
+	"\x11PaymentDisputeWon\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xdb\x01\n" +
+	"\x12PaymentDisputeLost\x12;\n" +
+	"\x0freversed_amount\x18\x01 \x01(\v2\x12.google.type.MoneyR\x0ereversedAmount\x129\n" +
+	"\x0etotal_reversed\x18\x02 \x01(\v2\x12.google.type.MoneyR\rtotalReversed\x12\x12\n" +
+	"\x04full\x18\x03 \x01(\bR\x04full\x129\n" +
+	"\n" +
+	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"\xeb\n" +
+	"\n" +
 	"\fPaymentEvent\x12:\n" +
 	"\x04meta\x18\x01 \x01(\v2&.domain.integration_event.v1.EventMetaR\x04meta\x12G\n" +
 	"\acreated\x18\n" +
@@ -1058,7 +1696,14 @@ const file_domain_integration_event_v1_payment_events_proto_rawDesc = "" +
 	"\brefunded\x18\x0e \x01(\v2,.domain.integration_event.v1.PaymentRefundedH\x00R\brefunded\x12W\n" +
 	"\rrefund_failed\x18\x0f \x01(\v20.domain.integration_event.v1.PaymentRefundFailedH\x00R\frefundFailed\x12J\n" +
 	"\bcanceled\x18\x10 \x01(\v2,.domain.integration_event.v1.PaymentCanceledH\x00R\bcanceled\x12D\n" +
-	"\x06failed\x18\x11 \x01(\v2*.domain.integration_event.v1.PaymentFailedH\x00R\x06failed\x129\n" +
+	"\x06failed\x18\x11 \x01(\v2*.domain.integration_event.v1.PaymentFailedH\x00R\x06failed\x12Z\n" +
+	"\x0edispute_opened\x18\x12 \x01(\v21.domain.integration_event.v1.PaymentDisputeOpenedH\x00R\rdisputeOpened\x12|\n" +
+	"\x1adispute_evidence_submitted\x18\x13 \x01(\v2<.domain.integration_event.v1.PaymentDisputeEvidenceSubmittedH\x00R\x18disputeEvidenceSubmitted\x12Q\n" +
+	"\vdispute_won\x18\x14 \x01(\v2..domain.integration_event.v1.PaymentDisputeWonH\x00R\n" +
+	"disputeWon\x12T\n" +
+	"\fdispute_lost\x18\x15 \x01(\v2/.domain.integration_event.v1.PaymentDisputeLostH\x00R\vdisputeLost\x12Z\n" +
+	"\x0erefund_pending\x18\x16 \x01(\v21.domain.integration_event.v1.PaymentRefundPendingH\x00R\rrefundPending\x12r\n" +
+	"\x16authorization_released\x18\x17 \x01(\v29.domain.integration_event.v1.PaymentAuthorizationReleasedH\x00R\x15authorizationReleased\x129\n" +
 	"\n" +
 	"field_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMaskB\a\n" +
 	"\x05event*e\n" +
@@ -1085,7 +1730,22 @@ const file_domain_integration_event_v1_payment_events_proto_rawDesc = "" +
 	" FAILURE_REASON_SCA_NOT_COMPLETED\x10\x05\x12\"\n" +
 	"\x1eFAILURE_REASON_FRAUD_SUSPECTED\x10\x06\x12 \n" +
 	"\x1cFAILURE_REASON_NETWORK_ERROR\x10\a\x12!\n" +
-	"\x1dFAILURE_REASON_PROVIDER_ERROR\x10\bB\x92\x02\n" +
+	"\x1dFAILURE_REASON_PROVIDER_ERROR\x10\b*\xad\x02\n" +
+	"\rDisputeReason\x12\x1e\n" +
+	"\x1aDISPUTE_REASON_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19DISPUTE_REASON_FRAUDULENT\x10\x01\x12'\n" +
+	"#DISPUTE_REASON_PRODUCT_NOT_RECEIVED\x10\x02\x12'\n" +
+	"#DISPUTE_REASON_PRODUCT_UNACCEPTABLE\x10\x03\x12\x1c\n" +
+	"\x18DISPUTE_REASON_DUPLICATE\x10\x04\x12(\n" +
+	"$DISPUTE_REASON_SUBSCRIPTION_CANCELED\x10\x05\x12'\n" +
+	"#DISPUTE_REASON_CREDIT_NOT_PROCESSED\x10\x06\x12\x1a\n" +
+	"\x16DISPUTE_REASON_GENERAL\x10\a*\xb3\x01\n" +
+	"\fRefundReason\x12\x1d\n" +
+	"\x19REFUND_REASON_UNSPECIFIED\x10\x00\x12'\n" +
+	"#REFUND_REASON_REQUESTED_BY_CUSTOMER\x10\x01\x12\x1b\n" +
+	"\x17REFUND_REASON_DUPLICATE\x10\x02\x12\x1c\n" +
+	"\x18REFUND_REASON_FRAUDULENT\x10\x03\x12 \n" +
+	"\x1cREFUND_REASON_ORDER_CANCELED\x10\x04B\x92\x02\n" +
 	"\x1fcom.domain.integration_event.v1B\x12PaymentEventsProtoP\x01ZQgithub.com/shortlink-org/billing/payments/integration_event/v1;integrationeventv1\xa2\x02\x03DIX\xaa\x02\x1aDomain.IntegrationEvent.V1\xca\x02\x1aDomain\\IntegrationEvent\\V1\xe2\x02&Domain\\IntegrationEvent\\V1\\GPBMetadata\xea\x02\x1cDomain::IntegrationEvent::V1b\x06proto3"
 
 var (
@@ -1100,61 +1760,91 @@ func file_domain_integration_event_v1_payment_events_proto_rawDescGZIP() []byte
 	return file_domain_integration_event_v1_payment_events_proto_rawDescData
 }
 
-var file_domain_integration_event_v1_payment_events_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_domain_integration_event_v1_payment_events_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_domain_integration_event_v1_payment_events_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_domain_integration_event_v1_payment_events_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_domain_integration_event_v1_payment_events_proto_goTypes = []any{
-	(PaymentKind)(0),                      // 0: domain.integration_event.v1.PaymentKind
-	(CaptureMode)(0),                      // 1: domain.integration_event.v1.CaptureMode
-	(CancelReason)(0),                     // 2: domain.integration_event.v1.CancelReason
-	(FailureReason)(0),                    // 3: domain.integration_event.v1.FailureReason
-	(*EventMeta)(nil),                     // 4: domain.integration_event.v1.EventMeta
-	(*PaymentCreated)(nil),                // 5: domain.integration_event.v1.PaymentCreated
-	(*PaymentWaitingForConfirmation)(nil), // 6: domain.integration_event.v1.PaymentWaitingForConfirmation
-	(*PaymentAuthorized)(nil),             // 7: domain.integration_event.v1.PaymentAuthorized
-	(*PaymentPaid)(nil),                   // 8: domain.integration_event.v1.PaymentPaid
-	(*PaymentRefunded)(nil),               // 9: domain.integration_event.v1.PaymentRefunded
-	(*PaymentRefundFailed)(nil),           // 10: domain.integration_event.v1.PaymentRefundFailed
-	(*PaymentCanceled)(nil),               // 11: domain.integration_event.v1.PaymentCanceled
-	(*PaymentFailed)(nil),                 // 12: domain.integration_event.v1.PaymentFailed
-	(*PaymentEvent)(nil),                  // 13: domain.integration_event.v1.PaymentEvent
-	(*fieldmaskpb.FieldMask)(nil),         // 14: google.protobuf.FieldMask
-	(*money.Money)(nil),                   // 15: google.type.Money
+	(PaymentKind)(0),                        // 0: domain.integration_event.v1.PaymentKind
+	(CaptureMode)(0),                        // 1: domain.integration_event.v1.CaptureMode
+	(CancelReason)(0),                       // 2: domain.integration_event.v1.CancelReason
+	(FailureReason)(0),                      // 3: domain.integration_event.v1.FailureReason
+	(DisputeReason)(0),                      // 4: domain.integration_event.v1.DisputeReason
+	(RefundReason)(0),                       // 5: domain.integration_event.v1.RefundReason
+	(*EventMeta)(nil),                       // 6: domain.integration_event.v1.EventMeta
+	(*PaymentCreated)(nil),                  // 7: domain.integration_event.v1.PaymentCreated
+	(*PaymentWaitingForConfirmation)(nil),   // 8: domain.integration_event.v1.PaymentWaitingForConfirmation
+	(*PaymentAuthorized)(nil),               // 9: domain.integration_event.v1.PaymentAuthorized
+	(*PaymentPaid)(nil),                     // 10: domain.integration_event.v1.PaymentPaid
+	(*PaymentAuthorizationReleased)(nil),    // 11: domain.integration_event.v1.PaymentAuthorizationReleased
+	(*PaymentRefundPending)(nil),            // 12: domain.integration_event.v1.PaymentRefundPending
+	(*PaymentRefunded)(nil),                 // 13: domain.integration_event.v1.PaymentRefunded
+	(*PaymentRefundFailed)(nil),             // 14: domain.integration_event.v1.PaymentRefundFailed
+	(*PaymentCanceled)(nil),                 // 15: domain.integration_event.v1.PaymentCanceled
+	(*PaymentFailed)(nil),                   // 16: domain.integration_event.v1.PaymentFailed
+	(*PaymentDisputeOpened)(nil),            // 17: domain.integration_event.v1.PaymentDisputeOpened
+	(*PaymentDisputeEvidenceSubmitted)(nil), // 18: domain.integration_event.v1.PaymentDisputeEvidenceSubmitted
+	(*PaymentDisputeWon)(nil),               // 19: domain.integration_event.v1.PaymentDisputeWon
+	(*PaymentDisputeLost)(nil),              // 20: domain.integration_event.v1.PaymentDisputeLost
+	(*PaymentEvent)(nil),                    // 21: domain.integration_event.v1.PaymentEvent
+	(*fieldmaskpb.FieldMask)(nil),           // 22: google.protobuf.FieldMask
+	(*money.Money)(nil),                     // 23: google.type.Money
 }
 var file_domain_integration_event_v1_payment_events_proto_depIdxs = []int32{
-	14, // 0: domain.integration_event.v1.EventMeta.field_mask:type_name -> google.protobuf.FieldMask
-	15, // 1: domain.integration_event.v1.PaymentCreated.amount:type_name -> google.type.Money
+	22, // 0: domain.integration_event.v1.EventMeta.field_mask:type_name -> google.protobuf.FieldMask
+	23, // 1: domain.integration_event.v1.PaymentCreated.amount:type_name -> google.type.Money
 	0,  // 2: domain.integration_event.v1.PaymentCreated.kind:type_name -> domain.integration_event.v1.PaymentKind
 	1,  // 3: domain.integration_event.v1.PaymentCreated.capture_mode:type_name -> domain.integration_event.v1.CaptureMode
-	14, // 4: domain.integration_event.v1.PaymentCreated.field_mask:type_name -> google.protobuf.FieldMask
-	14, // 5: domain.integration_event.v1.PaymentWaitingForConfirmation.field_mask:type_name -> google.protobuf.FieldMask
-	15, // 6: domain.integration_event.v1.PaymentAuthorized.authorized_amount:type_name -> google.type.Money
-	14, // 7: domain.integration_event.v1.PaymentAuthorized.field_mask:type_name -> google.protobuf.FieldMask
-	15, // 8: domain.integration_event.v1.PaymentPaid.captured_amount:type_name -> google.type.Money
-	14, // 9: domain.integration_event.v1.PaymentPaid.field_mask:type_name -> google.protobuf.FieldMask
-	15, // 10: domain.integration_event.v1.PaymentRefunded.refund_amount:type_name -> google.type.Money
-	15, // 11: domain.integration_event.v1.PaymentRefunded.total_refunded:type_name -> google.type.Money
-	14, // 12: domain.integration_event.v1.PaymentRefunded.field_mask:type_name -> google.protobuf.FieldMask
-	3,  // 13: domain.integration_event.v1.PaymentRefundFailed.reason:type_name -> domain.integration_event.v1.FailureReason
-	14, // 14: domain.integration_event.v1.PaymentRefundFailed.field_mask:type_name -> google.protobuf.FieldMask
-	2,  // 15: domain.integration_event.v1.PaymentCanceled.reason:type_name -> domain.integration_event.v1.CancelReason
-	14, // 16: domain.integration_event.v1.PaymentCanceled.field_mask:type_name -> google.protobuf.FieldMask
-	3,  // 17: domain.integration_event.v1.PaymentFailed.reason:type_name -> domain.integration_event.v1.FailureReason
-	14, // 18: domain.integration_event.v1.PaymentFailed.field_mask:type_name -> google.protobuf.FieldMask
-	4,  // 19: domain.integration_event.v1.PaymentEvent.meta:type_name -> domain.integration_event.v1.EventMeta
-	5,  // 20: domain.integration_event.v1.PaymentEvent.created:type_name -> domain.integration_event.v1.PaymentCreated
-	6,  // 21: domain.integration_event.v1.PaymentEvent.waiting_for_confirmation:type_name -> domain.integration_event.v1.PaymentWaitingForConfirmation
-	7,  // 22: domain.integration_event.v1.PaymentEvent.authorized:type_name -> domain.integration_event.v1.PaymentAuthorized
-	8,  // 23: domain.integration_event.v1.PaymentEvent.paid:type_name -> domain.integration_event.v1.PaymentPaid
-	9,  // 24: domain.integration_event.v1.PaymentEvent.refunded:type_name -> domain.integration_event.v1.PaymentRefunded
-	10, // 25: domain.integration_event.v1.PaymentEvent.refund_failed:type_name -> domain.integration_event.v1.PaymentRefundFailed
-	11, // 26: domain.integration_event.v1.PaymentEvent.canceled:type_name -> domain.integration_event.v1.PaymentCanceled
-	12, // 27: domain.integration_event.v1.PaymentEvent.failed:type_name -> domain.integration_event.v1.PaymentFailed
-	14, // 28: domain.integration_event.v1.PaymentEvent.field_mask:type_name -> google.protobuf.FieldMask
-	29, // [29:29] is the sub-list for method output_type
-	29, // [29:29] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	22, // 4: domain.integration_event.v1.PaymentCreated.field_mask:type_name -> google.protobuf.FieldMask
+	22, // 5: domain.integration_event.v1.PaymentWaitingForConfirmation.field_mask:type_name -> google.protobuf.FieldMask
+	23, // 6: domain.integration_event.v1.PaymentAuthorized.authorized_amount:type_name -> google.type.Money
+	23, // 7: domain.integration_event.v1.PaymentAuthorized.amount:type_name -> google.type.Money
+	22, // 8: domain.integration_event.v1.PaymentAuthorized.field_mask:type_name -> google.protobuf.FieldMask
+	23, // 9: domain.integration_event.v1.PaymentPaid.captured_amount:type_name -> google.type.Money
+	22, // 10: domain.integration_event.v1.PaymentPaid.field_mask:type_name -> google.protobuf.FieldMask
+	23, // 11: domain.integration_event.v1.PaymentAuthorizationReleased.released_amount:type_name -> google.type.Money
+	22, // 12: domain.integration_event.v1.PaymentAuthorizationReleased.field_mask:type_name -> google.protobuf.FieldMask
+	23, // 13: domain.integration_event.v1.PaymentRefundPending.amount:type_name -> google.type.Money
+	5,  // 14: domain.integration_event.v1.PaymentRefundPending.reason:type_name -> domain.integration_event.v1.RefundReason
+	22, // 15: domain.integration_event.v1.PaymentRefundPending.field_mask:type_name -> google.protobuf.FieldMask
+	23, // 16: domain.integration_event.v1.PaymentRefunded.refund_amount:type_name -> google.type.Money
+	23, // 17: domain.integration_event.v1.PaymentRefunded.total_refunded:type_name -> google.type.Money
+	5,  // 18: domain.integration_event.v1.PaymentRefunded.reason:type_name -> domain.integration_event.v1.RefundReason
+	22, // 19: domain.integration_event.v1.PaymentRefunded.field_mask:type_name -> google.protobuf.FieldMask
+	3,  // 20: domain.integration_event.v1.PaymentRefundFailed.reason:type_name -> domain.integration_event.v1.FailureReason
+	23, // 21: domain.integration_event.v1.PaymentRefundFailed.amount:type_name -> google.type.Money
+	22, // 22: domain.integration_event.v1.PaymentRefundFailed.field_mask:type_name -> google.protobuf.FieldMask
+	2,  // 23: domain.integration_event.v1.PaymentCanceled.reason:type_name -> domain.integration_event.v1.CancelReason
+	22, // 24: domain.integration_event.v1.PaymentCanceled.field_mask:type_name -> google.protobuf.FieldMask
+	3,  // 25: domain.integration_event.v1.PaymentFailed.reason:type_name -> domain.integration_event.v1.FailureReason
+	22, // 26: domain.integration_event.v1.PaymentFailed.field_mask:type_name -> google.protobuf.FieldMask
+	23, // 27: domain.integration_event.v1.PaymentDisputeOpened.amount:type_name -> google.type.Money
+	4,  // 28: domain.integration_event.v1.PaymentDisputeOpened.reason:type_name -> domain.integration_event.v1.DisputeReason
+	22, // 29: domain.integration_event.v1.PaymentDisputeOpened.field_mask:type_name -> google.protobuf.FieldMask
+	22, // 30: domain.integration_event.v1.PaymentDisputeEvidenceSubmitted.field_mask:type_name -> google.protobuf.FieldMask
+	22, // 31: domain.integration_event.v1.PaymentDisputeWon.field_mask:type_name -> google.protobuf.FieldMask
+	23, // 32: domain.integration_event.v1.PaymentDisputeLost.reversed_amount:type_name -> google.type.Money
+	23, // 33: domain.integration_event.v1.PaymentDisputeLost.total_reversed:type_name -> google.type.Money
+	22, // 34: domain.integration_event.v1.PaymentDisputeLost.field_mask:type_name -> google.protobuf.FieldMask
+	6,  // 35: domain.integration_event.v1.PaymentEvent.meta:type_name -> domain.integration_event.v1.EventMeta
+	7,  // 36: domain.integration_event.v1.PaymentEvent.created:type_name -> domain.integration_event.v1.PaymentCreated
+	8,  // 37: domain.integration_event.v1.PaymentEvent.waiting_for_confirmation:type_name -> domain.integration_event.v1.PaymentWaitingForConfirmation
+	9,  // 38: domain.integration_event.v1.PaymentEvent.authorized:type_name -> domain.integration_event.v1.PaymentAuthorized
+	10, // 39: domain.integration_event.v1.PaymentEvent.paid:type_name -> domain.integration_event.v1.PaymentPaid
+	13, // 40: domain.integration_event.v1.PaymentEvent.refunded:type_name -> domain.integration_event.v1.PaymentRefunded
+	14, // 41: domain.integration_event.v1.PaymentEvent.refund_failed:type_name -> domain.integration_event.v1.PaymentRefundFailed
+	15, // 42: domain.integration_event.v1.PaymentEvent.canceled:type_name -> domain.integration_event.v1.PaymentCanceled
+	16, // 43: domain.integration_event.v1.PaymentEvent.failed:type_name -> domain.integration_event.v1.PaymentFailed
+	17, // 44: domain.integration_event.v1.PaymentEvent.dispute_opened:type_name -> domain.integration_event.v1.PaymentDisputeOpened
+	18, // 45: domain.integration_event.v1.PaymentEvent.dispute_evidence_submitted:type_name -> domain.integration_event.v1.PaymentDisputeEvidenceSubmitted
+	19, // 46: domain.integration_event.v1.PaymentEvent.dispute_won:type_name -> domain.integration_event.v1.PaymentDisputeWon
+	20, // 47: domain.integration_event.v1.PaymentEvent.dispute_lost:type_name -> domain.integration_event.v1.PaymentDisputeLost
+	12, // 48: domain.integration_event.v1.PaymentEvent.refund_pending:type_name -> domain.integration_event.v1.PaymentRefundPending
+	11, // 49: domain.integration_event.v1.PaymentEvent.authorization_released:type_name -> domain.integration_event.v1.PaymentAuthorizationReleased
+	22, // 50: domain.integration_event.v1.PaymentEvent.field_mask:type_name -> google.protobuf.FieldMask
+	51, // [51:51] is the sub-list for method output_type
+	51, // [51:51] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_domain_integration_event_v1_payment_events_proto_init() }
@@ -1162,7 +1852,7 @@ func file_domain_integration_event_v1_payment_events_proto_init() {
 	if File_domain_integration_event_v1_payment_events_proto != nil {
 		return
 	}
-	file_domain_integration_event_v1_payment_events_proto_msgTypes[9].OneofWrappers = []any{
+	file_domain_integration_event_v1_payment_events_proto_msgTypes[15].OneofWrappers = []any{
 		(*PaymentEvent_Created)(nil),
 		(*PaymentEvent_WaitingForConfirmation)(nil),
 		(*PaymentEvent_Authorized)(nil),
@@ -1171,14 +1861,20 @@ func file_domain_integration_event_v1_payment_events_proto_init() {
 		(*PaymentEvent_RefundFailed)(nil),
 		(*PaymentEvent_Canceled)(nil),
 		(*PaymentEvent_Failed)(nil),
+		(*PaymentEvent_DisputeOpened)(nil),
+		(*PaymentEvent_DisputeEvidenceSubmitted)(nil),
+		(*PaymentEvent_DisputeWon)(nil),
+		(*PaymentEvent_DisputeLost)(nil),
+		(*PaymentEvent_RefundPending)(nil),
+		(*PaymentEvent_AuthorizationReleased)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_domain_integration_event_v1_payment_events_proto_rawDesc), len(file_domain_integration_event_v1_payment_events_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   10,
+			NumEnums:      6,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,6 +21,14 @@ type Payment struct {
 	kind        eventv1.PaymentKind
 	captureMode eventv1.CaptureMode
 
+	provider    string // set by PaymentProviderAttached
+	providerID  string
+	disputeID   string // provider dispute ID, set by PaymentDisputeOpened
+	sca         SCADecision
+	captures    int // PaymentPaid events, for the capture count limit
+	refunds     []Refund
+	settlements []Settlement
+
 	state   flowv1.PaymentFlow
 	Ledger  ledger.Ledger
 	version uint64
@@ -54,6 +62,9 @@ func New(id uuid.UUID, invoiceID uuid.UUID, amount *money.Money, kind eventv1.Pa
 	if !p.policy.IsCurrencySupported(p.Ledger.Amount.GetCurrencyCode()) {
 		return nil, ErrUnsupportedCurrency
 	}
+	if err := satisfies(p.policy.Specifications().Create, &Creation{Amount: p.Ledger.Amount, Kind: kind, Mode: mode}); err != nil {
+		return nil, err
+	}
 
 	// Emit PaymentCreated (UUIDs as bytes)
 	inv := p.invoiceID
@@ -74,8 +85,14 @@ func New(id uuid.UUID, invoiceID uuid.UUID, amount *money.Money, kind eventv1.Pa
 // Accessors
 func (p *Payment) ID() uuid.UUID                      { return p.id }
 func (p *Payment) InvoiceID() uuid.UUID               { return p.invoiceID }
+func (p *Payment) Kind() eventv1.PaymentKind          { return p.kind }
+func (p *Payment) CaptureMode() eventv1.CaptureMode   { return p.captureMode }
 func (p *Payment) State() flowv1.PaymentFlow          { return p.state }
 func (p *Payment) Version() uint64                    { return p.version }
+func (p *Payment) Provider() string                   { return p.provider }
+func (p *Payment) ProviderID() string                 { return p.providerID }
+func (p *Payment) DisputeID() string                  { return p.disputeID }
+func (p *Payment) SCA() SCADecision                   { return p.sca }
 func (p *Payment) UncommittedEvents() []proto.Message { return p.uncommitted }
 func (p *Payment) ClearUncommitted()                  { p.uncommitted = nil }
 
@@ -104,11 +121,23 @@ func (p *Payment) apply(e proto.Message) error {
 		p.state = flowv1.PaymentFlow_PAYMENT_FLOW_CREATED
 		p.version = ev.GetMeta().GetVersion()
 
+	case *eventv1.PaymentProviderAttached:
+		p.provider = ev.GetProvider()
+		p.providerID = ev.GetProviderId()
+		p.version = ev.GetMeta().GetVersion()
+
+	case *eventv1.PaymentSCAEvaluated:
+		p.sca = SCADecision{Required: ev.GetRequired(), Exemption: ev.GetExemption(), Rule: ev.GetRule()}
+		p.version = ev.GetMeta().GetVersion()
+
 	case *eventv1.PaymentWaitingForConfirmation:
 		p.state = flowv1.PaymentFlow_PAYMENT_FLOW_WAITING_FOR_CONFIRMATION
 		p.version = ev.GetMeta().GetVersion()
 
 	case *eventv1.PaymentAuthorized:
+		if ev.GetAmount() != nil {
+			p.Ledger.Amount = ledger.Clone(ev.GetAmount())
+		}
 		if p.Ledger.Authorized == nil {
 			p.Ledger.Authorized = ledger.Clone(ev.GetAuthorizedAmount())
 		} else {
@@ -125,12 +154,42 @@ func (p *Payment) apply(e proto.Message) error {
 			sum, _ := ledger.Add(p.Ledger.Captured, ev.GetCapturedAmount())
 			p.Ledger.Captured = sum
 		}
+		p.captures++
 		p.state = flowv1.PaymentFlow_PAYMENT_FLOW_PAID
 		p.version = ev.GetMeta().GetVersion()
 
+	case *eventv1.PaymentAuthorizationReleased:
+		// Deterministic rehydration: event carries the new total.
+		p.Ledger.Released = ledger.Clone(ev.GetTotalReleased())
+		p.version = ev.GetMeta().GetVersion()
+
+	case *eventv1.PaymentRefundRequested:
+		// Deterministic rehydration: event carries the new total.
+		p.Ledger.PendingRefunded = ledger.Clone(ev.GetTotalPending())
+		p.refunds = append(p.refunds, Refund{
+			ID:               refundID(ev.GetRefundId()),
+			ProviderRefundID: ev.GetProviderRefundId(),
+			Amount:           ledger.Clone(ev.GetAmount()),
+			Reason:           ev.GetReason(),
+			Status:           RefundStatusPending,
+		})
+		p.version = ev.GetMeta().GetVersion()
+
 	case *eventv1.PaymentRefunded:
 		// Deterministic rehydration: event carries the new total.
 		p.Ledger.TotalRefunded = ledger.Clone(ev.GetTotalRefunded())
+		if r := p.refund(refundID(ev.GetRefundId())); r != nil && r.Status == RefundStatusPending {
+			p.Ledger.PendingRefunded = releasePending(p.Ledger.PendingRefunded, r.Amount)
+			r.Status = RefundStatusSucceeded
+		} else {
+			p.refunds = append(p.refunds, Refund{
+				ID:               refundID(ev.GetRefundId()),
+				ProviderRefundID: ev.GetProviderRefundId(),
+				Amount:           ledger.Clone(ev.GetRefundAmount()),
+				Reason:           ev.GetReason(),
+				Status:           RefundStatusSucceeded,
+			})
+		}
 		if ev.GetFull() {
 			p.state = flowv1.PaymentFlow_PAYMENT_FLOW_REFUNDED
 		} else {
@@ -139,7 +198,11 @@ func (p *Payment) apply(e proto.Message) error {
 		p.version = ev.GetMeta().GetVersion()
 
 	case *eventv1.PaymentRefundFailed:
-		// State unchanged; only version++
+		// State unchanged; a pending refund releases its amount.
+		if r := p.refund(refundID(ev.GetRefundId())); r != nil && r.Status == RefundStatusPending {
+			p.Ledger.PendingRefunded = releasePending(p.Ledger.PendingRefunded, r.Amount)
+			r.Status = RefundStatusFailed
+		}
 		p.version = ev.GetMeta().GetVersion()
 
 	case *eventv1.PaymentCanceled:
@@ -149,6 +212,42 @@ func (p *Payment) apply(e proto.Message) error {
 	case *eventv1.PaymentFailed:
 		p.state = flowv1.PaymentFlow_PAYMENT_FLOW_FAILED
 		p.version = ev.GetMeta().GetVersion()
+
+	case *eventv1.PaymentDisputeOpened:
+		p.Ledger.Disputed = ledger.Clone(ev.GetAmount())
+		p.disputeID = ev.GetDisputeId()
+		p.state = flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED
+		p.version = ev.GetMeta().GetVersion()
+
+	case *eventv1.PaymentDisputeEvidenceSubmitted:
+		// State unchanged; only version++
+		p.version = ev.GetMeta().GetVersion()
+
+	case *eventv1.PaymentDisputeWon:
+		p.Ledger.Disputed = nil
+		p.state = flowv1.PaymentFlow_PAYMENT_FLOW_PAID
+		p.version = ev.GetMeta().GetVersion()
+
+	case *eventv1.PaymentDisputeLost:
+		// Deterministic rehydration: event carries the new total.
+		p.Ledger.Reversed = ledger.Clone(ev.GetTotalReversed())
+		p.Ledger.Disputed = nil
+		if ev.GetFull() {
+			p.state = flowv1.PaymentFlow_PAYMENT_FLOW_CHARGED_BACK
+		} else {
+			p.state = flowv1.PaymentFlow_PAYMENT_FLOW_PAID
+		}
+		p.version = ev.GetMeta().GetVersion()
+
+	case *eventv1.PaymentSettled:
+		// State unchanged. Deterministic rehydration: event carries the new totals.
+		p.Ledger.Fees = ledger.Clone(ev.GetTotalFees())
+		p.Ledger.Net = ledger.Clone(ev.GetTotalNet())
+		if at := ev.GetSettledAt().AsTime(); at.After(p.Ledger.SettledAt) {
+			p.Ledger.SettledAt = at
+		}
+		p.settlements = append(p.settlements, SettlementOf(ev))
+		p.version = ev.GetMeta().GetVersion()
 	}
 
 	// Keep FSM in sync with the latest state
@@ -156,11 +255,21 @@ func (p *Payment) apply(e proto.Message) error {
 	return nil
 }
 
+// releasePending subtracts a settled or failed refund from the pending total; nil once nothing is pending.
+func releasePending(pending, amt *money.Money) *money.Money {
+	left, err := ledger.Sub(pending, amt)
+	if err != nil || ledger.Compare(left, ledger.Zero(left.GetCurrencyCode())) <= 0 {
+		return nil
+	}
+	return left
+}
+
 func (p *Payment) isTerminal() bool {
 	switch p.state {
 	case flowv1.PaymentFlow_PAYMENT_FLOW_REFUNDED,
 		flowv1.PaymentFlow_PAYMENT_FLOW_CANCELED,
-		flowv1.PaymentFlow_PAYMENT_FLOW_FAILED:
+		flowv1.PaymentFlow_PAYMENT_FLOW_FAILED,
+		flowv1.PaymentFlow_PAYMENT_FLOW_CHARGED_BACK:
 		return true
 	default:
 		return false
@@ -169,9 +278,10 @@ func (p *Payment) isTerminal() bool {
 
 func (p *Payment) metaNext() *eventv1.EventMeta {
 	p.version++
-	pid := p.id
+	pid, inv := p.id, p.invoiceID
 	return &eventv1.EventMeta{
 		PaymentId: pid[:], // proto expects bytes (16)
+		InvoiceId: inv[:],
 		Version:   p.version,
 		// EventId is set by outbox/publisher layer.
 	}
@@ -191,7 +301,10 @@ func (p *Payment) Invariants() error {
 	if !p.policy.IsCurrencySupported(cur) {
 		return ErrUnsupportedCurrency
 	}
-	for _, m := range []*money.Money{p.Ledger.Authorized, p.Ledger.Captured, p.Ledger.TotalRefunded} {
+	for _, m := range []*money.Money{
+		p.Ledger.Authorized, p.Ledger.Captured, p.Ledger.Released, p.Ledger.TotalRefunded,
+		p.Ledger.PendingRefunded, p.Ledger.Disputed, p.Ledger.Reversed,
+	} {
 		if m == nil {
 			continue
 		}
@@ -214,12 +327,59 @@ func (p *Payment) Invariants() error {
 		return ErrInvariantViolation
 	}
 
+	// Captured + Released ≤ Authorized: only an uncaptured hold can be released
+	if p.Ledger.Released != nil {
+		if p.Ledger.Authorized == nil {
+			return ErrInvariantViolation
+		}
+		held := ledger.Clone(p.Ledger.Released)
+		if p.Ledger.Captured != nil {
+			held, _ = ledger.Add(p.Ledger.Captured, p.Ledger.Released)
+		}
+		if ledger.Compare(held, p.Ledger.Authorized) > 0 {
+			return ErrInvariantViolation
+		}
+	}
+
 	// TotalRefunded ≤ Captured
 	if p.Ledger.TotalRefunded != nil && p.Ledger.Captured != nil &&
 		ledger.Compare(p.Ledger.TotalRefunded, p.Ledger.Captured) > 0 {
 		return ErrInvariantViolation
 	}
 
+	// Pending refunds and disputes need captured funds:
+	// TotalRefunded + PendingRefunded + Reversed + Disputed ≤ Captured
+	if (p.Ledger.PendingRefunded != nil || p.Ledger.Disputed != nil || p.Ledger.Reversed != nil) &&
+		p.Ledger.Captured == nil {
+		return ErrInvariantViolation
+	}
+	if p.Ledger.Captured != nil && ledger.Compare(p.Ledger.Refundable(), ledger.Zero(cur)) < 0 {
+		return ErrInvariantViolation
+	}
+
+	// An open dispute withholds funds, and only DISPUTED has one.
+	if (p.state == flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED) != (p.Ledger.Disputed != nil) {
+		return ErrInvariantViolation
+	}
+
+	// Fees and Net share the settlement currency, need captured funds and are set with SettledAt.
+	if (p.Ledger.Fees == nil) != (p.Ledger.Net == nil) || (p.Ledger.Net == nil) != p.Ledger.SettledAt.IsZero() {
+		return ErrInvariantViolation
+	}
+	if p.Ledger.Net != nil {
+		if p.Ledger.Captured == nil || ledger.Currency(p.Ledger.Fees) != ledger.Currency(p.Ledger.Net) {
+			return ErrInvariantViolation
+		}
+		// Fees ≥ 0: refunded fees never exceed the fees charged
+		if ledger.Compare(p.Ledger.Fees, ledger.Zero(ledger.Currency(p.Ledger.Fees))) < 0 {
+			return ErrInvariantViolation
+		}
+		// Settled charges ≤ Captured
+		if ledger.Compare(p.settledCharges(), p.Ledger.Captured) > 0 {
+			return ErrInvariantViolation
+		}
+	}
+
 	// Policy: CREATED->PAID immediate capture not allowed for MANUAL mode (no auth recorded).
 	if p.state == flowv1.PaymentFlow_PAYMENT_FLOW_PAID &&
 		p.captureMode == eventv1.CaptureMode_CAPTURE_MODE_MANUAL &&
@@ -4,14 +4,80 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+
 	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
 	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
 	"github.com/shortlink-org/billing/payments/internal/domain/payment/fsm"
 	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
 
 	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// AttachProvider records the provider and its object ID (no state change).
+// Re-attaching the same reference is a no-op; a different one is rejected.
+func (p *Payment) AttachProvider(ctx context.Context, provider, providerID string) error {
+	_ = ctx
+	if provider == "" || providerID == "" {
+		return ErrInvalidArgs
+	}
+	if p.isTerminal() {
+		return ErrTerminalState
+	}
+	if p.provider != "" {
+		if p.provider == provider && p.providerID == providerID {
+			return nil
+		}
+		return ErrProviderAttached
+	}
+
+	ev := &eventv1.PaymentProviderAttached{
+		Meta:       p.metaNext(),
+		Provider:   provider,
+		ProviderId: providerID,
+	}
+	if err := p.apply(ev); err != nil {
+		return err
+	}
+	p.record(ev)
+	return nil
+}
+
+// EvaluateSCA asks the policy whether SCA/3DS is requested for this payment and records
+// the decision with the rule that matched (no state change). Only allowed in CREATED.
+func (p *Payment) EvaluateSCA(ctx context.Context, region string, merchantInitiated bool) (SCADecision, error) {
+	_ = ctx
+	if p.state != flowv1.PaymentFlow_PAYMENT_FLOW_CREATED {
+		return SCADecision{}, ErrInvalidTransition
+	}
+
+	d := p.policy.ShouldRequireSCA(SCAContext{
+		Amount:            ledger.Clone(p.Ledger.Amount),
+		Kind:              p.kind,
+		Mode:              p.captureMode,
+		Region:            region,
+		MerchantInitiated: merchantInitiated,
+	})
+	if d.Required {
+		d.Exemption = eventv1.SCAExemption_SCA_EXEMPTION_UNSPECIFIED
+	}
+
+	ev := &eventv1.PaymentSCAEvaluated{
+		Meta:              p.metaNext(),
+		Required:          d.Required,
+		Exemption:         d.Exemption,
+		Rule:              d.Rule,
+		Region:            region,
+		MerchantInitiated: merchantInitiated,
+	}
+	if err := p.apply(ev); err != nil {
+		return SCADecision{}, err
+	}
+	p.record(ev)
+	return d, nil
+}
+
 // RequireSCA: CREATED -> WAITING_FOR_CONFIRMATION
 func (p *Payment) RequireSCA(ctx context.Context) error {
 	if p.isTerminal() {
@@ -74,6 +140,39 @@ func (p *Payment) Authorize(ctx context.Context, amt *money.Money) error {
 	return nil
 }
 
+// IncreaseAuthorization records a hold the provider raised by amt (e.g. a hotel stay extended).
+// AUTHORIZED only; the payment amount grows with the hold when it would be exceeded,
+// and the raised amount must still satisfy the creation specifications.
+func (p *Payment) IncreaseAuthorization(ctx context.Context, amt *money.Money) error {
+	_ = ctx
+	if p.isTerminal() {
+		return ErrTerminalState
+	}
+	if p.state != flowv1.PaymentFlow_PAYMENT_FLOW_AUTHORIZED {
+		return ErrInvalidTransition
+	}
+	if amt == nil || ledger.Compare(amt, ledger.Zero(amt.GetCurrencyCode())) <= 0 {
+		return ErrInvalidArgs
+	}
+
+	if err := p.CheckIncrease(p.policy.Specifications().Create, amt); err != nil {
+		return err
+	}
+
+	ev := &eventv1.PaymentAuthorized{
+		Meta:             p.metaNext(),
+		AuthorizedAmount: ledger.Clone(amt),
+	}
+	if next, _ := ledger.Add(p.Ledger.Authorized, amt); ledger.Compare(next, p.Ledger.Amount) > 0 {
+		ev.Amount = next
+	}
+	if err := p.apply(ev); err != nil {
+		return err
+	}
+	p.record(ev)
+	return nil
+}
+
 // Confirm: WAITING_FOR_CONFIRMATION -> AUTHORIZED (incremental authorize)
 func (p *Payment) Confirm(ctx context.Context, amt *money.Money) error {
 	if p.isTerminal() {
@@ -126,6 +225,11 @@ func (p *Payment) Capture(ctx context.Context, amt *money.Money) error {
 		return ErrPolicyCaptureMode
 	}
 
+	// A final capture closed the authorization
+	if p.Ledger.Released != nil {
+		return ErrAuthorizationClosed
+	}
+
 	// Validate next captured ≤ limit
 	cur := ledger.Clone(p.Ledger.Captured)
 	if cur == nil {
@@ -171,26 +275,163 @@ func (p *Payment) Capture(ctx context.Context, amt *money.Money) error {
 	return nil
 }
 
-// Refund: partial -> stay PAID; full -> FSM refund_full -> REFUNDED.
+// ReleaseAuthorization records that the provider released the uncaptured remainder of the
+// authorization after a final capture. State stays PAID; further captures are rejected.
+// Returns the released amount, nil when nothing was left to release.
+func (p *Payment) ReleaseAuthorization(ctx context.Context) (*money.Money, error) {
+	_ = ctx
+	if p.isTerminal() {
+		return nil, ErrTerminalState
+	}
+	if p.state != flowv1.PaymentFlow_PAYMENT_FLOW_PAID {
+		return nil, ErrInvalidTransition
+	}
+
+	rem := p.Ledger.RemainingToCapture()
+	if ledger.Compare(rem, ledger.Zero(rem.GetCurrencyCode())) <= 0 {
+		return nil, nil
+	}
+	l := p.Ledger
+	if err := l.Release(rem); err != nil {
+		return nil, err
+	}
+
+	ev := &eventv1.PaymentAuthorizationReleased{
+		Meta:           p.metaNext(),
+		ReleasedAmount: ledger.Clone(rem),
+		TotalReleased:  ledger.Clone(l.Released), // carry new total for deterministic rehydration
+	}
+	if err := p.apply(ev); err != nil {
+		return nil, err
+	}
+	p.record(ev)
+	return rem, nil
+}
+
+// Refund records a refund that already succeeded at the provider, e.g. one only known
+// from the provider's running totals. It gets a fresh refund ID.
+// Partial -> stay PAID; full -> FSM refund_full -> REFUNDED.
 func (p *Payment) Refund(ctx context.Context, amt *money.Money) (bool, error) {
+	return p.RequestRefund(ctx, Refund{ID: uuid.New(), Amount: amt, Status: RefundStatusSucceeded})
+}
+
+// RequestRefund records a refund the provider has accepted.
+// Status PENDING reserves the amount until SettleRefund or FailRefund; SUCCEEDED refunds at once.
+// Validation: amt ≤ Refundable (captured, neither refunded, pending, reversed nor withheld).
+// Reversed funds are gone already: full means TotalRefunded + Reversed == Captured.
+func (p *Payment) RequestRefund(ctx context.Context, r Refund) (bool, error) {
 	if p.isTerminal() {
 		return false, ErrTerminalState
 	}
+	if p.state == flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED {
+		return false, ErrDisputeOpen
+	}
 	if p.Ledger.Captured == nil {
 		return false, ledger.ErrRefundWithoutCapture
 	}
+	if r.ID == uuid.Nil || r.Amount == nil {
+		return false, ErrInvalidArgs
+	}
+	if p.refund(r.ID) != nil {
+		return false, fmt.Errorf("%w: %s", ErrRefundExists, r.ID)
+	}
+	if _, ok := p.FindProviderRefund(r.ProviderRefundID); ok {
+		return false, fmt.Errorf("%w: %s", ErrRefundExists, r.ProviderRefundID)
+	}
+	if ledger.Compare(r.Amount, ledger.Zero(r.Amount.GetCurrencyCode())) <= 0 {
+		return false, ledger.ErrNonPositiveAmount
+	}
+	if ledger.Currency(r.Amount) != ledger.Currency(p.Ledger.Captured) {
+		return false, ledger.ErrCurrencyMismatch
+	}
+	if ledger.Compare(r.Amount, p.Ledger.Refundable()) > 0 {
+		return false, ledger.ErrRefundExceeds
+	}
+
+	switch r.Status {
+	case RefundStatusPending:
+		pending := ledger.Clone(p.Ledger.PendingRefunded)
+		if pending == nil {
+			pending = ledger.Zero(p.Ledger.Captured.GetCurrencyCode())
+		}
+		next, err := ledger.Add(pending, r.Amount)
+		if err != nil {
+			return false, err
+		}
+
+		id := r.ID
+		ev := &eventv1.PaymentRefundRequested{
+			Meta:             p.metaNext(),
+			RefundId:         id[:],
+			ProviderRefundId: r.ProviderRefundID,
+			Amount:           ledger.Clone(r.Amount),
+			Reason:           r.Reason,
+			TotalPending:     next, // carry new total for deterministic rehydration
+		}
+		if err := p.apply(ev); err != nil {
+			return false, err
+		}
+		p.record(ev)
+		return false, nil
+	case RefundStatusSucceeded:
+		return p.refunded(ctx, r)
+	default:
+		return false, ErrInvalidArgs
+	}
+}
+
+// SettleRefund marks a pending refund as succeeded.
+// Partial -> stay PAID; full -> FSM refund_full -> REFUNDED.
+func (p *Payment) SettleRefund(ctx context.Context, id uuid.UUID) (bool, error) {
+	r := p.refund(id)
+	if r == nil {
+		return false, fmt.Errorf("%w: %s", ErrRefundNotFound, id)
+	}
+	if r.Status != RefundStatusPending {
+		return false, fmt.Errorf("%w: %s is %s", ErrRefundNotPending, id, r.Status)
+	}
+	return p.refunded(ctx, *r)
+}
+
+// FailRefund releases a pending refund: its amount becomes refundable again. State unchanged.
+func (p *Payment) FailRefund(ctx context.Context, id uuid.UUID, reason eventv1.FailureReason) error {
+	_ = ctx
+	r := p.refund(id)
+	if r == nil {
+		return fmt.Errorf("%w: %s", ErrRefundNotFound, id)
+	}
+	if r.Status != RefundStatusPending {
+		return fmt.Errorf("%w: %s is %s", ErrRefundNotPending, id, r.Status)
+	}
+
+	ev := &eventv1.PaymentRefundFailed{
+		Meta:     p.metaNext(),
+		Reason:   reason,
+		RefundId: id[:],
+		Amount:   ledger.Clone(r.Amount),
+	}
+	if err := p.apply(ev); err != nil {
+		return err
+	}
+	p.record(ev)
+	return nil
+}
+
+// refunded records PaymentRefunded for r, settling it if it is pending.
+func (p *Payment) refunded(ctx context.Context, r Refund) (bool, error) {
 	cur := ledger.Clone(p.Ledger.TotalRefunded)
 	if cur == nil {
 		cur = ledger.Zero(p.Ledger.Captured.GetCurrencyCode())
 	}
-	next, err := ledger.Add(cur, amt)
+	next, err := ledger.Add(cur, r.Amount)
 	if err != nil {
 		return false, err
 	}
-	if ledger.Compare(next, p.Ledger.Captured) > 0 {
+	settled := p.Ledger.Settled()
+	if ledger.Compare(next, settled) > 0 {
 		return false, ledger.ErrRefundExceeds
 	}
-	full := ledger.Compare(next, p.Ledger.Captured) == 0
+	full := ledger.Compare(next, settled) == 0
 
 	if full {
 		if err := p.guard.Trigger(ctx, fsm.EventRefundFull); err != nil {
@@ -198,11 +439,15 @@ func (p *Payment) Refund(ctx context.Context, amt *money.Money) (bool, error) {
 		}
 	}
 
+	id := r.ID
 	ev := &eventv1.PaymentRefunded{
-		Meta:          p.metaNext(),
-		RefundAmount:  ledger.Clone(amt),
-		TotalRefunded: ledger.Clone(next), // carry new total for deterministic rehydration
-		Full:          full,
+		Meta:             p.metaNext(),
+		RefundAmount:     ledger.Clone(r.Amount),
+		TotalRefunded:    ledger.Clone(next), // carry new total for deterministic rehydration
+		Full:             full,
+		RefundId:         id[:],
+		ProviderRefundId: r.ProviderRefundID,
+		Reason:           r.Reason,
 	}
 	if err := p.apply(ev); err != nil {
 		return false, err
@@ -212,6 +457,7 @@ func (p *Payment) Refund(ctx context.Context, amt *money.Money) (bool, error) {
 }
 
 // RefundFailed: stays in PAID; version++ only (enum reason).
+// Use it when the provider never accepted the refund; FailRefund releases an accepted one.
 func (p *Payment) RefundFailed(ctx context.Context, reason eventv1.FailureReason) {
 	_ = ctx
 	ev := &eventv1.PaymentRefundFailed{
@@ -259,3 +505,192 @@ func (p *Payment) Fail(ctx context.Context, reason eventv1.FailureReason) error
 	p.record(ev)
 	return nil
 }
+
+// OpenDispute: PAID -> DISPUTED; amt is withheld until the dispute is resolved.
+// Validation: amt ≤ Refundable (captured, neither refunded nor reversed).
+func (p *Payment) OpenDispute(ctx context.Context, amt *money.Money, reason eventv1.DisputeReason, disputeID string) error {
+	if p.isTerminal() {
+		return ErrTerminalState
+	}
+	if p.state != flowv1.PaymentFlow_PAYMENT_FLOW_PAID {
+		return ErrInvalidTransition
+	}
+	if amt == nil {
+		return ErrInvalidArgs
+	}
+	if ledger.Compare(amt, ledger.Zero(amt.GetCurrencyCode())) <= 0 {
+		return ledger.ErrNonPositiveAmount
+	}
+	if ledger.Currency(amt) != ledger.Currency(p.Ledger.Captured) {
+		return ledger.ErrCurrencyMismatch
+	}
+	if ledger.Compare(amt, p.Ledger.Refundable()) > 0 {
+		return ledger.ErrDisputeExceeds
+	}
+	if err := p.guard.Trigger(ctx, fsm.EventDisputeOpen); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidTransition, err)
+	}
+
+	ev := &eventv1.PaymentDisputeOpened{
+		Meta:      p.metaNext(),
+		Amount:    ledger.Clone(amt),
+		Reason:    reason,
+		DisputeId: disputeID,
+	}
+	if err := p.apply(ev); err != nil {
+		return err
+	}
+	p.record(ev)
+	return nil
+}
+
+// SubmitDisputeEvidence: stays in DISPUTED; version++ only.
+func (p *Payment) SubmitDisputeEvidence(ctx context.Context) error {
+	_ = ctx
+	if p.state != flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED {
+		return ErrInvalidTransition
+	}
+	ev := &eventv1.PaymentDisputeEvidenceSubmitted{
+		Meta: p.metaNext(),
+	}
+	if err := p.apply(ev); err != nil {
+		return err
+	}
+	p.record(ev)
+	return nil
+}
+
+// WinDispute: DISPUTED -> PAID; withheld funds are released.
+func (p *Payment) WinDispute(ctx context.Context) error {
+	if err := p.guard.Trigger(ctx, fsm.EventDisputeWon); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidTransition, err)
+	}
+	ev := &eventv1.PaymentDisputeWon{
+		Meta: p.metaNext(),
+	}
+	if err := p.apply(ev); err != nil {
+		return err
+	}
+	p.record(ev)
+	return nil
+}
+
+// LoseDispute: the disputed amount is reversed to the customer.
+// Partial -> back to PAID; full (TotalRefunded + Reversed == Captured) -> CHARGED_BACK.
+func (p *Payment) LoseDispute(ctx context.Context) (bool, error) {
+	if p.state != flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED {
+		return false, ErrInvalidTransition
+	}
+
+	amt := ledger.Clone(p.Ledger.Disputed)
+	cur := ledger.Clone(p.Ledger.Reversed)
+	if cur == nil {
+		cur = ledger.Zero(amt.GetCurrencyCode())
+	}
+	next, err := ledger.Add(cur, amt)
+	if err != nil {
+		return false, err
+	}
+
+	// Full when nothing is left to refund after the reversal.
+	refunded := ledger.Clone(p.Ledger.TotalRefunded)
+	if refunded == nil {
+		refunded = ledger.Zero(amt.GetCurrencyCode())
+	}
+	gone, err := ledger.Add(refunded, next)
+	if err != nil {
+		return false, err
+	}
+	full := ledger.Compare(gone, p.Ledger.Captured) >= 0
+
+	trigger := fsm.EventDisputeLost
+	if full {
+		trigger = fsm.EventChargeback
+	}
+	if err := p.guard.Trigger(ctx, trigger); err != nil {
+		return false, fmt.Errorf("%w: %s", ErrInvalidTransition, err)
+	}
+
+	ev := &eventv1.PaymentDisputeLost{
+		Meta:           p.metaNext(),
+		ReversedAmount: amt,
+		TotalReversed:  next, // carry new total for deterministic rehydration
+		Full:           full,
+	}
+	if err := p.apply(ev); err != nil {
+		return false, err
+	}
+	p.record(ev)
+	return full, nil
+}
+
+// Settle records a movement of the payment's funds in a provider payout: what the provider
+// credited, kept as fees and paid out (no state change). Funds settle after the payment is
+// over as well, so terminal states are allowed.
+// Validation: captured funds, settled charges ≤ Captured, one settlement currency, net = gross - fee.
+// A settlement recorded already returns ErrSettlementExists.
+func (p *Payment) Settle(ctx context.Context, s Settlement) error {
+	_ = ctx
+	if p.Ledger.Captured == nil {
+		return ledger.ErrSettleWithoutCapture
+	}
+	if s.ID == "" || s.PayoutID == "" || s.SettledAt.IsZero() ||
+		s.Amount == nil || s.Gross == nil || s.Fee == nil || s.Net == nil {
+		return ErrInvalidArgs
+	}
+	if _, ok := p.FindSettlement(s.ID); ok {
+		return fmt.Errorf("%w: %s", ErrSettlementExists, s.ID)
+	}
+	if ledger.Currency(s.Amount) != ledger.Currency(p.Ledger.Captured) {
+		return ledger.ErrCurrencyMismatch
+	}
+
+	switch s.Type {
+	case eventv1.SettlementType_SETTLEMENT_TYPE_CHARGE:
+		if ledger.Compare(s.Amount, ledger.Zero(s.Amount.GetCurrencyCode())) <= 0 {
+			return ledger.ErrNonPositiveAmount
+		}
+		charged, err := ledger.Add(p.settledCharges(), s.Amount)
+		if err != nil {
+			return err
+		}
+		if ledger.Compare(charged, p.Ledger.Captured) > 0 {
+			return ledger.ErrSettleExceeds
+		}
+	case eventv1.SettlementType_SETTLEMENT_TYPE_REFUND,
+		eventv1.SettlementType_SETTLEMENT_TYPE_DISPUTE,
+		eventv1.SettlementType_SETTLEMENT_TYPE_ADJUSTMENT:
+		// Funds move either way: a failed refund or a won dispute gives them back.
+	default:
+		return ErrInvalidArgs
+	}
+
+	l := p.Ledger
+	if err := l.Settle(s.Gross, s.Fee, s.Net, s.SettledAt); err != nil {
+		return err
+	}
+	fx, err := p.fxAdjustment(s)
+	if err != nil {
+		return err
+	}
+
+	ev := &eventv1.PaymentSettled{
+		Meta:         p.metaNext(),
+		SettlementId: s.ID,
+		PayoutId:     s.PayoutID,
+		Type:         s.Type,
+		Amount:       ledger.Clone(s.Amount),
+		Gross:        ledger.Clone(s.Gross),
+		Fee:          ledger.Clone(s.Fee),
+		FxAdjustment: fx,
+		Net:          ledger.Clone(s.Net),
+		SettledAt:    timestamppb.New(s.SettledAt),
+		TotalFees:    ledger.Clone(l.Fees), // carry new totals for deterministic rehydration
+		TotalNet:     ledger.Clone(l.Net),
+	}
+	if err := p.apply(ev); err != nil {
+		return err
+	}
+	p.record(ev)
+	return nil
+}
@@ -12,4 +12,12 @@ var (
 	ErrBadPaymentID        = errors.New("payment: invalid meta.payment_id bytes")
 	ErrBadInvoiceID        = errors.New("payment: invalid invoice_id bytes")
 	ErrVersionConflict     = errors.New("payment: version conflict")
+	ErrProviderAttached    = errors.New("payment: another provider reference is already attached")
+	ErrProviderNotAttached = errors.New("payment: no provider reference attached")
+	ErrDisputeOpen         = errors.New("payment: funds are withheld by an open dispute")
+	ErrRefundExists        = errors.New("payment: refund is already recorded")
+	ErrRefundNotFound      = errors.New("payment: refund not found")
+	ErrRefundNotPending    = errors.New("payment: refund is not pending")
+	ErrAuthorizationClosed = errors.New("payment: authorization remainder was released")
+	ErrSettlementExists    = errors.New("payment: settlement is already recorded")
 )
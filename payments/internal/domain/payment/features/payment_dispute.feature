@@ -0,0 +1,80 @@
+Feature: Disputes and chargebacks
+
+  Background:
+    And the amount is "USD 100.00"
+    And the payment kind is "ONE_TIME"
+    And the capture mode is "IMMEDIATE"
+
+  Scenario: Won dispute releases the withheld funds
+    Given a payment "71717171-0000-0000-0000-000000000001" is created for invoice "81818181-0000-0000-0000-000000000001"
+    When I capture "USD 100.00"
+    And a dispute of "USD 100.00" is opened with reason "FRAUDULENT"
+    Then the payment state must be "DISPUTED"
+    And the disputed amount equals "USD 100.00"
+
+    When dispute evidence is submitted
+    Then the payment state must still be "DISPUTED"
+
+    When the dispute is won
+    Then the payment state must be "PAID"
+    And the disputed amount equals "none"
+    And the uncommitted events include, in order:
+      | PaymentCreated                  |
+      | PaymentPaid                     |
+      | PaymentDisputeOpened            |
+      | PaymentDisputeEvidenceSubmitted |
+      | PaymentDisputeWon               |
+    And after rehydration the payment state is "PAID"
+
+  Scenario: Lost dispute on a partially refunded payment is a full chargeback
+    Given a payment "71717171-0000-0000-0000-000000000002" is created for invoice "81818181-0000-0000-0000-000000000002"
+    When I capture "USD 100.00"
+    And I refund "USD 30.00"
+    And a dispute of "USD 70.00" is opened with reason "PRODUCT_NOT_RECEIVED"
+    And the dispute is lost
+    Then the payment state must be "CHARGED_BACK"
+    And the total reversed equals "USD 70.00"
+    And the total refunded equals "USD 30.00"
+    And full refund flag is "true"
+    And the invariants hold
+    And after rehydration the payment state is "CHARGED_BACK"
+
+  Scenario: Partially lost dispute leaves the rest refundable
+    Given a payment "71717171-0000-0000-0000-000000000003" is created for invoice "81818181-0000-0000-0000-000000000003"
+    When I capture "USD 100.00"
+    And a dispute of "USD 40.00" is opened with reason "DUPLICATE"
+    And the dispute is lost
+    Then the payment state must be "PAID"
+    And the total reversed equals "USD 40.00"
+    And full refund flag is "false"
+
+    When I try to refund "USD 61.00"
+    Then the operation must be rejected
+
+    When I refund "USD 60.00"
+    Then the payment state must be "REFUNDED"
+    And full refund flag is "true"
+    And the invariants hold
+    And after rehydration the payment state is "REFUNDED"
+
+  Scenario: Refunds are blocked while a dispute is open
+    Given a payment "71717171-0000-0000-0000-000000000004" is created for invoice "81818181-0000-0000-0000-000000000004"
+    When I capture "USD 100.00"
+    And a dispute of "USD 50.00" is opened with reason "GENERAL"
+    And I try to refund "USD 10.00"
+    Then the operation must be rejected
+    And the payment state must still be "DISPUTED"
+
+  Scenario: A dispute cannot exceed what is left to refund
+    Given a payment "71717171-0000-0000-0000-000000000005" is created for invoice "81818181-0000-0000-0000-000000000005"
+    When I capture "USD 100.00"
+    And I refund "USD 80.00"
+    And I try to open a dispute of "USD 30.00"
+    Then the operation must be rejected
+    And the payment state must still be "PAID"
+
+  Scenario: Only paid payments can be disputed
+    Given a payment "71717171-0000-0000-0000-000000000006" is created for invoice "81818181-0000-0000-0000-000000000006"
+    And I try to open a dispute of "USD 10.00"
+    Then the operation must be rejected
+    And the payment state must still be "CREATED"
@@ -0,0 +1,36 @@
+Feature: Incremental authorization raises the hold
+
+  Background:
+    Given the policy allows "USD" amounts from "USD 1.00" to "USD 500.00"
+    And the amount is "USD 200.00"
+    And the payment kind is "ONE_TIME"
+    And the capture mode is "MANUAL"
+
+  Scenario: Extending a stay raises the hold and the payment amount
+    Given a payment "55555555-6666-7777-8888-999999999999" is created for invoice "b5b5b5b5-b5b5-b5b5-b5b5-b5b5b5b5b5b5"
+    When I authorize "USD 200.00"
+    And I increase the authorization by "USD 150.00"
+    Then the payment state must be "AUTHORIZED"
+    And the authorized total equals "USD 350.00"
+    And the payment amount equals "USD 350.00"
+    And the uncommitted events include, in order:
+      | PaymentCreated    |
+      | PaymentAuthorized |
+      | PaymentAuthorized |
+    And after rehydration the payment state is "AUTHORIZED"
+
+    When I capture "USD 350.00"
+    Then the captured total equals "USD 350.00"
+
+  Scenario: The raised amount must stay within the policy
+    Given a payment "56565656-6666-7777-8888-999999999999" is created for invoice "b6b6b6b6-b6b6-b6b6-b6b6-b6b6b6b6b6b6"
+    When I authorize "USD 200.00"
+    And I try to increase the authorization by "USD 300.01"
+    Then the policy violations are "max_amount"
+    And the authorized total equals "USD 200.00"
+
+  Scenario: Only a held payment can be increased
+    Given a payment "57575757-6666-7777-8888-999999999999" is created for invoice "b7b7b7b7-b7b7-b7b7-b7b7-b7b7b7b7b7b7"
+    When I try to increase the authorization by "USD 10.00"
+    Then the operation must be rejected
+    And the payment state must still be "CREATED"
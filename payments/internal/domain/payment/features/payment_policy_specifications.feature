@@ -0,0 +1,44 @@
+Feature: Payment policy specifications
+
+  Background:
+    Given the policy allows "USD" amounts from "USD 1.00" to "USD 100.00"
+    And the policy allows "EUR" for "RECURRING" payments
+    And the policy allows at most 2 captures per payment
+    And the policy allows at most 1 refunds per payment
+    And the payment kind is "RECURRING"
+    And the capture mode is "MANUAL"
+
+  Scenario: Every failed creation rule is reported
+    Given the amount is "USD 0.50"
+    When I try to create payment "41414141-4141-4141-4141-414141414141" for invoice "a1a1a1a1-a1a1-a1a1-a1a1-a1a1a1a1a1a1"
+    Then the policy violations are "min_amount, currency"
+
+  Scenario: Amount above the maximum of its currency
+    Given the payment kind is "ONE_TIME"
+    And the amount is "USD 100.01"
+    When I try to create payment "42424242-4242-4242-4242-424242424242" for invoice "a2a2a2a2-a2a2-a2a2-a2a2-a2a2a2a2a2a2"
+    Then the policy violations are "max_amount"
+
+  Scenario: Currencies without limits are accepted
+    Given the amount is "EUR 5000.00"
+    And a payment "43434343-4343-4343-4343-434343434343" is created for invoice "a3a3a3a3-a3a3-a3a3-a3a3-a3a3a3a3a3a3"
+    Then the payment state must be "CREATED"
+
+  Scenario: Partial captures and refunds are limited
+    Given the amount is "EUR 30.00"
+    And a payment "44444444-4444-4444-4444-444444444444" is created for invoice "a4a4a4a4-a4a4-a4a4-a4a4-a4a4a4a4a4a4"
+    When I authorize "EUR 30.00"
+    And I capture "EUR 10.00"
+    And I check a capture of "EUR 10.00"
+    Then the policy violations are "none"
+
+    When I capture "EUR 10.00"
+    And I check a capture of "EUR 10.00"
+    Then the policy violations are "max_captures"
+
+    When I check a refund of "EUR 5.00"
+    Then the policy violations are "none"
+
+    When I refund "EUR 5.00"
+    And I check a refund of "EUR 5.00"
+    Then the policy violations are "max_refunds"
@@ -0,0 +1,34 @@
+Feature: Provider reference
+
+  Background:
+    And the amount is "USD 12.00"
+    And the payment kind is "ONE_TIME"
+
+  Scenario: Attached reference survives rehydration
+    Given a payment "12121212-3434-5656-7878-909090909090" is created for invoice "abababab-abab-abab-abab-abababababab"
+    And the capture mode is "MANUAL"
+    When I attach provider "stripe" with reference "pi_123"
+    And I authorize "USD 12.00"
+    Then the uncommitted events include, in order:
+      | PaymentCreated          |
+      | PaymentProviderAttached |
+      | PaymentAuthorized       |
+    And the payment state must be "AUTHORIZED"
+    And after rehydration the provider reference is "stripe" "pi_123"
+
+  Scenario: Attaching the same reference again is a no-op
+    Given a payment "23232323-4545-6767-8989-010101010101" is created for invoice "bcbcbcbc-bcbc-bcbc-bcbc-bcbcbcbcbcbc"
+    And the capture mode is "IMMEDIATE"
+    When I attach provider "stripe" with reference "pi_123"
+    And I attach provider "stripe" with reference "pi_123"
+    Then the uncommitted events include, in order:
+      | PaymentCreated          |
+      | PaymentProviderAttached |
+
+  Scenario: A different reference is rejected
+    Given a payment "34343434-5656-7878-9090-121212121212" is created for invoice "cdcdcdcd-1212-3434-5656-cdcdcdcdcdcd"
+    And the capture mode is "MANUAL"
+    When I attach provider "stripe" with reference "pi_123"
+    And I try to attach provider "tinkoff" with reference "700001"
+    Then the operation must be rejected
+    And after rehydration the provider reference is "stripe" "pi_123"
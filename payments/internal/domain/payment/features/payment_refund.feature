@@ -30,3 +30,47 @@ Feature: Refunds (partial and full)
     When I capture "USD 100.00"
     And a refund attempt fails with reason "NETWORK_ERROR"
     Then the payment state must be "PAID"
+
+  Scenario: Pending refund reserves funds until it settles
+    Given a payment "67676767-0000-0000-0000-000000000001" is created for invoice "78787878-0000-0000-0000-000000000001"
+    When I capture "USD 100.00"
+    And a refund "90909090-0000-0000-0000-000000000001" of "USD 60.00" is pending with reason "REQUESTED_BY_CUSTOMER"
+    Then the pending refunds equal "USD 60.00"
+    And the refundable amount equals "USD 40.00"
+    And the refund "90909090-0000-0000-0000-000000000001" is "pending"
+
+    When I try to refund "USD 50.00"
+    Then the operation must be rejected
+
+    When a refund "90909090-0000-0000-0000-000000000002" of "USD 40.00" is pending with reason "DUPLICATE"
+    And the refund "90909090-0000-0000-0000-000000000001" settles
+    Then the total refunded equals "USD 60.00"
+    And the pending refunds equal "USD 40.00"
+    And the payment state must be "PAID"
+    And full refund flag is "false"
+
+    When the refund "90909090-0000-0000-0000-000000000002" settles
+    Then the payment state must be "REFUNDED"
+    And the pending refunds equal "none"
+    And full refund flag is "true"
+    And the invariants hold
+    And after rehydration the payment state is "REFUNDED"
+
+  Scenario: Failed refund releases its amount
+    Given a payment "67676767-0000-0000-0000-000000000002" is created for invoice "78787878-0000-0000-0000-000000000002"
+    When I capture "USD 100.00"
+    And a refund "90909090-0000-0000-0000-000000000003" of "USD 100.00" is pending with reason "ORDER_CANCELED"
+    And the refund "90909090-0000-0000-0000-000000000003" fails with reason "DECLINED"
+    Then the refund "90909090-0000-0000-0000-000000000003" is "failed"
+    And the pending refunds equal "none"
+    And the refundable amount equals "USD 100.00"
+    And the payment state must be "PAID"
+    And the uncommitted events include, in order:
+      | PaymentCreated         |
+      | PaymentPaid            |
+      | PaymentRefundRequested |
+      | PaymentRefundFailed    |
+    And after rehydration the payment state is "PAID"
+
+    When I try to settle the refund "90909090-0000-0000-0000-000000000003"
+    Then the payment state must still be "PAID"
@@ -0,0 +1,31 @@
+Feature: Final capture releases the remaining authorization
+
+  Background:
+    And the amount is "USD 100.00"
+    And the payment kind is "ONE_TIME"
+    And the capture mode is "MANUAL"
+
+  Scenario: Partial final capture releases the rest of the hold
+    Given a payment "77777777-1111-2222-3333-444444444444" is created for invoice "cdcdcdcd-cdcd-cdcd-cdcd-cdcdcdcdcdcd"
+    When I authorize "USD 100.00"
+    And I capture "USD 60.00"
+    And the authorization remainder is released
+    Then the payment state must be "PAID"
+    And the total released equals "USD 40.00"
+    And the last uncommitted event is "PaymentAuthorizationReleased"
+    And the invariants hold
+
+    When I try to capture "USD 10.00"
+    Then the operation must be rejected
+    And the captured total equals "USD 60.00"
+    And after rehydration the payment state is "PAID"
+
+    When I refund "USD 60.00"
+    Then the payment state must be "REFUNDED"
+
+  Scenario: Only a captured payment can release its hold
+    Given a payment "88888888-1111-2222-3333-444444444444" is created for invoice "efefefef-efef-efef-efef-efefefefefef"
+    When I authorize "USD 100.00"
+    And I try to release the authorization remainder
+    Then the operation must be rejected
+    And the payment state must still be "AUTHORIZED"
@@ -16,3 +16,23 @@ Feature: SCA/3DS confirmation flow
 
     When I capture "USD 25.00"
     Then the payment state must be "PAID"
+
+  Scenario: Rejected SCA challenge -> FAILED
+    Given a payment "34343434-3434-3434-3434-343434343434" is created for invoice "cdcdcdcd-cdcd-cdcd-cdcd-cdcdcdcdcdcd"
+    When I require SCA
+    And I fail the payment with reason "SCA_FAILED"
+    Then the payment state must be "FAILED"
+
+  Scenario: SCA decision is recorded without a state change
+    Given a payment "35353535-3535-3535-3535-353535353535" is created for invoice "cececece-cece-cece-cece-cececececece"
+    When SCA is evaluated for region "DE"
+    Then the payment state must be "CREATED"
+    And the SCA decision is "not required" by rule "static"
+    And the last uncommitted event is "PaymentSCAEvaluated"
+    And after rehydration the payment state is "CREATED"
+
+  Scenario: SCA is only evaluated before the provider is involved
+    Given a payment "36363636-3636-3636-3636-363636363636" is created for invoice "cfcfcfcf-cfcf-cfcf-cfcf-cfcfcfcfcfcf"
+    When I require SCA
+    And I try to evaluate SCA
+    Then the operation must be rejected
@@ -0,0 +1,55 @@
+Feature: Settlement of provider payouts into the ledger
+
+  Background:
+    And the amount is "EUR 100.00"
+    And the payment kind is "ONE_TIME"
+    And the capture mode is "IMMEDIATE"
+
+  Scenario: Fees and net are recorded in the settlement currency
+    Given a payment "99999999-1111-2222-3333-444444444444" is created for invoice "abababab-1212-3434-5656-787878787878"
+    When I capture "EUR 100.00"
+    And the charge "txn_1" of "EUR 100.00" settles in payout "po_1" as "USD 108.50" with fee "USD 3.45"
+    Then the settled fees equal "USD 3.45"
+    And the settled net equals "USD 105.05"
+    And the FX adjustment of "txn_1" equals "USD 0.00"
+    And the last uncommitted event is "PaymentSettled"
+    And the payment state must still be "PAID"
+    And the invariants hold
+
+    # The rate moved between the charge (1.085) and the refund (1.09).
+    When I refund "EUR 10.00"
+    And the refund "txn_2" of "EUR -10.00" settles in payout "po_2" as "USD -10.90" with fee "USD 0.00"
+    Then the settled net equals "USD 94.15"
+    And the FX adjustment of "txn_2" equals "USD -0.05"
+    And after rehydration the payment state is "PAID"
+
+  Scenario: A payment settled in its own currency has no FX adjustment
+    Given a payment "99999999-2222-2222-3333-444444444444" is created for invoice "abababab-2323-3434-5656-787878787878"
+    When I capture "EUR 100.00"
+    And the charge "txn_1" of "EUR 100.00" settles in payout "po_1" as "EUR 100.00" with fee "EUR 1.65"
+    And I refund "EUR 100.00"
+    Then the payment state must be "REFUNDED"
+
+    # Settlements arrive after the payment is over; the refund gives part of the fee back.
+    When the refund "txn_2" of "EUR -100.00" settles in payout "po_2" as "EUR -100.00" with fee "EUR -0.25"
+    Then the settled fees equal "EUR 1.40"
+    And the settled net equals "EUR -1.40"
+    And the FX adjustment of "txn_2" equals "EUR 0.00"
+    And the invariants hold
+
+  Scenario: Settlements are validated against the ledger
+    Given a payment "99999999-3333-2222-3333-444444444444" is created for invoice "abababab-3434-3434-5656-787878787878"
+    When I try to settle the charge "txn_0" of "EUR 100.00" in payout "po_1" as "EUR 100.00" with fee "EUR 1.65"
+    Then the operation must be rejected
+
+    When I capture "EUR 60.00"
+    And I try to settle the charge "txn_1" of "EUR 100.00" in payout "po_1" as "EUR 100.00" with fee "EUR 1.65"
+    Then the operation must be rejected
+
+    When the charge "txn_1" of "EUR 60.00" settles in payout "po_1" as "EUR 60.00" with fee "EUR 1.00"
+    And I try to settle the charge "txn_1" of "EUR 60.00" in payout "po_1" as "EUR 60.00" with fee "EUR 1.00"
+    Then the operation must be rejected
+
+    When I try to settle the refund "txn_2" of "EUR -10.00" in payout "po_2" as "USD -10.90" with fee "USD 0.00"
+    Then the operation must be rejected
+    And the settled net equals "EUR 59.00"
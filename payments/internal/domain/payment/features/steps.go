@@ -2,11 +2,13 @@ package payment
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cucumber/godog"
 	"github.com/google/uuid"
@@ -14,6 +16,7 @@ import (
 	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
 	flowv1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
 	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
 
 	"google.golang.org/genproto/googleapis/type/money"
 )
@@ -30,6 +33,8 @@ type paymentWorld struct {
 	p        *payment.Payment
 	lastErr  error
 	lastFull *bool
+	limits   *payment.Limits
+	specs    payment.Specifications
 }
 
 func (w *paymentWorld) reset(ctx context.Context) {
@@ -42,6 +47,8 @@ func (w *paymentWorld) reset(ctx context.Context) {
 	w.p = nil
 	w.lastErr = nil
 	w.lastFull = nil
+	w.limits = nil
+	w.specs = payment.Specifications{}
 }
 
 func (w *paymentWorld) ensureCreated() error {
@@ -54,10 +61,27 @@ func (w *paymentWorld) ensureCreated() error {
 		return nil
 	}
 	var err error
-	w.p, err = payment.New(w.id, w.invoice, w.amount, w.kind, w.mode)
+	w.p, err = payment.New(w.id, w.invoice, w.amount, w.kind, w.mode, w.options()...)
 	return err
 }
 
+// options applies the limits configured by the scenario, if any.
+func (w *paymentWorld) options() []payment.Option {
+	if w.limits == nil {
+		return nil
+	}
+	policy := &payment.StaticPolicy{Limits: *w.limits}
+	w.specs = policy.Specifications()
+	return []payment.Option{payment.WithPolicy(policy)}
+}
+
+func (w *paymentWorld) policyLimits() *payment.Limits {
+	if w.limits == nil {
+		w.limits = &payment.Limits{}
+	}
+	return w.limits
+}
+
 // ---- helpers ----
 
 func parseMoney(input string) (*money.Money, error) {
@@ -66,7 +90,7 @@ func parseMoney(input string) (*money.Money, error) {
 		return nil, fmt.Errorf("invalid money: %q", input)
 	}
 	cur := strings.ToUpper(parts[0])
-	dec := parts[1]
+	dec, negative := strings.CutPrefix(parts[1], "-")
 	re := regexp.MustCompile(`^([0-9]+)(?:\.([0-9]{1,9}))?$`)
 	m := re.FindStringSubmatch(dec)
 	if m == nil {
@@ -88,6 +112,9 @@ func parseMoney(input string) (*money.Money, error) {
 	if err != nil {
 		return nil, err
 	}
+	if negative {
+		units, nanos = -units, -nanos
+	}
 	return &money.Money{
 		CurrencyCode: cur,
 		Units:        units,
@@ -95,6 +122,19 @@ func parseMoney(input string) (*money.Money, error) {
 	}, nil
 }
 
+func refundsEq(a, b []payment.Refund) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID || a[i].ProviderRefundID != b[i].ProviderRefundID ||
+			a[i].Status != b[i].Status || a[i].Reason != b[i].Reason || !moneyEq(a[i].Amount, b[i].Amount) {
+			return false
+		}
+	}
+	return true
+}
+
 func moneyEq(a, b *money.Money) bool {
 	if a == nil || b == nil {
 		return a == b
@@ -150,6 +190,10 @@ func parseFailureReason(s string) (eventv1.FailureReason, error) {
 		return eventv1.FailureReason_FAILURE_REASON_AUTH_EXPIRED, nil
 	case "NETWORK_ERROR", "NETWORK":
 		return eventv1.FailureReason_FAILURE_REASON_NETWORK_ERROR, nil
+	case "SCA_NOT_COMPLETED":
+		return eventv1.FailureReason_FAILURE_REASON_SCA_NOT_COMPLETED, nil
+	case "SCA_FAILED":
+		return eventv1.FailureReason_FAILURE_REASON_SCA_FAILED, nil
 	default:
 		return eventv1.FailureReason_FAILURE_REASON_UNSPECIFIED,
 			fmt.Errorf("unknown failure reason %q", s)
@@ -171,6 +215,42 @@ func parseCancelReason(s string) (eventv1.CancelReason, error) {
 	}
 }
 
+func parseDisputeReason(s string) (eventv1.DisputeReason, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "FRAUDULENT":
+		return eventv1.DisputeReason_DISPUTE_REASON_FRAUDULENT, nil
+	case "PRODUCT_NOT_RECEIVED":
+		return eventv1.DisputeReason_DISPUTE_REASON_PRODUCT_NOT_RECEIVED, nil
+	case "PRODUCT_UNACCEPTABLE":
+		return eventv1.DisputeReason_DISPUTE_REASON_PRODUCT_UNACCEPTABLE, nil
+	case "DUPLICATE":
+		return eventv1.DisputeReason_DISPUTE_REASON_DUPLICATE, nil
+	case "SUBSCRIPTION_CANCELED":
+		return eventv1.DisputeReason_DISPUTE_REASON_SUBSCRIPTION_CANCELED, nil
+	case "CREDIT_NOT_PROCESSED":
+		return eventv1.DisputeReason_DISPUTE_REASON_CREDIT_NOT_PROCESSED, nil
+	case "GENERAL":
+		return eventv1.DisputeReason_DISPUTE_REASON_GENERAL, nil
+	default:
+		return eventv1.DisputeReason_DISPUTE_REASON_UNSPECIFIED, fmt.Errorf("unknown dispute reason %q", s)
+	}
+}
+
+func parseRefundReason(s string) (eventv1.RefundReason, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "REQUESTED_BY_CUSTOMER":
+		return eventv1.RefundReason_REFUND_REASON_REQUESTED_BY_CUSTOMER, nil
+	case "DUPLICATE":
+		return eventv1.RefundReason_REFUND_REASON_DUPLICATE, nil
+	case "FRAUDULENT":
+		return eventv1.RefundReason_REFUND_REASON_FRAUDULENT, nil
+	case "ORDER_CANCELED":
+		return eventv1.RefundReason_REFUND_REASON_ORDER_CANCELED, nil
+	default:
+		return eventv1.RefundReason_REFUND_REASON_UNSPECIFIED, fmt.Errorf("unknown refund reason %q", s)
+	}
+}
+
 // ---- steps (Given/When/Then) ----
 
 func (w *paymentWorld) givenPaymentCreatedForInvoice(id, invoice string) error {
@@ -186,6 +266,97 @@ func (w *paymentWorld) givenPaymentCreatedForInvoice(id, invoice string) error {
 	return w.ensureCreated()
 }
 
+func (w *paymentWorld) whenTryCreatePayment(id, invoice string) error {
+	pid, err := uuid.Parse(strings.TrimSpace(id))
+	if err != nil {
+		return fmt.Errorf("bad payment id: %w", err)
+	}
+	inv, err := uuid.Parse(strings.TrimSpace(invoice))
+	if err != nil {
+		return fmt.Errorf("bad invoice id: %w", err)
+	}
+	_, err = payment.New(pid, inv, w.amount, w.kind, w.mode, w.options()...)
+	if err == nil {
+		return fmt.Errorf("expected error, got nil")
+	}
+	w.lastErr = err
+	return nil
+}
+
+func (w *paymentWorld) givenAmountRange(currency, lowest, highest string) error {
+	lo, err := parseMoney(lowest)
+	if err != nil {
+		return err
+	}
+	hi, err := parseMoney(highest)
+	if err != nil {
+		return err
+	}
+	l := w.policyLimits()
+	l.MinAmount = map[string]*money.Money{currency: lo}
+	l.MaxAmount = map[string]*money.Money{currency: hi}
+	return nil
+}
+
+func (w *paymentWorld) givenCurrenciesFor(currencies, kind string) error {
+	k, ok := eventv1.PaymentKind_value["PAYMENT_KIND_"+strings.ToUpper(kind)]
+	if !ok {
+		return fmt.Errorf("unknown kind %q", kind)
+	}
+	l := w.policyLimits()
+	if l.Currencies == nil {
+		l.Currencies = make(map[payment.CurrencyScope][]string)
+	}
+	l.Currencies[payment.CurrencyScope{Kind: eventv1.PaymentKind(k)}] = strings.Split(currencies, ",")
+	return nil
+}
+
+func (w *paymentWorld) givenMaxOperations(max int, op string) error {
+	l := w.policyLimits()
+	if op == "captures" {
+		l.MaxCaptures = max
+	} else {
+		l.MaxRefunds = max
+	}
+	return nil
+}
+
+func (w *paymentWorld) whenCheckOperation(op, amount string) error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	m, err := parseMoney(amount)
+	if err != nil {
+		return err
+	}
+	if op == "capture" {
+		w.lastErr = w.p.CheckCapture(w.specs.Capture, m)
+	} else {
+		w.lastErr = w.p.CheckRefund(w.specs.Refund, m)
+	}
+	return nil
+}
+
+func (w *paymentWorld) thenViolationsAre(rules string) error {
+	if rules == "none" {
+		if w.lastErr != nil {
+			return fmt.Errorf("expected no violation, got %v", w.lastErr)
+		}
+		return nil
+	}
+	if !errors.Is(w.lastErr, payment.ErrPolicyViolation) {
+		return fmt.Errorf("expected a policy violation, got %v", w.lastErr)
+	}
+	var got []string
+	for _, v := range payment.Violations(w.lastErr) {
+		got = append(got, v.Rule)
+	}
+	if strings.Join(got, ", ") != rules {
+		return fmt.Errorf("violations mismatch: got %q, want %q", strings.Join(got, ", "), rules)
+	}
+	return nil
+}
+
 func (w *paymentWorld) andAmountIs(s string) error {
 	m, err := parseMoney(s)
 	if err != nil {
@@ -221,6 +392,26 @@ func (w *paymentWorld) whenRequireSCA() error {
 	return w.lastErr
 }
 
+func (w *paymentWorld) whenEvaluateSCA(region string) error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	_, w.lastErr = w.p.EvaluateSCA(w.ctx, region, false)
+	return w.lastErr
+}
+
+func (w *paymentWorld) whenTryEvaluateSCA() error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	_, err := w.p.EvaluateSCA(w.ctx, "", false)
+	if err == nil {
+		return fmt.Errorf("expected error, got nil")
+	}
+	w.lastErr = err
+	return nil
+}
+
 func (w *paymentWorld) whenConfirmAuthorizationOf(s string) error {
 	if err := w.ensureCreated(); err != nil {
 		return err
@@ -253,6 +444,25 @@ func (w *paymentWorld) whenTryAuthorize(s string) error {
 	return nil
 }
 
+func (w *paymentWorld) whenIncreaseAuthorization(s string) error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	m, err := parseMoney(s)
+	if err != nil {
+		return err
+	}
+	w.lastErr = w.p.IncreaseAuthorization(w.ctx, m)
+	return w.lastErr
+}
+
+func (w *paymentWorld) whenTryIncreaseAuthorization(s string) error {
+	if err := w.whenIncreaseAuthorization(s); err == nil {
+		return fmt.Errorf("expected error, got nil")
+	}
+	return nil
+}
+
 func (w *paymentWorld) whenCapture(s string) error {
 	if err := w.ensureCreated(); err != nil {
 		return err
@@ -273,6 +483,21 @@ func (w *paymentWorld) whenTryCapture(s string) error {
 	return nil
 }
 
+func (w *paymentWorld) whenReleaseAuthorization() error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	_, w.lastErr = w.p.ReleaseAuthorization(w.ctx)
+	return w.lastErr
+}
+
+func (w *paymentWorld) whenTryReleaseAuthorization() error {
+	if err := w.whenReleaseAuthorization(); err == nil {
+		return fmt.Errorf("expected error, got nil")
+	}
+	return nil
+}
+
 func (w *paymentWorld) whenRefund(s string) error {
 	if err := w.ensureCreated(); err != nil {
 		return err
@@ -287,6 +512,46 @@ func (w *paymentWorld) whenRefund(s string) error {
 	return e
 }
 
+func parseSettlementType(s string) (eventv1.SettlementType, error) {
+	v, ok := eventv1.SettlementType_value["SETTLEMENT_TYPE_"+strings.ToUpper(s)]
+	if !ok {
+		return eventv1.SettlementType_SETTLEMENT_TYPE_UNSPECIFIED, fmt.Errorf("unknown settlement type: %q", s)
+	}
+	return eventv1.SettlementType(v), nil
+}
+
+// whenSettle settles id net of fee: net = gross - fee.
+func (w *paymentWorld) whenSettle(typ, id, amount, payout, gross, fee string) error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	t, err := parseSettlementType(typ)
+	if err != nil {
+		return err
+	}
+	s := payment.Settlement{ID: id, PayoutID: payout, Type: t, SettledAt: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)}
+	for _, f := range []struct {
+		in  string
+		out **money.Money
+	}{{amount, &s.Amount}, {gross, &s.Gross}, {fee, &s.Fee}} {
+		if *f.out, err = parseMoney(f.in); err != nil {
+			return err
+		}
+	}
+	if s.Net, err = ledger.Sub(s.Gross, s.Fee); err != nil {
+		return err
+	}
+	w.lastErr = w.p.Settle(w.ctx, s)
+	return w.lastErr
+}
+
+func (w *paymentWorld) whenTrySettle(typ, id, amount, payout, gross, fee string) error {
+	if err := w.whenSettle(typ, id, amount, payout, gross, fee); err == nil {
+		return fmt.Errorf("expected error, got nil")
+	}
+	return nil
+}
+
 // Refund failed (enum)
 func (w *paymentWorld) whenRefundFailedWithReason(s string) error {
 	if err := w.ensureCreated(); err != nil {
@@ -300,6 +565,64 @@ func (w *paymentWorld) whenRefundFailedWithReason(s string) error {
 	return nil
 }
 
+// Pending refunds
+func (w *paymentWorld) whenRefundPending(id, amount, reason string) error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	m, err := parseMoney(amount)
+	if err != nil {
+		return err
+	}
+	r, err := parseRefundReason(reason)
+	if err != nil {
+		return err
+	}
+	_, w.lastErr = w.p.RequestRefund(w.ctx, payment.Refund{
+		ID:               rid,
+		ProviderRefundID: "re_" + id[len(id)-12:],
+		Amount:           m,
+		Reason:           r,
+		Status:           payment.RefundStatusPending,
+	})
+	return w.lastErr
+}
+
+func (w *paymentWorld) whenRefundSettles(id string) error {
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	full, err := w.p.SettleRefund(w.ctx, rid)
+	w.lastErr = err
+	w.lastFull = &full
+	return err
+}
+
+func (w *paymentWorld) whenRefundFails(id, reason string) error {
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	r, err := parseFailureReason(reason)
+	if err != nil {
+		return err
+	}
+	w.lastErr = w.p.FailRefund(w.ctx, rid, r)
+	return w.lastErr
+}
+
+func (w *paymentWorld) whenTrySettleRefund(id string) error {
+	if err := w.whenRefundSettles(id); err == nil {
+		return fmt.Errorf("expected error, got nil")
+	}
+	return nil
+}
+
 // Cancel (enum)
 func (w *paymentWorld) whenCancel(reason string) error {
 	if err := w.ensureCreated(); err != nil {
@@ -358,6 +681,77 @@ func (w *paymentWorld) whenTryCancel(reason string) error {
 	return nil
 }
 
+// Provider reference
+func (w *paymentWorld) whenAttachProvider(provider, providerID string) error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	return w.p.AttachProvider(w.ctx, provider, providerID)
+}
+
+func (w *paymentWorld) whenTryAttachProvider(provider, providerID string) error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	err := w.p.AttachProvider(w.ctx, provider, providerID)
+	if err == nil {
+		return fmt.Errorf("expected error, got nil")
+	}
+	w.lastErr = err
+	return nil
+}
+
+// Disputes
+func (w *paymentWorld) whenOpenDispute(amount, reason string) error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	m, err := parseMoney(amount)
+	if err != nil {
+		return err
+	}
+	r, err := parseDisputeReason(reason)
+	if err != nil {
+		return err
+	}
+	w.lastErr = w.p.OpenDispute(w.ctx, m, r, "dp_1")
+	return w.lastErr
+}
+
+func (w *paymentWorld) whenTryOpenDispute(amount string) error {
+	err := w.whenOpenDispute(amount, "GENERAL")
+	if err == nil {
+		return fmt.Errorf("expected error, got nil")
+	}
+	return nil
+}
+
+func (w *paymentWorld) whenSubmitDisputeEvidence() error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	w.lastErr = w.p.SubmitDisputeEvidence(w.ctx)
+	return w.lastErr
+}
+
+func (w *paymentWorld) whenDisputeWon() error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	w.lastErr = w.p.WinDispute(w.ctx)
+	return w.lastErr
+}
+
+func (w *paymentWorld) whenDisputeLost() error {
+	if err := w.ensureCreated(); err != nil {
+		return err
+	}
+	full, err := w.p.LoseDispute(w.ctx)
+	w.lastErr = err
+	w.lastFull = &full
+	return err
+}
+
 // ---- assertions ----
 
 func (w *paymentWorld) thenStateMustBe(expected string) error {
@@ -399,6 +793,20 @@ func (w *paymentWorld) thenAuthorizedTotalEquals(s string) error {
 	return nil
 }
 
+func (w *paymentWorld) thenAmountEquals(s string) error {
+	want, err := parseMoney(s)
+	if err != nil {
+		return err
+	}
+	got := w.p.Ledger.Amount
+	if !moneyEq(want, got) {
+		return fmt.Errorf("amount mismatch: got %s %d.%09d, want %s %d.%09d",
+			got.GetCurrencyCode(), got.GetUnits(), got.GetNanos(),
+			want.GetCurrencyCode(), want.GetUnits(), want.GetNanos())
+	}
+	return nil
+}
+
 func (w *paymentWorld) thenTotalRefundedEquals(s string) error {
 	want, err := parseMoney(s)
 	if err != nil {
@@ -413,6 +821,177 @@ func (w *paymentWorld) thenTotalRefundedEquals(s string) error {
 	return nil
 }
 
+func (w *paymentWorld) thenDisputedAmountEquals(s string) error {
+	if strings.EqualFold(s, "none") {
+		if w.p.Ledger.Disputed != nil {
+			return fmt.Errorf("disputed mismatch: got %s %d, want none",
+				w.p.Ledger.Disputed.GetCurrencyCode(), w.p.Ledger.Disputed.GetUnits())
+		}
+		return nil
+	}
+	want, err := parseMoney(s)
+	if err != nil {
+		return err
+	}
+	got := w.p.Ledger.Disputed
+	if !moneyEq(want, got) {
+		return fmt.Errorf("disputed mismatch: got %s %d.%09d, want %s %d.%09d",
+			got.GetCurrencyCode(), got.GetUnits(), got.GetNanos(),
+			want.GetCurrencyCode(), want.GetUnits(), want.GetNanos())
+	}
+	return nil
+}
+
+func (w *paymentWorld) thenPendingRefundsEqual(s string) error {
+	got := w.p.Ledger.PendingRefunded
+	if strings.EqualFold(s, "none") {
+		if got != nil {
+			return fmt.Errorf("pending refunds mismatch: got %s %d, want none", got.GetCurrencyCode(), got.GetUnits())
+		}
+		return nil
+	}
+	want, err := parseMoney(s)
+	if err != nil {
+		return err
+	}
+	if !moneyEq(want, got) {
+		return fmt.Errorf("pending refunds mismatch: got %s %d.%09d, want %s %d.%09d",
+			got.GetCurrencyCode(), got.GetUnits(), got.GetNanos(),
+			want.GetCurrencyCode(), want.GetUnits(), want.GetNanos())
+	}
+	return nil
+}
+
+func (w *paymentWorld) thenRefundStatusIs(id, status string) error {
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	r, ok := w.p.FindRefund(rid)
+	if !ok {
+		return fmt.Errorf("refund %s not found", id)
+	}
+	if got := r.Status.String(); !strings.EqualFold(got, status) {
+		return fmt.Errorf("refund status mismatch: got %s, want %s", got, status)
+	}
+	return nil
+}
+
+func (w *paymentWorld) thenRefundableEquals(s string) error {
+	want, err := parseMoney(s)
+	if err != nil {
+		return err
+	}
+	got := w.p.Ledger.Refundable()
+	if !moneyEq(want, got) {
+		return fmt.Errorf("refundable mismatch: got %s %d.%09d, want %s %d.%09d",
+			got.GetCurrencyCode(), got.GetUnits(), got.GetNanos(),
+			want.GetCurrencyCode(), want.GetUnits(), want.GetNanos())
+	}
+	return nil
+}
+
+func (w *paymentWorld) thenSCADecisionIs(required, rule string) error {
+	d := w.p.SCA()
+	if got := map[bool]string{true: "required", false: "not required"}[d.Required]; got != required || d.Rule != rule {
+		return fmt.Errorf("SCA decision mismatch: got %s by %q, want %s by %q", got, d.Rule, required, rule)
+	}
+	return nil
+}
+
+func (w *paymentWorld) thenTotalReversedEquals(s string) error {
+	want, err := parseMoney(s)
+	if err != nil {
+		return err
+	}
+	got := w.p.Ledger.Reversed
+	if !moneyEq(want, got) {
+		return fmt.Errorf("total_reversed mismatch: got %s %d.%09d, want %s %d.%09d",
+			got.GetCurrencyCode(), got.GetUnits(), got.GetNanos(),
+			want.GetCurrencyCode(), want.GetUnits(), want.GetNanos())
+	}
+	return nil
+}
+
+func (w *paymentWorld) thenTotalReleasedEquals(s string) error {
+	want, err := parseMoney(s)
+	if err != nil {
+		return err
+	}
+	got := w.p.Ledger.Released
+	if !moneyEq(want, got) {
+		return fmt.Errorf("total_released mismatch: got %s %d.%09d, want %s %d.%09d",
+			got.GetCurrencyCode(), got.GetUnits(), got.GetNanos(),
+			want.GetCurrencyCode(), want.GetUnits(), want.GetNanos())
+	}
+	return nil
+}
+
+func (w *paymentWorld) thenSettlementEquals(what, s string) error {
+	want, err := parseMoney(s)
+	if err != nil {
+		return err
+	}
+	got := w.p.Ledger.Fees
+	if what == "net" {
+		got = w.p.Ledger.Net
+	}
+	if !moneyEq(want, got) {
+		return fmt.Errorf("settled %s mismatch: got %s %d.%09d, want %s %d.%09d", what,
+			got.GetCurrencyCode(), got.GetUnits(), got.GetNanos(),
+			want.GetCurrencyCode(), want.GetUnits(), want.GetNanos())
+	}
+	return nil
+}
+
+func (w *paymentWorld) thenFXAdjustmentEquals(id, s string) error {
+	want, err := parseMoney(s)
+	if err != nil {
+		return err
+	}
+	st, ok := w.p.FindSettlement(id)
+	if !ok {
+		return fmt.Errorf("settlement %s not found", id)
+	}
+	if got := st.FXAdjustment; !moneyEq(want, got) {
+		return fmt.Errorf("fx adjustment mismatch: got %s %d.%09d, want %s %d.%09d",
+			got.GetCurrencyCode(), got.GetUnits(), got.GetNanos(),
+			want.GetCurrencyCode(), want.GetUnits(), want.GetNanos())
+	}
+	return nil
+}
+
+func (w *paymentWorld) thenInvariantsHold() error {
+	return w.p.Invariants()
+}
+
+func (w *paymentWorld) thenStateAfterRehydration(expected string) error {
+	p := payment.Rehydrate(w.p.UncommittedEvents())
+	if got := enumState(p.State()); got != strings.ToUpper(expected) {
+		return fmt.Errorf("state after rehydration mismatch: got %s, want %s", got, expected)
+	}
+	if !moneyEq(p.Ledger.Reversed, w.p.Ledger.Reversed) || !moneyEq(p.Ledger.Disputed, w.p.Ledger.Disputed) {
+		return fmt.Errorf("dispute totals differ after rehydration")
+	}
+	if !moneyEq(p.Ledger.PendingRefunded, w.p.Ledger.PendingRefunded) || !refundsEq(p.Refunds(), w.p.Refunds()) {
+		return fmt.Errorf("refunds differ after rehydration")
+	}
+	if !moneyEq(p.Ledger.Amount, w.p.Ledger.Amount) || !moneyEq(p.Ledger.Authorized, w.p.Ledger.Authorized) {
+		return fmt.Errorf("amount or hold differs after rehydration")
+	}
+	if !moneyEq(p.Ledger.Released, w.p.Ledger.Released) {
+		return fmt.Errorf("released total differs after rehydration")
+	}
+	if p.SCA() != w.p.SCA() {
+		return fmt.Errorf("SCA decision differs after rehydration")
+	}
+	if !moneyEq(p.Ledger.Fees, w.p.Ledger.Fees) || !moneyEq(p.Ledger.Net, w.p.Ledger.Net) ||
+		!p.Ledger.SettledAt.Equal(w.p.Ledger.SettledAt) || len(p.Settlements()) != len(w.p.Settlements()) {
+		return fmt.Errorf("settlements differ after rehydration")
+	}
+	return p.Invariants()
+}
+
 func (w *paymentWorld) thenUncommittedEventsIncludeInOrder(table *godog.Table) error {
 	evs := w.p.UncommittedEvents()
 	if len(table.Rows) == 0 {
@@ -476,6 +1055,15 @@ func (w *paymentWorld) thenFullRefundFlagIs(val string) error {
 	return fmt.Errorf("no PaymentRefunded event found")
 }
 
+func (w *paymentWorld) thenProviderReferenceAfterRehydration(provider, providerID string) error {
+	p := payment.Rehydrate(w.p.UncommittedEvents())
+	if p.Provider() != provider || p.ProviderID() != providerID {
+		return fmt.Errorf("provider reference mismatch: got %s %s, want %s %s",
+			p.Provider(), p.ProviderID(), provider, providerID)
+	}
+	return nil
+}
+
 // Alias: "the payment state must still be" → same as "the payment state must be"
 func (w *paymentWorld) thenStateMustStillBe(expected string) error {
 	return w.thenStateMustBe(expected)
@@ -495,15 +1083,26 @@ func InitializeScenario(sc *godog.ScenarioContext) {
 	sc.Step(`^a payment "([^"]+)" is created for invoice "([^"]+)"$`, w.givenPaymentCreatedForInvoice)
 	sc.Step(`^the amount is "([^"]+)"$`, w.andAmountIs)
 	sc.Step(`^the payment kind is "([^"]+)"$`, w.andKindIs)
+	sc.Step(`^the policy allows "([^"]+)" amounts from "([^"]+)" to "([^"]+)"$`, w.givenAmountRange)
+	sc.Step(`^the policy allows "([^"]+)" for "([^"]+)" payments$`, w.givenCurrenciesFor)
+	sc.Step(`^the policy allows at most (\d+) (captures|refunds) per payment$`, w.givenMaxOperations)
 	sc.Step(`^the capture mode is "([^"]+)"$`, w.andCaptureModeIs)
 
 	// When (commands)
+	sc.Step(`^I try to create payment "([^"]+)" for invoice "([^"]+)"$`, w.whenTryCreatePayment)
+	sc.Step(`^I check a (capture|refund) of "([^"]+)"$`, w.whenCheckOperation)
 	sc.Step(`^I require SCA$`, w.whenRequireSCA)
+	sc.Step(`^SCA is evaluated for region "([^"]*)"$`, w.whenEvaluateSCA)
+	sc.Step(`^I try to evaluate SCA$`, w.whenTryEvaluateSCA)
 	sc.Step(`^I confirm authorization of "([^"]+)"$`, w.whenConfirmAuthorizationOf)
 	sc.Step(`^I authorize "([^"]+)"$`, w.whenAuthorize)
 	sc.Step(`^I try to authorize "([^"]+)"$`, w.whenTryAuthorize)
+	sc.Step(`^I increase the authorization by "([^"]+)"$`, w.whenIncreaseAuthorization)
+	sc.Step(`^I try to increase the authorization by "([^"]+)"$`, w.whenTryIncreaseAuthorization)
 	sc.Step(`^I capture "([^"]+)"$`, w.whenCapture)
 	sc.Step(`^I try to capture "([^"]+)"$`, w.whenTryCapture)
+	sc.Step(`^the authorization remainder is released$`, w.whenReleaseAuthorization)
+	sc.Step(`^I try to release the authorization remainder$`, w.whenTryReleaseAuthorization)
 	sc.Step(`^I refund "([^"]+)"$`, w.whenRefund)
 	sc.Step(`^a refund attempt fails with reason "([^"]+)"$`, w.whenRefundFailedWithReason)
 	sc.Step(`^I cancel the payment with reason "([^"]+)"$`, w.whenCancel)
@@ -515,15 +1114,42 @@ func InitializeScenario(sc *godog.ScenarioContext) {
 	})
 	sc.Step(`^I try to refund "([^"]+)"$`, w.whenTryRefund)
 	sc.Step(`^I try to cancel the payment with reason "([^"]+)"$`, w.whenTryCancel)
+	sc.Step(`^I attach provider "([^"]+)" with reference "([^"]+)"$`, w.whenAttachProvider)
+	sc.Step(`^I try to attach provider "([^"]+)" with reference "([^"]+)"$`, w.whenTryAttachProvider)
+	sc.Step(`^a refund "([^"]+)" of "([^"]+)" is pending with reason "([^"]+)"$`, w.whenRefundPending)
+	sc.Step(`^the refund "([^"]+)" settles$`, w.whenRefundSettles)
+	sc.Step(`^the refund "([^"]+)" fails with reason "([^"]+)"$`, w.whenRefundFails)
+	sc.Step(`^I try to settle the refund "([^"]+)"$`, w.whenTrySettleRefund)
+	sc.Step(`^a dispute of "([^"]+)" is opened with reason "([^"]+)"$`, w.whenOpenDispute)
+	sc.Step(`^I try to open a dispute of "([^"]+)"$`, w.whenTryOpenDispute)
+	sc.Step(`^dispute evidence is submitted$`, w.whenSubmitDisputeEvidence)
+	sc.Step(`^the dispute is won$`, w.whenDisputeWon)
+	sc.Step(`^the dispute is lost$`, w.whenDisputeLost)
+	sc.Step(`^the (charge|refund|dispute|adjustment) "([^"]+)" of "([^"]+)" settles in payout "([^"]+)" as "([^"]+)" with fee "([^"]+)"$`, w.whenSettle)
+	sc.Step(`^I try to settle the (charge|refund|dispute|adjustment) "([^"]+)" of "([^"]+)" in payout "([^"]+)" as "([^"]+)" with fee "([^"]+)"$`, w.whenTrySettle)
 
 	// Then (assertions)
 	sc.Step(`^the payment state must be "([^"]+)"$`, w.thenStateMustBe)
 	sc.Step(`^the captured total equals "([^"]+)"$`, w.thenCapturedTotalEquals)
 	sc.Step(`^the authorized total equals "([^"]+)"$`, w.thenAuthorizedTotalEquals)
+	sc.Step(`^the payment amount equals "([^"]+)"$`, w.thenAmountEquals)
 	sc.Step(`^the total refunded equals "([^"]+)"$`, w.thenTotalRefundedEquals)
 	sc.Step(`^the uncommitted events include, in order:$`, w.thenUncommittedEventsIncludeInOrder)
 	sc.Step(`^the last uncommitted event is "([^"]+)"$`, w.thenLastUncommittedEventIs)
 	sc.Step(`^the operation must be rejected$`, w.thenOperationMustBeRejected)
 	sc.Step(`^full refund flag is "([^"]+)"$`, w.thenFullRefundFlagIs)
+	sc.Step(`^the disputed amount equals "([^"]+)"$`, w.thenDisputedAmountEquals)
+	sc.Step(`^the total reversed equals "([^"]+)"$`, w.thenTotalReversedEquals)
+	sc.Step(`^the total released equals "([^"]+)"$`, w.thenTotalReleasedEquals)
+	sc.Step(`^the pending refunds equal "([^"]+)"$`, w.thenPendingRefundsEqual)
+	sc.Step(`^the refund "([^"]+)" is "([^"]+)"$`, w.thenRefundStatusIs)
+	sc.Step(`^the SCA decision is "(required|not required)" by rule "([^"]+)"$`, w.thenSCADecisionIs)
+	sc.Step(`^the policy violations are "([^"]+)"$`, w.thenViolationsAre)
+	sc.Step(`^the refundable amount equals "([^"]+)"$`, w.thenRefundableEquals)
+	sc.Step(`^the settled (fees|net) equals? "([^"]+)"$`, w.thenSettlementEquals)
+	sc.Step(`^the FX adjustment of "([^"]+)" equals "([^"]+)"$`, w.thenFXAdjustmentEquals)
+	sc.Step(`^the invariants hold$`, w.thenInvariantsHold)
+	sc.Step(`^after rehydration the payment state is "([^"]+)"$`, w.thenStateAfterRehydration)
 	sc.Step(`^the payment state must still be "([^"]+)"$`, w.thenStateMustStillBe)
+	sc.Step(`^after rehydration the provider reference is "([^"]+)" "([^"]+)"$`, w.thenProviderReferenceAfterRehydration)
 }
@@ -8,6 +8,8 @@
 //   - Refund semantics are explicit:
 //   - partial refund keeps the state in PAID (self-loop),
 //   - full refund moves to REFUNDED (terminal).
+//   - Dispute semantics mirror refunds: a won or partially lost dispute returns to PAID,
+//     losing everything that was not refunded moves to CHARGED_BACK (terminal).
 package fsm
 
 import (
@@ -35,6 +37,12 @@ const (
 	EventRefundFull    = "refund_full"    // PAID -> REFUNDED
 	EventCancel        = "cancel"         // CREATED|WAITING|AUTHORIZED -> CANCELED
 	EventFail          = "fail"           // CREATED|WAITING|AUTHORIZED -> FAILED
+
+	EventDisputeOpen     = "dispute_open"     // PAID -> DISPUTED
+	EventDisputeEvidence = "dispute_evidence" // DISPUTED -> DISPUTED (self-loop) — NOTE: not triggered in FSM
+	EventDisputeWon      = "dispute_won"      // DISPUTED -> PAID
+	EventDisputeLost     = "dispute_lost"     // DISPUTED -> PAID (part of the payment reversed)
+	EventChargeback      = "chargeback"       // DISPUTED -> CHARGED_BACK (everything not refunded reversed)
 )
 
 // guardTransitions — canonical list of allowed transitions for the guard FSM.
@@ -77,6 +85,28 @@ var guardTransitions = fsm.Events{
 		Dst:  flowv1.PaymentFlow_PAYMENT_FLOW_REFUNDED.String(),
 	},
 
+	// Disputes:
+	{
+		Name: EventDisputeOpen,
+		Src:  []string{flowv1.PaymentFlow_PAYMENT_FLOW_PAID.String()},
+		Dst:  flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED.String(),
+	},
+	{
+		Name: EventDisputeWon,
+		Src:  []string{flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED.String()},
+		Dst:  flowv1.PaymentFlow_PAYMENT_FLOW_PAID.String(),
+	},
+	{
+		Name: EventDisputeLost,
+		Src:  []string{flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED.String()},
+		Dst:  flowv1.PaymentFlow_PAYMENT_FLOW_PAID.String(),
+	},
+	{
+		Name: EventChargeback,
+		Src:  []string{flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED.String()},
+		Dst:  flowv1.PaymentFlow_PAYMENT_FLOW_CHARGED_BACK.String(),
+	},
+
 	// Problem exits:
 	{
 		Name: EventCancel,
@@ -55,11 +55,17 @@ func TestFSM_AllowedTransitionsMatrix(t *testing.T) {
 			// IMPORTANT: partial refunds do NOT trigger FSM (state stays PAID),
 			// so only full refund is listed as a transition.
 			state:  flowv1.PaymentFlow_PAYMENT_FLOW_PAID,
-			expect: []string{fsm.EventRefundFull},
+			expect: []string{fsm.EventRefundFull, fsm.EventDisputeOpen},
+		},
+		{
+			// Evidence submission is a self-loop and does not trigger the FSM either.
+			state:  flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED,
+			expect: []string{fsm.EventDisputeWon, fsm.EventDisputeLost, fsm.EventChargeback},
 		},
 		{state: flowv1.PaymentFlow_PAYMENT_FLOW_REFUNDED, expect: []string{}},
 		{state: flowv1.PaymentFlow_PAYMENT_FLOW_CANCELED, expect: []string{}},
 		{state: flowv1.PaymentFlow_PAYMENT_FLOW_FAILED, expect: []string{}},
+		{state: flowv1.PaymentFlow_PAYMENT_FLOW_CHARGED_BACK, expect: []string{}},
 	}
 
 	for _, tc := range cases {
@@ -133,11 +139,13 @@ func TestFSM_InvalidTransitions(t *testing.T) {
 		flowv1.PaymentFlow_PAYMENT_FLOW_REFUNDED,
 		flowv1.PaymentFlow_PAYMENT_FLOW_CANCELED,
 		flowv1.PaymentFlow_PAYMENT_FLOW_FAILED,
+		flowv1.PaymentFlow_PAYMENT_FLOW_CHARGED_BACK,
 	}
 	allEvents := []string{
 		fsm.EventSCARequired, fsm.EventAuthorize, fsm.EventConfirm,
 		fsm.EventCapture, fsm.EventRefundFull,
 		fsm.EventCancel, fsm.EventFail,
+		fsm.EventDisputeOpen, fsm.EventDisputeWon, fsm.EventDisputeLost, fsm.EventChargeback,
 	}
 
 	for _, s := range terminals {
@@ -188,3 +196,32 @@ func TestFSM_ProblemExits(t *testing.T) {
 		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_FAILED.String(), g.Current())
 	}
 }
+
+func TestFSM_Disputes(t *testing.T) {
+	ctx := context.Background()
+
+	// PAID -> DISPUTED -> PAID (won, or lost in part)
+	for _, ev := range []string{fsm.EventDisputeWon, fsm.EventDisputeLost} {
+		g := fsm.New(flowv1.PaymentFlow_PAYMENT_FLOW_PAID)
+		require.NoError(t, g.Trigger(ctx, fsm.EventDisputeOpen))
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED.String(), g.Current())
+
+		require.NoError(t, g.Trigger(ctx, ev))
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_PAID.String(), g.Current())
+	}
+
+	// PAID -> DISPUTED -> CHARGED_BACK (terminal)
+	{
+		g := fsm.New(flowv1.PaymentFlow_PAYMENT_FLOW_PAID)
+		require.NoError(t, g.Trigger(ctx, fsm.EventDisputeOpen))
+		require.NoError(t, g.Trigger(ctx, fsm.EventChargeback))
+		require.Equal(t, flowv1.PaymentFlow_PAYMENT_FLOW_CHARGED_BACK.String(), g.Current())
+	}
+
+	// Refunds and captures wait for the dispute to be resolved.
+	{
+		g := fsm.New(flowv1.PaymentFlow_PAYMENT_FLOW_DISPUTED)
+		require.Error(t, g.Trigger(ctx, fsm.EventRefundFull))
+		require.Error(t, g.Trigger(ctx, fsm.EventDisputeOpen))
+	}
+}
@@ -15,5 +15,12 @@ var (
 	ErrAuthorizeExceeds     = errors.New("authorize: would exceed amount")
 	ErrCaptureExceedsLimit  = errors.New("capture: would exceed limit")
 	ErrRefundWithoutCapture = errors.New("refund: nothing captured")
+	ErrReleaseWithoutHold   = errors.New("release: nothing authorized")
+	ErrReleaseExceeds       = errors.New("release: would exceed uncaptured authorization")
 	ErrRefundExceeds        = errors.New("refund: would exceed captured")
+	ErrDisputeExceeds       = errors.New("dispute: would exceed refundable")
+	ErrSettleWithoutCapture = errors.New("settle: nothing captured")
+	ErrSettleExceeds        = errors.New("settle: would exceed captured")
+	ErrSettleMismatch       = errors.New("settle: net is not gross minus fee")
+	ErrNegativeFees         = errors.New("settle: fees would turn negative")
 )
@@ -220,6 +220,36 @@ func Sub(a, b *money.Money) (*money.Money, error) {
 	return scaledToMoney(a.GetCurrencyCode(), diff)
 }
 
+// Convert returns m at the rate base -> quote, i.e. m * quote / base in quote's currency
+// (m and base in the same currency, base > 0). The result is rounded half to even to the
+// minor units of quote.
+func Convert(m, base, quote *money.Money) (*money.Money, error) {
+	if m == nil || quote == nil {
+		return nil, ErrNilMoney
+	}
+	if err := validatePositive(base); err != nil {
+		return nil, err
+	}
+	if err := sameCurrency(m, base); err != nil {
+		return nil, err
+	}
+	mn, err := moneyToScaled(m)
+	if err != nil {
+		return nil, err
+	}
+	qn, err := moneyToScaled(quote)
+	if err != nil {
+		return nil, err
+	}
+	bn, _ := moneyToScaled(base)
+	step, _ := StepNanos(quote.GetCurrencyCode()) // valid: quote passed validateScale
+	stepD := decimal.NewFromInt(int64(step))
+
+	// Multiply first to keep the precision of the division for the rounding.
+	v := mn.Mul(qn).Div(bn).Div(stepD).RoundBank(0).Mul(stepD)
+	return scaledToMoney(quote.GetCurrencyCode(), v)
+}
+
 // AmountToMinorUnits converts Money to integer minor units (e.g., cents).
 // It validates currency scale and guarantees exact conversion (no rounding).
 func AmountToMinorUnits(m *money.Money) (int64, error) {
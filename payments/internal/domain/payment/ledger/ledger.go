@@ -1,16 +1,27 @@
 package ledger
 
 import (
+	"time"
+
 	"google.golang.org/genproto/googleapis/type/money"
 )
 
 // Ledger is a Value Object holding monetary totals for a payment.
-// All amounts must share the same currency (as Amount).
+// All amounts must share the same currency (as Amount), except Fees and Net: they are in the
+// settlement currency the provider pays out in.
 type Ledger struct {
-	Amount        *money.Money // target to charge
-	Authorized    *money.Money // total hold
-	Captured      *money.Money // total captured
-	TotalRefunded *money.Money // total refunded
+	Amount          *money.Money // target to charge
+	Authorized      *money.Money // total hold
+	Captured        *money.Money // total captured
+	Released        *money.Money // authorization released uncaptured, nil when none was released
+	TotalRefunded   *money.Money // total refunded
+	PendingRefunded *money.Money // accepted by the provider but not settled, nil when none is pending
+	Disputed        *money.Money // withheld by the open dispute, nil when none is open
+	Reversed        *money.Money // total reversed by lost disputes (chargebacks)
+
+	Fees      *money.Money // total provider fees, nil until the first settlement
+	Net       *money.Money // total paid out net of fees, nil until the first settlement
+	SettledAt time.Time    // latest settlement, zero until the first
 }
 
 // Authorize accumulates a hold.
@@ -39,7 +50,7 @@ func (l *Ledger) Authorize(amt *money.Money) error {
 
 // Capture accumulates captured total.
 // Invariants: amt > 0, same currency.
-// Limit: if Authorized present -> Captured+amt <= Authorized; else <= Amount.
+// Limit: if Authorized present -> Captured+amt <= Authorized-Released; else <= Amount.
 func (l *Ledger) Capture(amt *money.Money) error {
 	if l.Amount == nil {
 		return ErrNilAmount
@@ -57,11 +68,7 @@ func (l *Ledger) Capture(amt *money.Money) error {
 		return err
 	}
 
-	limit := l.Amount
-	if l.Authorized != nil {
-		limit = l.Authorized
-	}
-	if Compare(next, limit) > 0 {
+	if Compare(next, l.captureLimit()) > 0 {
 		return ErrCaptureExceedsLimit
 	}
 
@@ -69,9 +76,43 @@ func (l *Ledger) Capture(amt *money.Money) error {
 	return nil
 }
 
+// Release accumulates the authorization given back uncaptured.
+// Invariants: amt > 0, same currency, Captured+Released+amt <= Authorized.
+func (l *Ledger) Release(amt *money.Money) error {
+	if l.Authorized == nil {
+		return ErrReleaseWithoutHold
+	}
+	if err := validatePositive(amt); err != nil {
+		return err
+	}
+	if err := sameCurrency(l.Authorized, amt); err != nil {
+		return err
+	}
+
+	next, err := Add(ensureMoney(l.Authorized.GetCurrencyCode(), l.Released), amt)
+	if err != nil {
+		return err
+	}
+	if Compare(amt, l.RemainingToCapture()) > 0 {
+		return ErrReleaseExceeds
+	}
+
+	l.Released = next
+	return nil
+}
+
+// captureLimit is Authorized - Released when authorized, else Amount.
+func (l *Ledger) captureLimit() *money.Money {
+	if l.Authorized == nil {
+		return l.Amount
+	}
+	diff, _ := Sub(l.Authorized, ensureMoney(l.Authorized.GetCurrencyCode(), l.Released))
+	return diff
+}
+
 // Refund accumulates TotalRefunded.
-// Invariants: amt > 0, same currency, TotalRefunded+amt <= Captured.
-// Returns full=true if after the operation TotalRefunded == Captured.
+// Invariants: amt > 0, same currency, TotalRefunded+PendingRefunded+amt <= Captured-Reversed.
+// Returns full=true if after the operation TotalRefunded+Reversed == Captured.
 func (l *Ledger) Refund(amt *money.Money) (bool, error) {
 	if l.Captured == nil {
 		return false, ErrRefundWithoutCapture
@@ -88,42 +129,108 @@ func (l *Ledger) Refund(amt *money.Money) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	if Compare(next, l.Captured) > 0 {
+	limit := l.Settled()
+	reserved, err := Add(next, ensureMoney(l.Captured.GetCurrencyCode(), l.PendingRefunded))
+	if err != nil {
+		return false, err
+	}
+	if Compare(reserved, limit) > 0 {
 		return false, ErrRefundExceeds
 	}
 
 	l.TotalRefunded = next
-	return Compare(l.TotalRefunded, l.Captured) == 0, nil
+	return Compare(l.TotalRefunded, limit) == 0, nil
 }
 
-// RemainingToCapture returns Amount/Authorized minus Captured (same currency).
+// Settle accumulates a settlement of the payment: gross is what the provider credited, fee what it
+// kept and net what it paid out, all in the settlement currency.
+// Invariants: captured funds, same settlement currency, net = gross - fee, Fees+fee >= 0.
+func (l *Ledger) Settle(gross, fee, net *money.Money, at time.Time) error {
+	if l.Captured == nil {
+		return ErrSettleWithoutCapture
+	}
+	if gross == nil || fee == nil || net == nil {
+		return ErrNilMoney
+	}
+	if err := sameCurrency(gross, fee); err != nil {
+		return err
+	}
+	if err := sameCurrency(gross, net); err != nil {
+		return err
+	}
+	if l.Net != nil {
+		if err := sameCurrency(l.Net, net); err != nil {
+			return err
+		}
+	}
+
+	paid, err := Sub(gross, fee)
+	if err != nil {
+		return err
+	}
+	if Compare(paid, net) != 0 {
+		return ErrSettleMismatch
+	}
+
+	cur := net.GetCurrencyCode()
+	fees, err := Add(ensureMoney(cur, l.Fees), fee)
+	if err != nil {
+		return err
+	}
+	if Compare(fees, Zero(cur)) < 0 {
+		return ErrNegativeFees
+	}
+	total, err := Add(ensureMoney(cur, l.Net), net)
+	if err != nil {
+		return err
+	}
+
+	l.Fees, l.Net = fees, total
+	if at.After(l.SettledAt) {
+		l.SettledAt = at
+	}
+	return nil
+}
+
+// Settled returns Captured - Reversed: what the merchant keeps unless it is refunded.
+func (l *Ledger) Settled() *money.Money {
+	if l.Captured == nil {
+		return nil
+	}
+	rev := ensureMoney(l.Captured.GetCurrencyCode(), l.Reversed)
+	diff, _ := Sub(l.Captured, rev)
+	return diff
+}
+
+// RemainingToCapture returns Amount/Authorized minus Captured and Released (same currency).
 func (l *Ledger) RemainingToCapture() *money.Money {
 	if l.Amount == nil {
 		return nil
 	}
-	limit := l.Amount
-	if l.Authorized != nil {
-		limit = l.Authorized
-	}
+	limit := l.captureLimit()
 	cap := ensureMoney(limit.GetCurrencyCode(), l.Captured)
 	diff, _ := Sub(limit, cap)
 	return diff
 }
 
-// Refundable returns Captured - TotalRefunded (same currency).
+// Refundable returns Captured - TotalRefunded - PendingRefunded - Reversed - Disputed (same currency):
+// neither pending, reversed nor withheld funds can be refunded again.
 func (l *Ledger) Refundable() *money.Money {
 	if l.Captured == nil {
 		return nil
 	}
-	ref := ensureMoney(l.Captured.GetCurrencyCode(), l.TotalRefunded)
-	diff, _ := Sub(l.Captured, ref)
+	cur := l.Captured.GetCurrencyCode()
+	diff := l.Settled()
+	for _, m := range []*money.Money{l.TotalRefunded, l.PendingRefunded, l.Disputed} {
+		diff, _ = Sub(diff, ensureMoney(cur, m))
+	}
 	return diff
 }
 
-// IsFullyRefunded is true if TotalRefunded == Captured (>0).
+// IsFullyRefunded is true if TotalRefunded == Captured - Reversed (>0).
 func (l *Ledger) IsFullyRefunded() bool {
 	if l.Captured == nil {
 		return false
 	}
-	return Compare(ensureMoney(l.Captured.GetCurrencyCode(), l.TotalRefunded), l.Captured) == 0
+	return Compare(ensureMoney(l.Captured.GetCurrencyCode(), l.TotalRefunded), l.Settled()) == 0
 }
@@ -2,6 +2,7 @@ package ledger
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/type/money"
@@ -64,6 +65,23 @@ func TestCaptureImmediateWithoutAuthorized(t *testing.T) {
 	require.ErrorIs(t, err, ErrCaptureExceedsLimit)
 }
 
+func TestReleaseStopsFurtherCaptures(t *testing.T) {
+	l := &Ledger{Amount: M("USD", 10, 0)}
+	require.ErrorIs(t, l.Release(M("USD", 1, 0)), ErrReleaseWithoutHold)
+
+	require.NoError(t, l.Authorize(M("USD", 10, 0)))
+	require.NoError(t, l.Capture(M("USD", 6, 0)))
+
+	require.ErrorIs(t, l.Release(M("USD", 5, 0)), ErrReleaseExceeds)
+	require.ErrorIs(t, l.Release(M("EUR", 4, 0)), ErrCurrencyMismatch)
+	require.NoError(t, l.Release(M("USD", 4, 0)))
+	require.Equal(t, int64(4), l.Released.Units)
+	require.Zero(t, l.RemainingToCapture().Units)
+
+	require.ErrorIs(t, l.Capture(M("USD", 1, 0)), ErrCaptureExceedsLimit)
+	require.ErrorIs(t, l.Release(M("USD", 0, 10_000_000)), ErrReleaseExceeds)
+}
+
 func TestRefundAccumulatesAndStopsAtCaptured(t *testing.T) {
 	l := &Ledger{
 		Amount:   M("USD", 10, 0),
@@ -152,3 +170,91 @@ func TestSubUnitsWithNanos_ValidScale(t *testing.T) {
 	err := l.Authorize(M("USD", 0, 10_000_000))
 	require.ErrorIs(t, err, ErrAuthorizeExceeds)
 }
+
+func TestRefundStopsAtSettledAfterChargeback(t *testing.T) {
+	l := &Ledger{
+		Amount:   M("USD", 10, 0),
+		Captured: M("USD", 10, 0),
+		Reversed: M("USD", 4, 0),
+	}
+
+	require.Equal(t, int64(6), l.Settled().Units)
+	require.Equal(t, int64(6), l.Refundable().Units)
+
+	// Withheld by an open dispute
+	l.Disputed = M("USD", 2, 0)
+	require.Equal(t, int64(4), l.Refundable().Units)
+	l.Disputed = nil
+
+	_, err := l.Refund(M("USD", 7, 0))
+	require.ErrorIs(t, err, ErrRefundExceeds)
+
+	full, err := l.Refund(M("USD", 6, 0))
+	require.NoError(t, err)
+	require.True(t, full)
+	require.True(t, l.IsFullyRefunded())
+}
+
+func TestPendingRefundsAreNotRefundable(t *testing.T) {
+	l := &Ledger{
+		Amount:          M("USD", 10, 0),
+		Captured:        M("USD", 10, 0),
+		PendingRefunded: M("USD", 3, 0),
+	}
+
+	require.Equal(t, int64(7), l.Refundable().Units)
+
+	_, err := l.Refund(M("USD", 8, 0))
+	require.ErrorIs(t, err, ErrRefundExceeds)
+
+	full, err := l.Refund(M("USD", 7, 0))
+	require.NoError(t, err)
+	require.False(t, full, "pending refunds are not settled yet")
+	require.False(t, l.IsFullyRefunded())
+}
+
+func TestSettleAccumulatesFeesAndNet(t *testing.T) {
+	l := &Ledger{Amount: M("EUR", 100, 0)}
+	at := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	require.ErrorIs(t, l.Settle(M("USD", 108, 500_000_000), M("USD", 3, 450_000_000), M("USD", 105, 50_000_000), at), ErrSettleWithoutCapture)
+	l.Captured = M("EUR", 100, 0)
+
+	// Settled in another currency than the payment.
+	require.NoError(t, l.Settle(M("USD", 108, 500_000_000), M("USD", 3, 450_000_000), M("USD", 105, 50_000_000), at))
+	require.Equal(t, M("USD", 3, 450_000_000), l.Fees)
+	require.Equal(t, M("USD", 105, 50_000_000), l.Net)
+	require.Equal(t, at, l.SettledAt)
+
+	// A refund takes funds back; an earlier settlement does not move SettledAt back.
+	require.NoError(t, l.Settle(M("USD", -10, 0), M("USD", 0, 0), M("USD", -10, 0), at.Add(-time.Hour)))
+	require.Equal(t, M("USD", 95, 50_000_000), l.Net)
+	require.Equal(t, at, l.SettledAt)
+
+	require.ErrorIs(t, l.Settle(M("USD", 10, 0), M("USD", 1, 0), M("USD", 10, 0), at), ErrSettleMismatch)
+	require.ErrorIs(t, l.Settle(M("EUR", 10, 0), M("EUR", 1, 0), M("EUR", 9, 0), at), ErrCurrencyMismatch)
+	require.ErrorIs(t, l.Settle(M("USD", 0, 0), M("USD", -4, 0), M("USD", 4, 0), at), ErrNegativeFees)
+	require.ErrorIs(t, l.Settle(nil, M("USD", 0, 0), M("USD", 0, 0), at), ErrNilMoney)
+}
+
+func TestConvertRoundsToMinorUnits(t *testing.T) {
+	// 100.00 EUR settled as 108.50 USD: 10.00 EUR back is 10.85 USD.
+	got, err := Convert(M("EUR", 10, 0), M("EUR", 100, 0), M("USD", 108, 500_000_000))
+	require.NoError(t, err)
+	require.Equal(t, M("USD", 10, 850_000_000), got)
+
+	// 33.33 * 1.085 = 36.16305 -> 36.16
+	got, err = Convert(M("EUR", 33, 330_000_000), M("EUR", 100, 0), M("USD", 108, 500_000_000))
+	require.NoError(t, err)
+	require.Equal(t, M("USD", 36, 160_000_000), got)
+
+	// Negative amounts convert to negative amounts.
+	got, err = Convert(M("EUR", -10, 0), M("EUR", 100, 0), M("JPY", 16000, 0))
+	require.NoError(t, err)
+	require.Equal(t, M("JPY", -1600, 0), got)
+
+	_, err = Convert(M("EUR", 1, 0), M("EUR", 0, 0), M("USD", 1, 0))
+	require.ErrorIs(t, err, ErrNonPositiveAmount)
+	_, err = Convert(M("USD", 1, 0), M("EUR", 1, 0), M("USD", 1, 0))
+	require.ErrorIs(t, err, ErrCurrencyMismatch)
+}
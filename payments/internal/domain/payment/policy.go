@@ -3,6 +3,8 @@ package payment
 
 import (
 	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+
+	"google.golang.org/genproto/googleapis/type/money"
 )
 
 // Policy defines business rules independent from process state.
@@ -11,14 +13,33 @@ type Policy interface {
 	AllowImmediateCapture(kind eventv1.PaymentKind, mode eventv1.CaptureMode) bool
 	// IsCurrencySupported tells if currency is allowed.
 	IsCurrencySupported(code string) bool
-	// ShouldRequireSCA allows forcing SCA at creation time (can be extended by amount/region/etc).
-	ShouldRequireSCA(kind eventv1.PaymentKind, mode eventv1.CaptureMode) bool
+	// ShouldRequireSCA decides at creation time whether SCA/3DS is requested, or which exemption applies.
+	ShouldRequireSCA(in SCAContext) SCADecision
+	// Specifications returns the rules new payments, captures and refunds must satisfy.
+	Specifications() Specifications
+}
+
+// SCAContext is what an SCA decision is taken on.
+type SCAContext struct {
+	Amount            *money.Money
+	Kind              eventv1.PaymentKind
+	Mode              eventv1.CaptureMode
+	Region            string // customer country, ISO 3166-1 alpha-2; empty if unknown
+	MerchantInitiated bool   // charged by the merchant without the customer in session
+}
+
+// SCADecision is the outcome of Policy.ShouldRequireSCA.
+type SCADecision struct {
+	Required  bool
+	Exemption eventv1.SCAExemption // why SCA is not required; UNSPECIFIED if none was claimed
+	Rule      string               // name of the rule that matched
 }
 
 // StaticPolicy is a simple default implementation.
 type StaticPolicy struct {
 	SupportedCurrencies map[string]struct{} // nil => allow all
 	ForceSCA            bool                // if true — always require SCA
+	Limits              Limits              // amount, currency and operation-count rules
 }
 
 func (p *StaticPolicy) AllowImmediateCapture(_ eventv1.PaymentKind, mode eventv1.CaptureMode) bool {
@@ -33,8 +54,12 @@ func (p *StaticPolicy) IsCurrencySupported(code string) bool {
 	return ok
 }
 
-func (p *StaticPolicy) ShouldRequireSCA(_ eventv1.PaymentKind, _ eventv1.CaptureMode) bool {
-	return p.ForceSCA
+func (p *StaticPolicy) ShouldRequireSCA(_ SCAContext) SCADecision {
+	return SCADecision{Required: p.ForceSCA, Rule: "static"}
+}
+
+func (p *StaticPolicy) Specifications() Specifications {
+	return NewSpecifications(p.Limits)
 }
 
 var defaultPolicy = &StaticPolicy{}
@@ -0,0 +1,93 @@
+package payment
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+)
+
+// RefundStatus is the lifecycle of a single refund.
+type RefundStatus int
+
+const (
+	RefundStatusUnspecified RefundStatus = iota
+	RefundStatusPending                  // accepted by the provider, money not returned yet
+	RefundStatusSucceeded                // money returned to the customer
+	RefundStatusFailed                   // rejected or reverted by the provider; amount is refundable again
+)
+
+func (s RefundStatus) String() string {
+	switch s {
+	case RefundStatusPending:
+		return "pending"
+	case RefundStatusSucceeded:
+		return "succeeded"
+	case RefundStatusFailed:
+		return "failed"
+	default:
+		return "unspecified"
+	}
+}
+
+// Refund is one refund of a payment. Its ID is ours; the provider refund ID links webhooks to it.
+type Refund struct {
+	ID               uuid.UUID
+	ProviderRefundID string // e.g. Stripe re_...
+	Amount           *money.Money
+	Reason           eventv1.RefundReason
+	Status           RefundStatus
+}
+
+// Refunds returns a copy of all refunds in the order they were requested.
+func (p *Payment) Refunds() []Refund {
+	out := make([]Refund, len(p.refunds))
+	for i, r := range p.refunds {
+		r.Amount = ledger.Clone(r.Amount)
+		out[i] = r
+	}
+	return out
+}
+
+// FindRefund looks a refund up by our ID.
+func (p *Payment) FindRefund(id uuid.UUID) (Refund, bool) {
+	if r := p.refund(id); r != nil {
+		return *r, true
+	}
+	return Refund{}, false
+}
+
+// FindProviderRefund looks a refund up by the provider refund ID.
+func (p *Payment) FindProviderRefund(providerRefundID string) (Refund, bool) {
+	if providerRefundID == "" {
+		return Refund{}, false
+	}
+	for _, r := range p.refunds {
+		if r.ProviderRefundID == providerRefundID {
+			return r, true
+		}
+	}
+	return Refund{}, false
+}
+
+func (p *Payment) refund(id uuid.UUID) *Refund {
+	if id == uuid.Nil {
+		return nil
+	}
+	for i := range p.refunds {
+		if p.refunds[i].ID == id {
+			return &p.refunds[i]
+		}
+	}
+	return nil
+}
+
+// refundID decodes a refund ID from event bytes; legacy events carry none.
+func refundID(b []byte) uuid.UUID {
+	id, err := uuid.FromBytes(b)
+	if err != nil {
+		return uuid.Nil
+	}
+	return id
+}
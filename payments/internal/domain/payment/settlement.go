@@ -0,0 +1,90 @@
+package payment
+
+import (
+	"time"
+
+	"google.golang.org/genproto/googleapis/type/money"
+
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+)
+
+// Settlement is a movement of the payment's funds in a provider payout. Amount is in the
+// payment currency; Gross, Fee, FXAdjustment and Net are in the settlement currency.
+type Settlement struct {
+	ID           string // provider transaction, e.g. Stripe txn_...; unique per payment
+	PayoutID     string // e.g. Stripe po_..., T-Bank payment order number
+	Type         eventv1.SettlementType
+	Amount       *money.Money // negative when funds go back, e.g. a refund
+	Gross        *money.Money // Amount converted by the provider
+	Fee          *money.Money // provider fees, negative when refunded
+	FXAdjustment *money.Money // Gross - Amount at the rate of the first settled charge; set by Settle
+	Net          *money.Money // Gross - Fee, paid out
+	SettledAt    time.Time    // funds available for the payout
+}
+
+// SettlementOf reads a settlement from its event.
+func SettlementOf(ev *eventv1.PaymentSettled) Settlement {
+	return Settlement{
+		ID:           ev.GetSettlementId(),
+		PayoutID:     ev.GetPayoutId(),
+		Type:         ev.GetType(),
+		Amount:       ledger.Clone(ev.GetAmount()),
+		Gross:        ledger.Clone(ev.GetGross()),
+		Fee:          ledger.Clone(ev.GetFee()),
+		FXAdjustment: ledger.Clone(ev.GetFxAdjustment()),
+		Net:          ledger.Clone(ev.GetNet()),
+		SettledAt:    ev.GetSettledAt().AsTime(),
+	}
+}
+
+// Settlements returns a copy of all settlements in the order they were imported.
+func (p *Payment) Settlements() []Settlement {
+	out := make([]Settlement, len(p.settlements))
+	for i, s := range p.settlements {
+		s.Amount, s.Gross, s.Fee = ledger.Clone(s.Amount), ledger.Clone(s.Gross), ledger.Clone(s.Fee)
+		s.FXAdjustment, s.Net = ledger.Clone(s.FXAdjustment), ledger.Clone(s.Net)
+		out[i] = s
+	}
+	return out
+}
+
+// FindSettlement looks a settlement up by the provider transaction ID.
+func (p *Payment) FindSettlement(id string) (Settlement, bool) {
+	for _, s := range p.settlements {
+		if s.ID == id {
+			return s, true
+		}
+	}
+	return Settlement{}, false
+}
+
+// settledCharges sums the Amount of the charges settled so far, in the payment currency.
+func (p *Payment) settledCharges() *money.Money {
+	total := ledger.Zero(p.Ledger.Amount.GetCurrencyCode())
+	for _, s := range p.settlements {
+		if s.Type == eventv1.SettlementType_SETTLEMENT_TYPE_CHARGE {
+			total, _ = ledger.Add(total, s.Amount)
+		}
+	}
+	return total
+}
+
+// fxAdjustment returns what s.Gross differs from s.Amount at the rate of the first settled charge.
+// A payment settled in its own currency has a rate of 1; the first charge sets the rate otherwise.
+func (p *Payment) fxAdjustment(s Settlement) (*money.Money, error) {
+	if ledger.Currency(s.Gross) == ledger.Currency(s.Amount) {
+		return ledger.Sub(s.Gross, s.Amount)
+	}
+	for _, c := range p.settlements {
+		if c.Type != eventv1.SettlementType_SETTLEMENT_TYPE_CHARGE || ledger.Currency(c.Gross) != ledger.Currency(s.Gross) {
+			continue
+		}
+		at, err := ledger.Convert(s.Amount, c.Amount, c.Gross)
+		if err != nil {
+			return nil, err
+		}
+		return ledger.Sub(s.Gross, at)
+	}
+	return ledger.Zero(s.Gross.GetCurrencyCode()), nil
+}
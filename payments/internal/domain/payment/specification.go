@@ -0,0 +1,216 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/shopspring/decimal"
+	"github.com/shortlink-org/shortlink/pkg/pattern/specification"
+
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+
+	"google.golang.org/genproto/googleapis/type/money"
+)
+
+// ErrPolicyViolation is wrapped by every Violation.
+var ErrPolicyViolation = errors.New("payment: policy violation")
+
+// Rule names reported by Violation.
+const (
+	RuleMinAmount   = "min_amount"
+	RuleMaxAmount   = "max_amount"
+	RuleCurrency    = "currency"
+	RuleMaxCaptures = "max_captures"
+	RuleMaxRefunds  = "max_refunds"
+)
+
+// Violation explains which payment specification failed and why.
+// Several violations of one check are joined; Violations lists them.
+type Violation struct {
+	Rule   string // one of the Rule* names
+	Detail string
+}
+
+func (v *Violation) Error() string { return fmt.Sprintf("payment: %s: %s", v.Rule, v.Detail) }
+
+func (v *Violation) Unwrap() error { return ErrPolicyViolation }
+
+// Violations returns every Violation in err's tree.
+func Violations(err error) []*Violation {
+	var out []*Violation
+	var walk func(error)
+	walk = func(err error) {
+		switch e := err.(type) {
+		case nil:
+		case *Violation:
+			out = append(out, e)
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				walk(inner)
+			}
+		default:
+			walk(errors.Unwrap(err))
+		}
+	}
+	walk(err)
+	return out
+}
+
+// Creation is what the creation specifications are checked against.
+type Creation struct {
+	Amount *money.Money
+	Kind   eventv1.PaymentKind
+	Mode   eventv1.CaptureMode
+}
+
+// Operation is a capture or refund about to be sent to the provider.
+type Operation struct {
+	Amount   *money.Money
+	Previous int // operations of the same type already recorded for the payment
+}
+
+// CurrencyScope selects payments a currency list applies to. Unspecified fields match everything.
+type CurrencyScope struct {
+	Kind eventv1.PaymentKind
+	Mode eventv1.CaptureMode
+}
+
+// Limits configure the payment specifications. Zero values disable a limit.
+type Limits struct {
+	MinAmount map[string]*money.Money // by currency; a currency without an entry has no minimum
+	MaxAmount map[string]*money.Money // by currency; a currency without an entry has no maximum
+	// Currencies allowed per scope; the most specific scope wins: kind and mode, kind, mode, any.
+	Currencies  map[CurrencyScope][]string
+	MaxCaptures int // captures per payment, partial ones included
+	MaxRefunds  int // refunds per payment that did not fail
+}
+
+// Specifications are the composed rules of a Policy.
+type Specifications struct {
+	Create  specification.Specification[Creation]  // checked by New
+	Capture specification.Specification[Operation] // checked by CheckCapture
+	Refund  specification.Specification[Operation] // checked by CheckRefund
+}
+
+// NewSpecifications composes the specifications configured by l.
+func NewSpecifications(l Limits) Specifications {
+	return Specifications{
+		Create: specification.NewAndSpecification[Creation](
+			&MinAmount{Limits: l.MinAmount},
+			&MaxAmount{Limits: l.MaxAmount},
+			&AllowedCurrency{Currencies: l.Currencies},
+		),
+		Capture: specification.NewAndSpecification[Operation](
+			&MaxOperations{Rule: RuleMaxCaptures, Max: l.MaxCaptures},
+		),
+		Refund: specification.NewAndSpecification[Operation](
+			&MaxOperations{Rule: RuleMaxRefunds, Max: l.MaxRefunds},
+		),
+	}
+}
+
+// CheckCapture tells whether a capture of amt may be sent to the provider.
+// Captures the provider already made (e.g. reported by webhooks) are recorded regardless.
+func (p *Payment) CheckCapture(spec specification.Specification[Operation], amt *money.Money) error {
+	return satisfies(spec, &Operation{Amount: amt, Previous: p.captures})
+}
+
+// CheckRefund tells whether a refund of amt may be sent to the provider.
+// Refunds the provider already made (e.g. reported by webhooks) are recorded regardless.
+func (p *Payment) CheckRefund(spec specification.Specification[Operation], amt *money.Money) error {
+	previous := 0
+	for _, r := range p.refunds {
+		if r.Status != RefundStatusFailed {
+			previous++
+		}
+	}
+	return satisfies(spec, &Operation{Amount: amt, Previous: previous})
+}
+
+// CheckIncrease tells whether the hold may be raised by amt: a payment amount it raises
+// must still satisfy spec, the creation specifications.
+func (p *Payment) CheckIncrease(spec specification.Specification[Creation], amt *money.Money) error {
+	next, err := ledger.Add(p.Ledger.Authorized, amt)
+	if err != nil || ledger.Compare(next, p.Ledger.Amount) <= 0 {
+		return err
+	}
+	return satisfies(spec, &Creation{Amount: next, Kind: p.kind, Mode: p.captureMode})
+}
+
+func satisfies[T any](spec specification.Specification[T], candidate *T) error {
+	if spec == nil {
+		return nil
+	}
+	return spec.IsSatisfiedBy(candidate)
+}
+
+// MinAmount rejects payments below the minimum of their currency.
+type MinAmount struct {
+	Limits map[string]*money.Money
+}
+
+func (s *MinAmount) IsSatisfiedBy(c *Creation) error {
+	limit, ok := s.Limits[c.Amount.GetCurrencyCode()]
+	if !ok || ledger.Compare(c.Amount, limit) >= 0 {
+		return nil
+	}
+	return &Violation{Rule: RuleMinAmount, Detail: fmt.Sprintf("%s is below the minimum of %s", format(c.Amount), format(limit))}
+}
+
+// MaxAmount rejects payments above the maximum of their currency.
+type MaxAmount struct {
+	Limits map[string]*money.Money
+}
+
+func (s *MaxAmount) IsSatisfiedBy(c *Creation) error {
+	limit, ok := s.Limits[c.Amount.GetCurrencyCode()]
+	if !ok || ledger.Compare(c.Amount, limit) <= 0 {
+		return nil
+	}
+	return &Violation{Rule: RuleMaxAmount, Detail: fmt.Sprintf("%s is above the maximum of %s", format(c.Amount), format(limit))}
+}
+
+// AllowedCurrency rejects currencies not listed for the payment kind and capture mode.
+type AllowedCurrency struct {
+	Currencies map[CurrencyScope][]string
+}
+
+func (s *AllowedCurrency) IsSatisfiedBy(c *Creation) error {
+	for _, scope := range []CurrencyScope{
+		{Kind: c.Kind, Mode: c.Mode},
+		{Kind: c.Kind},
+		{Mode: c.Mode},
+		{},
+	} {
+		allowed, ok := s.Currencies[scope]
+		if !ok {
+			continue
+		}
+		if slices.Contains(allowed, c.Amount.GetCurrencyCode()) {
+			return nil
+		}
+		return &Violation{Rule: RuleCurrency, Detail: fmt.Sprintf("%s is not allowed for %s %s payments, allowed: %v",
+			c.Amount.GetCurrencyCode(), c.Kind, c.Mode, allowed)}
+	}
+	return nil
+}
+
+// MaxOperations caps the number of captures or refunds of one payment.
+type MaxOperations struct {
+	Rule string // RuleMaxCaptures or RuleMaxRefunds
+	Max  int
+}
+
+func (s *MaxOperations) IsSatisfiedBy(op *Operation) error {
+	if s.Max <= 0 || op.Previous < s.Max {
+		return nil
+	}
+	return &Violation{Rule: s.Rule, Detail: fmt.Sprintf("limit of %d per payment reached", s.Max)}
+}
+
+func format(m *money.Money) string {
+	amount := decimal.New(m.GetUnits(), 0).Add(decimal.New(int64(m.GetNanos()), -9))
+	return m.GetCurrencyCode() + " " + amount.String()
+}
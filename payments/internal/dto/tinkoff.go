@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment/ledger"
+)
+
+// MapTinkoffStatus maps T-Bank acquiring payment status to provider status.
+func MapTinkoffStatus(status string) ports.ProviderStatus {
+	switch status {
+	case "NEW", "FORM_SHOWED", "3DS_CHECKING":
+		// The customer has yet to pay on the payment form or pass 3DS.
+		return ports.ProviderStatusRequiresAction
+	case "AUTHORIZING", "3DS_CHECKED", "CONFIRMING", "REVERSING":
+		return ports.ProviderStatusPending
+	case "AUTHORIZED", "PARTIAL_REVERSED":
+		return ports.ProviderStatusRequiresCapture
+	case "CONFIRMED", "REFUNDING", "PARTIAL_REFUNDED", "REFUNDED":
+		// Refunds do not undo the capture of the payment.
+		return ports.ProviderStatusSucceeded
+	case "REJECTED", "AUTH_FAIL", "DEADLINE_EXPIRED":
+		return ports.ProviderStatusFailed
+	case "CANCELED", "REVERSED":
+		return ports.ProviderStatusCanceled
+	default:
+		return ports.ProviderStatusUnknown
+	}
+}
+
+// MapTinkoffRefundStatus maps T-Bank acquiring payment status after a refund to refund status.
+func MapTinkoffRefundStatus(status string) ports.ProviderStatus {
+	switch status {
+	case "REFUNDED", "PARTIAL_REFUNDED":
+		return ports.ProviderStatusSucceeded
+	case "REFUNDING":
+		return ports.ProviderStatusPending
+	default:
+		return ports.ProviderStatusUnknown
+	}
+}
+
+// FormatAmountForTinkoff converts money.Money to minor units (kopecks for RUB).
+func FormatAmountForTinkoff(m *money.Money) (int64, error) {
+	return ledger.AmountToMinorUnits(m)
+}
+
+// FromMinorTinkoff converts minor currency units to money.Money; T-Bank responses carry no currency.
+func FromMinorTinkoff(currency string, v int64) *money.Money {
+	return ledger.MinorUnitsToAmount(currency, v)
+}
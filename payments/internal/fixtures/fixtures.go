@@ -0,0 +1,64 @@
+// Package fixtures provides the payments and provider mocks shared by the use-case tests.
+package fixtures
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/money"
+
+	"github.com/shortlink-org/billing/payments/internal/application/payments/ports"
+	"github.com/shortlink-org/billing/payments/internal/application/payments/repository"
+	eventv1 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	"github.com/shortlink-org/billing/payments/internal/domain/payment"
+	"github.com/shortlink-org/billing/payments/internal/mocks"
+)
+
+// ProviderID is the Stripe PaymentIntent ID of the stored payments.
+const ProviderID = "pi_1"
+
+// USD returns whole US dollars.
+func USD(units int64) *money.Money { return &money.Money{CurrencyCode: "USD", Units: units} }
+
+// AuthorizedPayment stores a MANUAL payment holding amount.
+func AuthorizedPayment(t *testing.T, repo repository.PaymentRepository, amount *money.Money) *payment.Payment {
+	t.Helper()
+	return storedPayment(t, repo, amount, eventv1.CaptureMode_CAPTURE_MODE_MANUAL, func(ctx context.Context, p *payment.Payment) error {
+		return p.Authorize(ctx, amount)
+	})
+}
+
+// PaidPayment stores an IMMEDIATE payment that captured amount.
+func PaidPayment(t *testing.T, repo repository.PaymentRepository, amount *money.Money) *payment.Payment {
+	t.Helper()
+	return storedPayment(t, repo, amount, eventv1.CaptureMode_CAPTURE_MODE_IMMEDIATE, func(ctx context.Context, p *payment.Payment) error {
+		return p.Capture(ctx, amount)
+	})
+}
+
+// Provider returns a provider mock reporting caps.
+func Provider(t *testing.T, caps ports.Capabilities) *mocks.MockPaymentProvider {
+	provider := mocks.NewMockPaymentProvider(t)
+	provider.EXPECT().Capabilities().Return(caps).Maybe()
+	return provider
+}
+
+func storedPayment(
+	t *testing.T,
+	repo repository.PaymentRepository,
+	amount *money.Money,
+	mode eventv1.CaptureMode,
+	step func(context.Context, *payment.Payment) error,
+) *payment.Payment {
+	t.Helper()
+	ctx := context.Background()
+
+	p, err := payment.New(uuid.New(), uuid.New(), amount, eventv1.PaymentKind_PAYMENT_KIND_ONE_TIME, mode)
+	require.NoError(t, err)
+	require.NoError(t, p.AttachProvider(ctx, "stripe", ProviderID))
+	require.NoError(t, step(ctx, p))
+	require.NoError(t, repo.Save(ctx, p, 0))
+	return p
+}
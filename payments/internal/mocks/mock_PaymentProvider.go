@@ -22,6 +22,165 @@ func (_m *MockPaymentProvider) EXPECT() *MockPaymentProvider_Expecter {
 	return &MockPaymentProvider_Expecter{mock: &_m.Mock}
 }
 
+// CancelPayment provides a mock function with given fields: ctx, in
+func (_m *MockPaymentProvider) CancelPayment(ctx context.Context, in ports.CancelPaymentIn) (ports.CancelPaymentOut, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelPayment")
+	}
+
+	var r0 ports.CancelPaymentOut
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ports.CancelPaymentIn) (ports.CancelPaymentOut, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ports.CancelPaymentIn) ports.CancelPaymentOut); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(ports.CancelPaymentOut)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ports.CancelPaymentIn) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentProvider_CancelPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelPayment'
+type MockPaymentProvider_CancelPayment_Call struct {
+	*mock.Call
+}
+
+// CancelPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in ports.CancelPaymentIn
+func (_e *MockPaymentProvider_Expecter) CancelPayment(ctx interface{}, in interface{}) *MockPaymentProvider_CancelPayment_Call {
+	return &MockPaymentProvider_CancelPayment_Call{Call: _e.mock.On("CancelPayment", ctx, in)}
+}
+
+func (_c *MockPaymentProvider_CancelPayment_Call) Run(run func(ctx context.Context, in ports.CancelPaymentIn)) *MockPaymentProvider_CancelPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ports.CancelPaymentIn))
+	})
+	return _c
+}
+
+func (_c *MockPaymentProvider_CancelPayment_Call) Return(_a0 ports.CancelPaymentOut, _a1 error) *MockPaymentProvider_CancelPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentProvider_CancelPayment_Call) RunAndReturn(run func(context.Context, ports.CancelPaymentIn) (ports.CancelPaymentOut, error)) *MockPaymentProvider_CancelPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Capabilities provides a mock function with no fields
+func (_m *MockPaymentProvider) Capabilities() ports.Capabilities {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Capabilities")
+	}
+
+	var r0 ports.Capabilities
+	if rf, ok := ret.Get(0).(func() ports.Capabilities); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(ports.Capabilities)
+	}
+
+	return r0
+}
+
+// MockPaymentProvider_Capabilities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Capabilities'
+type MockPaymentProvider_Capabilities_Call struct {
+	*mock.Call
+}
+
+// Capabilities is a helper method to define mock.On call
+func (_e *MockPaymentProvider_Expecter) Capabilities() *MockPaymentProvider_Capabilities_Call {
+	return &MockPaymentProvider_Capabilities_Call{Call: _e.mock.On("Capabilities")}
+}
+
+func (_c *MockPaymentProvider_Capabilities_Call) Run(run func()) *MockPaymentProvider_Capabilities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPaymentProvider_Capabilities_Call) Return(_a0 ports.Capabilities) *MockPaymentProvider_Capabilities_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPaymentProvider_Capabilities_Call) RunAndReturn(run func() ports.Capabilities) *MockPaymentProvider_Capabilities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CapturePayment provides a mock function with given fields: ctx, in
+func (_m *MockPaymentProvider) CapturePayment(ctx context.Context, in ports.CapturePaymentIn) (ports.CapturePaymentOut, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CapturePayment")
+	}
+
+	var r0 ports.CapturePaymentOut
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ports.CapturePaymentIn) (ports.CapturePaymentOut, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ports.CapturePaymentIn) ports.CapturePaymentOut); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(ports.CapturePaymentOut)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ports.CapturePaymentIn) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentProvider_CapturePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CapturePayment'
+type MockPaymentProvider_CapturePayment_Call struct {
+	*mock.Call
+}
+
+// CapturePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in ports.CapturePaymentIn
+func (_e *MockPaymentProvider_Expecter) CapturePayment(ctx interface{}, in interface{}) *MockPaymentProvider_CapturePayment_Call {
+	return &MockPaymentProvider_CapturePayment_Call{Call: _e.mock.On("CapturePayment", ctx, in)}
+}
+
+func (_c *MockPaymentProvider_CapturePayment_Call) Run(run func(ctx context.Context, in ports.CapturePaymentIn)) *MockPaymentProvider_CapturePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ports.CapturePaymentIn))
+	})
+	return _c
+}
+
+func (_c *MockPaymentProvider_CapturePayment_Call) Return(_a0 ports.CapturePaymentOut, _a1 error) *MockPaymentProvider_CapturePayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentProvider_CapturePayment_Call) RunAndReturn(run func(context.Context, ports.CapturePaymentIn) (ports.CapturePaymentOut, error)) *MockPaymentProvider_CapturePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePayment provides a mock function with given fields: ctx, in
 func (_m *MockPaymentProvider) CreatePayment(ctx context.Context, in ports.CreatePaymentIn) (ports.CreatePaymentOut, error) {
 	ret := _m.Called(ctx, in)
@@ -79,6 +238,63 @@ func (_c *MockPaymentProvider_CreatePayment_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetPayment provides a mock function with given fields: ctx, in
+func (_m *MockPaymentProvider) GetPayment(ctx context.Context, in ports.GetPaymentIn) (ports.GetPaymentOut, error) {
+	ret := _m.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPayment")
+	}
+
+	var r0 ports.GetPaymentOut
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ports.GetPaymentIn) (ports.GetPaymentOut, error)); ok {
+		return rf(ctx, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ports.GetPaymentIn) ports.GetPaymentOut); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(ports.GetPaymentOut)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ports.GetPaymentIn) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentProvider_GetPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPayment'
+type MockPaymentProvider_GetPayment_Call struct {
+	*mock.Call
+}
+
+// GetPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in ports.GetPaymentIn
+func (_e *MockPaymentProvider_Expecter) GetPayment(ctx interface{}, in interface{}) *MockPaymentProvider_GetPayment_Call {
+	return &MockPaymentProvider_GetPayment_Call{Call: _e.mock.On("GetPayment", ctx, in)}
+}
+
+func (_c *MockPaymentProvider_GetPayment_Call) Run(run func(ctx context.Context, in ports.GetPaymentIn)) *MockPaymentProvider_GetPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ports.GetPaymentIn))
+	})
+	return _c
+}
+
+func (_c *MockPaymentProvider_GetPayment_Call) Return(_a0 ports.GetPaymentOut, _a1 error) *MockPaymentProvider_GetPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentProvider_GetPayment_Call) RunAndReturn(run func(context.Context, ports.GetPaymentIn) (ports.GetPaymentOut, error)) *MockPaymentProvider_GetPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefundPayment provides a mock function with given fields: ctx, in
 func (_m *MockPaymentProvider) RefundPayment(ctx context.Context, in ports.RefundPaymentIn) (ports.RefundPaymentOut, error) {
 	ret := _m.Called(ctx, in)
@@ -24,6 +24,65 @@ func (_m *MockPaymentRepository) EXPECT() *MockPaymentRepository_Expecter {
 	return &MockPaymentRepository_Expecter{mock: &_m.Mock}
 }
 
+// ListByInvoice provides a mock function with given fields: ctx, invoiceID
+func (_m *MockPaymentRepository) ListByInvoice(ctx context.Context, invoiceID uuid.UUID) ([]*payment.Payment, error) {
+	ret := _m.Called(ctx, invoiceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByInvoice")
+	}
+
+	var r0 []*payment.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*payment.Payment, error)); ok {
+		return rf(ctx, invoiceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*payment.Payment); ok {
+		r0 = rf(ctx, invoiceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*payment.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, invoiceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPaymentRepository_ListByInvoice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByInvoice'
+type MockPaymentRepository_ListByInvoice_Call struct {
+	*mock.Call
+}
+
+// ListByInvoice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invoiceID uuid.UUID
+func (_e *MockPaymentRepository_Expecter) ListByInvoice(ctx interface{}, invoiceID interface{}) *MockPaymentRepository_ListByInvoice_Call {
+	return &MockPaymentRepository_ListByInvoice_Call{Call: _e.mock.On("ListByInvoice", ctx, invoiceID)}
+}
+
+func (_c *MockPaymentRepository_ListByInvoice_Call) Run(run func(ctx context.Context, invoiceID uuid.UUID)) *MockPaymentRepository_ListByInvoice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPaymentRepository_ListByInvoice_Call) Return(_a0 []*payment.Payment, _a1 error) *MockPaymentRepository_ListByInvoice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPaymentRepository_ListByInvoice_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*payment.Payment, error)) *MockPaymentRepository_ListByInvoice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Load provides a mock function with given fields: ctx, id
 func (_m *MockPaymentRepository) Load(ctx context.Context, id uuid.UUID) (*payment.Payment, error) {
 	ret := _m.Called(ctx, id)
@@ -0,0 +1,1987 @@
+// payments/internal/payments/v1/payment_service.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: payments/v1/payment_service.proto
+
+package paymentsv1
+
+import (
+	v11 "github.com/shortlink-org/billing/payments/internal/domain/event/v1"
+	v1 "github.com/shortlink-org/billing/payments/internal/domain/flow/v1"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Payment is a read model of the payment aggregate.
+type Payment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InvoiceId       string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	State           v1.PaymentFlow         `protobuf:"varint,3,opt,name=state,proto3,enum=domain.flow.v1.PaymentFlow" json:"state,omitempty"`
+	Version         uint64                 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Provider        string                 `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`                       // e.g., "stripe"
+	ProviderId      string                 `protobuf:"bytes,6,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"` // e.g., Stripe PaymentIntent ID
+	Amount          *money.Money           `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Authorized      *money.Money           `protobuf:"bytes,8,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	Captured        *money.Money           `protobuf:"bytes,9,opt,name=captured,proto3" json:"captured,omitempty"`
+	Refunded        *money.Money           `protobuf:"bytes,10,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	Disputed        *money.Money           `protobuf:"bytes,11,opt,name=disputed,proto3" json:"disputed,omitempty"`                                      // withheld by an open dispute
+	Reversed        *money.Money           `protobuf:"bytes,12,opt,name=reversed,proto3" json:"reversed,omitempty"`                                      // lost in chargebacks
+	PendingRefunded *money.Money           `protobuf:"bytes,13,opt,name=pending_refunded,json=pendingRefunded,proto3" json:"pending_refunded,omitempty"` // refunds accepted by the gateway, not settled yet
+	Released        *money.Money           `protobuf:"bytes,14,opt,name=released,proto3" json:"released,omitempty"`                                      // authorization given back by a final capture
+	// Settled by the provider, in the settlement currency; unset until the first settlement.
+	Fees          *money.Money           `protobuf:"bytes,15,opt,name=fees,proto3" json:"fees,omitempty"`
+	Net           *money.Money           `protobuf:"bytes,16,opt,name=net,proto3" json:"net,omitempty"`
+	SettledAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *Payment) GetState() v1.PaymentFlow {
+	if x != nil {
+		return x.State
+	}
+	return v1.PaymentFlow(0)
+}
+
+func (x *Payment) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Payment) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Payment) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Payment) GetAuthorized() *money.Money {
+	if x != nil {
+		return x.Authorized
+	}
+	return nil
+}
+
+func (x *Payment) GetCaptured() *money.Money {
+	if x != nil {
+		return x.Captured
+	}
+	return nil
+}
+
+func (x *Payment) GetRefunded() *money.Money {
+	if x != nil {
+		return x.Refunded
+	}
+	return nil
+}
+
+func (x *Payment) GetDisputed() *money.Money {
+	if x != nil {
+		return x.Disputed
+	}
+	return nil
+}
+
+func (x *Payment) GetReversed() *money.Money {
+	if x != nil {
+		return x.Reversed
+	}
+	return nil
+}
+
+func (x *Payment) GetPendingRefunded() *money.Money {
+	if x != nil {
+		return x.PendingRefunded
+	}
+	return nil
+}
+
+func (x *Payment) GetReleased() *money.Money {
+	if x != nil {
+		return x.Released
+	}
+	return nil
+}
+
+func (x *Payment) GetFees() *money.Money {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *Payment) GetNet() *money.Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *Payment) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+type CreateRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId         string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"` // optional client-generated UUID
+	InvoiceId         string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Amount            *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Kind              v11.PaymentKind        `protobuf:"varint,4,opt,name=kind,proto3,enum=domain.event.v1.PaymentKind" json:"kind,omitempty"`
+	Mode              v11.CaptureMode        `protobuf:"varint,5,opt,name=mode,proto3,enum=domain.event.v1.CaptureMode" json:"mode,omitempty"`
+	Description       string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Metadata          map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ReturnUrl         string                 `protobuf:"bytes,8,opt,name=return_url,json=returnUrl,proto3" json:"return_url,omitempty"`
+	IdempotencyKey    string                 `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`            // a retry with the same key returns the first response
+	CustomerRegion    string                 `protobuf:"bytes,10,opt,name=customer_region,json=customerRegion,proto3" json:"customer_region,omitempty"`           // ISO 3166-1 alpha-2, input of the SCA policy
+	MerchantInitiated bool                   `protobuf:"varint,11,opt,name=merchant_initiated,json=merchantInitiated,proto3" json:"merchant_initiated,omitempty"` // charged without the customer in session
+	MerchantId        string                 `protobuf:"bytes,12,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`                       // input of payment routing, e.g. a marketplace seller
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateRequest) Reset() {
+	*x = CreateRequest{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRequest) ProtoMessage() {}
+
+func (x *CreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetKind() v11.PaymentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return v11.PaymentKind(0)
+}
+
+func (x *CreateRequest) GetMode() v11.CaptureMode {
+	if x != nil {
+		return x.Mode
+	}
+	return v11.CaptureMode(0)
+}
+
+func (x *CreateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetReturnUrl() string {
+	if x != nil {
+		return x.ReturnUrl
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetCustomerRegion() string {
+	if x != nil {
+		return x.CustomerRegion
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetMerchantInitiated() bool {
+	if x != nil {
+		return x.MerchantInitiated
+	}
+	return false
+}
+
+func (x *CreateRequest) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+type CreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`  // for the client-side SCA/3DS flow, never logged
+	ScaRequested  bool                   `protobuf:"varint,3,opt,name=sca_requested,json=scaRequested,proto3" json:"sca_requested,omitempty"` // 3DS was requested from the provider by the SCA policy
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResponse) Reset() {
+	*x = CreateResponse{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResponse) ProtoMessage() {}
+
+func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *CreateResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *CreateResponse) GetScaRequested() bool {
+	if x != nil {
+		return x.ScaRequested
+	}
+	return false
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type RefundRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId      string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount         *money.Money           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // unset → refund everything still refundable
+	Reason         v11.RefundReason       `protobuf:"varint,3,opt,name=reason,proto3,enum=domain.event.v1.RefundReason" json:"reason,omitempty"`
+	Metadata       map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // a retry with the same key returns the first response
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RefundRequest) Reset() {
+	*x = RefundRequest{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundRequest) ProtoMessage() {}
+
+func (x *RefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundRequest.ProtoReflect.Descriptor instead.
+func (*RefundRequest) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RefundRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RefundRequest) GetReason() v11.RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return v11.RefundReason(0)
+}
+
+func (x *RefundRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RefundRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type RefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	RefundId      string                 `protobuf:"bytes,2,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	RefundAmount  *money.Money           `protobuf:"bytes,3,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	TotalRefunded *money.Money           `protobuf:"bytes,4,opt,name=total_refunded,json=totalRefunded,proto3" json:"total_refunded,omitempty"`
+	FullRefund    bool                   `protobuf:"varint,5,opt,name=full_refund,json=fullRefund,proto3" json:"full_refund,omitempty"`
+	State         v1.PaymentFlow         `protobuf:"varint,6,opt,name=state,proto3,enum=domain.flow.v1.PaymentFlow" json:"state,omitempty"`
+	Version       uint64                 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	Pending       bool                   `protobuf:"varint,8,opt,name=pending,proto3" json:"pending,omitempty"` // accepted by the gateway, not settled yet
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundResponse) Reset() {
+	*x = RefundResponse{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundResponse) ProtoMessage() {}
+
+func (x *RefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundResponse.ProtoReflect.Descriptor instead.
+func (*RefundResponse) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RefundResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *RefundResponse) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *RefundResponse) GetRefundAmount() *money.Money {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return nil
+}
+
+func (x *RefundResponse) GetTotalRefunded() *money.Money {
+	if x != nil {
+		return x.TotalRefunded
+	}
+	return nil
+}
+
+func (x *RefundResponse) GetFullRefund() bool {
+	if x != nil {
+		return x.FullRefund
+	}
+	return false
+}
+
+func (x *RefundResponse) GetState() v1.PaymentFlow {
+	if x != nil {
+		return x.State
+	}
+	return v1.PaymentFlow(0)
+}
+
+func (x *RefundResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RefundResponse) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+type CaptureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // unset → capture everything still held
+	Metadata      map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Final         bool                   `protobuf:"varint,4,opt,name=final,proto3" json:"final,omitempty"` // release the rest of the authorization; no further captures
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureRequest) Reset() {
+	*x = CaptureRequest{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureRequest) ProtoMessage() {}
+
+func (x *CaptureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureRequest.ProtoReflect.Descriptor instead.
+func (*CaptureRequest) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CaptureRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CaptureRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CaptureRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CaptureRequest) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+type CaptureResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId          string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	CapturedAmount     *money.Money           `protobuf:"bytes,2,opt,name=captured_amount,json=capturedAmount,proto3" json:"captured_amount,omitempty"`
+	TotalCaptured      *money.Money           `protobuf:"bytes,3,opt,name=total_captured,json=totalCaptured,proto3" json:"total_captured,omitempty"`
+	RemainingToCapture *money.Money           `protobuf:"bytes,4,opt,name=remaining_to_capture,json=remainingToCapture,proto3" json:"remaining_to_capture,omitempty"`
+	State              v1.PaymentFlow         `protobuf:"varint,5,opt,name=state,proto3,enum=domain.flow.v1.PaymentFlow" json:"state,omitempty"`
+	Version            uint64                 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	ReleasedAmount     *money.Money           `protobuf:"bytes,7,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount,omitempty"` // set by a final capture that left a remainder
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CaptureResponse) Reset() {
+	*x = CaptureResponse{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureResponse) ProtoMessage() {}
+
+func (x *CaptureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureResponse.ProtoReflect.Descriptor instead.
+func (*CaptureResponse) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CaptureResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CaptureResponse) GetCapturedAmount() *money.Money {
+	if x != nil {
+		return x.CapturedAmount
+	}
+	return nil
+}
+
+func (x *CaptureResponse) GetTotalCaptured() *money.Money {
+	if x != nil {
+		return x.TotalCaptured
+	}
+	return nil
+}
+
+func (x *CaptureResponse) GetRemainingToCapture() *money.Money {
+	if x != nil {
+		return x.RemainingToCapture
+	}
+	return nil
+}
+
+func (x *CaptureResponse) GetState() v1.PaymentFlow {
+	if x != nil {
+		return x.State
+	}
+	return v1.PaymentFlow(0)
+}
+
+func (x *CaptureResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CaptureResponse) GetReleasedAmount() *money.Money {
+	if x != nil {
+		return x.ReleasedAmount
+	}
+	return nil
+}
+
+type IncreaseAuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // added on top of the current hold
+	Metadata      map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncreaseAuthorizationRequest) Reset() {
+	*x = IncreaseAuthorizationRequest{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncreaseAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncreaseAuthorizationRequest) ProtoMessage() {}
+
+func (x *IncreaseAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncreaseAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*IncreaseAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IncreaseAuthorizationRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *IncreaseAuthorizationRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *IncreaseAuthorizationRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type IncreaseAuthorizationResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId       string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	IncrementAmount *money.Money           `protobuf:"bytes,2,opt,name=increment_amount,json=incrementAmount,proto3" json:"increment_amount,omitempty"`
+	TotalAuthorized *money.Money           `protobuf:"bytes,3,opt,name=total_authorized,json=totalAuthorized,proto3" json:"total_authorized,omitempty"`
+	Amount          *money.Money           `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"` // payment amount, raised with the hold when exceeded
+	State           v1.PaymentFlow         `protobuf:"varint,5,opt,name=state,proto3,enum=domain.flow.v1.PaymentFlow" json:"state,omitempty"`
+	Version         uint64                 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *IncreaseAuthorizationResponse) Reset() {
+	*x = IncreaseAuthorizationResponse{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncreaseAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncreaseAuthorizationResponse) ProtoMessage() {}
+
+func (x *IncreaseAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncreaseAuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*IncreaseAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IncreaseAuthorizationResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *IncreaseAuthorizationResponse) GetIncrementAmount() *money.Money {
+	if x != nil {
+		return x.IncrementAmount
+	}
+	return nil
+}
+
+func (x *IncreaseAuthorizationResponse) GetTotalAuthorized() *money.Money {
+	if x != nil {
+		return x.TotalAuthorized
+	}
+	return nil
+}
+
+func (x *IncreaseAuthorizationResponse) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *IncreaseAuthorizationResponse) GetState() v1.PaymentFlow {
+	if x != nil {
+		return x.State
+	}
+	return v1.PaymentFlow(0)
+}
+
+func (x *IncreaseAuthorizationResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ConfirmRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmRequest) Reset() {
+	*x = ConfirmRequest{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRequest) ProtoMessage() {}
+
+func (x *ConfirmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmRequest) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConfirmRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+type ConfirmResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	State         v1.PaymentFlow         `protobuf:"varint,2,opt,name=state,proto3,enum=domain.flow.v1.PaymentFlow" json:"state,omitempty"` // still WAITING_FOR_CONFIRMATION → poll again
+	Version       uint64                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmResponse) Reset() {
+	*x = ConfirmResponse{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmResponse) ProtoMessage() {}
+
+func (x *ConfirmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmResponse) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfirmResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *ConfirmResponse) GetState() v1.PaymentFlow {
+	if x != nil {
+		return x.State
+	}
+	return v1.PaymentFlow(0)
+}
+
+func (x *ConfirmResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type CancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Reason        v11.CancelReason       `protobuf:"varint,2,opt,name=reason,proto3,enum=domain.event.v1.CancelReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CancelRequest) GetReason() v11.CancelReason {
+	if x != nil {
+		return x.Reason
+	}
+	return v11.CancelReason(0)
+}
+
+type CancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Reason        v11.CancelReason       `protobuf:"varint,2,opt,name=reason,proto3,enum=domain.event.v1.CancelReason" json:"reason,omitempty"`
+	State         v1.PaymentFlow         `protobuf:"varint,3,opt,name=state,proto3,enum=domain.flow.v1.PaymentFlow" json:"state,omitempty"`
+	Version       uint64                 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CancelResponse) GetReason() v11.CancelReason {
+	if x != nil {
+		return x.Reason
+	}
+	return v11.CancelReason(0)
+}
+
+func (x *CancelResponse) GetState() v1.PaymentFlow {
+	if x != nil {
+		return x.State
+	}
+	return v1.PaymentFlow(0)
+}
+
+func (x *CancelResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ListByInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListByInvoiceRequest) Reset() {
+	*x = ListByInvoiceRequest{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListByInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListByInvoiceRequest) ProtoMessage() {}
+
+func (x *ListByInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListByInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*ListByInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListByInvoiceRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+type ListByInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payments      []*Payment             `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListByInvoiceResponse) Reset() {
+	*x = ListByInvoiceResponse{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListByInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListByInvoiceResponse) ProtoMessage() {}
+
+func (x *ListByInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListByInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*ListByInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListByInvoiceResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type ImportSettlementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                 // e.g., "stripe"; empty → the default provider
+	PayoutId      string                 `protobuf:"bytes,2,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"` // e.g., Stripe po_..., T-Bank payment order number
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSettlementRequest) Reset() {
+	*x = ImportSettlementRequest{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSettlementRequest) ProtoMessage() {}
+
+func (x *ImportSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSettlementRequest.ProtoReflect.Descriptor instead.
+func (*ImportSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportSettlementRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ImportSettlementRequest) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+type ImportSettlementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      string                 `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	Imported      int32                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	Duplicates    int32                  `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"` // imported before, a repeated import is safe
+	Unmatched     int32                  `protobuf:"varint,4,opt,name=unmatched,proto3" json:"unmatched,omitempty"`   // of no payment of ours
+	Skipped       int32                  `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`       // not a payment's funds, e.g. the payout itself
+	Rejected      int32                  `protobuf:"varint,6,opt,name=rejected,proto3" json:"rejected,omitempty"`     // refused by their payment, e.g. a charge above what it captured
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSettlementResponse) Reset() {
+	*x = ImportSettlementResponse{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSettlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSettlementResponse) ProtoMessage() {}
+
+func (x *ImportSettlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSettlementResponse.ProtoReflect.Descriptor instead.
+func (*ImportSettlementResponse) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportSettlementResponse) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+func (x *ImportSettlementResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportSettlementResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *ImportSettlementResponse) GetUnmatched() int32 {
+	if x != nil {
+		return x.Unmatched
+	}
+	return 0
+}
+
+func (x *ImportSettlementResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportSettlementResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+type GetSettlementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      string                 `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettlementRequest) Reset() {
+	*x = GetSettlementRequest{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettlementRequest) ProtoMessage() {}
+
+func (x *GetSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettlementRequest.ProtoReflect.Descriptor instead.
+func (*GetSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetSettlementRequest) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+// Settlement is a movement of a payment's funds in a payout.
+type Settlement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // provider transaction
+	PaymentId     string                 `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Type          v11.SettlementType     `protobuf:"varint,4,opt,name=type,proto3,enum=domain.event.v1.SettlementType" json:"type,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // in the payment currency
+	Gross         *money.Money           `protobuf:"bytes,6,opt,name=gross,proto3" json:"gross,omitempty"`   // in the settlement currency, as are the following
+	Fee           *money.Money           `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	FxAdjustment  *money.Money           `protobuf:"bytes,8,opt,name=fx_adjustment,json=fxAdjustment,proto3" json:"fx_adjustment,omitempty"`
+	Net           *money.Money           `protobuf:"bytes,9,opt,name=net,proto3" json:"net,omitempty"`
+	SettledAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settlement) ProtoMessage() {}
+
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Settlement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Settlement) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *Settlement) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Settlement) GetType() v11.SettlementType {
+	if x != nil {
+		return x.Type
+	}
+	return v11.SettlementType(0)
+}
+
+func (x *Settlement) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Settlement) GetGross() *money.Money {
+	if x != nil {
+		return x.Gross
+	}
+	return nil
+}
+
+func (x *Settlement) GetFee() *money.Money {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *Settlement) GetFxAdjustment() *money.Money {
+	if x != nil {
+		return x.FxAdjustment
+	}
+	return nil
+}
+
+func (x *Settlement) GetNet() *money.Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *Settlement) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+type GetSettlementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      string                 `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	Settlements   []*Settlement          `protobuf:"bytes,2,rep,name=settlements,proto3" json:"settlements,omitempty"` // by settlement time
+	Gross         *money.Money           `protobuf:"bytes,3,opt,name=gross,proto3" json:"gross,omitempty"`
+	Fees          *money.Money           `protobuf:"bytes,4,opt,name=fees,proto3" json:"fees,omitempty"`
+	Net           *money.Money           `protobuf:"bytes,5,opt,name=net,proto3" json:"net,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettlementResponse) Reset() {
+	*x = GetSettlementResponse{}
+	mi := &file_payments_v1_payment_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettlementResponse) ProtoMessage() {}
+
+func (x *GetSettlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_v1_payment_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettlementResponse.ProtoReflect.Descriptor instead.
+func (*GetSettlementResponse) Descriptor() ([]byte, []int) {
+	return file_payments_v1_payment_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetSettlementResponse) GetPayoutId() string {
+	if x != nil {
+		return x.PayoutId
+	}
+	return ""
+}
+
+func (x *GetSettlementResponse) GetSettlements() []*Settlement {
+	if x != nil {
+		return x.Settlements
+	}
+	return nil
+}
+
+func (x *GetSettlementResponse) GetGross() *money.Money {
+	if x != nil {
+		return x.Gross
+	}
+	return nil
+}
+
+func (x *GetSettlementResponse) GetFees() *money.Money {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *GetSettlementResponse) GetNet() *money.Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+var File_payments_v1_payment_service_proto protoreflect.FileDescriptor
+
+const file_payments_v1_payment_service_proto_rawDesc = "" +
+	"\n" +
+	"!payments/v1/payment_service.proto\x12\vpayments.v1\x1a$domain/event/v1/payment_events.proto\x1a\x19domain/flow/v1/flow.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"\xda\x05\n" +
+	"\aPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\tR\tinvoiceId\x121\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x1b.domain.flow.v1.PaymentFlowR\x05state\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x04R\aversion\x12\x1a\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vprovider_id\x18\x06 \x01(\tR\n" +
+	"providerId\x12*\n" +
+	"\x06amount\x18\a \x01(\v2\x12.google.type.MoneyR\x06amount\x122\n" +
+	"\n" +
+	"authorized\x18\b \x01(\v2\x12.google.type.MoneyR\n" +
+	"authorized\x12.\n" +
+	"\bcaptured\x18\t \x01(\v2\x12.google.type.MoneyR\bcaptured\x12.\n" +
+	"\brefunded\x18\n" +
+	" \x01(\v2\x12.google.type.MoneyR\brefunded\x12.\n" +
+	"\bdisputed\x18\v \x01(\v2\x12.google.type.MoneyR\bdisputed\x12.\n" +
+	"\breversed\x18\f \x01(\v2\x12.google.type.MoneyR\breversed\x12=\n" +
+	"\x10pending_refunded\x18\r \x01(\v2\x12.google.type.MoneyR\x0fpendingRefunded\x12.\n" +
+	"\breleased\x18\x0e \x01(\v2\x12.google.type.MoneyR\breleased\x12&\n" +
+	"\x04fees\x18\x0f \x01(\v2\x12.google.type.MoneyR\x04fees\x12$\n" +
+	"\x03net\x18\x10 \x01(\v2\x12.google.type.MoneyR\x03net\x129\n" +
+	"\n" +
+	"settled_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tsettledAt\"\xc3\x04\n" +
+	"\rCreateRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\tR\tinvoiceId\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x120\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x1c.domain.event.v1.PaymentKindR\x04kind\x120\n" +
+	"\x04mode\x18\x05 \x01(\x0e2\x1c.domain.event.v1.CaptureModeR\x04mode\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12D\n" +
+	"\bmetadata\x18\a \x03(\v2(.payments.v1.CreateRequest.MetadataEntryR\bmetadata\x12\x1d\n" +
+	"\n" +
+	"return_url\x18\b \x01(\tR\treturnUrl\x12'\n" +
+	"\x0fidempotency_key\x18\t \x01(\tR\x0eidempotencyKey\x12'\n" +
+	"\x0fcustomer_region\x18\n" +
+	" \x01(\tR\x0ecustomerRegion\x12-\n" +
+	"\x12merchant_initiated\x18\v \x01(\bR\x11merchantInitiated\x12\x1f\n" +
+	"\vmerchant_id\x18\f \x01(\tR\n" +
+	"merchantId\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8a\x01\n" +
+	"\x0eCreateResponse\x12.\n" +
+	"\apayment\x18\x01 \x01(\v2\x14.payments.v1.PaymentR\apayment\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12#\n" +
+	"\rsca_requested\x18\x03 \x01(\bR\fscaRequested\"+\n" +
+	"\n" +
+	"GetRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\"=\n" +
+	"\vGetResponse\x12.\n" +
+	"\apayment\x18\x01 \x01(\v2\x14.payments.v1.PaymentR\apayment\"\xbd\x02\n" +
+	"\rRefundRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x125\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x1d.domain.event.v1.RefundReasonR\x06reason\x12D\n" +
+	"\bmetadata\x18\x04 \x03(\v2(.payments.v1.RefundRequest.MetadataEntryR\bmetadata\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc8\x02\n" +
+	"\x0eRefundResponse\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x1b\n" +
+	"\trefund_id\x18\x02 \x01(\tR\brefundId\x127\n" +
+	"\rrefund_amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\frefundAmount\x129\n" +
+	"\x0etotal_refunded\x18\x04 \x01(\v2\x12.google.type.MoneyR\rtotalRefunded\x12\x1f\n" +
+	"\vfull_refund\x18\x05 \x01(\bR\n" +
+	"fullRefund\x121\n" +
+	"\x05state\x18\x06 \x01(\x0e2\x1b.domain.flow.v1.PaymentFlowR\x05state\x12\x18\n" +
+	"\aversion\x18\a \x01(\x04R\aversion\x12\x18\n" +
+	"\apending\x18\b \x01(\bR\apending\"\xf5\x01\n" +
+	"\x0eCaptureRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x12E\n" +
+	"\bmetadata\x18\x03 \x03(\v2).payments.v1.CaptureRequest.MetadataEntryR\bmetadata\x12\x14\n" +
+	"\x05final\x18\x04 \x01(\bR\x05final\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf8\x02\n" +
+	"\x0fCaptureResponse\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12;\n" +
+	"\x0fcaptured_amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x0ecapturedAmount\x129\n" +
+	"\x0etotal_captured\x18\x03 \x01(\v2\x12.google.type.MoneyR\rtotalCaptured\x12D\n" +
+	"\x14remaining_to_capture\x18\x04 \x01(\v2\x12.google.type.MoneyR\x12remainingToCapture\x121\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x1b.domain.flow.v1.PaymentFlowR\x05state\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x04R\aversion\x12;\n" +
+	"\x0freleased_amount\x18\a \x01(\v2\x12.google.type.MoneyR\x0ereleasedAmount\"\xfb\x01\n" +
+	"\x1cIncreaseAuthorizationRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x12S\n" +
+	"\bmetadata\x18\x03 \x03(\v27.payments.v1.IncreaseAuthorizationRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb5\x02\n" +
+	"\x1dIncreaseAuthorizationResponse\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12=\n" +
+	"\x10increment_amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x0fincrementAmount\x12=\n" +
+	"\x10total_authorized\x18\x03 \x01(\v2\x12.google.type.MoneyR\x0ftotalAuthorized\x12*\n" +
+	"\x06amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\x06amount\x121\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x1b.domain.flow.v1.PaymentFlowR\x05state\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x04R\aversion\"/\n" +
+	"\x0eConfirmRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\"}\n" +
+	"\x0fConfirmResponse\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x121\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1b.domain.flow.v1.PaymentFlowR\x05state\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"e\n" +
+	"\rCancelRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x125\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x1d.domain.event.v1.CancelReasonR\x06reason\"\xb3\x01\n" +
+	"\x0eCancelResponse\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x125\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x1d.domain.event.v1.CancelReasonR\x06reason\x121\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x1b.domain.flow.v1.PaymentFlowR\x05state\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x04R\aversion\"5\n" +
+	"\x14ListByInvoiceRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\"I\n" +
+	"\x15ListByInvoiceResponse\x120\n" +
+	"\bpayments\x18\x01 \x03(\v2\x14.payments.v1.PaymentR\bpayments\"R\n" +
+	"\x17ImportSettlementRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1b\n" +
+	"\tpayout_id\x18\x02 \x01(\tR\bpayoutId\"\xc7\x01\n" +
+	"\x18ImportSettlementResponse\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\tR\bpayoutId\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x05R\bimported\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x03 \x01(\x05R\n" +
+	"duplicates\x12\x1c\n" +
+	"\tunmatched\x18\x04 \x01(\x05R\tunmatched\x12\x18\n" +
+	"\askipped\x18\x05 \x01(\x05R\askipped\x12\x1a\n" +
+	"\brejected\x18\x06 \x01(\x05R\brejected\"3\n" +
+	"\x14GetSettlementRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\tR\bpayoutId\"\xa2\x03\n" +
+	"\n" +
+	"Settlement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x02 \x01(\tR\tpaymentId\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x123\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1f.domain.event.v1.SettlementTypeR\x04type\x12*\n" +
+	"\x06amount\x18\x05 \x01(\v2\x12.google.type.MoneyR\x06amount\x12(\n" +
+	"\x05gross\x18\x06 \x01(\v2\x12.google.type.MoneyR\x05gross\x12$\n" +
+	"\x03fee\x18\a \x01(\v2\x12.google.type.MoneyR\x03fee\x127\n" +
+	"\rfx_adjustment\x18\b \x01(\v2\x12.google.type.MoneyR\ffxAdjustment\x12$\n" +
+	"\x03net\x18\t \x01(\v2\x12.google.type.MoneyR\x03net\x129\n" +
+	"\n" +
+	"settled_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tsettledAt\"\xe7\x01\n" +
+	"\x15GetSettlementResponse\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\tR\bpayoutId\x129\n" +
+	"\vsettlements\x18\x02 \x03(\v2\x17.payments.v1.SettlementR\vsettlements\x12(\n" +
+	"\x05gross\x18\x03 \x01(\v2\x12.google.type.MoneyR\x05gross\x12&\n" +
+	"\x04fees\x18\x04 \x01(\v2\x12.google.type.MoneyR\x04fees\x12$\n" +
+	"\x03net\x18\x05 \x01(\v2\x12.google.type.MoneyR\x03net2\xa0\x06\n" +
+	"\x0ePaymentService\x12A\n" +
+	"\x06Create\x12\x1a.payments.v1.CreateRequest\x1a\x1b.payments.v1.CreateResponse\x128\n" +
+	"\x03Get\x12\x17.payments.v1.GetRequest\x1a\x18.payments.v1.GetResponse\x12A\n" +
+	"\x06Refund\x12\x1a.payments.v1.RefundRequest\x1a\x1b.payments.v1.RefundResponse\x12D\n" +
+	"\aCapture\x12\x1b.payments.v1.CaptureRequest\x1a\x1c.payments.v1.CaptureResponse\x12n\n" +
+	"\x15IncreaseAuthorization\x12).payments.v1.IncreaseAuthorizationRequest\x1a*.payments.v1.IncreaseAuthorizationResponse\x12D\n" +
+	"\aConfirm\x12\x1b.payments.v1.ConfirmRequest\x1a\x1c.payments.v1.ConfirmResponse\x12A\n" +
+	"\x06Cancel\x12\x1a.payments.v1.CancelRequest\x1a\x1b.payments.v1.CancelResponse\x12V\n" +
+	"\rListByInvoice\x12!.payments.v1.ListByInvoiceRequest\x1a\".payments.v1.ListByInvoiceResponse\x12_\n" +
+	"\x10ImportSettlement\x12$.payments.v1.ImportSettlementRequest\x1a%.payments.v1.ImportSettlementResponse\x12V\n" +
+	"\rGetSettlement\x12!.payments.v1.GetSettlementRequest\x1a\".payments.v1.GetSettlementResponseB\xbe\x01\n" +
+	"\x0fcom.payments.v1B\x13PaymentServiceProtoP\x01ZIgithub.com/shortlink-org/billing/payments/internal/payments/v1;paymentsv1\xa2\x02\x03PXX\xaa\x02\vPayments.V1\xca\x02\vPayments\\V1\xe2\x02\x17Payments\\V1\\GPBMetadata\xea\x02\fPayments::V1b\x06proto3"
+
+var (
+	file_payments_v1_payment_service_proto_rawDescOnce sync.Once
+	file_payments_v1_payment_service_proto_rawDescData []byte
+)
+
+func file_payments_v1_payment_service_proto_rawDescGZIP() []byte {
+	file_payments_v1_payment_service_proto_rawDescOnce.Do(func() {
+		file_payments_v1_payment_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_payments_v1_payment_service_proto_rawDesc), len(file_payments_v1_payment_service_proto_rawDesc)))
+	})
+	return file_payments_v1_payment_service_proto_rawDescData
+}
+
+var file_payments_v1_payment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_payments_v1_payment_service_proto_goTypes = []any{
+	(*Payment)(nil),                       // 0: payments.v1.Payment
+	(*CreateRequest)(nil),                 // 1: payments.v1.CreateRequest
+	(*CreateResponse)(nil),                // 2: payments.v1.CreateResponse
+	(*GetRequest)(nil),                    // 3: payments.v1.GetRequest
+	(*GetResponse)(nil),                   // 4: payments.v1.GetResponse
+	(*RefundRequest)(nil),                 // 5: payments.v1.RefundRequest
+	(*RefundResponse)(nil),                // 6: payments.v1.RefundResponse
+	(*CaptureRequest)(nil),                // 7: payments.v1.CaptureRequest
+	(*CaptureResponse)(nil),               // 8: payments.v1.CaptureResponse
+	(*IncreaseAuthorizationRequest)(nil),  // 9: payments.v1.IncreaseAuthorizationRequest
+	(*IncreaseAuthorizationResponse)(nil), // 10: payments.v1.IncreaseAuthorizationResponse
+	(*ConfirmRequest)(nil),                // 11: payments.v1.ConfirmRequest
+	(*ConfirmResponse)(nil),               // 12: payments.v1.ConfirmResponse
+	(*CancelRequest)(nil),                 // 13: payments.v1.CancelRequest
+	(*CancelResponse)(nil),                // 14: payments.v1.CancelResponse
+	(*ListByInvoiceRequest)(nil),          // 15: payments.v1.ListByInvoiceRequest
+	(*ListByInvoiceResponse)(nil),         // 16: payments.v1.ListByInvoiceResponse
+	(*ImportSettlementRequest)(nil),       // 17: payments.v1.ImportSettlementRequest
+	(*ImportSettlementResponse)(nil),      // 18: payments.v1.ImportSettlementResponse
+	(*GetSettlementRequest)(nil),          // 19: payments.v1.GetSettlementRequest
+	(*Settlement)(nil),                    // 20: payments.v1.Settlement
+	(*GetSettlementResponse)(nil),         // 21: payments.v1.GetSettlementResponse
+	nil,                                   // 22: payments.v1.CreateRequest.MetadataEntry
+	nil,                                   // 23: payments.v1.RefundRequest.MetadataEntry
+	nil,                                   // 24: payments.v1.CaptureRequest.MetadataEntry
+	nil,                                   // 25: payments.v1.IncreaseAuthorizationRequest.MetadataEntry
+	(v1.PaymentFlow)(0),                   // 26: domain.flow.v1.PaymentFlow
+	(*money.Money)(nil),                   // 27: google.type.Money
+	(*timestamppb.Timestamp)(nil),         // 28: google.protobuf.Timestamp
+	(v11.PaymentKind)(0),                  // 29: domain.event.v1.PaymentKind
+	(v11.CaptureMode)(0),                  // 30: domain.event.v1.CaptureMode
+	(v11.RefundReason)(0),                 // 31: domain.event.v1.RefundReason
+	(v11.CancelReason)(0),                 // 32: domain.event.v1.CancelReason
+	(v11.SettlementType)(0),               // 33: domain.event.v1.SettlementType
+}
+var file_payments_v1_payment_service_proto_depIdxs = []int32{
+	26, // 0: payments.v1.Payment.state:type_name -> domain.flow.v1.PaymentFlow
+	27, // 1: payments.v1.Payment.amount:type_name -> google.type.Money
+	27, // 2: payments.v1.Payment.authorized:type_name -> google.type.Money
+	27, // 3: payments.v1.Payment.captured:type_name -> google.type.Money
+	27, // 4: payments.v1.Payment.refunded:type_name -> google.type.Money
+	27, // 5: payments.v1.Payment.disputed:type_name -> google.type.Money
+	27, // 6: payments.v1.Payment.reversed:type_name -> google.type.Money
+	27, // 7: payments.v1.Payment.pending_refunded:type_name -> google.type.Money
+	27, // 8: payments.v1.Payment.released:type_name -> google.type.Money
+	27, // 9: payments.v1.Payment.fees:type_name -> google.type.Money
+	27, // 10: payments.v1.Payment.net:type_name -> google.type.Money
+	28, // 11: payments.v1.Payment.settled_at:type_name -> google.protobuf.Timestamp
+	27, // 12: payments.v1.CreateRequest.amount:type_name -> google.type.Money
+	29, // 13: payments.v1.CreateRequest.kind:type_name -> domain.event.v1.PaymentKind
+	30, // 14: payments.v1.CreateRequest.mode:type_name -> domain.event.v1.CaptureMode
+	22, // 15: payments.v1.CreateRequest.metadata:type_name -> payments.v1.CreateRequest.MetadataEntry
+	0,  // 16: payments.v1.CreateResponse.payment:type_name -> payments.v1.Payment
+	0,  // 17: payments.v1.GetResponse.payment:type_name -> payments.v1.Payment
+	27, // 18: payments.v1.RefundRequest.amount:type_name -> google.type.Money
+	31, // 19: payments.v1.RefundRequest.reason:type_name -> domain.event.v1.RefundReason
+	23, // 20: payments.v1.RefundRequest.metadata:type_name -> payments.v1.RefundRequest.MetadataEntry
+	27, // 21: payments.v1.RefundResponse.refund_amount:type_name -> google.type.Money
+	27, // 22: payments.v1.RefundResponse.total_refunded:type_name -> google.type.Money
+	26, // 23: payments.v1.RefundResponse.state:type_name -> domain.flow.v1.PaymentFlow
+	27, // 24: payments.v1.CaptureRequest.amount:type_name -> google.type.Money
+	24, // 25: payments.v1.CaptureRequest.metadata:type_name -> payments.v1.CaptureRequest.MetadataEntry
+	27, // 26: payments.v1.CaptureResponse.captured_amount:type_name -> google.type.Money
+	27, // 27: payments.v1.CaptureResponse.total_captured:type_name -> google.type.Money
+	27, // 28: payments.v1.CaptureResponse.remaining_to_capture:type_name -> google.type.Money
+	26, // 29: payments.v1.CaptureResponse.state:type_name -> domain.flow.v1.PaymentFlow
+	27, // 30: payments.v1.CaptureResponse.released_amount:type_name -> google.type.Money
+	27, // 31: payments.v1.IncreaseAuthorizationRequest.amount:type_name -> google.type.Money
+	25, // 32: payments.v1.IncreaseAuthorizationRequest.metadata:type_name -> payments.v1.IncreaseAuthorizationRequest.MetadataEntry
+	27, // 33: payments.v1.IncreaseAuthorizationResponse.increment_amount:type_name -> google.type.Money
+	27, // 34: payments.v1.IncreaseAuthorizationResponse.total_authorized:type_name -> google.type.Money
+	27, // 35: payments.v1.IncreaseAuthorizationResponse.amount:type_name -> google.type.Money
+	26, // 36: payments.v1.IncreaseAuthorizationResponse.state:type_name -> domain.flow.v1.PaymentFlow
+	26, // 37: payments.v1.ConfirmResponse.state:type_name -> domain.flow.v1.PaymentFlow
+	32, // 38: payments.v1.CancelRequest.reason:type_name -> domain.event.v1.CancelReason
+	32, // 39: payments.v1.CancelResponse.reason:type_name -> domain.event.v1.CancelReason
+	26, // 40: payments.v1.CancelResponse.state:type_name -> domain.flow.v1.PaymentFlow
+	0,  // 41: payments.v1.ListByInvoiceResponse.payments:type_name -> payments.v1.Payment
+	33, // 42: payments.v1.Settlement.type:type_name -> domain.event.v1.SettlementType
+	27, // 43: payments.v1.Settlement.amount:type_name -> google.type.Money
+	27, // 44: payments.v1.Settlement.gross:type_name -> google.type.Money
+	27, // 45: payments.v1.Settlement.fee:type_name -> google.type.Money
+	27, // 46: payments.v1.Settlement.fx_adjustment:type_name -> google.type.Money
+	27, // 47: payments.v1.Settlement.net:type_name -> google.type.Money
+	28, // 48: payments.v1.Settlement.settled_at:type_name -> google.protobuf.Timestamp
+	20, // 49: payments.v1.GetSettlementResponse.settlements:type_name -> payments.v1.Settlement
+	27, // 50: payments.v1.GetSettlementResponse.gross:type_name -> google.type.Money
+	27, // 51: payments.v1.GetSettlementResponse.fees:type_name -> google.type.Money
+	27, // 52: payments.v1.GetSettlementResponse.net:type_name -> google.type.Money
+	1,  // 53: payments.v1.PaymentService.Create:input_type -> payments.v1.CreateRequest
+	3,  // 54: payments.v1.PaymentService.Get:input_type -> payments.v1.GetRequest
+	5,  // 55: payments.v1.PaymentService.Refund:input_type -> payments.v1.RefundRequest
+	7,  // 56: payments.v1.PaymentService.Capture:input_type -> payments.v1.CaptureRequest
+	9,  // 57: payments.v1.PaymentService.IncreaseAuthorization:input_type -> payments.v1.IncreaseAuthorizationRequest
+	11, // 58: payments.v1.PaymentService.Confirm:input_type -> payments.v1.ConfirmRequest
+	13, // 59: payments.v1.PaymentService.Cancel:input_type -> payments.v1.CancelRequest
+	15, // 60: payments.v1.PaymentService.ListByInvoice:input_type -> payments.v1.ListByInvoiceRequest
+	17, // 61: payments.v1.PaymentService.ImportSettlement:input_type -> payments.v1.ImportSettlementRequest
+	19, // 62: payments.v1.PaymentService.GetSettlement:input_type -> payments.v1.GetSettlementRequest
+	2,  // 63: payments.v1.PaymentService.Create:output_type -> payments.v1.CreateResponse
+	4,  // 64: payments.v1.PaymentService.Get:output_type -> payments.v1.GetResponse
+	6,  // 65: payments.v1.PaymentService.Refund:output_type -> payments.v1.RefundResponse
+	8,  // 66: payments.v1.PaymentService.Capture:output_type -> payments.v1.CaptureResponse
+	10, // 67: payments.v1.PaymentService.IncreaseAuthorization:output_type -> payments.v1.IncreaseAuthorizationResponse
+	12, // 68: payments.v1.PaymentService.Confirm:output_type -> payments.v1.ConfirmResponse
+	14, // 69: payments.v1.PaymentService.Cancel:output_type -> payments.v1.CancelResponse
+	16, // 70: payments.v1.PaymentService.ListByInvoice:output_type -> payments.v1.ListByInvoiceResponse
+	18, // 71: payments.v1.PaymentService.ImportSettlement:output_type -> payments.v1.ImportSettlementResponse
+	21, // 72: payments.v1.PaymentService.GetSettlement:output_type -> payments.v1.GetSettlementResponse
+	63, // [63:73] is the sub-list for method output_type
+	53, // [53:63] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
+}
+
+func init() { file_payments_v1_payment_service_proto_init() }
+func file_payments_v1_payment_service_proto_init() {
+	if File_payments_v1_payment_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payments_v1_payment_service_proto_rawDesc), len(file_payments_v1_payment_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_payments_v1_payment_service_proto_goTypes,
+		DependencyIndexes: file_payments_v1_payment_service_proto_depIdxs,
+		MessageInfos:      file_payments_v1_payment_service_proto_msgTypes,
+	}.Build()
+	File_payments_v1_payment_service_proto = out.File
+	file_payments_v1_payment_service_proto_goTypes = nil
+	file_payments_v1_payment_service_proto_depIdxs = nil
+}